@@ -39,6 +39,9 @@ type Clients interface {
 
 	// Returns true if either user is following the other.
 	AreConnected(ctx context.Context, userA, userB ct.Id) (bool, *ce.Error)
+
+	// Returns true if recipient's privacy settings allow sender to message them.
+	CanMessage(ctx context.Context, senderId, recipientId ct.Id) (bool, *ce.Error)
 }
 
 func NewChatService(
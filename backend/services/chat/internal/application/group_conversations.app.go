@@ -113,6 +113,22 @@ func (c *ChatService) GetNextGroupMessages(ctx context.Context,
 	return res, nil
 }
 
+// Closes the conversation of a deleted group.
+// Called on the GroupDeleted event, no membership check is made.
+func (c *ChatService) CloseGroupConversation(ctx context.Context,
+	groupId ct.Id) *ce.Error {
+	input := fmt.Sprintf("groupId: %v", groupId)
+
+	if err := groupId.Validate(); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, input)
+	}
+
+	if err := c.Queries.CloseGroupConversation(ctx, groupId); err != nil {
+		return ce.Wrap(nil, err, input)
+	}
+	return nil
+}
+
 func (c *ChatService) retrieveMessageSenders(ctx context.Context, msgs []md.GroupMsg, input string) error {
 	allMemberIDs := make(ct.Ids, 0)
 	for _, r := range msgs {
@@ -13,7 +13,8 @@ import (
 )
 
 var (
-	ErrNotConnected = errors.New("users are not connected")
+	ErrNotConnected        = errors.New("users are not connected")
+	ErrMessagingNotAllowed = errors.New("recipient's privacy settings don't allow messages from sender")
 )
 
 func (c *ChatService) GetPrivateConversationById(ctx context.Context,
@@ -74,14 +75,20 @@ func (c *ChatService) GetPrivateConversations(ctx context.Context,
 		return nil, ce.Wrap(nil, err, input)
 	}
 
-	for i := range conversations {
-		retrieved := usersMap[conversations[i].Interlocutor.UserId]
-		conversations[i].Interlocutor.Username = retrieved.Username
-		conversations[i].Interlocutor.AvatarId = retrieved.AvatarId
-		conversations[i].Interlocutor.AvatarURL = retrieved.AvatarURL
+	// interlocutors missing from the map are deactivated and stay hidden
+	visible := make([]md.PrivateConvsPreview, 0, len(conversations))
+	for _, conv := range conversations {
+		retrieved, ok := usersMap[conv.Interlocutor.UserId]
+		if !ok {
+			continue
+		}
+		conv.Interlocutor.Username = retrieved.Username
+		conv.Interlocutor.AvatarId = retrieved.AvatarId
+		conv.Interlocutor.AvatarURL = retrieved.AvatarURL
+		visible = append(visible, conv)
 	}
 
-	return conversations, nil
+	return visible, nil
 }
 
 func (c *ChatService) GetConvsWithUnreadsCount(ctx context.Context, userId ct.Id) (count int, err error) {
@@ -108,6 +115,15 @@ func (c *ChatService) CreatePrivateMessage(ctx context.Context,
 	if !areConnected {
 		return msg, ce.New(ce.ErrPermissionDenied, ErrNotConnected, input).WithPublic("users are not connected")
 	}
+
+	canMessage, Err := c.Clients.CanMessage(ctx, arg.SenderId, arg.InterlocutorId)
+	if Err != nil {
+		return msg, Err
+	}
+	if !canMessage {
+		return msg, ce.New(ce.ErrPermissionDenied, ErrMessagingNotAllowed, input).WithPublic("this user doesn't accept messages from you")
+	}
+
 	c.txRunner.RunTx(ctx, func(q *dbservice.Queries) error {
 		msg, err = q.CreateNewPrivateMessage(ctx, arg)
 		if err != nil {
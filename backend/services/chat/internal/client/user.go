@@ -54,3 +54,16 @@ func (c *Clients) AreConnected(ctx context.Context, userA, userB ct.Id) (bool, *
 	connected := resp.FollowerFollowsTarget || resp.TargetFollowsFollower
 	return connected, nil
 }
+
+func (c *Clients) CanMessage(ctx context.Context, senderId, recipientId ct.Id) (bool, *ce.Error) {
+	input := fmt.Sprintf("senderId: %v, recipientId: %v", senderId, recipientId)
+	resp, err := c.UserClient.CanInteract(ctx, &users.CanInteractRequest{
+		ActorId:  senderId.Int64(),
+		TargetId: recipientId.Int64(),
+		Action:   ct.ActionMessage.String(),
+	})
+	if err != nil {
+		return false, ce.DecodeProto(err, input)
+	}
+	return resp.GetValue(), nil
+}
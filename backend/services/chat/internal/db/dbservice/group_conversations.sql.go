@@ -136,3 +136,12 @@ func (q *Queries) GetNextGroupMessages(ctx context.Context,
 
 	return res, nil
 }
+
+func (q *Queries) CloseGroupConversation(ctx context.Context, groupId ct.Id) error {
+	input := fmt.Sprintf("groupId: %v", groupId)
+
+	if _, err := q.db.Exec(ctx, closeGroupConversation, groupId); err != nil {
+		return ce.New(ce.ErrInternal, err, input)
+	}
+	return nil
+}
@@ -94,6 +94,10 @@ type Querier interface {
 	// Gets paginated group messages that are updated after a given date time.
 	GetNextGroupMessages(ctx context.Context,
 		req md.GetGroupMsgsReq) (msgs md.GetGroupMsgsResp, err error)
+
+	// Marks the conversation of a deleted group as deleted.
+	// Messages can no longer be created or fetched. Idempotent.
+	CloseGroupConversation(ctx context.Context, groupId ct.Id) error
 }
 
 var _ Querier = (*Queries)(nil)
@@ -69,6 +69,15 @@ const (
     LIMIT $3;
 	`
 
+	// Inserts the conversation as already closed if no message was ever sent.
+	closeGroupConversation = `
+	INSERT INTO group_conversations (group_id, deleted_at)
+	VALUES ($1, CURRENT_TIMESTAMP)
+	ON CONFLICT (group_id) DO UPDATE
+		SET deleted_at = CURRENT_TIMESTAMP
+	WHERE group_conversations.deleted_at IS NULL;
+	`
+
 	// ====================================
 	// PRIVATE_CONVERSATIONS
 	// ====================================
@@ -11,10 +11,12 @@ import (
 	"social-network/services/chat/internal/handler"
 	"social-network/shared/gen-go/chat"
 	"social-network/shared/gen-go/media"
+	"social-network/shared/gen-go/notifications"
 	"social-network/shared/gen-go/users"
 	configutil "social-network/shared/go/configs"
 	"social-network/shared/go/ct"
 	"social-network/shared/go/gorpc"
+	"social-network/shared/go/kafgo"
 	"social-network/shared/go/models"
 	postgresql "social-network/shared/go/postgre"
 	rds "social-network/shared/go/redis"
@@ -27,6 +29,7 @@ import (
 
 	"github.com/dgraph-io/ristretto/v2"
 	"github.com/nats-io/nats.go"
+	"google.golang.org/protobuf/proto"
 )
 
 type configs struct {
@@ -45,7 +48,7 @@ type configs struct {
 	TelemetryCollectorAddress string   `env:"OTEL_EXPORTER_OTLP_ENDPOINT"`
 	NatsHost                  string   `env:"NATS_HOST"`
 	NatsCluster               string   `env:"NATS_CLUSTER"`
-	KafkaBrokers              []string `end:"KAFKA_BROKERS"`
+	KafkaBrokers              []string `env:"KAFKA_BROKERS"`
 }
 
 var cfgs configs
@@ -156,6 +159,14 @@ func Run() error {
 		tele.Fatalf("failed to create chat service application: %s", err.Error())
 	}
 
+	//
+	//
+	//
+	// KAFKA CONSUMER
+	if err := startKafkaConsumer(ctx, app); err != nil {
+		tele.Fatalf("failed to start kafka consumer: %s", err.Error())
+	}
+
 	handler := handler.NewChatHandler(app)
 
 	//
@@ -198,6 +209,71 @@ func Run() error {
 	return nil
 }
 
+// startKafkaConsumer listens to the notification topic for the events chat
+// has to act on. Everything else on the topic is committed and skipped.
+func startKafkaConsumer(ctx context.Context, app *application.ChatService) error {
+	kafkaConsumer, err := kafgo.NewKafkaConsumer(
+		cfgs.KafkaBrokers,
+		"chat", // Consumer group name for chat
+		ct.NotificationTopic,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create kafka consumer: %w", err)
+	}
+	kafkaConsumer = kafkaConsumer.WithCommitBuffer(100)
+
+	eventChannel, closeConsumer, err := kafkaConsumer.StartConsuming(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start kafka consumer: %w", err)
+	}
+
+	go func() {
+		defer closeConsumer()
+		for {
+			select {
+			case <-ctx.Done():
+				tele.Info(ctx, "kafka listener context done")
+				return
+			case record, ok := <-eventChannel:
+				if !ok {
+					tele.Info(ctx, "kafka event channel closed")
+					return
+				}
+
+				if err := processEvent(ctx, record, app); err != nil {
+					tele.Error(ctx, "failed to process kafka event", "error", err.Error())
+					// Don't commit the record if processing failed
+					continue
+				}
+
+				if err := record.Commit(ctx); err != nil {
+					tele.Error(ctx, "failed to commit kafka record", "error", err)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// processEvent handles a single event from the notification topic
+func processEvent(ctx context.Context, record *kafgo.Record, app *application.ChatService) error {
+	var event notifications.NotificationEvent
+	if err := proto.Unmarshal(record.Data(ctx), &event); err != nil {
+		return fmt.Errorf("failed to unmarshal protobuf notification event: %w", err)
+	}
+
+	switch event.EventType {
+	case notifications.EventType_GROUP_DELETED:
+		groupId := ct.Id(event.GetGroupDeleted().GetGroupId())
+		if err := app.CloseGroupConversation(ctx, groupId); err != nil {
+			return err
+		}
+		tele.Info(ctx, "closed conversation of deleted group @1", "groupId", groupId)
+	}
+	return nil
+}
+
 func initClients() *client.Clients {
 	//
 	//
@@ -27,8 +27,9 @@ func (h *Handlers) createComment() http.HandlerFunc {
 		}
 
 		type CreateCommentJSONRequest struct {
-			ParentId ct.Id          `json:"parent_id"`
-			Body     ct.CommentBody `json:"comment_body"`
+			ParentId  ct.Id          `json:"parent_id"`
+			Body      ct.CommentBody `json:"comment_body"`
+			ReplyToId ct.Id          `json:"reply_to_id" validate:"nullable"` // comment of the same post, left out for a top level comment
 
 			ImageName string `json:"image_name"`
 			ImageSize int64  `json:"image_size"`
@@ -85,6 +86,7 @@ func (h *Handlers) createComment() http.HandlerFunc {
 			ParentId:  httpReq.ParentId.Int64(),
 			Body:      httpReq.Body.String(),
 			ImageId:   ImageId.Int64(),
+			ReplyToId: httpReq.ReplyToId.Int64(),
 		}
 
 		commentId, err := h.PostsService.CreateComment(ctx, &grpcReq)
@@ -163,11 +165,19 @@ func (h *Handlers) getCommentsByParentId() http.HandlerFunc {
 					AvatarURL: c.User.AvatarUrl,
 				},
 				ReactionsCount: int(c.ReactionsCount),
+				ReactionCounts: reactionCountsFromPb(c.ReactionCounts),
 				CreatedAt:      ct.GenDateTime(c.CreatedAt.AsTime()),
 				UpdatedAt:      ct.GenDateTime(c.UpdatedAt.AsTime()),
+				Edited:         c.Edited,
 				LikedByUser:    c.LikedByUser,
+				UserReaction:   c.UserReaction,
+				Mentions:       mentionsFromPb(c.Mentions),
 				ImageId:        ct.Id(c.ImageId),
 				ImageUrl:       c.ImageUrl,
+				ReplyToId:      ct.Id(c.ReplyToId),
+				Depth:          int(c.Depth),
+				RepliesCount:   int(c.RepliesCount),
+				Deleted:        c.Deleted,
 			}
 			commentsResponse = append(commentsResponse, comment)
 		}
@@ -0,0 +1,322 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"social-network/shared/gen-go/media"
+	"social-network/shared/gen-go/posts"
+	ct "social-network/shared/go/ct"
+	utils "social-network/shared/go/http-utils"
+	"social-network/shared/go/jwt"
+	"social-network/shared/go/models"
+	tele "social-network/shared/go/telemetry"
+)
+
+// the requester's draft with the given id, not found if it isn't one of their live drafts
+func (h *Handlers) findDraft(ctx context.Context, requesterId, draftId ct.Id) (*posts.Draft, error) {
+	resp, err := h.PostsService.GetDrafts(ctx, &posts.GenericPaginatedReq{
+		RequesterId: requesterId.Int64(),
+		Limit:       ct.MaxDraftsPerUser,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range resp.Drafts {
+		if d.DraftId == draftId.Int64() {
+			return d, nil
+		}
+	}
+	return nil, nil
+}
+
+func (h *Handlers) createDraft() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tele.Info(ctx, "createDraft handler called")
+
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		// everything but the kind, and the parent post for comment drafts, can be left empty until publishing
+		type CreateDraftJSONRequest struct {
+			Kind        ct.DraftKind    `json:"kind"`
+			ParentId    ct.Id           `json:"parent_id" validate:"nullable"`
+			Body        ct.PostBody     `json:"body" validate:"nullable"`
+			GroupId     ct.Id           `json:"group_id" validate:"nullable"`
+			Audience    ct.Audience     `json:"audience" validate:"nullable"`
+			AudienceIds ct.Ids          `json:"audience_ids" validate:"nullable"`
+			Images      []postImageJSON `json:"images"` // gallery in display order
+		}
+
+		httpReq := CreateDraftJSONRequest{}
+
+		decoder := json.NewDecoder(r.Body)
+		defer r.Body.Close()
+		if err := decoder.Decode(&httpReq); err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if err := ct.ValidateStruct(httpReq); err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// a new draft has no images to keep, only uploads
+		if err := validatePostImages(httpReq.Images, nil); err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// the audience can still change before publishing
+		imageIds, uploads, err := h.uploadPostImages(ctx, httpReq.Images, media.FileVisibility_PRIVATE)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		draftId, err := h.PostsService.CreateDraft(ctx, &posts.CreateDraftReq{
+			CreatorId:   int64(claims.UserId),
+			Kind:        httpReq.Kind.String(),
+			Body:        httpReq.Body.String(),
+			ParentId:    httpReq.ParentId.Int64(),
+			GroupId:     httpReq.GroupId.Int64(),
+			Audience:    httpReq.Audience.String(),
+			AudienceIds: httpReq.AudienceIds.Int64(),
+			ImageIds:    imageIds.Int64(),
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+		type httpResponse struct {
+			DraftId ct.Id
+			Uploads []postImageUpload
+		}
+		utils.WriteJSON(ctx, w, http.StatusOK, httpResponse{
+			DraftId: ct.Id(draftId.Id),
+			Uploads: uploads,
+		})
+	}
+}
+
+// replaces the whole content of a draft, fields left out are cleared
+func (h *Handlers) updateDraft() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tele.Info(ctx, "updateDraft handler called")
+
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		// kind and parent are fixed on creation
+		type UpdateDraftJSONRequest struct {
+			Body        ct.PostBody     `json:"body" validate:"nullable"`
+			GroupId     ct.Id           `json:"group_id" validate:"nullable"`
+			Audience    ct.Audience     `json:"audience" validate:"nullable"`
+			AudienceIds ct.Ids          `json:"audience_ids" validate:"nullable"`
+			Images      []postImageJSON `json:"images"` // new gallery in display order, replaces the current one
+		}
+
+		httpReq := UpdateDraftJSONRequest{}
+
+		decoder := json.NewDecoder(r.Body)
+		defer r.Body.Close()
+		if err := decoder.Decode(&httpReq); err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		draftId, err := utils.PathValueGet(r, "draft_id", ct.Id(0), true)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		if err := ct.ValidateStruct(httpReq); err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// images kept from the current gallery must really belong to the draft
+		var currentImageIds ct.Ids
+		for _, img := range httpReq.Images {
+			if img.ImageSize == 0 && img.ImageId != 0 {
+				current, err := h.findDraft(ctx, ct.Id(claims.UserId), draftId)
+				if err != nil {
+					utils.ReturnHttpError(ctx, w, err)
+					return
+				}
+				if current == nil {
+					utils.ErrorJSON(ctx, w, http.StatusNotFound, "draft not found")
+					return
+				}
+				currentImageIds = ct.FromInt64s(current.ImageIds)
+				break
+			}
+		}
+		if err := validatePostImages(httpReq.Images, currentImageIds); err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		imageIds, uploads, err := h.uploadPostImages(ctx, httpReq.Images, media.FileVisibility_PRIVATE)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		_, err = h.PostsService.UpdateDraft(ctx, &posts.UpdateDraftReq{
+			RequesterId: int64(claims.UserId),
+			DraftId:     draftId.Int64(),
+			Body:        httpReq.Body.String(),
+			GroupId:     httpReq.GroupId.Int64(),
+			Audience:    httpReq.Audience.String(),
+			AudienceIds: httpReq.AudienceIds.Int64(),
+			ImageIds:    imageIds.Int64(),
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+		type httpResponse struct {
+			Uploads []postImageUpload
+		}
+		utils.WriteJSON(ctx, w, http.StatusOK, httpResponse{Uploads: uploads})
+	}
+}
+
+// the requester's drafts, most recently saved first
+func (h *Handlers) getDrafts() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tele.Info(ctx, "getDrafts handler called")
+
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		v := r.URL.Query()
+		limit, err1 := utils.ParamGet(v, "limit", int32(1), false)
+		offset, err2 := utils.ParamGet(v, "offset", int32(0), false)
+		if err := errors.Join(err1, err2); err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		grpcResp, err := h.PostsService.GetDrafts(ctx, &posts.GenericPaginatedReq{
+			RequesterId: claims.UserId,
+			Limit:       limit,
+			Offset:      offset,
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		draftsResponse := []models.Draft{}
+		for _, d := range grpcResp.Drafts {
+			draftsResponse = append(draftsResponse, models.Draft{
+				DraftId:     ct.Id(d.DraftId),
+				Kind:        ct.DraftKind(d.Kind),
+				Body:        ct.PostBody(d.Body),
+				ParentId:    ct.Id(d.ParentId),
+				GroupId:     ct.Id(d.GroupId),
+				Audience:    ct.Audience(d.Audience),
+				AudienceIds: ct.FromInt64s(d.AudienceIds),
+				ImageIds:    ct.FromInt64s(d.ImageIds),
+				ImageUrls:   d.ImageUrls,
+				CreatedAt:   ct.GenDateTime(d.CreatedAt.AsTime()),
+				UpdatedAt:   ct.GenDateTime(d.UpdatedAt.AsTime()),
+				ExpiresAt:   ct.GenDateTime(d.ExpiresAt.AsTime()),
+			})
+		}
+
+		err = utils.WriteJSON(ctx, w, http.StatusOK, draftsResponse)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusInternalServerError, fmt.Sprintf("failed to send drafts: %v", err.Error()))
+			return
+		}
+	}
+}
+
+func (h *Handlers) deleteDraft() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tele.Info(ctx, "deleteDraft handler called")
+
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		draftId, err := utils.PathValueGet(r, "draft_id", ct.Id(0), true)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		_, err = h.PostsService.DeleteDraft(ctx, &posts.GenericReq{
+			RequesterId: int64(claims.UserId),
+			EntityId:    draftId.Int64(),
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		utils.WriteJSON(ctx, w, http.StatusOK, nil)
+	}
+}
+
+// publish a draft as a post or comment, body: {"publish_at": "..."} to schedule a post draft
+func (h *Handlers) publishDraft() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tele.Info(ctx, "publishDraft handler called")
+
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		body := models.PublishDraftReq{}
+		if r.ContentLength != 0 {
+			decoder := json.NewDecoder(r.Body)
+			defer r.Body.Close()
+			if err := decoder.Decode(&body); err != nil {
+				utils.ErrorJSON(ctx, w, http.StatusBadRequest, "Bad JSON data received")
+				return
+			}
+		}
+
+		var err error
+		body.DraftId, err = utils.PathValueGet(r, "draft_id", ct.Id(0), true)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		resp, err := h.PostsService.PublishDraft(ctx, &posts.PublishDraftReq{
+			RequesterId: int64(claims.UserId),
+			DraftId:     body.DraftId.Int64(),
+			PublishAt:   body.PublishAt.ToProto(),
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+		type httpResponse struct {
+			Id ct.Id
+		}
+		utils.WriteJSON(ctx, w, http.StatusOK, httpResponse{Id: ct.Id(resp.Id)})
+	}
+}
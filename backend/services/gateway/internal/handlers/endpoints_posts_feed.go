@@ -64,12 +64,23 @@ func (h *Handlers) getPublicFeed() http.HandlerFunc {
 				Audience:        ct.Audience(p.Audience),
 				CommentsCount:   int(p.CommentsCount),
 				ReactionsCount:  int(p.ReactionsCount),
+				ReactionCounts:  reactionCountsFromPb(p.ReactionCounts),
 				LastCommentedAt: ct.GenDateTime(p.LastCommentedAt.AsTime()),
 				CreatedAt:       ct.GenDateTime(p.CreatedAt.AsTime()),
 				UpdatedAt:       ct.GenDateTime(p.UpdatedAt.AsTime()),
+				Edited:          p.Edited,
 				LikedByUser:     p.LikedByUser,
+				UserReaction:    p.UserReaction,
+				Mentions:        mentionsFromPb(p.Mentions),
+				SharedPostId:    ct.Id(p.SharedPostId),
+				SharedPost:      sharedPostFromPb(p.SharedPost),
+				RepostsCount:    int(p.RepostsCount),
+				QuotesCount:     int(p.QuotesCount),
+				RepostedByUser:  p.RepostedByUser,
 				ImageId:         ct.Id(p.ImageId),
 				ImageUrl:        p.ImageUrl,
+				ImageIds:        ct.FromInt64s(p.ImageIds),
+				ImageUrls:       p.ImageUrls,
 			}
 			postsResponse = append(postsResponse, post)
 		}
@@ -137,12 +148,23 @@ func (h *Handlers) getPersonalizedFeed() http.HandlerFunc {
 				Audience:        ct.Audience(p.Audience),
 				CommentsCount:   int(p.CommentsCount),
 				ReactionsCount:  int(p.ReactionsCount),
+				ReactionCounts:  reactionCountsFromPb(p.ReactionCounts),
 				LastCommentedAt: ct.GenDateTime(p.LastCommentedAt.AsTime()),
 				CreatedAt:       ct.GenDateTime(p.CreatedAt.AsTime()),
 				UpdatedAt:       ct.GenDateTime(p.UpdatedAt.AsTime()),
+				Edited:          p.Edited,
 				LikedByUser:     p.LikedByUser,
+				UserReaction:    p.UserReaction,
+				Mentions:        mentionsFromPb(p.Mentions),
+				SharedPostId:    ct.Id(p.SharedPostId),
+				SharedPost:      sharedPostFromPb(p.SharedPost),
+				RepostsCount:    int(p.RepostsCount),
+				QuotesCount:     int(p.QuotesCount),
+				RepostedByUser:  p.RepostedByUser,
 				ImageId:         ct.Id(p.ImageId),
 				ImageUrl:        p.ImageUrl,
+				ImageIds:        ct.FromInt64s(p.ImageIds),
+				ImageUrls:       p.ImageUrls,
 			}
 			postsResponse = append(postsResponse, post)
 		}
@@ -206,12 +228,23 @@ func (h *Handlers) getUserPostsPaginated() http.HandlerFunc {
 				Audience:        ct.Audience(p.Audience),
 				CommentsCount:   int(p.CommentsCount),
 				ReactionsCount:  int(p.ReactionsCount),
+				ReactionCounts:  reactionCountsFromPb(p.ReactionCounts),
 				LastCommentedAt: ct.GenDateTime(p.LastCommentedAt.AsTime()),
 				CreatedAt:       ct.GenDateTime(p.CreatedAt.AsTime()),
 				UpdatedAt:       ct.GenDateTime(p.UpdatedAt.AsTime()),
+				Edited:          p.Edited,
 				LikedByUser:     p.LikedByUser,
+				UserReaction:    p.UserReaction,
+				Mentions:        mentionsFromPb(p.Mentions),
+				SharedPostId:    ct.Id(p.SharedPostId),
+				SharedPost:      sharedPostFromPb(p.SharedPost),
+				RepostsCount:    int(p.RepostsCount),
+				QuotesCount:     int(p.QuotesCount),
+				RepostedByUser:  p.RepostedByUser,
 				ImageId:         ct.Id(p.ImageId),
 				ImageUrl:        p.ImageUrl,
+				ImageIds:        ct.FromInt64s(p.ImageIds),
+				ImageUrls:       p.ImageUrls,
 			}
 			postsResponse = append(postsResponse, post)
 		}
@@ -275,12 +308,26 @@ func (h *Handlers) getGroupPostsPaginated() http.HandlerFunc {
 				Audience:        ct.Audience(p.Audience),
 				CommentsCount:   int(p.CommentsCount),
 				ReactionsCount:  int(p.ReactionsCount),
+				ReactionCounts:  reactionCountsFromPb(p.ReactionCounts),
 				LastCommentedAt: ct.GenDateTime(p.LastCommentedAt.AsTime()),
 				CreatedAt:       ct.GenDateTime(p.CreatedAt.AsTime()),
 				UpdatedAt:       ct.GenDateTime(p.UpdatedAt.AsTime()),
+				Edited:          p.Edited,
 				LikedByUser:     p.LikedByUser,
+				UserReaction:    p.UserReaction,
+				Mentions:        mentionsFromPb(p.Mentions),
+				SharedPostId:    ct.Id(p.SharedPostId),
+				SharedPost:      sharedPostFromPb(p.SharedPost),
+				RepostsCount:    int(p.RepostsCount),
+				QuotesCount:     int(p.QuotesCount),
+				RepostedByUser:  p.RepostedByUser,
 				ImageId:         ct.Id(p.ImageId),
 				ImageUrl:        p.ImageUrl,
+				ImageIds:        ct.FromInt64s(p.ImageIds),
+				ImageUrls:       p.ImageUrls,
+				Pinned:          p.Pinned,
+				Announcement:    p.Announcement,
+				ApprovalStatus:  p.ApprovalStatus,
 			}
 			postsResponse = append(postsResponse, post)
 		}
@@ -292,4 +339,68 @@ func (h *Handlers) getGroupPostsPaginated() http.HandlerFunc {
 		}
 
 	}
-}
+}
+
+// posts of a group awaiting approval, oldest first, only for group staff
+func (h *Handlers) getPendingGroupPosts() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tele.Info(ctx, "getPendingGroupPosts handler called")
+
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		v := r.URL.Query()
+		groupId, err1 := utils.PathValueGet(r, "group_id", ct.Id(0), true)
+		limit, err2 := utils.ParamGet(v, "limit", int32(1), false)
+		offset, err3 := utils.ParamGet(v, "offset", int32(0), false)
+		if err := errors.Join(err1, err2, err3); err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		grpcResp, err := h.PostsService.GetPendingGroupPosts(ctx, &posts.GetGroupPostsReq{
+			RequesterId: claims.UserId,
+			GroupId:     groupId.Int64(),
+			Limit:       limit,
+			Offset:      offset,
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		postsResponse := []models.Post{}
+		for _, p := range grpcResp.Posts {
+			postsResponse = append(postsResponse, models.Post{
+				PostId: ct.Id(p.PostId),
+				Body:   ct.PostBody(p.PostBody),
+				User: models.User{
+					UserId:    ct.Id(p.User.UserId),
+					Username:  ct.Username(p.User.Username),
+					AvatarId:  ct.Id(p.User.Avatar),
+					AvatarURL: p.User.AvatarUrl,
+				},
+				GroupId:        ct.Id(p.GroupId),
+				Audience:       ct.Audience(p.Audience),
+				CreatedAt:      ct.GenDateTime(p.CreatedAt.AsTime()),
+				UpdatedAt:      ct.GenDateTime(p.UpdatedAt.AsTime()),
+				Edited:         p.Edited,
+				ImageId:        ct.Id(p.ImageId),
+				ImageUrl:       p.ImageUrl,
+				ImageIds:       ct.FromInt64s(p.ImageIds),
+				ImageUrls:      p.ImageUrls,
+				ApprovalStatus: p.ApprovalStatus,
+				Mentions:       mentionsFromPb(p.Mentions),
+			})
+		}
+
+		err = utils.WriteJSON(ctx, w, http.StatusOK, postsResponse)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusInternalServerError, fmt.Sprintf("failed to send group %v pending posts: %v", groupId, err.Error()))
+			return
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"social-network/shared/gen-go/posts"
+	ct "social-network/shared/go/ct"
+	utils "social-network/shared/go/http-utils"
+	"social-network/shared/go/jwt"
+	"social-network/shared/go/models"
+	tele "social-network/shared/go/telemetry"
+)
+
+func (h *Handlers) getHashtagFeed() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tele.Info(ctx, "getHashtagFeed handler called")
+
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		rawTag, err := utils.PathValueGet(r, "tag", "", true)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+		tag := ct.NormalizeHashtag(rawTag)
+		if err := tag.Validate(); err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "invalid hashtag: "+err.Error())
+			return
+		}
+
+		v := r.URL.Query()
+		limit, err1 := utils.ParamGet(v, "limit", int32(1), false)
+		offset, err2 := utils.ParamGet(v, "offset", int32(0), false)
+		if err := errors.Join(err1, err2); err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		grpcResp, err := h.PostsService.GetHashtagFeed(ctx, &posts.GetHashtagFeedReq{
+			RequesterId: claims.UserId,
+			Tag:         tag.String(),
+			Limit:       limit,
+			Offset:      offset,
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		tele.Info(ctx, "retrieved hashtag feed. @1", "grpcResp", grpcResp)
+
+		postsResponse := []models.Post{}
+		for _, p := range grpcResp.Posts {
+			postsResponse = append(postsResponse, models.Post{
+				PostId: ct.Id(p.PostId),
+				Body:   ct.PostBody(p.PostBody),
+				User: models.User{
+					UserId:    ct.Id(p.User.UserId),
+					Username:  ct.Username(p.User.Username),
+					AvatarId:  ct.Id(p.User.Avatar),
+					AvatarURL: p.User.AvatarUrl,
+				},
+				GroupId:         ct.Id(p.GroupId),
+				Audience:        ct.Audience(p.Audience),
+				CommentsCount:   int(p.CommentsCount),
+				ReactionsCount:  int(p.ReactionsCount),
+				ReactionCounts:  reactionCountsFromPb(p.ReactionCounts),
+				LastCommentedAt: ct.GenDateTime(p.LastCommentedAt.AsTime()),
+				CreatedAt:       ct.GenDateTime(p.CreatedAt.AsTime()),
+				UpdatedAt:       ct.GenDateTime(p.UpdatedAt.AsTime()),
+				Edited:          p.Edited,
+				LikedByUser:     p.LikedByUser,
+				UserReaction:    p.UserReaction,
+				Mentions:        mentionsFromPb(p.Mentions),
+				SharedPostId:    ct.Id(p.SharedPostId),
+				SharedPost:      sharedPostFromPb(p.SharedPost),
+				RepostsCount:    int(p.RepostsCount),
+				QuotesCount:     int(p.QuotesCount),
+				RepostedByUser:  p.RepostedByUser,
+				ImageId:         ct.Id(p.ImageId),
+				ImageUrl:        p.ImageUrl,
+				ImageIds:        ct.FromInt64s(p.ImageIds),
+				ImageUrls:       p.ImageUrls,
+			})
+		}
+
+		err = utils.WriteJSON(ctx, w, http.StatusOK, postsResponse)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusInternalServerError, "failed to send hashtag feed")
+			return
+		}
+	}
+}
+
+func (h *Handlers) getTrendingHashtags() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tele.Info(ctx, "getTrendingHashtags handler called")
+
+		v := r.URL.Query()
+		windowHours, err1 := utils.ParamGet(v, "window_hours", int32(0), false)
+		limit, err2 := utils.ParamGet(v, "limit", int32(10), false)
+		if err := errors.Join(err1, err2); err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		grpcResp, err := h.PostsService.GetTrendingHashtags(ctx, &posts.GetTrendingHashtagsReq{
+			WindowHours: windowHours,
+			Limit:       limit,
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		tags := make([]models.TrendingHashtag, 0, len(grpcResp.Hashtags))
+		for _, t := range grpcResp.Hashtags {
+			tags = append(tags, models.TrendingHashtag{
+				Tag:          ct.Hashtag(t.Tag),
+				PostsCount:   int(t.PostsCount),
+				AuthorsCount: int(t.AuthorsCount),
+			})
+		}
+
+		err = utils.WriteJSON(ctx, w, http.StatusOK, tags)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusInternalServerError, "failed to send trending hashtags")
+			return
+		}
+	}
+}
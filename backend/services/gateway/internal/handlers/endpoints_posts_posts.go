@@ -1,7 +1,10 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"social-network/shared/gen-go/common"
 	"social-network/shared/gen-go/media"
@@ -68,13 +71,29 @@ func (h *Handlers) getPostById() http.HandlerFunc {
 			Audience:              ct.Audience(grpcResp.Audience),
 			CommentsCount:         int(grpcResp.CommentsCount),
 			ReactionsCount:        int(grpcResp.ReactionsCount),
+			ReactionCounts:        reactionCountsFromPb(grpcResp.ReactionCounts),
 			LastCommentedAt:       ct.GenDateTime(grpcResp.LastCommentedAt.AsTime()),
 			CreatedAt:             ct.GenDateTime(grpcResp.CreatedAt.AsTime()),
 			UpdatedAt:             ct.GenDateTime(grpcResp.UpdatedAt.AsTime()),
+			Edited:                grpcResp.Edited,
 			LikedByUser:           grpcResp.LikedByUser,
+			UserReaction:          grpcResp.UserReaction,
+			Mentions:              mentionsFromPb(grpcResp.Mentions),
+			SharedPostId:          ct.Id(grpcResp.SharedPostId),
+			SharedPost:            sharedPostFromPb(grpcResp.SharedPost),
+			RepostsCount:          int(grpcResp.RepostsCount),
+			QuotesCount:           int(grpcResp.QuotesCount),
+			RepostedByUser:        grpcResp.RepostedByUser,
 			ImageId:               ct.Id(grpcResp.ImageId),
 			ImageUrl:              grpcResp.ImageUrl,
+			ImageIds:              ct.FromInt64s(grpcResp.ImageIds),
+			ImageUrls:             grpcResp.ImageUrls,
 			SelectedAudienceUsers: selectedAudience,
+			Pinned:                grpcResp.Pinned,
+			Announcement:          grpcResp.Announcement,
+			ApprovalStatus:        grpcResp.ApprovalStatus,
+			RejectionReason:       grpcResp.RejectionReason,
+			PublishAt:             ct.GenDateTime(grpcResp.PublishAt.AsTime()),
 		}
 
 		err = utils.WriteJSON(ctx, w, http.StatusOK, post)
@@ -127,12 +146,18 @@ func (h *Handlers) getMostPopularPostInGroup() http.HandlerFunc {
 				Audience:        ct.Audience(grpcResp.Audience),
 				CommentsCount:   int(grpcResp.CommentsCount),
 				ReactionsCount:  int(grpcResp.ReactionsCount),
+				ReactionCounts:  reactionCountsFromPb(grpcResp.ReactionCounts),
 				LastCommentedAt: ct.GenDateTime(grpcResp.LastCommentedAt.AsTime()),
 				CreatedAt:       ct.GenDateTime(grpcResp.CreatedAt.AsTime()),
 				UpdatedAt:       ct.GenDateTime(grpcResp.UpdatedAt.AsTime()),
+				Edited:          grpcResp.Edited,
 				LikedByUser:     grpcResp.LikedByUser,
+				UserReaction:    grpcResp.UserReaction,
+				Mentions:        mentionsFromPb(grpcResp.Mentions),
 				ImageId:         ct.Id(grpcResp.ImageId),
 				ImageUrl:        grpcResp.ImageUrl,
+				ImageIds:        ct.FromInt64s(grpcResp.ImageIds),
+				ImageUrls:       grpcResp.ImageUrls,
 			}
 		}
 
@@ -145,6 +170,78 @@ func (h *Handlers) getMostPopularPostInGroup() http.HandlerFunc {
 	}
 }
 
+// One entry of a post gallery in create and edit requests: either an image
+// the post already has, kept at this position, or a new image to upload.
+type postImageJSON struct {
+	ImageId   ct.Id  `json:"image" validate:"nullable"`
+	ImageName string `json:"image_name"`
+	ImageSize int64  `json:"image_size"`
+	ImageType string `json:"image_type"`
+}
+
+// Upload url returned for each new image of a post gallery
+type postImageUpload struct {
+	FileId    ct.Id
+	UploadUrl string
+}
+
+// checks the requested gallery size and that every kept image is one of allowedIds.
+// Media doesn't record who uploaded a file, so this is what keeps users to their own images:
+// new images are uploaded here for the requester and the posts service only rejects images
+// attached to other content
+func validatePostImages(images []postImageJSON, allowedIds ct.Ids) error {
+	if len(images) > ct.MaxPostImages {
+		return fmt.Errorf("a post can have up to %d images", ct.MaxPostImages)
+	}
+	allowed := make(map[ct.Id]struct{}, len(allowedIds))
+	for _, id := range allowedIds {
+		allowed[id] = struct{}{}
+	}
+	for _, img := range images {
+		if img.ImageSize != 0 {
+			continue
+		}
+		if img.ImageId == 0 {
+			return fmt.Errorf("every image needs either an id or upload info")
+		}
+		if _, ok := allowed[img.ImageId]; !ok {
+			return fmt.Errorf("image %v doesn't belong to this post", img.ImageId)
+		}
+	}
+	return nil
+}
+
+// requests an upload url for every new image of the gallery and returns
+// the ids of the whole gallery in order, along with the new uploads
+func (h *Handlers) uploadPostImages(ctx context.Context, images []postImageJSON, visibility media.FileVisibility) (ct.Ids, []postImageUpload, error) {
+	ids := make(ct.Ids, 0, len(images))
+	uploads := []postImageUpload{}
+	exp := time.Duration(10 * time.Minute).Seconds()
+	for _, img := range images {
+		if img.ImageSize == 0 {
+			ids = append(ids, img.ImageId)
+			continue
+		}
+		mediaRes, err := h.MediaService.UploadImage(ctx, &media.UploadImageRequest{
+			Filename:          img.ImageName,
+			MimeType:          img.ImageType,
+			SizeBytes:         img.ImageSize,
+			Visibility:        visibility,
+			Variants:          []media.FileVariant{media.FileVariant_MEDIUM},
+			ExpirationSeconds: int64(exp),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		ids = append(ids, ct.Id(mediaRes.FileId))
+		uploads = append(uploads, postImageUpload{
+			FileId:    ct.Id(mediaRes.FileId),
+			UploadUrl: mediaRes.GetUploadUrl(),
+		})
+	}
+	return ids, uploads, nil
+}
+
 func (h *Handlers) createPost() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
@@ -164,6 +261,10 @@ func (h *Handlers) createPost() http.HandlerFunc {
 			ImageName string `json:"image_name"`
 			ImageSize int64  `json:"image_size"`
 			ImageType string `json:"image_type"`
+
+			Images []postImageJSON `json:"images"` // gallery in display order, takes precedence over the single image fields
+
+			PublishAt ct.GenDateTime `json:"publish_at" validate:"nullable"` // leave empty to publish right away
 		}
 
 		httpReq := CreatePostJSONRequest{}
@@ -180,14 +281,26 @@ func (h *Handlers) createPost() http.HandlerFunc {
 			return
 		}
 
+		// a new post has no images to keep, only uploads
+		if err := validatePostImages(httpReq.Images, nil); err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		imageVisibility := media.FileVisibility_PUBLIC
 		if httpReq.Audience.String() != "everyone" {
 			imageVisibility = media.FileVisibility_PRIVATE
 		}
 
+		imageIds, uploads, err := h.uploadPostImages(ctx, httpReq.Images, imageVisibility)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
 		var ImageId ct.Id
 		var uploadURL string
-		if httpReq.ImageSize != 0 {
+		if len(httpReq.Images) == 0 && httpReq.ImageSize != 0 {
 			exp := time.Duration(10 * time.Minute).Seconds()
 			mediaRes, err := h.MediaService.UploadImage(ctx, &media.UploadImageRequest{
 				Filename:          httpReq.ImageName,
@@ -213,7 +326,9 @@ func (h *Handlers) createPost() http.HandlerFunc {
 			AudienceIds: &common.UserIds{
 				Values: httpReq.AudienceIds.Int64(),
 			},
-			ImageId: ImageId.Int64(),
+			ImageId:   ImageId.Int64(),
+			ImageIds:  imageIds.Int64(),
+			PublishAt: httpReq.PublishAt.ToProto(),
 		}
 
 		postId, err := h.PostsService.CreatePost(ctx, &grpcReq)
@@ -227,12 +342,14 @@ func (h *Handlers) createPost() http.HandlerFunc {
 			UserId    ct.Id
 			FileId    ct.Id
 			UploadUrl string
+			Uploads   []postImageUpload
 		}
 		httpResp := httpResponse{
 			PostId:    ct.Id(postId.Id),
 			UserId:    ct.Id(claims.UserId),
 			FileId:    ImageId,
 			UploadUrl: uploadURL,
+			Uploads:   uploads,
 		}
 		tele.Info(ctx, "created post successfully")
 		utils.WriteJSON(ctx, w, http.StatusOK, httpResp)
@@ -259,6 +376,8 @@ func (h *Handlers) editPost() http.HandlerFunc {
 			ImageName string `json:"image_name"`
 			ImageSize int64  `json:"image_size"`
 			ImageType string `json:"image_type"`
+
+			Images []postImageJSON `json:"images"` // new gallery in display order, replaces the current one
 		}
 
 		httpReq := EditPostJSONRequest{}
@@ -282,14 +401,42 @@ func (h *Handlers) editPost() http.HandlerFunc {
 			return
 		}
 
+		// reject images that aren't in the current gallery before uploading anything,
+		// the posts service only rejects images attached to other content
+		var currentImageIds ct.Ids
+		for _, img := range httpReq.Images {
+			if img.ImageSize == 0 && img.ImageId != 0 {
+				current, err := h.PostsService.GetPostById(ctx, &posts.GenericReq{
+					RequesterId: int64(claims.UserId),
+					EntityId:    httpReq.PostId.Int64(),
+				})
+				if err != nil {
+					utils.ReturnHttpError(ctx, w, err)
+					return
+				}
+				currentImageIds = ct.FromInt64s(current.ImageIds)
+				break
+			}
+		}
+		if err := validatePostImages(httpReq.Images, currentImageIds); err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		imageVisibility := media.FileVisibility_PUBLIC
 		if httpReq.Audience.String() != "everyone" {
 			imageVisibility = media.FileVisibility_PRIVATE
 		}
 
+		imageIds, uploads, err := h.uploadPostImages(ctx, httpReq.Images, imageVisibility)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
 		var ImageId ct.Id
 		var uploadURL string
-		if httpReq.ImageSize != 0 {
+		if len(httpReq.Images) == 0 && httpReq.ImageSize != 0 {
 			exp := time.Duration(10 * time.Minute).Seconds()
 			mediaRes, err := h.MediaService.UploadImage(ctx, &media.UploadImageRequest{
 				Filename:          httpReq.ImageName,
@@ -317,6 +464,7 @@ func (h *Handlers) editPost() http.HandlerFunc {
 			},
 			ImageId:     ImageId.Int64(),
 			DeleteImage: httpReq.DeleteImage,
+			ImageIds:    imageIds.Int64(),
 		}
 
 		_, err = h.PostsService.EditPost(ctx, &grpcReq)
@@ -329,11 +477,13 @@ func (h *Handlers) editPost() http.HandlerFunc {
 			UserId    ct.Id
 			FileId    ct.Id
 			UploadUrl string
+			Uploads   []postImageUpload
 		}
 		httpResp := httpResponse{
 			UserId:    ct.Id(claims.UserId),
 			FileId:    ImageId,
-			UploadUrl: uploadURL}
+			UploadUrl: uploadURL,
+			Uploads:   uploads}
 
 		utils.WriteJSON(ctx, w, http.StatusOK, httpResp)
 
@@ -370,3 +520,341 @@ func (h *Handlers) deletePost() http.HandlerFunc {
 
 	}
 }
+
+// pin or unpin a group post, body: {"value": true|false}
+func (h *Handlers) setPostPinned() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tele.Info(ctx, "setPostPinned handler called")
+
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		body, err := utils.JSON2Struct(&models.SetPostFlagReq{}, r)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "Bad JSON data received")
+			return
+		}
+
+		body.PostId, err = utils.PathValueGet(r, "post_id", ct.Id(0), true)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		_, err = h.PostsService.SetPostPinned(ctx, &posts.SetPostFlagReq{
+			RequesterId: int64(claims.UserId),
+			PostId:      body.PostId.Int64(),
+			Value:       body.Value,
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		utils.WriteJSON(ctx, w, http.StatusOK, nil)
+	}
+}
+
+// mark or unmark a group post as an announcement, body: {"value": true|false}
+func (h *Handlers) setPostAnnouncement() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tele.Info(ctx, "setPostAnnouncement handler called")
+
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		body, err := utils.JSON2Struct(&models.SetPostFlagReq{}, r)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "Bad JSON data received")
+			return
+		}
+
+		body.PostId, err = utils.PathValueGet(r, "post_id", ct.Id(0), true)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		_, err = h.PostsService.SetPostAnnouncement(ctx, &posts.SetPostFlagReq{
+			RequesterId: int64(claims.UserId),
+			PostId:      body.PostId.Int64(),
+			Value:       body.Value,
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		utils.WriteJSON(ctx, w, http.StatusOK, nil)
+	}
+}
+
+// approve a group post awaiting approval, no body
+func (h *Handlers) approvePost() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tele.Info(ctx, "approvePost handler called")
+
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		postId, err := utils.PathValueGet(r, "post_id", ct.Id(0), true)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		_, err = h.PostsService.ApprovePost(ctx, &posts.ReviewPostReq{
+			RequesterId: int64(claims.UserId),
+			PostId:      postId.Int64(),
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		utils.WriteJSON(ctx, w, http.StatusOK, nil)
+	}
+}
+
+// reject a group post awaiting approval, body: {"reason": "..."} (reason can be empty)
+func (h *Handlers) rejectPost() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tele.Info(ctx, "rejectPost handler called")
+
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		body, err := utils.JSON2Struct(&models.ReviewPostReq{}, r)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "Bad JSON data received")
+			return
+		}
+
+		body.PostId, err = utils.PathValueGet(r, "post_id", ct.Id(0), true)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		_, err = h.PostsService.RejectPost(ctx, &posts.ReviewPostReq{
+			RequesterId: int64(claims.UserId),
+			PostId:      body.PostId.Int64(),
+			Reason:      body.Reason,
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		utils.WriteJSON(ctx, w, http.StatusOK, nil)
+	}
+}
+
+// the requester's posts waiting to be published, next to go out first
+func (h *Handlers) getScheduledPosts() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tele.Info(ctx, "getScheduledPosts handler called")
+
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		v := r.URL.Query()
+		limit, err1 := utils.ParamGet(v, "limit", int32(1), false)
+		offset, err2 := utils.ParamGet(v, "offset", int32(0), false)
+		if err := errors.Join(err1, err2); err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		grpcResp, err := h.PostsService.GetScheduledPosts(ctx, &posts.GenericPaginatedReq{
+			RequesterId: claims.UserId,
+			Limit:       limit,
+			Offset:      offset,
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		postsResponse := []models.Post{}
+		for _, p := range grpcResp.Posts {
+			postsResponse = append(postsResponse, models.Post{
+				PostId: ct.Id(p.PostId),
+				Body:   ct.PostBody(p.PostBody),
+				User: models.User{
+					UserId:    ct.Id(p.User.UserId),
+					Username:  ct.Username(p.User.Username),
+					AvatarId:  ct.Id(p.User.Avatar),
+					AvatarURL: p.User.AvatarUrl,
+				},
+				GroupId:        ct.Id(p.GroupId),
+				Audience:       ct.Audience(p.Audience),
+				CreatedAt:      ct.GenDateTime(p.CreatedAt.AsTime()),
+				UpdatedAt:      ct.GenDateTime(p.UpdatedAt.AsTime()),
+				Edited:         p.Edited,
+				ImageId:        ct.Id(p.ImageId),
+				ImageUrl:       p.ImageUrl,
+				ImageIds:       ct.FromInt64s(p.ImageIds),
+				ImageUrls:      p.ImageUrls,
+				ApprovalStatus: p.ApprovalStatus,
+				PublishAt:      ct.GenDateTime(p.PublishAt.AsTime()),
+				Mentions:       mentionsFromPb(p.Mentions),
+			})
+		}
+
+		err = utils.WriteJSON(ctx, w, http.StatusOK, postsResponse)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusInternalServerError, fmt.Sprintf("failed to send scheduled posts: %v", err.Error()))
+			return
+		}
+	}
+}
+
+// move the publish time of a scheduled post, body: {"publish_at": "..."}
+func (h *Handlers) reschedulePost() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tele.Info(ctx, "reschedulePost handler called")
+
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		body, err := utils.JSON2Struct(&models.ReschedulePostReq{}, r)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "Bad JSON data received")
+			return
+		}
+
+		body.PostId, err = utils.PathValueGet(r, "post_id", ct.Id(0), true)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		_, err = h.PostsService.ReschedulePost(ctx, &posts.ReschedulePostReq{
+			RequesterId: int64(claims.UserId),
+			PostId:      body.PostId.Int64(),
+			PublishAt:   body.PublishAt.ToProto(),
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		utils.WriteJSON(ctx, w, http.StatusOK, nil)
+	}
+}
+
+// delete a scheduled post before it's published
+func (h *Handlers) cancelScheduledPost() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tele.Info(ctx, "cancelScheduledPost handler called")
+
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		postId, err := utils.PathValueGet(r, "post_id", ct.Id(0), true)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		_, err = h.PostsService.CancelScheduledPost(ctx, &posts.GenericReq{
+			RequesterId: int64(claims.UserId),
+			EntityId:    postId.Int64(),
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		utils.WriteJSON(ctx, w, http.StatusOK, nil)
+	}
+}
+
+// previous versions of a post or comment body, most recent first.
+// pathKey names the url param holding the post or comment id
+func (h *Handlers) getRevisions(pathKey string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tele.Info(ctx, "getRevisions handler called")
+
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		entityId, err := utils.PathValueGet(r, pathKey, ct.Id(0), true)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		v := r.URL.Query()
+		limit, err1 := utils.ParamGet(v, "limit", int32(1), false)
+		offset, err2 := utils.ParamGet(v, "offset", int32(0), false)
+		if err := errors.Join(err1, err2); err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		grpcResp, err := h.PostsService.GetRevisions(ctx, &posts.EntityIdPaginatedReq{
+			RequesterId: claims.UserId,
+			EntityId:    entityId.Int64(),
+			Limit:       limit,
+			Offset:      offset,
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		revisions := []models.Revision{}
+		for _, rev := range grpcResp.Revisions {
+			revisions = append(revisions, models.Revision{
+				RevisionId: ct.Id(rev.RevisionId),
+				Body:       rev.Body,
+				CreatedAt:  ct.GenDateTime(rev.CreatedAt.AsTime()),
+			})
+		}
+
+		err = utils.WriteJSON(ctx, w, http.StatusOK, revisions)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusInternalServerError, fmt.Sprintf("failed to send revisions: %v", err.Error()))
+			return
+		}
+	}
+}
+
+// mentions in the order the posts service sent them, never null
+func mentionsFromPb(mentions []*posts.Mention) []models.Mention {
+	res := make([]models.Mention, 0, len(mentions))
+	for _, m := range mentions {
+		res = append(res, models.Mention{
+			UserId: ct.Id(m.UserId),
+			Start:  int(m.Start),
+			Length: int(m.Length),
+		})
+	}
+	return res
+}
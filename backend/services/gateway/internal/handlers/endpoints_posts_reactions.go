@@ -17,15 +17,21 @@ func (s *Handlers) toggleOrInsertReaction() http.HandlerFunc {
 			panic(1)
 		}
 
-		body, err := utils.JSON2Struct(&models.GenericReq{}, r)
+		body, err := utils.JSON2Struct(&models.ReactionReq{}, r)
 		if err != nil {
 			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "Bad JSON data received")
 			return
 		}
 
-		req := posts.GenericReq{
-			RequesterId: claims.UserId,
-			EntityId:    int64(body.EntityId),
+		// clients that predate reaction types only send likes
+		if body.ReactionType == "" {
+			body.ReactionType = ct.ReactionLike
+		}
+
+		req := posts.ReactionReq{
+			RequesterId:  claims.UserId,
+			EntityId:     int64(body.EntityId),
+			ReactionType: body.ReactionType.String(),
 		}
 
 		_, err = s.PostsService.ToggleOrInsertReaction(ctx, &req)
@@ -53,9 +59,17 @@ func (s *Handlers) getWhoLikedEntityId() http.HandlerFunc {
 			return
 		}
 
-		req := posts.GenericReq{
-			RequesterId: claims.UserId,
-			EntityId:    int64(entityId),
+		// everyone who reacted unless a type is given
+		reactionType, err := utils.ParamGet(r.URL.Query(), "reaction_type", "", false)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		req := posts.ReactionReq{
+			RequesterId:  claims.UserId,
+			EntityId:     int64(entityId),
+			ReactionType: reactionType,
 		}
 
 		grpcResp, err := s.PostsService.GetWhoLikedEntityId(ctx, &req)
@@ -79,3 +93,11 @@ func (s *Handlers) getWhoLikedEntityId() http.HandlerFunc {
 		utils.WriteJSON(ctx, w, http.StatusOK, resp)
 	}
 }
+
+func reactionCountsFromPb(counts map[string]int32) map[string]int {
+	res := make(map[string]int, len(counts))
+	for t, n := range counts {
+		res[t] = int(n)
+	}
+	return res
+}
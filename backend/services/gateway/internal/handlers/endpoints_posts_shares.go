@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"social-network/shared/gen-go/common"
+	"social-network/shared/gen-go/posts"
+	ct "social-network/shared/go/ct"
+	utils "social-network/shared/go/http-utils"
+	"social-network/shared/go/jwt"
+	"social-network/shared/go/models"
+	tele "social-network/shared/go/telemetry"
+)
+
+// reposts a post, or quotes it when post_body is set
+func (h *Handlers) sharePost() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tele.Info(ctx, "sharePost handler called")
+
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		postId, err := utils.PathValueGet(r, "post_id", ct.Id(0), true)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		type SharePostJSONRequest struct {
+			Body        ct.PostBody `json:"post_body" validate:"nullable"` // empty for a repost
+			Audience    ct.Audience `json:"audience"`
+			AudienceIds ct.Ids      `json:"audience_ids" validate:"nullable"`
+		}
+
+		httpReq := SharePostJSONRequest{}
+
+		decoder := json.NewDecoder(r.Body)
+		defer r.Body.Close()
+		if err := decoder.Decode(&httpReq); err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if err := ct.ValidateStruct(httpReq); err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		shareId, err := h.PostsService.SharePost(ctx, &posts.SharePostReq{
+			RequesterId: int64(claims.UserId),
+			PostId:      postId.Int64(),
+			Body:        httpReq.Body.String(),
+			Audience:    httpReq.Audience.String(),
+			AudienceIds: &common.UserIds{
+				Values: httpReq.AudienceIds.Int64(),
+			},
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		type httpResponse struct {
+			PostId ct.Id
+			UserId ct.Id
+		}
+		utils.WriteJSON(ctx, w, http.StatusOK, httpResponse{
+			PostId: ct.Id(shareId.Id),
+			UserId: ct.Id(claims.UserId),
+		})
+	}
+}
+
+// deletes the requester's repost of the post in the url
+func (h *Handlers) undoRepost() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tele.Info(ctx, "undoRepost handler called")
+
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		postId, err := utils.PathValueGet(r, "post_id", ct.Id(0), true)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		_, err = h.PostsService.UndoRepost(ctx, &posts.GenericReq{
+			RequesterId: int64(claims.UserId),
+			EntityId:    postId.Int64(),
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		utils.WriteJSON(ctx, w, http.StatusOK, nil)
+	}
+}
+
+// the post embedded in a repost or quote, nil if the requester can't see it
+func sharedPostFromPb(p *posts.Post) *models.Post {
+	if p == nil {
+		return nil
+	}
+	return &models.Post{
+		PostId: ct.Id(p.PostId),
+		Body:   ct.PostBody(p.PostBody),
+		User: models.User{
+			UserId:    ct.Id(p.User.GetUserId()),
+			Username:  ct.Username(p.User.GetUsername()),
+			AvatarId:  ct.Id(p.User.GetAvatar()),
+			AvatarURL: p.User.GetAvatarUrl(),
+		},
+		GroupId:         ct.Id(p.GroupId),
+		Audience:        ct.Audience(p.Audience),
+		CommentsCount:   int(p.CommentsCount),
+		ReactionsCount:  int(p.ReactionsCount),
+		ReactionCounts:  reactionCountsFromPb(p.ReactionCounts),
+		LastCommentedAt: ct.GenDateTime(p.LastCommentedAt.AsTime()),
+		CreatedAt:       ct.GenDateTime(p.CreatedAt.AsTime()),
+		UpdatedAt:       ct.GenDateTime(p.UpdatedAt.AsTime()),
+		Edited:          p.Edited,
+		LikedByUser:     p.LikedByUser,
+		UserReaction:    p.UserReaction,
+		Mentions:        mentionsFromPb(p.Mentions),
+		ImageId:         ct.Id(p.ImageId),
+		ImageUrl:        p.ImageUrl,
+		ImageIds:        ct.FromInt64s(p.ImageIds),
+		ImageUrls:       p.ImageUrls,
+		SharedPostId:    ct.Id(p.SharedPostId),
+		RepostsCount:    int(p.RepostsCount),
+		QuotesCount:     int(p.QuotesCount),
+		RepostedByUser:  p.RepostedByUser,
+	}
+}
@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"social-network/shared/gen-go/common"
+	"social-network/shared/gen-go/posts"
+	"social-network/shared/gen-go/users"
+	ct "social-network/shared/go/ct"
+	utils "social-network/shared/go/http-utils"
+	"social-network/shared/go/jwt"
+	"social-network/shared/go/models"
+	tele "social-network/shared/go/telemetry"
+	"sync"
+)
+
+// searches users, groups and posts at once and returns the three lists together.
+// limit and offset apply to each list, users have no offset so they're only returned on the first page.
+func (h *Handlers) search() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tele.Info(ctx, "search handler called")
+
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		v := r.URL.Query()
+		query, err1 := utils.ParamGet(v, "query", "", true)
+		limit, err2 := utils.ParamGet(v, "limit", int32(10), false)
+		offset, err3 := utils.ParamGet(v, "offset", int32(0), false)
+		if err := errors.Join(err1, err2, err3); err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		var (
+			wg        sync.WaitGroup
+			usersResp *common.ListUsers
+			userErr   error
+			groupResp *users.GroupArr
+			groupErr  error
+			postsResp *posts.ListSearchResults
+			postsErr  error
+		)
+
+		if offset == 0 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				usersResp, userErr = h.UsersService.SearchUsers(ctx, &users.UserSearchRequest{
+					SearchTerm: query,
+					Limit:      limit,
+				})
+			}()
+		}
+
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			groupResp, groupErr = h.UsersService.SearchGroups(ctx, &users.GroupSearchRequest{
+				SearchTerm: query,
+				UserId:     claims.UserId,
+				Limit:      limit,
+				Offset:     offset,
+			})
+		}()
+		go func() {
+			defer wg.Done()
+			postsResp, postsErr = h.PostsService.SearchContent(ctx, &posts.SearchContentReq{
+				RequesterId: claims.UserId,
+				SearchTerm:  query,
+				Limit:       limit,
+				Offset:      offset,
+			})
+		}()
+		wg.Wait()
+
+		for _, err := range []error{userErr, groupErr, postsErr} {
+			if err != nil {
+				utils.ReturnHttpError(ctx, w, err)
+				return
+			}
+		}
+
+		resp := models.SearchResults{
+			Users:   []models.User{},
+			Groups:  []models.Group{},
+			Content: []models.SearchResult{},
+		}
+
+		for _, user := range usersResp.GetUsers() {
+			resp.Users = append(resp.Users, models.User{
+				UserId:    ct.Id(user.UserId),
+				Username:  ct.Username(user.Username),
+				AvatarId:  ct.Id(user.Avatar),
+				AvatarURL: user.AvatarUrl,
+			})
+		}
+
+		for _, group := range groupResp.GetGroupArr() {
+			resp.Groups = append(resp.Groups, models.Group{
+				GroupId:          ct.Id(group.GroupId),
+				GroupOwnerId:     ct.Id(group.GroupOwnerId),
+				GroupTitle:       ct.Title(group.GroupTitle),
+				GroupDescription: ct.About(group.GroupDescription),
+				GroupImage:       ct.Id(group.GroupImageId),
+				GroupImageURL:    group.GroupImageUrl,
+				MembersCount:     group.MembersCount,
+				IsMember:         group.IsMember,
+				IsOwner:          group.IsOwner,
+				PendingRequest:   group.PendingRequest,
+				PendingInvite:    group.PendingInvite,
+				Visibility:       ct.GroupVisibility(group.Visibility),
+				Category:         ct.GroupCategory(group.Category),
+				Tags:             ct.GroupTagsFromStrings(group.Tags),
+			})
+		}
+
+		for _, res := range postsResp.GetResults() {
+			resp.Content = append(resp.Content, models.SearchResult{
+				EntityType: res.EntityType,
+				EntityId:   ct.Id(res.EntityId),
+				PostId:     ct.Id(res.PostId),
+				GroupId:    ct.Id(res.GroupId),
+				User: models.User{
+					UserId:    ct.Id(res.User.GetUserId()),
+					Username:  ct.Username(res.User.GetUsername()),
+					AvatarId:  ct.Id(res.User.GetAvatar()),
+					AvatarURL: res.User.GetAvatarUrl(),
+				},
+				Title:     res.Title,
+				Body:      res.Body,
+				CreatedAt: ct.GenDateTime(res.CreatedAt.AsTime()),
+			})
+		}
+
+		err := utils.WriteJSON(ctx, w, http.StatusOK, resp)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusInternalServerError, "failed to send search results")
+			return
+		}
+	}
+}
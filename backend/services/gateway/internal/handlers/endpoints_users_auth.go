@@ -12,6 +12,8 @@ import (
 	"social-network/shared/go/models"
 	tele "social-network/shared/go/telemetry"
 	"time"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func (h *Handlers) loginHandler() http.HandlerFunc {
@@ -268,6 +270,38 @@ func (h *Handlers) logoutHandler() http.HandlerFunc {
 	}
 }
 
+// Temporarily deactivates the requester's account and logs them out.
+// The account is restored on the next login.
+func (h *Handlers) deactivateAccount() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		tele.Info(ctx, "deactivate account handler called")
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		_, err := h.UsersService.DeactivateAccount(ctx, wrapperspb.Int64(claims.UserId))
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		//CLEAR COOKIE
+		http.SetCookie(w, &http.Cookie{
+			Name:     "jwt",
+			Value:    "",
+			Path:     "/",
+			Expires:  time.Unix(0, 0),
+			HttpOnly: true,
+			Secure:   false, //TODO: set to true in production
+			SameSite: http.SameSiteLaxMode,
+		})
+
+		utils.WriteJSON(ctx, w, http.StatusOK, "account deactivated")
+	}
+}
+
 // Returns status ok if passed Auth
 func (h *Handlers) authStatus() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -69,6 +69,10 @@ func (s *Handlers) getFollowSuggestions() http.HandlerFunc {
 func (s *Handlers) getFollowersPaginated() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
 
 		v := r.URL.Query()
 		userId, err1 := utils.PathValueGet(r, "user_id", ct.Id(0), true)
@@ -80,9 +84,10 @@ func (s *Handlers) getFollowersPaginated() http.HandlerFunc {
 		}
 
 		req := users.Pagination{
-			UserId: userId.Int64(),
-			Limit:  limit,
-			Offset: offset,
+			UserId:      userId.Int64(),
+			Limit:       limit,
+			Offset:      offset,
+			RequesterId: claims.UserId,
 		}
 
 		grpcResp, err := s.UsersService.GetFollowersPaginated(ctx, &req)
@@ -110,10 +115,10 @@ func (s *Handlers) getFollowersPaginated() http.HandlerFunc {
 func (s *Handlers) getFollowingPaginated() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
-		// claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
-		// if !ok {
-		// 	panic(1)
-		// }
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
 
 		v := r.URL.Query()
 		userId, err1 := utils.PathValueGet(r, "user_id", ct.Id(0), true)
@@ -125,9 +130,10 @@ func (s *Handlers) getFollowingPaginated() http.HandlerFunc {
 		}
 
 		req := &users.Pagination{
-			UserId: userId.Int64(),
-			Limit:  limit,
-			Offset: offset,
+			UserId:      userId.Int64(),
+			Limit:       limit,
+			Offset:      offset,
+			RequesterId: claims.UserId,
 		}
 
 		grpcResp, err := s.UsersService.GetFollowingPaginated(ctx, req)
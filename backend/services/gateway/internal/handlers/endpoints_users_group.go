@@ -3,6 +3,7 @@ package handlers
 import (
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
 	"social-network/shared/gen-go/common"
 	"social-network/shared/gen-go/media"
@@ -24,8 +25,11 @@ func (s *Handlers) createGroup() http.HandlerFunc {
 		}
 
 		type createGroupData struct {
-			GroupTitle       string `json:"group_title"`
-			GroupDescription string `json:"group_description"`
+			GroupTitle       string             `json:"group_title"`
+			GroupDescription string             `json:"group_description"`
+			Visibility       ct.GroupVisibility `json:"visibility" validate:"nullable"`
+			Category         ct.GroupCategory   `json:"category" validate:"nullable"`
+			Tags             ct.GroupTags       `json:"tags" validate:"nullable"`
 
 			GroupImageName string `json:"group_image_name"`
 			GroupImageSize int64  `json:"group_image_size"`
@@ -72,6 +76,9 @@ func (s *Handlers) createGroup() http.HandlerFunc {
 			GroupTitle:       httpReq.GroupTitle,
 			GroupDescription: httpReq.GroupDescription,
 			GroupImageId:     GroupImageId.Int64(),
+			Visibility:       httpReq.Visibility.String(),
+			Category:         httpReq.Category.String(),
+			Tags:             httpReq.Tags.Strings(),
 		}
 
 		groupId, err := s.UsersService.CreateGroup(ctx, &createGroupRequest)
@@ -107,10 +114,13 @@ func (s *Handlers) updateGroup() http.HandlerFunc {
 
 		type updateGroupData struct {
 			GroupId          ct.Id
-			GroupTitle       string `json:"group_title"`
-			GroupDescription string `json:"group_description"`
-			GroupImageId     ct.Id  `json:"group_image_id" validate:"nullable"`
-			DeleteImage      bool   `json:"delete_image"`
+			GroupTitle       string             `json:"group_title"`
+			GroupDescription string             `json:"group_description"`
+			GroupImageId     ct.Id              `json:"group_image_id" validate:"nullable"`
+			DeleteImage      bool               `json:"delete_image"`
+			Visibility       ct.GroupVisibility `json:"visibility" validate:"nullable"`
+			Category         ct.GroupCategory   `json:"category" validate:"nullable"`
+			Tags             ct.GroupTags       `json:"tags" validate:"nullable"`
 
 			GroupImageName string `json:"group_image_name"`
 			GroupImageSize int64  `json:"group_image_size"`
@@ -169,6 +179,12 @@ func (s *Handlers) updateGroup() http.HandlerFunc {
 			GroupDescription: httpReq.GroupDescription,
 			GroupImageId:     groupImageId.Int64(),
 			DeleteImage:      httpReq.DeleteImage,
+			Visibility:       httpReq.Visibility.String(),
+			Category:         httpReq.Category.String(),
+		}
+		// omitted tags are left unchanged, an empty list clears them
+		if httpReq.Tags != nil {
+			updateGroupRequest.Tags = &users.GroupTags{Values: httpReq.Tags.Strings()}
 		}
 
 		_, err = s.UsersService.UpdateGroup(ctx, &updateGroupRequest)
@@ -237,6 +253,9 @@ func (s *Handlers) getAllGroupsPaginated() http.HandlerFunc {
 				IsOwner:          group.IsOwner,
 				PendingRequest:   group.PendingRequest,
 				PendingInvite:    group.PendingInvite,
+				Visibility:       ct.GroupVisibility(group.Visibility),
+				Category:         ct.GroupCategory(group.Category),
+				Tags:             ct.GroupTagsFromStrings(group.Tags),
 			}
 			resp = append(resp, newGroup)
 		}
@@ -273,17 +292,23 @@ func (s *Handlers) getGroupInfo() http.HandlerFunc {
 		}
 
 		resp := models.Group{
-			GroupId:          ct.Id(grpcResp.GroupId),
-			GroupOwnerId:     ct.Id(grpcResp.GroupOwnerId),
-			GroupTitle:       ct.Title(grpcResp.GroupTitle),
-			GroupDescription: ct.About(grpcResp.GroupDescription),
-			GroupImage:       ct.Id(grpcResp.GroupImageId),
-			GroupImageURL:    grpcResp.GroupImageUrl,
-			MembersCount:     grpcResp.MembersCount,
-			IsMember:         grpcResp.IsMember,
-			IsOwner:          grpcResp.IsOwner,
-			PendingRequest:   grpcResp.PendingRequest,
-			PendingInvite:    grpcResp.PendingInvite,
+			GroupId:              ct.Id(grpcResp.GroupId),
+			GroupOwnerId:         ct.Id(grpcResp.GroupOwnerId),
+			GroupTitle:           ct.Title(grpcResp.GroupTitle),
+			GroupDescription:     ct.About(grpcResp.GroupDescription),
+			GroupImage:           ct.Id(grpcResp.GroupImageId),
+			GroupImageURL:        grpcResp.GroupImageUrl,
+			MembersCount:         grpcResp.MembersCount,
+			IsMember:             grpcResp.IsMember,
+			IsOwner:              grpcResp.IsOwner,
+			PendingRequest:       grpcResp.PendingRequest,
+			PendingInvite:        grpcResp.PendingInvite,
+			OwnershipOffered:     grpcResp.OwnershipOffered,
+			Archived:             grpcResp.Archived,
+			Visibility:           ct.GroupVisibility(grpcResp.Visibility),
+			Category:             ct.GroupCategory(grpcResp.Category),
+			Tags:                 ct.GroupTagsFromStrings(grpcResp.Tags),
+			PostsRequireApproval: grpcResp.PostsRequireApproval,
 		}
 
 		utils.WriteJSON(ctx, w, http.StatusOK, resp)
@@ -381,6 +406,9 @@ func (s *Handlers) getUserGroupsPaginated() http.HandlerFunc {
 				IsOwner:          group.IsOwner,
 				PendingRequest:   group.PendingRequest,
 				PendingInvite:    group.PendingInvite,
+				Visibility:       ct.GroupVisibility(group.Visibility),
+				Category:         ct.GroupCategory(group.Category),
+				Tags:             ct.GroupTagsFromStrings(group.Tags),
 			}
 			resp = append(resp, newGroup)
 		}
@@ -572,8 +600,8 @@ func (s *Handlers) removeFromGroup() http.HandlerFunc {
 	}
 }
 
-// request to join a group
-func (s *Handlers) requestJoinGroup() http.HandlerFunc {
+// ban a user from a group, optionally until a given time
+func (s *Handlers) banFromGroup() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
@@ -581,29 +609,57 @@ func (s *Handlers) requestJoinGroup() http.HandlerFunc {
 			panic(1)
 		}
 
-		// body, err := utils.JSON2Struct(&models.GroupJoinRequest{}, r)
-		// if err != nil {
-		// 	utils.ErrorJSON(ctx, w, http.StatusBadRequest, "Bad JSON data received")
-		// 	return
-		// }
-		body := models.GroupJoinRequest{}
+		body, err := utils.JSON2Struct(&models.BanFromGroupReq{}, r)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "Bad JSON data received")
+			return
+		}
 
-		var err error
 		body.GroupId, err = utils.PathValueGet(r, "group_id", ct.Id(0), true)
 		if err != nil {
 			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
 			return
 		}
 
-		req := &users.GroupJoinRequest{
-			RequesterId: claims.UserId,
+		_, err = s.UsersService.BanFromGroup(ctx, &users.BanFromGroupRequest{
 			GroupId:     body.GroupId.Int64(),
+			RequesterId: claims.UserId,
+			UserId:      body.UserId.Int64(),
+			Reason:      body.Reason,
+			ExpiresAt:   body.ExpiresAt.ToProto(),
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
 		}
 
-		_, err = s.UsersService.RequestJoinGroup(ctx, req)
+		utils.WriteJSON(ctx, w, http.StatusOK, nil)
+	}
+}
+
+// lift a group ban
+func (s *Handlers) unbanFromGroup() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		groupId, err1 := utils.PathValueGet(r, "group_id", ct.Id(0), true)
+		userId, err2 := utils.PathValueGet(r, "user_id", ct.Id(0), true)
+		if err := errors.Join(err1, err2); err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		_, err := s.UsersService.UnbanFromGroup(ctx, &users.UnbanFromGroupRequest{
+			GroupId:     groupId.Int64(),
+			RequesterId: claims.UserId,
+			UserId:      userId.Int64(),
+		})
 		if err != nil {
 			utils.ReturnHttpError(ctx, w, err)
-			//utils.ErrorJSON(ctx, w, http.StatusInternalServerError, "Could not process join request: "+err.Error())
 			return
 		}
 
@@ -611,8 +667,8 @@ func (s *Handlers) requestJoinGroup() http.HandlerFunc {
 	}
 }
 
-// accept or decline an invitation to
-func (s *Handlers) respondToGroupInvite() http.HandlerFunc {
+// list the active bans of a group
+func (s *Handlers) getGroupBans() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
@@ -620,12 +676,67 @@ func (s *Handlers) respondToGroupInvite() http.HandlerFunc {
 			panic(1)
 		}
 
-		type reqBody struct {
-			GroupId ct.Id `json:"group_id"`
-			Accept  bool  `json:"accept"`
+		v := r.URL.Query()
+		groupId, err1 := utils.PathValueGet(r, "group_id", ct.Id(0), true)
+		limit, err2 := utils.ParamGet(v, "limit", int32(1), false)
+		offset, err3 := utils.ParamGet(v, "offset", int32(0), false)
+		if err := errors.Join(err1, err2, err3); err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
 		}
 
-		body, err := utils.JSON2Struct(&reqBody{}, r)
+		grpcResp, err := s.UsersService.GetGroupBans(ctx, &users.GroupMembersRequest{
+			UserId:  claims.UserId,
+			GroupId: groupId.Int64(),
+			Limit:   limit,
+			Offset:  offset,
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		resp := models.GroupBans{
+			Bans: make([]models.GroupBan, 0, len(grpcResp.Bans)),
+		}
+		for _, b := range grpcResp.Bans {
+			resp.Bans = append(resp.Bans, models.GroupBan{
+				User: models.User{
+					UserId:    ct.Id(b.User.GetUserId()),
+					Username:  ct.Username(b.User.GetUsername()),
+					AvatarId:  ct.Id(b.User.GetAvatar()),
+					AvatarURL: b.User.GetAvatarUrl(),
+				},
+				BannedBy:  ct.Id(b.BannedBy),
+				Reason:    b.Reason,
+				ExpiresAt: ct.GenDateTime(b.ExpiresAt.AsTime()),
+				BannedAt:  ct.GenDateTime(b.BannedAt.AsTime()),
+			})
+		}
+
+		utils.WriteJSON(ctx, w, http.StatusOK, resp)
+	}
+}
+
+// promote a member to moderator or admin
+func (s *Handlers) promoteGroupMember() http.HandlerFunc {
+	return s.changeGroupMemberRole(true)
+}
+
+// demote a member to a lower role (defaults to member)
+func (s *Handlers) demoteGroupMember() http.HandlerFunc {
+	return s.changeGroupMemberRole(false)
+}
+
+func (s *Handlers) changeGroupMemberRole(promote bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		body, err := utils.JSON2Struct(&models.ChangeGroupRoleReq{}, r)
 		if err != nil {
 			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "Bad JSON data received")
 			return
@@ -637,16 +748,24 @@ func (s *Handlers) respondToGroupInvite() http.HandlerFunc {
 			return
 		}
 
-		req := &users.HandleGroupInviteRequest{
-			InvitedId: claims.UserId,
-			GroupId:   body.GroupId.Int64(),
-			Accepted:  body.Accept,
+		if !promote && body.Role == "" {
+			body.Role = ct.GroupRoleMember
 		}
 
-		_, err = s.UsersService.RespondToGroupInvite(ctx, req)
+		req := &users.GroupRoleRequest{
+			GroupId:     body.GroupId.Int64(),
+			RequesterId: claims.UserId,
+			MemberId:    body.MemberId.Int64(),
+			Role:        body.Role.String(),
+		}
+
+		if promote {
+			_, err = s.UsersService.PromoteGroupMember(ctx, req)
+		} else {
+			_, err = s.UsersService.DemoteGroupMember(ctx, req)
+		}
 		if err != nil {
 			utils.ReturnHttpError(ctx, w, err)
-			//utils.ErrorJSON(ctx, w, http.StatusInternalServerError, "Could not respond to invite: "+err.Error())
 			return
 		}
 
@@ -654,7 +773,8 @@ func (s *Handlers) respondToGroupInvite() http.HandlerFunc {
 	}
 }
 
-func (s *Handlers) searchGroups() http.HandlerFunc {
+// offer group ownership to a member
+func (s *Handlers) transferGroupOwnership() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
@@ -662,55 +782,78 @@ func (s *Handlers) searchGroups() http.HandlerFunc {
 			panic(1)
 		}
 
-		v := r.URL.Query()
-		query, err1 := utils.ParamGet(v, "query", "", true)
-		limit, err2 := utils.ParamGet(v, "limit", int32(1), false)
-		offset, err3 := utils.ParamGet(v, "offset", int32(0), false)
-		if err := errors.Join(err1, err2, err3); err != nil {
-			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+		body, err := utils.JSON2Struct(&models.TransferOwnershipReq{}, r)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "Bad JSON data received")
 			return
 		}
 
-		req := &users.GroupSearchRequest{
-			SearchTerm: query,
-			Limit:      limit,
-			Offset:     offset,
-			UserId:     claims.UserId,
+		body.GroupId, err = utils.PathValueGet(r, "group_id", ct.Id(0), true)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
 		}
 
-		grpcResp, err := s.UsersService.SearchGroups(ctx, req)
+		_, err = s.UsersService.TransferGroupOwnership(ctx, &users.TransferOwnershipRequest{
+			GroupId:    body.GroupId.Int64(),
+			OwnerId:    claims.UserId,
+			NewOwnerId: body.NewOwnerId.Int64(),
+		})
 		if err != nil {
 			utils.ReturnHttpError(ctx, w, err)
-			//utils.ErrorJSON(ctx, w, http.StatusInternalServerError, "Could not search groups: "+err.Error())
 			return
 		}
 
-		resp := models.Groups{
-			Groups: make([]models.Group, 0, len(grpcResp.GroupArr)),
+		utils.WriteJSON(ctx, w, http.StatusOK, nil)
+	}
+}
+
+// accept or decline an ownership offer
+func (s *Handlers) respondToOwnershipTransfer() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
 		}
-		for _, group := range grpcResp.GroupArr {
-			newGroup := models.Group{
-				GroupId:          ct.Id(group.GroupId),
-				GroupOwnerId:     ct.Id(group.GroupOwnerId),
-				GroupTitle:       ct.Title(group.GroupTitle),
-				GroupDescription: ct.About(group.GroupDescription),
-				GroupImage:       ct.Id(group.GroupImageId),
-				GroupImageURL:    group.GroupImageUrl,
-				MembersCount:     group.MembersCount,
-				IsMember:         group.IsMember,
-				IsOwner:          group.IsOwner,
-				PendingRequest:   group.PendingRequest,
-				PendingInvite:    group.PendingInvite,
-			}
 
-			resp.Groups = append(resp.Groups, newGroup)
+		body, err := utils.JSON2Struct(&models.HandleOwnershipTransferReq{}, r)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "Bad JSON data received")
+			return
 		}
 
-		utils.WriteJSON(ctx, w, http.StatusOK, resp)
+		body.GroupId, err = utils.PathValueGet(r, "group_id", ct.Id(0), true)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		_, err = s.UsersService.RespondToOwnershipTransfer(ctx, &users.HandleOwnershipTransferRequest{
+			GroupId:  body.GroupId.Int64(),
+			UserId:   claims.UserId,
+			Accepted: body.Accepted,
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		utils.WriteJSON(ctx, w, http.StatusOK, nil)
 	}
 }
 
-func (s *Handlers) getPendingGroupJoinRequests() http.HandlerFunc {
+// archive a group, making it read-only for members
+func (s *Handlers) archiveGroup() http.HandlerFunc {
+	return s.setGroupArchived(true)
+}
+
+// unarchive a previously archived group
+func (s *Handlers) unarchiveGroup() http.HandlerFunc {
+	return s.setGroupArchived(false)
+}
+
+func (s *Handlers) setGroupArchived(archived bool) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
@@ -718,39 +861,691 @@ func (s *Handlers) getPendingGroupJoinRequests() http.HandlerFunc {
 			panic(1)
 		}
 
-		v := r.URL.Query()
-		groupId, err1 := utils.PathValueGet(r, "group_id", ct.Id(0), true)
-		limit, err2 := utils.ParamGet(v, "limit", int32(1), false)
-		offset, err3 := utils.ParamGet(v, "offset", int32(0), false)
-		if err := errors.Join(err1, err2, err3); err != nil {
+		groupId, err := utils.PathValueGet(r, "group_id", ct.Id(0), true)
+		if err != nil {
 			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
 			return
 		}
 
-		req := &users.GroupMembersRequest{
+		req := &users.GeneralGroupRequest{
+			GroupId: groupId.Int64(),
 			UserId:  claims.UserId,
+		}
+
+		if archived {
+			_, err = s.UsersService.ArchiveGroup(ctx, req)
+		} else {
+			_, err = s.UsersService.UnarchiveGroup(ctx, req)
+		}
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		utils.WriteJSON(ctx, w, http.StatusOK, nil)
+	}
+}
+
+// permanently delete a group (owner only)
+func (s *Handlers) deleteGroup() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		groupId, err := utils.PathValueGet(r, "group_id", ct.Id(0), true)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		_, err = s.UsersService.DeleteGroup(ctx, &users.GeneralGroupRequest{
 			GroupId: groupId.Int64(),
-			Limit:   limit,
-			Offset:  offset,
+			UserId:  claims.UserId,
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
 		}
 
-		grpcResp, err := s.UsersService.GetPendingGroupJoinRequests(ctx, req)
+		utils.WriteJSON(ctx, w, http.StatusOK, nil)
+	}
+}
+
+// create a shareable invite link for a group
+func (s *Handlers) createGroupInviteLink() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		body, err := utils.JSON2Struct(&models.CreateGroupInviteLinkReq{}, r)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "Bad JSON data received")
+			return
+		}
+
+		body.GroupId, err = utils.PathValueGet(r, "group_id", ct.Id(0), true)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		grpcResp, err := s.UsersService.CreateGroupInviteLink(ctx, &users.CreateGroupInviteLinkRequest{
+			GroupId:     body.GroupId.Int64(),
+			RequesterId: claims.UserId,
+			ExpiresAt:   body.ExpiresAt.ToProto(),
+			MaxUses:     body.MaxUses,
+			AutoApprove: body.AutoApprove,
+		})
 		if err != nil {
 			utils.ReturnHttpError(ctx, w, err)
-			//utils.ErrorJSON(ctx, w, http.StatusInternalServerError, "Could not fetch group members: "+err.Error())
 			return
 		}
 
-		resp := &models.Users{}
+		utils.WriteJSON(ctx, w, http.StatusOK, inviteLinkFromPB(grpcResp))
+	}
+}
 
-		for _, grpcUser := range grpcResp.Users {
-			user := models.User{
-				UserId:    ct.Id(grpcUser.UserId),
-				Username:  ct.Username(grpcUser.Username),
-				AvatarId:  ct.Id(grpcUser.Avatar),
-				AvatarURL: grpcUser.AvatarUrl,
-			}
-			resp.Users = append(resp.Users, user)
+// list a group's invite links with their usage stats
+func (s *Handlers) getGroupInviteLinks() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		groupId, err := utils.PathValueGet(r, "group_id", ct.Id(0), true)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		grpcResp, err := s.UsersService.GetGroupInviteLinks(ctx, &users.GeneralGroupRequest{
+			GroupId: groupId.Int64(),
+			UserId:  claims.UserId,
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		resp := make([]models.GroupInviteLink, 0, len(grpcResp.Links))
+		for _, l := range grpcResp.Links {
+			resp = append(resp, inviteLinkFromPB(l))
+		}
+
+		utils.WriteJSON(ctx, w, http.StatusOK, resp)
+	}
+}
+
+// revoke a group invite link
+func (s *Handlers) revokeGroupInviteLink() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		groupId, err1 := utils.PathValueGet(r, "group_id", ct.Id(0), true)
+		linkId, err2 := utils.PathValueGet(r, "link_id", ct.Id(0), true)
+		if err := errors.Join(err1, err2); err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		_, err := s.UsersService.RevokeGroupInviteLink(ctx, &users.RevokeGroupInviteLinkRequest{
+			GroupId:     groupId.Int64(),
+			RequesterId: claims.UserId,
+			LinkId:      linkId.Int64(),
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		utils.WriteJSON(ctx, w, http.StatusOK, nil)
+	}
+}
+
+// join a group through an invite link token
+func (s *Handlers) joinGroupByLink() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		body, err := utils.JSON2Struct(&models.JoinGroupByLinkReq{}, r)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "Bad JSON data received")
+			return
+		}
+
+		req := &users.JoinGroupByLinkRequest{
+			UserId:      claims.UserId,
+			Token:       body.Token,
+			AcceptRules: body.AcceptRules,
+			Answers:     make([]*users.GroupJoinAnswer, 0, len(body.Answers)),
+		}
+		for _, a := range body.Answers {
+			req.Answers = append(req.Answers, &users.GroupJoinAnswer{
+				QuestionId: a.QuestionId.Int64(),
+				Answer:     a.Answer,
+			})
+		}
+
+		grpcResp, err := s.UsersService.JoinGroupByLink(ctx, req)
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		resp := models.JoinGroupByLinkResp{
+			GroupId: ct.Id(grpcResp.GroupId),
+			Joined:  grpcResp.Joined,
+		}
+
+		utils.WriteJSON(ctx, w, http.StatusOK, resp)
+	}
+}
+
+// get the rules and join questions of a group
+func (s *Handlers) getGroupJoinForm() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		groupId, err := utils.PathValueGet(r, "group_id", ct.Id(0), true)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		grpcResp, err := s.UsersService.GetGroupJoinForm(ctx, &users.GeneralGroupRequest{
+			GroupId: groupId.Int64(),
+			UserId:  claims.UserId,
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		resp := models.GroupJoinForm{
+			GroupId:   ct.Id(grpcResp.GroupId),
+			Rules:     grpcResp.Rules,
+			Questions: make([]models.GroupJoinQuestion, 0, len(grpcResp.Questions)),
+		}
+		if resp.Rules == nil {
+			resp.Rules = []string{}
+		}
+		for _, q := range grpcResp.Questions {
+			resp.Questions = append(resp.Questions, models.GroupJoinQuestion{
+				QuestionId: ct.Id(q.QuestionId),
+				Question:   q.Question,
+				Required:   q.Required,
+			})
+		}
+
+		utils.WriteJSON(ctx, w, http.StatusOK, resp)
+	}
+}
+
+// replace the rules and join questions of a group
+func (s *Handlers) setGroupJoinForm() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		body, err := utils.JSON2Struct(&models.SetGroupJoinFormReq{}, r)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "Bad JSON data received")
+			return
+		}
+
+		groupId, err := utils.PathValueGet(r, "group_id", ct.Id(0), true)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		req := &users.SetGroupJoinFormRequest{
+			RequesterId: claims.UserId,
+			GroupId:     groupId.Int64(),
+			Rules:       body.Rules,
+			Questions:   make([]*users.GroupJoinQuestion, 0, len(body.Questions)),
+		}
+		for _, q := range body.Questions {
+			req.Questions = append(req.Questions, &users.GroupJoinQuestion{
+				Question: q.Question,
+				Required: q.Required,
+			})
+		}
+
+		_, err = s.UsersService.SetGroupJoinForm(ctx, req)
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		utils.WriteJSON(ctx, w, http.StatusOK, nil)
+	}
+}
+
+// turn approval of new posts on or off, body: {"required": true|false}
+func (s *Handlers) setGroupPostApproval() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		body, err := utils.JSON2Struct(&models.SetGroupPostApprovalReq{}, r)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "Bad JSON data received")
+			return
+		}
+
+		groupId, err := utils.PathValueGet(r, "group_id", ct.Id(0), true)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		_, err = s.UsersService.SetGroupPostApproval(ctx, &users.SetGroupPostApprovalRequest{
+			RequesterId: claims.UserId,
+			GroupId:     groupId.Int64(),
+			Required:    body.Required,
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		utils.WriteJSON(ctx, w, http.StatusOK, nil)
+	}
+}
+
+func inviteLinkFromPB(l *users.GroupInviteLink) models.GroupInviteLink {
+	return models.GroupInviteLink{
+		LinkId:         ct.Id(l.LinkId),
+		GroupId:        ct.Id(l.GroupId),
+		Token:          l.Token,
+		CreatedBy:      ct.Id(l.CreatedBy),
+		CreatedAt:      ct.GenDateTime(l.CreatedAt.AsTime()),
+		ExpiresAt:      ct.GenDateTime(l.ExpiresAt.AsTime()),
+		MaxUses:        l.MaxUses,
+		AutoApprove:    l.AutoApprove,
+		Revoked:        l.Revoked,
+		Uses:           l.Uses,
+		JoinedCount:    l.JoinedCount,
+		RequestedCount: l.RequestedCount,
+		LastUsedAt:     ct.GenDateTime(l.LastUsedAt.AsTime()),
+	}
+}
+
+// request to join a group
+func (s *Handlers) requestJoinGroup() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		// the body is optional, groups without rules or questions can be joined without one
+		body := models.GroupJoinRequest{}
+		decoder := json.NewDecoder(r.Body)
+		defer r.Body.Close()
+		if err := decoder.Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "Bad JSON data received")
+			return
+		}
+
+		var err error
+		body.GroupId, err = utils.PathValueGet(r, "group_id", ct.Id(0), true)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		req := &users.GroupJoinRequest{
+			RequesterId: claims.UserId,
+			GroupId:     body.GroupId.Int64(),
+			AcceptRules: body.AcceptRules,
+			Answers:     make([]*users.GroupJoinAnswer, 0, len(body.Answers)),
+		}
+		for _, a := range body.Answers {
+			req.Answers = append(req.Answers, &users.GroupJoinAnswer{
+				QuestionId: a.QuestionId.Int64(),
+				Answer:     a.Answer,
+			})
+		}
+
+		_, err = s.UsersService.RequestJoinGroup(ctx, req)
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			//utils.ErrorJSON(ctx, w, http.StatusInternalServerError, "Could not process join request: "+err.Error())
+			return
+		}
+
+		utils.WriteJSON(ctx, w, http.StatusOK, nil)
+	}
+}
+
+// accept or decline an invitation to
+func (s *Handlers) respondToGroupInvite() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		type reqBody struct {
+			GroupId ct.Id `json:"group_id"`
+			Accept  bool  `json:"accept"`
+		}
+
+		body, err := utils.JSON2Struct(&reqBody{}, r)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "Bad JSON data received")
+			return
+		}
+
+		body.GroupId, err = utils.PathValueGet(r, "group_id", ct.Id(0), true)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		req := &users.HandleGroupInviteRequest{
+			InvitedId: claims.UserId,
+			GroupId:   body.GroupId.Int64(),
+			Accepted:  body.Accept,
+		}
+
+		_, err = s.UsersService.RespondToGroupInvite(ctx, req)
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			//utils.ErrorJSON(ctx, w, http.StatusInternalServerError, "Could not respond to invite: "+err.Error())
+			return
+		}
+
+		utils.WriteJSON(ctx, w, http.StatusOK, nil)
+	}
+}
+
+func (s *Handlers) searchGroups() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		v := r.URL.Query()
+		query, err1 := utils.ParamGet(v, "query", "", true)
+		limit, err2 := utils.ParamGet(v, "limit", int32(1), false)
+		offset, err3 := utils.ParamGet(v, "offset", int32(0), false)
+		if err := errors.Join(err1, err2, err3); err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		req := &users.GroupSearchRequest{
+			SearchTerm: query,
+			Limit:      limit,
+			Offset:     offset,
+			UserId:     claims.UserId,
+		}
+
+		grpcResp, err := s.UsersService.SearchGroups(ctx, req)
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			//utils.ErrorJSON(ctx, w, http.StatusInternalServerError, "Could not search groups: "+err.Error())
+			return
+		}
+
+		resp := models.Groups{
+			Groups: make([]models.Group, 0, len(grpcResp.GroupArr)),
+		}
+		for _, group := range grpcResp.GroupArr {
+			newGroup := models.Group{
+				GroupId:          ct.Id(group.GroupId),
+				GroupOwnerId:     ct.Id(group.GroupOwnerId),
+				GroupTitle:       ct.Title(group.GroupTitle),
+				GroupDescription: ct.About(group.GroupDescription),
+				GroupImage:       ct.Id(group.GroupImageId),
+				GroupImageURL:    group.GroupImageUrl,
+				MembersCount:     group.MembersCount,
+				IsMember:         group.IsMember,
+				IsOwner:          group.IsOwner,
+				PendingRequest:   group.PendingRequest,
+				PendingInvite:    group.PendingInvite,
+				Visibility:       ct.GroupVisibility(group.Visibility),
+				Category:         ct.GroupCategory(group.Category),
+				Tags:             ct.GroupTagsFromStrings(group.Tags),
+			}
+
+			resp.Groups = append(resp.Groups, newGroup)
+		}
+
+		utils.WriteJSON(ctx, w, http.StatusOK, resp)
+	}
+}
+
+func (s *Handlers) getGroupInsights() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		groupId, err := utils.PathValueGet(r, "group_id", ct.Id(0), true)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		grpcResp, err := s.UsersService.GetGroupInsights(ctx, &users.GeneralGroupRequest{
+			GroupId: groupId.Int64(),
+			UserId:  claims.UserId,
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		join := grpcResp.GetJoinRequests()
+		resp := models.GroupInsights{
+			GroupId:      ct.Id(grpcResp.GroupId),
+			Since:        ct.GenDateTime(grpcResp.Since.AsTime()),
+			ComputedAt:   ct.GenDateTime(grpcResp.ComputedAt.AsTime()),
+			MembersCount: grpcResp.MembersCount,
+			MemberGrowth: make([]models.GroupMemberGrowth, 0, len(grpcResp.MemberGrowth)),
+			GroupContentInsights: models.GroupContentInsights{
+				PostsPerDay:    groupDailyCountsFromPb(grpcResp.PostsPerDay),
+				CommentsPerDay: groupDailyCountsFromPb(grpcResp.CommentsPerDay),
+				ActivePosters:  make([]models.GroupActivePoster, 0, len(grpcResp.ActivePosters)),
+				Events:         make([]models.GroupEventAttendance, 0, len(grpcResp.Events)),
+			},
+			AttendanceRate: grpcResp.AttendanceRate,
+			JoinRequests: models.GroupJoinRequestStats{
+				Accepted:              join.GetAccepted(),
+				Rejected:              join.GetRejected(),
+				Pending:               join.GetPending(),
+				AvgApprovalSeconds:    join.GetAvgApprovalSeconds(),
+				MedianApprovalSeconds: join.GetMedianApprovalSeconds(),
+			},
+		}
+		for _, g := range grpcResp.MemberGrowth {
+			resp.MemberGrowth = append(resp.MemberGrowth, models.GroupMemberGrowth{
+				Day:    ct.GenDateTime(g.Day.AsTime()),
+				Joined: g.Joined,
+				Total:  g.Total,
+			})
+		}
+		for _, p := range grpcResp.ActivePosters {
+			resp.ActivePosters = append(resp.ActivePosters, models.GroupActivePoster{
+				User: models.User{
+					UserId:      ct.Id(p.User.GetUserId()),
+					Username:    ct.Username(p.User.GetUsername()),
+					AvatarId:    ct.Id(p.User.GetAvatar()),
+					AvatarURL:   p.User.GetAvatarUrl(),
+					Deactivated: p.User.GetDeactivated(),
+				},
+				PostCount:    p.PostCount,
+				CommentCount: p.CommentCount,
+			})
+		}
+		for _, e := range grpcResp.Events {
+			resp.Events = append(resp.Events, models.GroupEventAttendance{
+				EventId:        ct.Id(e.EventId),
+				Title:          ct.Title(e.Title),
+				EventDate:      ct.GenDateTime(e.EventDate.AsTime()),
+				GoingCount:     e.GoingCount,
+				NotGoingCount:  e.NotGoingCount,
+				AttendanceRate: e.AttendanceRate,
+			})
+		}
+
+		utils.WriteJSON(ctx, w, http.StatusOK, resp)
+	}
+}
+
+func groupDailyCountsFromPb(counts []*users.GroupDailyCount) []models.DailyCount {
+	out := make([]models.DailyCount, 0, len(counts))
+	for _, c := range counts {
+		out = append(out, models.DailyCount{Day: ct.GenDateTime(c.Day.AsTime()), Count: c.Count})
+	}
+	return out
+}
+
+func (s *Handlers) discoverGroups() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		v := r.URL.Query()
+		category, err1 := utils.ParamGet(v, "category", "", false)
+		limit, err2 := utils.ParamGet(v, "limit", int32(1), false)
+		offset, err3 := utils.ParamGet(v, "offset", int32(0), false)
+		if err := errors.Join(err1, err2, err3); err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		req := &users.DiscoverGroupsRequest{
+			UserId:   claims.UserId,
+			Category: category,
+			Limit:    limit,
+			Offset:   offset,
+		}
+
+		grpcResp, err := s.UsersService.DiscoverGroups(ctx, req)
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		resp := models.Groups{
+			Groups: make([]models.Group, 0, len(grpcResp.GroupArr)),
+		}
+		for _, group := range grpcResp.GroupArr {
+			resp.Groups = append(resp.Groups, models.Group{
+				GroupId:          ct.Id(group.GroupId),
+				GroupOwnerId:     ct.Id(group.GroupOwnerId),
+				GroupTitle:       ct.Title(group.GroupTitle),
+				GroupDescription: ct.About(group.GroupDescription),
+				GroupImage:       ct.Id(group.GroupImageId),
+				GroupImageURL:    group.GroupImageUrl,
+				MembersCount:     group.MembersCount,
+				PendingRequest:   group.PendingRequest,
+				PendingInvite:    group.PendingInvite,
+				Visibility:       ct.GroupVisibility(group.Visibility),
+				Category:         ct.GroupCategory(group.Category),
+				Tags:             ct.GroupTagsFromStrings(group.Tags),
+			})
+		}
+
+		utils.WriteJSON(ctx, w, http.StatusOK, resp)
+	}
+}
+
+func (s *Handlers) getPendingGroupJoinRequests() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		v := r.URL.Query()
+		groupId, err1 := utils.PathValueGet(r, "group_id", ct.Id(0), true)
+		limit, err2 := utils.ParamGet(v, "limit", int32(1), false)
+		offset, err3 := utils.ParamGet(v, "offset", int32(0), false)
+		if err := errors.Join(err1, err2, err3); err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		req := &users.GroupMembersRequest{
+			UserId:  claims.UserId,
+			GroupId: groupId.Int64(),
+			Limit:   limit,
+			Offset:  offset,
+		}
+
+		grpcResp, err := s.UsersService.GetPendingGroupJoinRequests(ctx, req)
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			//utils.ErrorJSON(ctx, w, http.StatusInternalServerError, "Could not fetch group members: "+err.Error())
+			return
+		}
+
+		resp := &models.PendingJoinRequests{}
+
+		for _, grpcReq := range grpcResp.Requests {
+			grpcUser := grpcReq.User
+			request := models.PendingJoinRequest{
+				User: models.User{
+					UserId:    ct.Id(grpcUser.GetUserId()),
+					Username:  ct.Username(grpcUser.GetUsername()),
+					AvatarId:  ct.Id(grpcUser.GetAvatar()),
+					AvatarURL: grpcUser.GetAvatarUrl(),
+				},
+				RequestedAt:   ct.GenDateTime(grpcReq.RequestedAt.AsTime()),
+				ExpiresAt:     ct.GenDateTime(grpcReq.ExpiresAt.AsTime()),
+				RulesAccepted: grpcReq.RulesAccepted,
+				Answers:       make([]models.GroupJoinAnswer, 0, len(grpcReq.Answers)),
+			}
+			for _, a := range grpcReq.Answers {
+				request.Answers = append(request.Answers, models.GroupJoinAnswer{
+					QuestionId: ct.Id(a.QuestionId),
+					Question:   a.Question,
+					Answer:     a.Answer,
+				})
+			}
+			resp.Users = append(resp.Users, request)
 		}
 
 		utils.WriteJSON(ctx, w, http.StatusOK, resp)
@@ -15,6 +15,8 @@ import (
 	"social-network/shared/go/models"
 	tele "social-network/shared/go/telemetry"
 	"time"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func (h *Handlers) getUserProfile() http.HandlerFunc {
@@ -180,6 +182,79 @@ func (s *Handlers) updateProfilePrivacy() http.HandlerFunc {
 	}
 }
 
+func (s *Handlers) getPrivacySettings() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		grpcResp, err := s.UsersService.GetPrivacySettings(ctx, wrapperspb.Int64(claims.UserId))
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		resp := models.PrivacySettings{
+			UserId:               ct.Id(grpcResp.UserId),
+			WhoCanMessage:        ct.PrivacyAudience(grpcResp.WhoCanMessage),
+			WhoCanComment:        ct.PrivacyAudience(grpcResp.WhoCanComment),
+			WhoCanSeeFollowLists: ct.PrivacyAudience(grpcResp.WhoCanSeeFollowLists),
+			WhoCanInviteToGroups: ct.PrivacyAudience(grpcResp.WhoCanInviteToGroups),
+			Discoverable:         grpcResp.Discoverable,
+		}
+
+		utils.WriteJSON(ctx, w, http.StatusOK, resp)
+	}
+}
+
+func (s *Handlers) updatePrivacySettings() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		type reqBody struct {
+			WhoCanMessage        ct.PrivacyAudience `json:"who_can_message"`
+			WhoCanComment        ct.PrivacyAudience `json:"who_can_comment"`
+			WhoCanSeeFollowLists ct.PrivacyAudience `json:"who_can_see_follow_lists"`
+			WhoCanInviteToGroups ct.PrivacyAudience `json:"who_can_invite_to_groups"`
+			Discoverable         bool               `json:"discoverable"`
+		}
+
+		body, err := utils.JSON2Struct(&reqBody{}, r)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "Bad JSON data received")
+			return
+		}
+
+		if err := ct.ValidateStruct(body); err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		req := &users.PrivacySettings{
+			UserId:               claims.UserId,
+			WhoCanMessage:        body.WhoCanMessage.String(),
+			WhoCanComment:        body.WhoCanComment.String(),
+			WhoCanSeeFollowLists: body.WhoCanSeeFollowLists.String(),
+			WhoCanInviteToGroups: body.WhoCanInviteToGroups.String(),
+			Discoverable:         body.Discoverable,
+		}
+
+		_, err = s.UsersService.UpdatePrivacySettings(ctx, req)
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		utils.WriteJSON(ctx, w, http.StatusOK, nil)
+	}
+}
+
 func (s *Handlers) updateUserProfile() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
@@ -189,7 +264,7 @@ func (s *Handlers) updateUserProfile() http.HandlerFunc {
 		}
 
 		type UpdateProfileJSONRequest struct {
-			Username    ct.Username    `json:"username"`
+			Username    ct.Username    `json:"username" validate:"nullable"`
 			FirstName   ct.Name        `json:"first_name"`
 			LastName    ct.Name        `json:"last_name"`
 			DateOfBirth ct.DateOfBirth `json:"date_of_birth"`
@@ -272,6 +347,71 @@ func (s *Handlers) updateUserProfile() http.HandlerFunc {
 	}
 }
 
+func (s *Handlers) changeUsername() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		claims, ok := utils.GetValue[jwt.Claims](r, ct.ClaimsKey)
+		if !ok {
+			panic(1)
+		}
+
+		type reqBody struct {
+			Username ct.Username `json:"username"`
+		}
+
+		body, err := utils.JSON2Struct(&reqBody{}, r)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "Bad JSON data received")
+			return
+		}
+
+		if err := ct.ValidateStruct(body); err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		_, err = s.UsersService.ChangeUsername(ctx, &users.ChangeUsernameRequest{
+			UserId:   claims.UserId,
+			Username: body.Username.String(),
+		})
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		utils.WriteJSON(ctx, w, http.StatusOK, nil)
+	}
+}
+
+// Get endpoint to map a current or former username to a user id.
+// endpoint: /users/resolve-handle?handle=
+func (s *Handlers) resolveHandle() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		v := r.URL.Query()
+		handle, err := utils.ParamGet(v, "handle", "", true)
+		if err != nil {
+			utils.ErrorJSON(ctx, w, http.StatusBadRequest, "bad url params: "+err.Error())
+			return
+		}
+
+		grpcResp, err := s.UsersService.ResolveHandle(ctx, wrapperspb.String(handle))
+		if err != nil {
+			utils.ReturnHttpError(ctx, w, err)
+			return
+		}
+
+		resp := models.ResolvedHandle{
+			UserId:     ct.Id(grpcResp.UserId),
+			Username:   ct.Username(grpcResp.Username),
+			Redirected: grpcResp.Redirected,
+		}
+
+		utils.WriteJSON(ctx, w, http.StatusOK, resp)
+	}
+}
+
 // Get endpoint to fetch username, avatarid and avatar thumbnail variant download url by user name.
 // endpoint: /users/{user_id}/retrieve
 func (h *Handlers) retrieveUser() http.HandlerFunc {
@@ -147,6 +147,14 @@ func (h *Handlers) BuildMux(serviceName string) *http.ServeMux {
 
 		//TODO group id from url --DONE
 
+	SetEndpoint("/groups/{group_id}/insights").
+		AllowedMethod("GET").
+		RateLimit(IP, 5, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 5, 5).
+		Finalize(h.getGroupInsights())
+
 	SetEndpoint("/groups/{group_id}/popular-post").
 		AllowedMethod("GET").
 		RateLimit(IP, 5, 5).
@@ -205,6 +213,150 @@ func (h *Handlers) BuildMux(serviceName string) *http.ServeMux {
 		RateLimit(USERID, 20, 5).
 		Finalize(h.removeFromGroup())
 
+	SetEndpoint("/groups/{group_id}/bans").
+		AllowedMethod("POST").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.banFromGroup())
+
+	SetEndpoint("/groups/{group_id}/bans").
+		AllowedMethod("GET").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.getGroupBans())
+
+	SetEndpoint("/groups/{group_id}/bans/{user_id}").
+		AllowedMethod("DELETE").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.unbanFromGroup())
+
+	SetEndpoint("/groups/{group_id}/promote-member").
+		AllowedMethod("POST").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.promoteGroupMember())
+
+	SetEndpoint("/groups/{group_id}/demote-member").
+		AllowedMethod("POST").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.demoteGroupMember())
+
+	SetEndpoint("/groups/{group_id}/transfer-ownership").
+		AllowedMethod("POST").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.transferGroupOwnership())
+
+	SetEndpoint("/groups/{group_id}/ownership-response").
+		AllowedMethod("POST").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.respondToOwnershipTransfer())
+
+	SetEndpoint("/groups/{group_id}/archive").
+		AllowedMethod("POST").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.archiveGroup())
+
+	SetEndpoint("/groups/{group_id}/unarchive").
+		AllowedMethod("POST").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.unarchiveGroup())
+
+	SetEndpoint("/groups/{group_id}").
+		AllowedMethod("DELETE").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.deleteGroup())
+
+	SetEndpoint("/groups/{group_id}/invite-links").
+		AllowedMethod("POST").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.createGroupInviteLink())
+
+	SetEndpoint("/groups/{group_id}/invite-links").
+		AllowedMethod("GET").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.getGroupInviteLinks())
+
+	SetEndpoint("/groups/{group_id}/invite-links/{link_id}").
+		AllowedMethod("DELETE").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.revokeGroupInviteLink())
+
+	SetEndpoint("/groups/{group_id}/join-form").
+		AllowedMethod("GET").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.getGroupJoinForm())
+
+	SetEndpoint("/groups/{group_id}/join-form").
+		AllowedMethod("POST").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.setGroupJoinForm())
+
+	SetEndpoint("/groups/{group_id}/post-approval").
+		AllowedMethod("POST").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.setGroupPostApproval())
+
+	SetEndpoint("/groups/{group_id}/pending-posts").
+		AllowedMethod("GET").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.getPendingGroupPosts())
+
+	SetEndpoint("/groups/join-by-link").
+		AllowedMethod("POST").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.joinGroupByLink())
+
 		//TODO group id url --DONE
 
 	SetEndpoint("/groups/{group_id}/join-request").
@@ -275,6 +427,16 @@ func (h *Handlers) BuildMux(serviceName string) *http.ServeMux {
 		RateLimit(USERID, 20, 5).
 		Finalize(h.searchGroups())
 
+		//params: category, limit, offset
+
+	SetEndpoint("/groups/discover").
+		AllowedMethod("GET").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.discoverGroups())
+
 		//params, groupid url --DONE
 
 	SetEndpoint("/groups/{group_id}/posts").
@@ -380,6 +542,22 @@ func (h *Handlers) BuildMux(serviceName string) *http.ServeMux {
 		RateLimit(USERID, 20, 5).
 		Finalize(h.searchUsers())
 
+	SetEndpoint("/search").
+		AllowedMethod("GET").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.search())
+
+	SetEndpoint("/users/resolve-handle").
+		AllowedMethod("GET").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.resolveHandle())
+
 		//userid url --DONE
 
 	SetEndpoint("/users/{user_id}/unfollow").
@@ -441,6 +619,22 @@ func (h *Handlers) BuildMux(serviceName string) *http.ServeMux {
 		RateLimit(USERID, 20, 5).
 		Finalize(h.updateProfilePrivacy())
 
+	SetEndpoint("/my/privacy-settings").
+		AllowedMethod("GET").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.getPrivacySettings())
+
+	SetEndpoint("/my/privacy-settings").
+		AllowedMethod("POST").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.updatePrivacySettings())
+
 	SetEndpoint("/my/profile/email").
 		AllowedMethod("POST").
 		RateLimit(IP, 20, 5).
@@ -457,6 +651,22 @@ func (h *Handlers) BuildMux(serviceName string) *http.ServeMux {
 		RateLimit(USERID, 20, 5).
 		Finalize(h.updateUserPassword())
 
+	SetEndpoint("/my/profile/username").
+		AllowedMethod("POST").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 5, 5).
+		Finalize(h.changeUsername())
+
+	SetEndpoint("/my/deactivate").
+		AllowedMethod("POST").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 5, 5).
+		Finalize(h.deactivateAccount())
+
 	SetEndpoint("/my/profile").
 		AllowedMethod("POST").
 		RateLimit(IP, 20, 5).
@@ -490,6 +700,22 @@ func (h *Handlers) BuildMux(serviceName string) *http.ServeMux {
 		RateLimit(USERID, 20, 5).
 		Finalize(h.getPersonalizedFeed())
 
+	SetEndpoint("/hashtags/trending").
+		AllowedMethod("GET").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.getTrendingHashtags())
+
+	SetEndpoint("/hashtags/{tag}").
+		AllowedMethod("GET").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.getHashtagFeed())
+
 		//params, postsid url --DONE
 
 	SetEndpoint("/posts/{post_id}").
@@ -530,6 +756,130 @@ func (h *Handlers) BuildMux(serviceName string) *http.ServeMux {
 		RateLimit(USERID, 20, 5).
 		Finalize(h.deletePost())
 
+	SetEndpoint("/posts/{post_id}/pin").
+		AllowedMethod("POST").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.setPostPinned())
+
+	SetEndpoint("/posts/{post_id}/announcement").
+		AllowedMethod("POST").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.setPostAnnouncement())
+
+	SetEndpoint("/posts/{post_id}/approve").
+		AllowedMethod("POST").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.approvePost())
+
+	SetEndpoint("/posts/{post_id}/reject").
+		AllowedMethod("POST").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.rejectPost())
+
+	SetEndpoint("/posts/scheduled").
+		AllowedMethod("GET").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.getScheduledPosts())
+
+	SetEndpoint("/posts/{post_id}/reschedule").
+		AllowedMethod("POST").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.reschedulePost())
+
+	SetEndpoint("/posts/{post_id}/cancel-schedule").
+		AllowedMethod("POST").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.cancelScheduledPost())
+
+	SetEndpoint("/posts/{post_id}/revisions").
+		AllowedMethod("GET").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.getRevisions("post_id"))
+
+	SetEndpoint("/posts/{post_id}/share").
+		AllowedMethod("POST").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.sharePost())
+
+	SetEndpoint("/posts/{post_id}/unrepost").
+		AllowedMethod("POST").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.undoRepost())
+
+		// DRAFTS ===================
+		// DRAFTS ===================
+		// DRAFTS ===================
+
+	SetEndpoint("/drafts").
+		AllowedMethod("POST").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.createDraft())
+
+	SetEndpoint("/drafts").
+		AllowedMethod("GET").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.getDrafts())
+
+	SetEndpoint("/drafts/{draft_id}").
+		AllowedMethod("POST").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.updateDraft())
+
+	SetEndpoint("/drafts/{draft_id}").
+		AllowedMethod("DELETE").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.deleteDraft())
+
+	SetEndpoint("/drafts/{draft_id}/publish").
+		AllowedMethod("POST").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.publishDraft())
+
 		// COMMENTS ===================
 		// COMMENTS ===================
 		// COMMENTS ===================
@@ -572,6 +922,14 @@ func (h *Handlers) BuildMux(serviceName string) *http.ServeMux {
 		RateLimit(USERID, 20, 5).
 		Finalize(h.getCommentsByParentId())
 
+	SetEndpoint("/comments/{comment_id}/revisions").
+		AllowedMethod("GET").
+		RateLimit(IP, 20, 5).
+		Auth().
+		EnrichContext().
+		RateLimit(USERID, 20, 5).
+		Finalize(h.getRevisions("comment_id"))
+
 		//EVENTS ===========================
 		//EVENTS ===========================
 		//EVENTS ===========================
@@ -300,3 +300,36 @@ func setExp(v ct.FileVisibility) time.Duration {
 	}
 	return time.Duration(0)
 }
+
+// Deletes the files and all their variants from db and file service.
+// Rows are only removed once every object is gone so a failed call can be retried.
+// Unknown ids are ignored.
+func (m *MediaService) DeleteImages(ctx context.Context, imgIds ct.Ids) error {
+	input := fmt.Sprintf("ids: %v", imgIds)
+
+	if len(imgIds) == 0 {
+		return nil
+	}
+	if err := imgIds.Validate(); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, input)
+	}
+
+	err := m.txRunner.RunTx(ctx, func(tx *dbservice.Queries) error {
+		fms, err := tx.DeleteFiles(ctx, imgIds)
+		if err != nil {
+			return mapDBError(err)
+		}
+
+		for _, fm := range fms {
+			if err := m.S3.DeleteFile(ctx, fm.Bucket, fm.ObjectKey); err != nil {
+				return ce.Wrap(ce.ErrInternal, err, input+": s3: delete file "+fm.ObjectKey)
+			}
+		}
+		tele.Info(ctx, "deleted @1 objects of files @2", "count", len(fms), "ids", imgIds)
+		return nil
+	})
+	if err != nil {
+		return ce.Wrap(nil, err, input)
+	}
+	return nil
+}
@@ -465,3 +465,57 @@ func (q *Queries) GetPendingVariants(
 
 	return pending, err
 }
+
+// Deletes the files rows with their variants and returns the objects
+// they pointed to, originals and variants alike.
+// Missing rows is no error
+func (q *Queries) DeleteFiles(
+	ctx context.Context,
+	ids ct.Ids,
+) ([]File, error) {
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	const query = `
+		WITH deleted_variants AS (
+			DELETE FROM file_variants
+			WHERE file_id = ANY($1)
+			RETURNING file_id, bucket, object_key, variant
+		), deleted_files AS (
+			DELETE FROM files
+			WHERE id = ANY($1)
+			RETURNING id, bucket, object_key
+		)
+		SELECT id, bucket, object_key, 'original' FROM deleted_files
+		UNION ALL
+		SELECT file_id, bucket, object_key, variant FROM deleted_variants
+	`
+
+	rows, err := q.db.Query(ctx, query, ids.Unique())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var files []File
+	for rows.Next() {
+		var fm File
+		if err := rows.Scan(
+			&fm.Id,
+			&fm.Bucket,
+			&fm.ObjectKey,
+			&fm.Variant,
+		); err != nil {
+			return nil, err
+		}
+		files = append(files, fm)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
@@ -46,6 +46,8 @@ type Querier interface {
 	GetPendingVariants(
 		ctx context.Context) (pending []Variant, err error)
 
+	DeleteFiles(ctx context.Context, ids ct.Ids) ([]File, error)
+
 	// StartStaleFilesWorker(ctx context.Context)
 	MarkStaleFilesFailed(ctx context.Context) error
 }
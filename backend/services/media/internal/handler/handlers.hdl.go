@@ -13,6 +13,7 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type MediaHandler struct {
@@ -175,3 +176,24 @@ func (m *MediaHandler) ValidateUpload(ctx context.Context,
 	tele.Info(ctx, "validate image success. @1 @2", "request", req.String(), "response", res.String())
 	return res, nil
 }
+
+// Deletes the files and all their variants from file service and db.
+// Unknown ids are ignored. Callers must hold the only reference to the ids.
+func (m *MediaHandler) DeleteImages(ctx context.Context,
+	req *pb.ImageIds) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	tele.Info(ctx, "delete images called. @1", "request", req.String())
+
+	ids := make(ct.Ids, len(req.ImgIds))
+	for i, id := range req.ImgIds {
+		ids[i] = ct.Id(id)
+	}
+
+	if err := m.Application.DeleteImages(ctx, ids); err != nil {
+		tele.Error(ctx, "delete images error", "request", req.String(), "error", err.Error())
+		return nil, ce.EncodeProto(err)
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"social-network/services/notifications/internal/client"
 	"social-network/services/notifications/internal/db/sqlc"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/nats-io/nats.go"
 )
 
@@ -19,12 +20,15 @@ type DBInterface interface {
 	MarkNotificationAsRead(ctx context.Context, arg sqlc.MarkNotificationAsReadParams) error
 	MarkAllAsRead(ctx context.Context, userID int64) error
 	DeleteNotification(ctx context.Context, arg sqlc.DeleteNotificationParams) error
+	DeleteGroupRequestNotifications(ctx context.Context, groupID pgtype.Int8) ([]sqlc.DeleteGroupRequestNotificationsRow, error)
 	CreateNotificationType(ctx context.Context, arg sqlc.CreateNotificationTypeParams) error
 	GetNotificationType(ctx context.Context, notifType string) (sqlc.NotificationType, error)
 	UpdateNotificationCount(ctx context.Context, arg sqlc.UpdateNotificationCountParams) error
 	GetUnreadNotificationByTypeAndEntity(ctx context.Context, arg sqlc.GetUnreadNotificationByTypeAndEntityParams) (sqlc.Notification, error)
 	GetNotificationByTypeAndEntity(ctx context.Context, arg sqlc.GetNotificationByTypeAndEntityParams) (sqlc.Notification, error)
 	MarkNotificationAsActed(ctx context.Context, arg sqlc.MarkNotificationAsActedParams) error
+	MarkGroupInviteNotificationsActed(ctx context.Context, arg sqlc.MarkGroupInviteNotificationsActedParams) (int64, error)
+	MarkGroupJoinRequestNotificationsActed(ctx context.Context, arg sqlc.MarkGroupJoinRequestNotificationsActedParams) (int64, error)
 }
 
 // Update the Application struct to use the interface
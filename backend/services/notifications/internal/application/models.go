@@ -24,6 +24,13 @@ const (
 	GroupInviteRejected      NotificationType = "group_invite_rejected"
 	GroupJoinRequestAccepted NotificationType = "group_join_request_accepted"
 	GroupJoinRequestRejected NotificationType = "group_join_request_rejected"
+	GroupAnnouncement        NotificationType = "group_announcement"
+	GroupPostApproved        NotificationType = "group_post_approved"
+	GroupPostRejected        NotificationType = "group_post_rejected"
+	ScheduledPostPublished   NotificationType = "scheduled_post_published"
+	CommentReply             NotificationType = "comment_reply"
+	PostRepost               NotificationType = "repost"
+	PostQuote                NotificationType = "quote"
 )
 
 // Notification represents a notification entity
@@ -85,8 +85,8 @@ func (a *Application) CreateGroupInviteForMultipleUsers(ctx context.Context, inv
 	}
 
 	// Create notifications for each user
-	for _, invitedUserID := range invitedUserIDs {
-		_, err := a.CreateNotificationWithAggregation(
+	for _, invitedUserID := range a.activeRecipients(ctx, invitedUserIDs) {
+		_, err := a.createOrAggregateNotification(
 			ctx,
 			invitedUserID, // recipient
 			GroupInvite,   // type
@@ -158,11 +158,11 @@ func (a *Application) CreateNewEventForMultipleUsers(ctx context.Context, userID
 	}
 
 	// Create notifications for each user
-	for _, userID := range userIDs {
+	for _, userID := range a.activeRecipients(ctx, userIDs) {
 		if userID == eventCreatorID {
 			continue
 		}
-		_, err := a.CreateNotificationWithAggregation(
+		_, err := a.createOrAggregateNotification(
 			ctx,
 			userID,   // recipient
 			NewEvent, // type
@@ -182,18 +182,142 @@ func (a *Application) CreateNewEventForMultipleUsers(ctx context.Context, userID
 	return nil
 }
 
+// CreateGroupAnnouncementForMultipleUsers creates a notification for the group members when a post is marked as an announcement
+func (a *Application) CreateGroupAnnouncementForMultipleUsers(ctx context.Context, userIDs []int64, authorID, groupID, postID int64, groupName, postContent string) error {
+	title := fmt.Sprintf("Announcement in %s", groupName)
+	message := fmt.Sprintf("New announcement in group \"%s\"", groupName)
+
+	payload := map[string]string{
+		"group_id":     fmt.Sprintf("%d", groupID),
+		"group_name":   groupName,
+		"post_id":      fmt.Sprintf("%d", postID),
+		"post_content": postContent,
+		"action":       "view_post",
+	}
+
+	// Create notifications for each user
+	for _, userID := range a.activeRecipients(ctx, userIDs) {
+		if userID == authorID {
+			continue
+		}
+		_, err := a.createOrAggregateNotification(
+			ctx,
+			userID,            // recipient
+			GroupAnnouncement, // type
+			title,             // title
+			message,           // message
+			"posts",           // source service
+			postID,            // source entity ID (the post)
+			false,             // doesn't need action (just informational)
+			payload,           // payload
+			false,             // never aggregate announcements
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create group announcement notification for user %d: %w", userID, err)
+		}
+	}
+
+	return nil
+}
+
+// CreateGroupPostReviewedNotification notifies the author of a group post awaiting approval
+// that it was approved or rejected
+func (a *Application) CreateGroupPostReviewedNotification(ctx context.Context, authorID, reviewerID, groupID, postID int64, groupName string, approved bool, reason string) error {
+	notifType := GroupPostApproved
+	title := "Post Approved"
+	message := fmt.Sprintf("Your post in group '%s' was approved", groupName)
+	if !approved {
+		notifType = GroupPostRejected
+		title = "Post Rejected"
+		message = fmt.Sprintf("Your post in group '%s' was rejected", groupName)
+		if reason != "" {
+			message = fmt.Sprintf("%s: %s", message, reason)
+		}
+	}
+
+	payload := map[string]string{
+		"group_id":    fmt.Sprintf("%d", groupID),
+		"group_name":  groupName,
+		"post_id":     fmt.Sprintf("%d", postID),
+		"reviewer_id": fmt.Sprintf("%d", reviewerID),
+		"reason":      reason,
+		"action":      "view_post",
+	}
+
+	_, err := a.CreateNotification(
+		ctx,
+		authorID,  // recipient (the post author)
+		notifType, // type
+		title,     // title
+		message,   // message
+		"posts",   // source service
+		postID,    // source entity ID (the post)
+		false,     // doesn't need action (just informational)
+		payload,   // payload
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create group post reviewed notification: %w", err)
+	}
+
+	return nil
+}
+
+// CreateScheduledPostPublishedNotification tells the author that their scheduled post went out.
+// Posts in groups that need approval go to the approval queue instead of going live.
+func (a *Application) CreateScheduledPostPublishedNotification(ctx context.Context, authorID, postID, groupID int64, pendingApproval bool) error {
+	title := "Scheduled Post Published"
+	message := "Your scheduled post is now live"
+	if pendingApproval {
+		message = "Your scheduled post was submitted and is awaiting approval by the group"
+	}
+
+	payload := map[string]string{
+		"post_id":          fmt.Sprintf("%d", postID),
+		"group_id":         fmt.Sprintf("%d", groupID),
+		"pending_approval": fmt.Sprintf("%t", pendingApproval),
+		"action":           "view_post",
+	}
+
+	_, err := a.CreateNotification(
+		ctx,
+		authorID,               // recipient (the post author)
+		ScheduledPostPublished, // type
+		title,                  // title
+		message,                // message
+		"posts",                // source service
+		postID,                 // source entity ID (the post)
+		false,                  // doesn't need action (just informational)
+		payload,                // payload
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create scheduled post published notification: %w", err)
+	}
+
+	return nil
+}
+
 // Additional notification types for extended functionality
 
-// CreatePostLikeNotification creates a notification when someone likes a user's post
-func (a *Application) CreatePostLikeNotification(ctx context.Context, userID, likerID, postID int64, likerUsername string, aggregate bool) error {
+// CreatePostLikeNotification creates a notification when someone reacts to a user's post
+func (a *Application) CreatePostLikeNotification(ctx context.Context, userID, likerID, postID int64, likerUsername, reactionType string, aggregate bool) error {
+	// reactions sent before there were reaction types are all likes
+	if reactionType == "" {
+		reactionType = "like"
+	}
+
 	title := "Post Liked"
 	message := fmt.Sprintf("%s liked your post", likerUsername)
+	if reactionType != "like" {
+		title = "New Reaction"
+		message = fmt.Sprintf("%s reacted with %s to your post", likerUsername, reactionType)
+	}
 
 	payload := map[string]string{
-		"liker_id":   fmt.Sprintf("%d", likerID),
-		"liker_name": likerUsername,
-		"post_id":    fmt.Sprintf("%d", postID),
-		"action":     "view_post",
+		"liker_id":      fmt.Sprintf("%d", likerID),
+		"liker_name":    likerUsername,
+		"post_id":       fmt.Sprintf("%d", postID),
+		"reaction_type": reactionType,
+		"action":        "view_post",
 	}
 
 	_, err := a.CreateNotificationWithAggregation(
@@ -247,10 +371,89 @@ func (a *Application) CreatePostCommentNotification(ctx context.Context, userID,
 	return nil
 }
 
+// CreateCommentReplyNotification creates a notification when someone replies to a user's comment
+// Replies to the same comment are aggregated together
+func (a *Application) CreateCommentReplyNotification(ctx context.Context, userID, replierID, postID, commentID, replyID int64, replierUsername, commentContent string, aggregate bool) error {
+	title := "New Reply"
+	message := fmt.Sprintf("%s replied to your comment", replierUsername)
+
+	payload := map[string]string{
+		"replier_id":      fmt.Sprintf("%d", replierID),
+		"replier_name":    replierUsername,
+		"post_id":         fmt.Sprintf("%d", postID),
+		"comment_id":      fmt.Sprintf("%d", commentID),
+		"reply_id":        fmt.Sprintf("%d", replyID),
+		"comment_content": commentContent,
+		"action":          "view_post",
+	}
+
+	_, err := a.CreateNotificationWithAggregation(
+		ctx,
+		userID,       // recipient (the comment author)
+		CommentReply, // type
+		title,        // title
+		message,      // message
+		"posts",      // source service
+		commentID,    // source entity ID (the comment replied to)
+		false,        // doesn't need action
+		payload,      // payload
+		aggregate,    // whether to aggregate
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create comment reply notification: %w", err)
+	}
+
+	return nil
+}
+
+// CreatePostSharedNotification creates a notification when someone reposts or quotes a user's post
+// Reposts of the same post are aggregated together, each quote gets its own notification
+func (a *Application) CreatePostSharedNotification(ctx context.Context, userID, sharerID, postID, shareID int64, sharerUsername, quoteContent string, quote, aggregate bool) error {
+	notifType := PostRepost
+	title := "New Repost"
+	message := fmt.Sprintf("%s reposted your post", sharerUsername)
+	if quote {
+		notifType = PostQuote
+		title = "New Quote"
+		message = fmt.Sprintf("%s quoted your post", sharerUsername)
+	}
+
+	payload := map[string]string{
+		"sharer_id":     fmt.Sprintf("%d", sharerID),
+		"sharer_name":   sharerUsername,
+		"post_id":       fmt.Sprintf("%d", postID),
+		"share_id":      fmt.Sprintf("%d", shareID),
+		"quote_content": quoteContent,
+		"action":        "view_post",
+	}
+
+	_, err := a.CreateNotificationWithAggregation(
+		ctx,
+		userID,    // recipient (the post author)
+		notifType, // type
+		title,     // title
+		message,   // message
+		"posts",   // source service
+		postID,    // source entity ID (the shared post)
+		false,     // doesn't need action
+		payload,   // payload
+		aggregate, // whether to aggregate
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create post shared notification: %w", err)
+	}
+
+	return nil
+}
+
 // CreateMentionNotification creates a notification when a user is mentioned in a post or comment
-func (a *Application) CreateMentionNotification(ctx context.Context, userID, mentionerID, postID int64, mentionerUsername, postContent, mentionText string) error {
+// commentID is 0 when the mention is in the post itself
+func (a *Application) CreateMentionNotification(ctx context.Context, userID, mentionerID, postID, commentID int64, mentionerUsername, postContent, mentionText string) error {
 	title := "You were mentioned"
 	message := fmt.Sprintf("%s mentioned you in a post", mentionerUsername)
+	if commentID != 0 {
+		message = fmt.Sprintf("%s mentioned you in a comment", mentionerUsername)
+	}
 
 	payload := map[string]string{
 		"mentioner_id":   fmt.Sprintf("%d", mentionerID),
@@ -260,6 +463,9 @@ func (a *Application) CreateMentionNotification(ctx context.Context, userID, men
 		"mention_text":   mentionText,
 		"action":         "view_post",
 	}
+	if commentID != 0 {
+		payload["comment_id"] = fmt.Sprintf("%d", commentID)
+	}
 
 	_, err := a.CreateNotificationWithAggregation(
 		ctx,
@@ -299,8 +505,8 @@ func (a *Application) CreateNewMessageForMultipleUsers(ctx context.Context, user
 	}
 
 	// Create notifications for each user
-	for _, userID := range userIDs {
-		_, err := a.CreateNotificationWithAggregation(
+	for _, userID := range a.activeRecipients(ctx, userIDs) {
+		_, err := a.createOrAggregateNotification(
 			ctx,
 			userID,     // recipient
 			NewMessage, // type
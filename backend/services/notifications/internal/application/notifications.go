@@ -10,6 +10,7 @@ import (
 	"time"
 
 	db "social-network/services/notifications/internal/db/sqlc"
+	cm "social-network/shared/gen-go/common"
 	pb "social-network/shared/gen-go/notifications"
 	ct "social-network/shared/go/ct"
 	tele "social-network/shared/go/telemetry"
@@ -25,7 +26,18 @@ func (a *Application) CreateNotification(ctx context.Context, userID int64, noti
 }
 
 // CreateNotificationWithAggregation creates a new notification or aggregates with an existing one if applicable
+// Returns a nil notification without error if the recipient is deactivated.
 func (a *Application) CreateNotificationWithAggregation(ctx context.Context, userID int64, notifType NotificationType, title, message, sourceService string, sourceEntityID int64, needsAction bool, payload map[string]string, aggregate bool) (*Notification, error) {
+	if a.isRecipientDeactivated(ctx, userID) {
+		tele.Info(ctx, "skipping @1 notification for deactivated user @2", "type", notifType, "userId", userID)
+		return nil, nil
+	}
+	return a.createOrAggregateNotification(ctx, userID, notifType, title, message, sourceService, sourceEntityID, needsAction, payload, aggregate)
+}
+
+// createOrAggregateNotification is CreateNotificationWithAggregation without the recipient status check,
+// for callers that already filtered their recipients with activeRecipients
+func (a *Application) createOrAggregateNotification(ctx context.Context, userID int64, notifType NotificationType, title, message, sourceService string, sourceEntityID int64, needsAction bool, payload map[string]string, aggregate bool) (*Notification, error) {
 	if !aggregate {
 		// If aggregation is disabled, create a new notification as before
 		return a.createNotification(ctx, userID, notifType, title, message, sourceService, sourceEntityID, needsAction, payload, 1)
@@ -130,6 +142,39 @@ func (a *Application) CreateNotificationWithAggregation(ctx context.Context, use
 	return notification, nil
 }
 
+// isRecipientDeactivated asks users service whether the user has temporarily deactivated their account.
+// Lookup failures are logged and treated as active so that notifications are not lost.
+func (a *Application) isRecipientDeactivated(ctx context.Context, userID int64) bool {
+	return len(a.activeRecipients(ctx, []int64{userID})) == 0
+}
+
+// activeRecipients drops the users that have temporarily deactivated their account,
+// asking users service about all of them in one call.
+// Lookup failures are logged and every user is treated as active so that notifications are not lost.
+func (a *Application) activeRecipients(ctx context.Context, userIDs []int64) []int64 {
+	if len(userIDs) == 0 || a.Clients == nil || a.Clients.UsersClient == nil {
+		return userIDs
+	}
+	resp, err := a.Clients.UsersClient.GetBatchBasicUserInfo(ctx, &cm.UserIds{Values: userIDs})
+	if err != nil {
+		tele.Warn(ctx, "failed to check status of users @1: @2", "userIds", userIDs, "error", err.Error())
+		return userIDs
+	}
+	deactivated := make(map[int64]bool)
+	for _, u := range resp.GetUsers() {
+		if u.GetDeactivated() {
+			deactivated[u.GetUserId()] = true
+		}
+	}
+	active := make([]int64, 0, len(userIDs))
+	for _, id := range userIDs {
+		if !deactivated[id] {
+			active = append(active, id)
+		}
+	}
+	return active
+}
+
 // createNotification is a helper function that creates a notification with a specific count
 func (a *Application) createNotification(ctx context.Context, userID int64, notifType NotificationType, title, message, sourceService string, sourceEntityID int64, needsAction bool, payload map[string]string, count int32) (*Notification, error) {
 	// Prepare the JSON payload
@@ -279,8 +324,20 @@ func (a *Application) CreateNotifications(ctx context.Context, notifications []s
 }) ([]*Notification, error) {
 	result := make([]*Notification, 0, len(notifications))
 
+	userIDs := make([]int64, 0, len(notifications))
+	for _, n := range notifications {
+		userIDs = append(userIDs, n.UserID)
+	}
+	active := make(map[int64]bool)
+	for _, id := range a.activeRecipients(ctx, userIDs) {
+		active[id] = true
+	}
+
 	for _, n := range notifications {
-		notification, err := a.CreateNotification(ctx, n.UserID, n.Type, n.Title, n.Message, n.SourceService, n.SourceEntityID, n.NeedsAction, n.Payload)
+		if !active[n.UserID] {
+			continue
+		}
+		notification, err := a.createOrAggregateNotification(ctx, n.UserID, n.Type, n.Title, n.Message, n.SourceService, n.SourceEntityID, n.NeedsAction, n.Payload, false)
 		if err != nil {
 			return nil, err
 		}
@@ -488,6 +545,13 @@ func (a *Application) CreateDefaultNotificationTypes(ctx context.Context) error
 		{string(GroupInviteRejected), "group", true},
 		{string(GroupJoinRequestAccepted), "group", true},
 		{string(GroupJoinRequestRejected), "group", true},
+		{string(GroupAnnouncement), "group", true},
+		{string(GroupPostApproved), "group", true},
+		{string(GroupPostRejected), "group", true},
+		{string(ScheduledPostPublished), "posts", true},
+		{string(CommentReply), "posts", true},
+		{string(PostRepost), "posts", true},
+		{string(PostQuote), "posts", true},
 	}
 
 	for _, nt := range defaultTypes {
@@ -649,6 +713,59 @@ func (a *Application) DeleteGroupJoinRequestNotification(ctx context.Context, gr
 	return nil
 }
 
+// DeleteGroupRequestNotifications deletes the pending invite and join request notifications of a deleted group
+func (a *Application) DeleteGroupRequestNotifications(ctx context.Context, groupID int64) error {
+	deleted, err := a.DB.DeleteGroupRequestNotifications(ctx, pgtype.Int8{Int64: groupID, Valid: true})
+	if err != nil {
+		return fmt.Errorf("failed to delete group request notifications: %w", err)
+	}
+
+	// Publish notification deletions to NATS for real-time updates
+	go func() {
+		natsCtx := context.Background()
+		for _, n := range deleted {
+			if err := a.publishNotificationDeletionToNATS(natsCtx, n.ID, n.UserID); err != nil {
+				tele.Error(natsCtx, "failed to publish notification deletion to nats in background: @1", "error", err.Error())
+			}
+		}
+	}()
+
+	tele.Info(ctx, "Deleted @1 pending request notifications for deleted group @2", "count", len(deleted), "groupID", groupID)
+	return nil
+}
+
+// MarkGroupInviteNotificationExpired marks the invite notification of an expired group invite as acted
+func (a *Application) MarkGroupInviteNotificationExpired(ctx context.Context, invitedUserID, groupID int64) error {
+	updated, err := a.DB.MarkGroupInviteNotificationsActed(ctx, db.MarkGroupInviteNotificationsActedParams{
+		UserID:         invitedUserID,
+		SourceEntityID: pgtype.Int8{Int64: groupID, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark expired group invite notification as acted: %w", err)
+	}
+
+	tele.Info(ctx, "Marked @1 expired group invite notifications as acted for user @2 in group @3", "count", updated, "invitedUserID", invitedUserID, "groupID", groupID)
+	return nil
+}
+
+// MarkGroupJoinRequestNotificationExpired marks the join request notifications of an expired join request as acted
+// for every member who could approve it
+func (a *Application) MarkGroupJoinRequestNotificationExpired(ctx context.Context, approverIDs []int64, requesterUserID, groupID int64) error {
+	for _, approverID := range approverIDs {
+		updated, err := a.DB.MarkGroupJoinRequestNotificationsActed(ctx, db.MarkGroupJoinRequestNotificationsActedParams{
+			UserID:         approverID,
+			SourceEntityID: pgtype.Int8{Int64: groupID, Valid: true},
+			RequesterID:    fmt.Sprintf("%d", requesterUserID),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to mark expired group join request notification as acted: %w", err)
+		}
+
+		tele.Info(ctx, "Marked @1 expired group join request notifications as acted for approver @2 from requester @3 for group @4", "count", updated, "approverID", approverID, "requesterUserID", requesterUserID, "groupID", groupID)
+	}
+	return nil
+}
+
 // MarkRelatedNotificationAsActed marks the original request notification as acted when a response is created
 func (a *Application) MarkRelatedNotificationAsActed(ctx context.Context, responseNotifType NotificationType, userID int64, sourceEntityID int64, payload map[string]string) error {
 	// Determine the original notification type based on the response type
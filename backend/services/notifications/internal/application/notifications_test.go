@@ -3,6 +3,7 @@ package application
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"testing"
 	"time"
 
@@ -11,7 +12,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"social-network/services/notifications/internal/client"
 	"social-network/services/notifications/internal/db/sqlc"
+	cm "social-network/shared/gen-go/common"
 	ct "social-network/shared/go/ct"
 )
 
@@ -1358,3 +1361,50 @@ func TestMarkRelatedNotificationAsActed_GroupInviteAccepted(t *testing.T) {
 
 	mockDB.AssertExpectations(t)
 }
+
+func TestActiveRecipients(t *testing.T) {
+	tests := []struct {
+		name     string
+		userIDs  []int64
+		users    []*cm.User
+		err      error
+		expected []int64
+	}{
+		{
+			name:     "drops deactivated users",
+			userIDs:  []int64{1, 2, 3},
+			users:    []*cm.User{{UserId: 1}, {UserId: 2, Deactivated: true}, {UserId: 3}},
+			expected: []int64{1, 3},
+		},
+		{
+			name:     "keeps users missing from the response",
+			userIDs:  []int64{1, 2},
+			users:    []*cm.User{{UserId: 1}},
+			expected: []int64{1, 2},
+		},
+		{
+			name:     "treats everyone as active when the lookup fails",
+			userIDs:  []int64{1, 2},
+			err:      errors.New("users service unavailable"),
+			expected: []int64{1, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			usersClient := new(MockUsersClient)
+			app := NewApplicationWithMocks(new(MockDB))
+			app.Clients = &client.Clients{UsersClient: usersClient}
+
+			var resp *cm.ListUsers
+			if tt.err == nil {
+				resp = &cm.ListUsers{Users: tt.users}
+			}
+			usersClient.On("GetBatchBasicUserInfo", ctx, &cm.UserIds{Values: tt.userIDs}).Return(resp, tt.err).Once()
+
+			assert.Equal(t, tt.expected, app.activeRecipients(ctx, tt.userIDs))
+			usersClient.AssertExpectations(t)
+		})
+	}
+}
@@ -4,8 +4,12 @@ import (
 	"context"
 
 	"social-network/services/notifications/internal/db/sqlc"
+	cm "social-network/shared/gen-go/common"
+	usersPb "social-network/shared/gen-go/users"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
 )
 
 // MockDB is a mock implementation of the database queries
@@ -53,6 +57,11 @@ func (m *MockDB) DeleteNotification(ctx context.Context, arg sqlc.DeleteNotifica
 	return args.Error(0)
 }
 
+func (m *MockDB) DeleteGroupRequestNotifications(ctx context.Context, groupID pgtype.Int8) ([]sqlc.DeleteGroupRequestNotificationsRow, error) {
+	args := m.Called(ctx, groupID)
+	return args.Get(0).([]sqlc.DeleteGroupRequestNotificationsRow), args.Error(1)
+}
+
 func (m *MockDB) CreateNotificationType(ctx context.Context, arg sqlc.CreateNotificationTypeParams) error {
 	args := m.Called(ctx, arg)
 	return args.Error(0)
@@ -83,6 +92,16 @@ func (m *MockDB) MarkNotificationAsActed(ctx context.Context, arg sqlc.MarkNotif
 	return args.Error(0)
 }
 
+func (m *MockDB) MarkGroupInviteNotificationsActed(ctx context.Context, arg sqlc.MarkGroupInviteNotificationsActedParams) (int64, error) {
+	args := m.Called(ctx, arg)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockDB) MarkGroupJoinRequestNotificationsActed(ctx context.Context, arg sqlc.MarkGroupJoinRequestNotificationsActedParams) (int64, error) {
+	args := m.Called(ctx, arg)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 // NewApplicationWithMocks creates a new application service with mocked dependencies for testing
 func NewApplicationWithMocks(db DBInterface) *Application {
 	return &Application{
@@ -90,4 +109,17 @@ func NewApplicationWithMocks(db DBInterface) *Application {
 		Clients: nil, // nil for tests
 		NatsConn: nil, // nil for tests
 	}
-}
\ No newline at end of file
+}
+// MockUsersClient is a mock of the users service client, only the methods used by the application are mocked
+type MockUsersClient struct {
+	usersPb.UserServiceClient
+	mock.Mock
+}
+
+func (m *MockUsersClient) GetBatchBasicUserInfo(ctx context.Context, in *cm.UserIds, opts ...grpc.CallOption) (*cm.ListUsers, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*cm.ListUsers), args.Error(1)
+}
@@ -0,0 +1,6 @@
+-- Notification type for posts marked as announcements in a group
+
+INSERT INTO notification_types (notif_type, category, default_enabled)
+VALUES
+  ('group_announcement', 'group',  TRUE)
+ON CONFLICT (notif_type) DO NOTHING;
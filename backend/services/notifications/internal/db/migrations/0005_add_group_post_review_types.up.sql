@@ -0,0 +1,7 @@
+-- Notification types for the outcome of a group post awaiting approval
+
+INSERT INTO notification_types (notif_type, category, default_enabled)
+VALUES
+  ('group_post_approved', 'group',  TRUE),
+  ('group_post_rejected', 'group',  TRUE)
+ON CONFLICT (notif_type) DO NOTHING;
@@ -0,0 +1,6 @@
+-- Notification type telling authors that their scheduled post was published
+
+INSERT INTO notification_types (notif_type, category, default_enabled)
+VALUES
+  ('scheduled_post_published', 'posts', TRUE)
+ON CONFLICT (notif_type) DO NOTHING;
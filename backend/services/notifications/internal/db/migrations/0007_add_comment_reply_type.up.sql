@@ -0,0 +1,6 @@
+-- Notification type telling users that someone replied to their comment
+
+INSERT INTO notification_types (notif_type, category, default_enabled)
+VALUES
+  ('comment_reply', 'posts', TRUE)
+ON CONFLICT (notif_type) DO NOTHING;
@@ -0,0 +1,7 @@
+-- Notification types telling users that someone reposted or quoted their post
+
+INSERT INTO notification_types (notif_type, category, default_enabled)
+VALUES
+  ('repost', 'posts', TRUE),
+  ('quote', 'posts', TRUE)
+ON CONFLICT (notif_type) DO NOTHING;
@@ -43,12 +43,40 @@ UPDATE notifications SET seen = true WHERE id = $1 AND user_id = $2;
 -- name: MarkNotificationAsActed :exec
 UPDATE notifications SET acted = true WHERE id = $1 AND user_id = $2;
 
+-- name: MarkGroupInviteNotificationsActed :execrows
+UPDATE notifications SET acted = true
+WHERE user_id = $1
+  AND source_service = 'users'
+  AND source_entity_id = $2
+  AND notif_type = 'group_invite'
+  AND acted = false
+  AND deleted_at IS NULL;
+
+-- name: MarkGroupJoinRequestNotificationsActed :execrows
+UPDATE notifications SET acted = true
+WHERE user_id = $1
+  AND source_service = 'users'
+  AND source_entity_id = $2
+  AND notif_type = 'group_join_request'
+  AND payload->>'requester_id' = @requester_id::text
+  AND acted = false
+  AND deleted_at IS NULL;
+
 -- name: MarkAllAsRead :exec
 UPDATE notifications SET seen = true WHERE user_id = $1 AND seen = false;
 
 -- name: DeleteNotification :exec
 UPDATE notifications SET deleted_at = NOW() WHERE id = $1 AND user_id = $2;
 
+-- name: DeleteGroupRequestNotifications :many
+UPDATE notifications SET deleted_at = NOW()
+WHERE source_service = 'users'
+  AND source_entity_id = $1
+  AND notif_type IN ('group_invite', 'group_join_request')
+  AND acted = false
+  AND deleted_at IS NULL
+RETURNING id, user_id;
+
 -- name: UpdateNotificationCount :exec
 UPDATE notifications SET count = $1 WHERE id = $2 AND user_id = $3;
 
@@ -88,6 +88,41 @@ func (q *Queries) CreateNotificationType(ctx context.Context, arg CreateNotifica
 	return err
 }
 
+const deleteGroupRequestNotifications = `-- name: DeleteGroupRequestNotifications :many
+UPDATE notifications SET deleted_at = NOW()
+WHERE source_service = 'users'
+  AND source_entity_id = $1
+  AND notif_type IN ('group_invite', 'group_join_request')
+  AND acted = false
+  AND deleted_at IS NULL
+RETURNING id, user_id
+`
+
+type DeleteGroupRequestNotificationsRow struct {
+	ID     int64
+	UserID int64
+}
+
+func (q *Queries) DeleteGroupRequestNotifications(ctx context.Context, sourceEntityID pgtype.Int8) ([]DeleteGroupRequestNotificationsRow, error) {
+	rows, err := q.db.Query(ctx, deleteGroupRequestNotifications, sourceEntityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []DeleteGroupRequestNotificationsRow{}
+	for rows.Next() {
+		var i DeleteGroupRequestNotificationsRow
+		if err := rows.Scan(&i.ID, &i.UserID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const deleteNotification = `-- name: DeleteNotification :exec
 UPDATE notifications SET deleted_at = NOW() WHERE id = $1 AND user_id = $2
 `
@@ -294,6 +329,54 @@ func (q *Queries) MarkAllAsRead(ctx context.Context, userID int64) error {
 	return err
 }
 
+const markGroupInviteNotificationsActed = `-- name: MarkGroupInviteNotificationsActed :execrows
+UPDATE notifications SET acted = true
+WHERE user_id = $1
+  AND source_service = 'users'
+  AND source_entity_id = $2
+  AND notif_type = 'group_invite'
+  AND acted = false
+  AND deleted_at IS NULL
+`
+
+type MarkGroupInviteNotificationsActedParams struct {
+	UserID         int64
+	SourceEntityID pgtype.Int8
+}
+
+func (q *Queries) MarkGroupInviteNotificationsActed(ctx context.Context, arg MarkGroupInviteNotificationsActedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markGroupInviteNotificationsActed, arg.UserID, arg.SourceEntityID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const markGroupJoinRequestNotificationsActed = `-- name: MarkGroupJoinRequestNotificationsActed :execrows
+UPDATE notifications SET acted = true
+WHERE user_id = $1
+  AND source_service = 'users'
+  AND source_entity_id = $2
+  AND notif_type = 'group_join_request'
+  AND payload->>'requester_id' = $3::text
+  AND acted = false
+  AND deleted_at IS NULL
+`
+
+type MarkGroupJoinRequestNotificationsActedParams struct {
+	UserID         int64
+	SourceEntityID pgtype.Int8
+	RequesterID    string
+}
+
+func (q *Queries) MarkGroupJoinRequestNotificationsActed(ctx context.Context, arg MarkGroupJoinRequestNotificationsActedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markGroupJoinRequestNotificationsActed, arg.UserID, arg.SourceEntityID, arg.RequesterID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const markNotificationAsActed = `-- name: MarkNotificationAsActed :exec
 UPDATE notifications SET acted = true WHERE id = $1 AND user_id = $2
 `
@@ -6,11 +6,14 @@ package sqlc
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
 	CreateNotification(ctx context.Context, arg CreateNotificationParams) (Notification, error)
 	CreateNotificationType(ctx context.Context, arg CreateNotificationTypeParams) error
+	DeleteGroupRequestNotifications(ctx context.Context, sourceEntityID pgtype.Int8) ([]DeleteGroupRequestNotificationsRow, error)
 	DeleteNotification(ctx context.Context, arg DeleteNotificationParams) error
 	GetNotificationByID(ctx context.Context, id int64) (Notification, error)
 	GetNotificationByTypeAndEntity(ctx context.Context, arg GetNotificationByTypeAndEntityParams) (Notification, error)
@@ -20,6 +23,8 @@ type Querier interface {
 	GetUserNotificationsCount(ctx context.Context, userID int64) (int64, error)
 	GetUserUnreadNotificationsCount(ctx context.Context, userID int64) (int64, error)
 	MarkAllAsRead(ctx context.Context, userID int64) error
+	MarkGroupInviteNotificationsActed(ctx context.Context, arg MarkGroupInviteNotificationsActedParams) (int64, error)
+	MarkGroupJoinRequestNotificationsActed(ctx context.Context, arg MarkGroupJoinRequestNotificationsActedParams) (int64, error)
 	MarkNotificationAsActed(ctx context.Context, arg MarkNotificationAsActedParams) error
 	MarkNotificationAsRead(ctx context.Context, arg MarkNotificationAsReadParams) error
 	UpdateNotificationCount(ctx context.Context, arg UpdateNotificationCountParams) error
@@ -158,7 +158,7 @@ func (suite *EndToEndIntegrationTestSuite) TestCompleteEventFlow() {
 	suite.mockApp.On("CreatePostCommentNotification",
 		mock.Anything, int64(1001), int64(3001), int64(5001), "user_c", "Great post! Thanks for sharing.", true).Return(nil)
 	suite.mockApp.On("CreatePostLikeNotification",
-		mock.Anything, int64(1001), int64(4001), int64(5001), "user_d", true).Return(nil)
+		mock.Anything, int64(1001), int64(4001), int64(5001), "user_d", "", true).Return(nil)
 	suite.mockApp.On("CreateNewMessageForMultipleUsers",
 		mock.Anything, []int64{1001}, int64(5001), int64(7001), "user_e", "Hey, are we still meeting tomorrow?", true).Return(nil)
 	suite.mockApp.On("CreateGroupJoinRequestNotification",
@@ -264,8 +264,8 @@ func (suite *EndToEndIntegrationTestSuite) TestRealWorldScenario() {
 	}
 
 	// Set up expectations for the scenario
-	suite.mockApp.On("CreatePostLikeNotification", mock.Anything, int64(1001), int64(2001), int64(1001), "alice", true).Return(nil)
-	suite.mockApp.On("CreatePostLikeNotification", mock.Anything, int64(1001), int64(2002), int64(1001), "bob", true).Return(nil)
+	suite.mockApp.On("CreatePostLikeNotification", mock.Anything, int64(1001), int64(2001), int64(1001), "alice", "", true).Return(nil)
+	suite.mockApp.On("CreatePostLikeNotification", mock.Anything, int64(1001), int64(2002), int64(1001), "bob", "", true).Return(nil)
 	suite.mockApp.On("CreateNewFollowerNotification", mock.Anything, int64(1001), int64(3001), "charlie", true).Return(nil)
 	suite.mockApp.On("CreateNewEventForMultipleUsers", mock.Anything, []int64{1001}, int64(1001), int64(4001), int64(5001), "Photography Club", "Sunset Photography Session").Return(nil)
 	suite.mockApp.On("CreateNewMessageForMultipleUsers", mock.Anything, []int64{1001}, int64(6001), int64(7001), "diana", "Did you see the new camera gear?", true).Return(nil)
@@ -59,6 +59,25 @@ func (h *EventHandler) Handle(ctx context.Context, event *pb.NotificationEvent)
 		return h.handleFollowRequestCancelled(ctx, payload.FollowRequestCancelled)
 	case *pb.NotificationEvent_GroupJoinRequestCancelled:
 		return h.handleGroupJoinRequestCancelled(ctx, payload.GroupJoinRequestCancelled)
+	case *pb.NotificationEvent_GroupDeleted:
+		return h.handleGroupDeleted(ctx, payload.GroupDeleted)
+	case *pb.NotificationEvent_GroupInviteExpired:
+		return h.handleGroupInviteExpired(ctx, payload.GroupInviteExpired)
+	case *pb.NotificationEvent_GroupJoinRequestExpired:
+		return h.handleGroupJoinRequestExpired(ctx, payload.GroupJoinRequestExpired)
+	case *pb.NotificationEvent_GroupAnnouncementCreated:
+		return h.handleGroupAnnouncementCreated(ctx, payload.GroupAnnouncementCreated)
+	case *pb.NotificationEvent_GroupPostReviewed:
+		return h.handleGroupPostReviewed(ctx, payload.GroupPostReviewed)
+	case *pb.NotificationEvent_ScheduledPostPublished:
+		return h.handleScheduledPostPublished(ctx, payload.ScheduledPostPublished)
+	case *pb.NotificationEvent_CommentReplyCreated:
+		return h.handleCommentReplyCreated(ctx, payload.CommentReplyCreated)
+	case *pb.NotificationEvent_PostShared:
+		return h.handlePostShared(ctx, payload.PostShared)
+	case *pb.NotificationEvent_UserDeactivationChanged, *pb.NotificationEvent_GroupArchiveChanged,
+		*pb.NotificationEvent_GroupVisibilityChanged, *pb.NotificationEvent_GroupPostApprovalChanged:
+		return nil // consumed by posts service, nobody is notified
 	default:
 		return fmt.Errorf("unknown notification event payload type: %T", payload)
 	}
@@ -77,6 +96,34 @@ func (h *EventHandler) handlePostCommentCreated(ctx context.Context, event *pb.P
 	)
 }
 
+func (h *EventHandler) handleCommentReplyCreated(ctx context.Context, event *pb.CommentReplyCreated) error {
+	return h.App.CreateCommentReplyNotification(
+		ctx,
+		event.CommentCreatorId, // userId (comment owner)
+		event.ReplierUserId,    // replierId
+		event.PostId,           // postId
+		event.CommentId,        // commentId
+		event.ReplyId,          // replyId
+		event.ReplierUsername,  // replierUsername
+		event.Body,             // commentContent
+		event.Aggregate,        // aggregate - use value from event
+	)
+}
+
+func (h *EventHandler) handlePostShared(ctx context.Context, event *pb.PostShared) error {
+	return h.App.CreatePostSharedNotification(
+		ctx,
+		event.PostCreatorId,  // userId (post owner)
+		event.SharerUserId,   // sharerId
+		event.PostId,         // postId
+		event.ShareId,        // shareId
+		event.SharerUsername, // sharerUsername
+		event.Body,           // quoteContent
+		event.Quote,          // quote
+		event.Aggregate,      // aggregate - use value from event
+	)
+}
+
 func (h *EventHandler) handlePostLiked(ctx context.Context, event *pb.PostLiked) error {
 	return h.App.CreatePostLikeNotification(
 		ctx,
@@ -84,6 +131,7 @@ func (h *EventHandler) handlePostLiked(ctx context.Context, event *pb.PostLiked)
 		event.LikerUserId,     // likerId
 		event.PostId,          // postId
 		event.LikerUsername,   // likerUsername
+		event.ReactionType,    // reactionType
 		event.Aggregate,       // aggregate - use value from event
 	)
 }
@@ -114,6 +162,35 @@ func (h *EventHandler) handleGroupJoinRequestCancelled(ctx context.Context, even
 	)
 }
 
+func (h *EventHandler) handleGroupDeleted(ctx context.Context, event *pb.GroupDeleted) error {
+	return h.App.DeleteGroupRequestNotifications(
+		ctx,
+		event.GroupId, // groupID
+	)
+}
+
+func (h *EventHandler) handleGroupInviteExpired(ctx context.Context, event *pb.GroupInviteExpired) error {
+	return h.App.MarkGroupInviteNotificationExpired(
+		ctx,
+		event.InvitedUserId, // invitedUserID
+		event.GroupId,       // groupID
+	)
+}
+
+func (h *EventHandler) handleGroupJoinRequestExpired(ctx context.Context, event *pb.GroupJoinRequestExpired) error {
+	approverIDs := event.ApproverIds
+	if len(approverIDs) == 0 {
+		// events from before approvers were sent only name the owner
+		approverIDs = []int64{event.GroupOwnerId}
+	}
+	return h.App.MarkGroupJoinRequestNotificationExpired(
+		ctx,
+		approverIDs,           // approverIDs
+		event.RequesterUserId, // requesterUserID
+		event.GroupId,         // groupID
+	)
+}
+
 func (h *EventHandler) handleNewFollowerCreated(ctx context.Context, event *pb.NewFollowerCreated) error {
 	return h.App.CreateNewFollowerNotification(
 		ctx,
@@ -159,12 +236,48 @@ func (h *EventHandler) handleNewEventCreated(ctx context.Context, event *pb.NewE
 	)
 }
 
+func (h *EventHandler) handleGroupAnnouncementCreated(ctx context.Context, event *pb.GroupAnnouncementCreated) error {
+	return h.App.CreateGroupAnnouncementForMultipleUsers(
+		ctx,
+		event.UserId,      // userIDs
+		event.AuthorId,    // authorID
+		event.GroupId,     // groupID
+		event.PostId,      // postID
+		event.GroupName,   // groupName
+		event.PostContent, // postContent
+	)
+}
+
+func (h *EventHandler) handleGroupPostReviewed(ctx context.Context, event *pb.GroupPostReviewed) error {
+	return h.App.CreateGroupPostReviewedNotification(
+		ctx,
+		event.AuthorId,   // authorID
+		event.ReviewerId, // reviewerID
+		event.GroupId,    // groupID
+		event.PostId,     // postID
+		event.GroupName,  // groupName
+		event.Approved,   // approved
+		event.Reason,     // reason
+	)
+}
+
+func (h *EventHandler) handleScheduledPostPublished(ctx context.Context, event *pb.ScheduledPostPublished) error {
+	return h.App.CreateScheduledPostPublishedNotification(
+		ctx,
+		event.AuthorId,        // authorID
+		event.PostId,          // postID
+		event.GroupId,         // groupID
+		event.PendingApproval, // pendingApproval
+	)
+}
+
 func (h *EventHandler) handleMentionCreated(ctx context.Context, event *pb.MentionCreated) error {
 	return h.App.CreateMentionNotification(
 		ctx,
 		event.MentionedUserId,   // userID
 		event.MentionerUserId,   // mentionerID
 		event.PostId,            // postID
+		event.CommentId,         // commentID
 		event.MentionerUsername, // mentionerUsername
 		event.PostContent,       // postContent
 		event.MentionText,       // mentionText
@@ -21,8 +21,8 @@ func (m *MockApplication) CreatePostCommentNotification(ctx context.Context, use
 	return args.Error(0)
 }
 
-func (m *MockApplication) CreatePostLikeNotification(ctx context.Context, userID, likerID, postID int64, likerUsername string, aggregate bool) error {
-	args := m.Called(ctx, userID, likerID, postID, likerUsername, aggregate)
+func (m *MockApplication) CreatePostLikeNotification(ctx context.Context, userID, likerID, postID int64, likerUsername, reactionType string, aggregate bool) error {
+	args := m.Called(ctx, userID, likerID, postID, likerUsername, reactionType, aggregate)
 	return args.Error(0)
 }
 
@@ -61,8 +61,8 @@ func (m *MockApplication) CreateNewEventForMultipleUsers(ctx context.Context, us
 	return args.Error(0)
 }
 
-func (m *MockApplication) CreateMentionNotification(ctx context.Context, userID, mentionerID, postID int64, mentionerUsername, postContent, mentionText string) error {
-	args := m.Called(ctx, userID, mentionerID, postID, mentionerUsername, postContent, mentionText)
+func (m *MockApplication) CreateMentionNotification(ctx context.Context, userID, mentionerID, postID, commentID int64, mentionerUsername, postContent, mentionText string) error {
+	args := m.Called(ctx, userID, mentionerID, postID, commentID, mentionerUsername, postContent, mentionText)
 	return args.Error(0)
 }
 
@@ -180,6 +180,46 @@ func (m *MockApplication) DeleteGroupJoinRequestNotification(ctx context.Context
 	return args.Error(0)
 }
 
+func (m *MockApplication) DeleteGroupRequestNotifications(ctx context.Context, groupID int64) error {
+	args := m.Called(ctx, groupID)
+	return args.Error(0)
+}
+
+func (m *MockApplication) MarkGroupInviteNotificationExpired(ctx context.Context, invitedUserID, groupID int64) error {
+	args := m.Called(ctx, invitedUserID, groupID)
+	return args.Error(0)
+}
+
+func (m *MockApplication) CreateGroupAnnouncementForMultipleUsers(ctx context.Context, userIDs []int64, authorID, groupID, postID int64, groupName, postContent string) error {
+	args := m.Called(ctx, userIDs, authorID, groupID, postID, groupName, postContent)
+	return args.Error(0)
+}
+
+func (m *MockApplication) CreateGroupPostReviewedNotification(ctx context.Context, authorID, reviewerID, groupID, postID int64, groupName string, approved bool, reason string) error {
+	args := m.Called(ctx, authorID, reviewerID, groupID, postID, groupName, approved, reason)
+	return args.Error(0)
+}
+
+func (m *MockApplication) CreateCommentReplyNotification(ctx context.Context, userID, replierID, postID, commentID, replyID int64, replierUsername, commentContent string, aggregate bool) error {
+	args := m.Called(ctx, userID, replierID, postID, commentID, replyID, replierUsername, commentContent, aggregate)
+	return args.Error(0)
+}
+
+func (m *MockApplication) CreatePostSharedNotification(ctx context.Context, userID, sharerID, postID, shareID int64, sharerUsername, quoteContent string, quote, aggregate bool) error {
+	args := m.Called(ctx, userID, sharerID, postID, shareID, sharerUsername, quoteContent, quote, aggregate)
+	return args.Error(0)
+}
+
+func (m *MockApplication) CreateScheduledPostPublishedNotification(ctx context.Context, authorID, postID, groupID int64, pendingApproval bool) error {
+	args := m.Called(ctx, authorID, postID, groupID, pendingApproval)
+	return args.Error(0)
+}
+
+func (m *MockApplication) MarkGroupJoinRequestNotificationExpired(ctx context.Context, approverIDs []int64, requesterUserID, groupID int64) error {
+	args := m.Called(ctx, approverIDs, requesterUserID, groupID)
+	return args.Error(0)
+}
+
 // Unit tests for each event handler
 func TestEventHandler_HandlePostCommentCreated(t *testing.T) {
 	mockApp := new(MockApplication)
@@ -219,6 +259,130 @@ func TestEventHandler_HandlePostCommentCreated(t *testing.T) {
 	mockApp.AssertExpectations(t)
 }
 
+func TestEventHandler_HandleCommentReplyCreated(t *testing.T) {
+	mockApp := new(MockApplication)
+	eventHandler := &EventHandler{App: mockApp}
+
+	event := &pb.NotificationEvent{
+		EventId:   "test-comment-reply-event-id",
+		EventType: pb.EventType_COMMENT_REPLY_CREATED,
+		Payload: &pb.NotificationEvent_CommentReplyCreated{
+			CommentReplyCreated: &pb.CommentReplyCreated{
+				CommentCreatorId: 123,
+				PostId:           101,
+				CommentId:        202,
+				ReplyId:          303,
+				ReplierUserId:    789,
+				ReplierUsername:  "test_user",
+				Body:             "This is the replied comment",
+				Aggregate:        true,
+			},
+		},
+	}
+
+	// Set up expectations
+	mockApp.On("CreateCommentReplyNotification",
+		mock.Anything,
+		int64(123),                    // userID (comment owner)
+		int64(789),                    // replierID
+		int64(101),                    // postID
+		int64(202),                    // commentID
+		int64(303),                    // replyID
+		"test_user",                   // replierUsername
+		"This is the replied comment", // commentContent
+		true,                          // aggregate
+	).Return(nil)
+
+	// Execute
+	err := eventHandler.Handle(context.Background(), event)
+
+	// Assert
+	assert.NoError(t, err)
+	mockApp.AssertExpectations(t)
+}
+
+func TestEventHandler_HandlePostShared(t *testing.T) {
+	mockApp := new(MockApplication)
+	eventHandler := &EventHandler{App: mockApp}
+
+	event := &pb.NotificationEvent{
+		EventId:   "test-post-shared-event-id",
+		EventType: pb.EventType_POST_SHARED,
+		Payload: &pb.NotificationEvent_PostShared{
+			PostShared: &pb.PostShared{
+				PostCreatorId:  123,
+				PostId:         101,
+				ShareId:        404,
+				SharerUserId:   789,
+				SharerUsername: "test_user",
+				Quote:          true,
+				Body:           "This is the quote",
+				Aggregate:      false,
+			},
+		},
+	}
+
+	// Set up expectations
+	mockApp.On("CreatePostSharedNotification",
+		mock.Anything,
+		int64(123),          // userID (post owner)
+		int64(789),          // sharerID
+		int64(101),          // postID
+		int64(404),          // shareID
+		"test_user",         // sharerUsername
+		"This is the quote", // quoteContent
+		true,                // quote
+		false,               // aggregate
+	).Return(nil)
+
+	// Execute
+	err := eventHandler.Handle(context.Background(), event)
+
+	// Assert
+	assert.NoError(t, err)
+	mockApp.AssertExpectations(t)
+}
+
+func TestEventHandler_HandleMentionCreated(t *testing.T) {
+	mockApp := new(MockApplication)
+	eventHandler := &EventHandler{App: mockApp}
+
+	event := &pb.NotificationEvent{
+		EventId:   "test-mention-event-id",
+		EventType: pb.EventType_MENTION_CREATED,
+		Payload: &pb.NotificationEvent_MentionCreated{
+			MentionCreated: &pb.MentionCreated{
+				MentionedUserId:   123,
+				MentionerUserId:   789,
+				PostId:            101,
+				CommentId:         202,
+				MentionerUsername: "test_user",
+				PostContent:       "thanks @other_user",
+				MentionText:       "@other_user",
+			},
+		},
+	}
+
+	// Set up expectations
+	mockApp.On("CreateMentionNotification",
+		mock.Anything,
+		int64(123),           // userID (mentioned user)
+		int64(789),           // mentionerID
+		int64(101),           // postID
+		int64(202),           // commentID
+		"test_user",          // mentionerUsername
+		"thanks @other_user", // postContent
+		"@other_user",        // mentionText
+	).Return(nil)
+
+	// Execute
+	err := eventHandler.Handle(context.Background(), event)
+
+	// Assert
+	assert.NoError(t, err)
+	mockApp.AssertExpectations(t)
+}
+
 func TestEventHandler_HandlePostLiked(t *testing.T) {
 	mockApp := new(MockApplication)
 	eventHandler := &EventHandler{App: mockApp}
@@ -233,6 +397,7 @@ func TestEventHandler_HandlePostLiked(t *testing.T) {
 				PostId:          123,
 				LikerUsername:   "user2",
 				Aggregate:       true,
+				ReactionType:    "love",
 			},
 		},
 	}
@@ -244,6 +409,7 @@ func TestEventHandler_HandlePostLiked(t *testing.T) {
 		int64(456), // likerID
 		int64(123), // postID
 		"user2",    // likerUsername
+		"love",     // reactionType
 		true,       // aggregate
 	).Return(nil)
 
@@ -349,6 +515,241 @@ func TestEventHandler_HandleGroupJoinRequestCancelled(t *testing.T) {
 	mockApp.AssertExpectations(t)
 }
 
+func TestEventHandler_HandleGroupDeleted(t *testing.T) {
+	mockApp := new(MockApplication)
+	eventHandler := &EventHandler{App: mockApp}
+
+	event := &pb.NotificationEvent{
+		EventId:   "test-group-deleted-event-id",
+		EventType: pb.EventType_GROUP_DELETED,
+		Payload: &pb.NotificationEvent_GroupDeleted{
+			GroupDeleted: &pb.GroupDeleted{
+				GroupId: 789,
+			},
+		},
+	}
+
+	// Set up expectations
+	mockApp.On("DeleteGroupRequestNotifications",
+		mock.Anything,
+		int64(789), // groupID
+	).Return(nil)
+
+	// Execute
+	err := eventHandler.Handle(context.Background(), event)
+
+	// Assert
+	assert.NoError(t, err)
+	mockApp.AssertExpectations(t)
+}
+
+func TestEventHandler_HandleGroupInviteExpired(t *testing.T) {
+	mockApp := new(MockApplication)
+	eventHandler := &EventHandler{App: mockApp}
+
+	event := &pb.NotificationEvent{
+		EventId:   "test-group-invite-expired-event-id",
+		EventType: pb.EventType_GROUP_INVITE_EXPIRED,
+		Payload: &pb.NotificationEvent_GroupInviteExpired{
+			GroupInviteExpired: &pb.GroupInviteExpired{
+				InvitedUserId: 123,
+				GroupId:       789,
+			},
+		},
+	}
+
+	// Set up expectations
+	mockApp.On("MarkGroupInviteNotificationExpired",
+		mock.Anything,
+		int64(123), // invitedUserID
+		int64(789), // groupID
+	).Return(nil)
+
+	// Execute
+	err := eventHandler.Handle(context.Background(), event)
+
+	// Assert
+	assert.NoError(t, err)
+	mockApp.AssertExpectations(t)
+}
+
+func TestEventHandler_HandleGroupJoinRequestExpired(t *testing.T) {
+	mockApp := new(MockApplication)
+	eventHandler := &EventHandler{App: mockApp}
+
+	event := &pb.NotificationEvent{
+		EventId:   "test-group-join-request-expired-event-id",
+		EventType: pb.EventType_GROUP_JOIN_REQUEST_EXPIRED,
+		Payload: &pb.NotificationEvent_GroupJoinRequestExpired{
+			GroupJoinRequestExpired: &pb.GroupJoinRequestExpired{
+				GroupOwnerId:    123,
+				RequesterUserId: 456,
+				GroupId:         789,
+			},
+		},
+	}
+
+	// Set up expectations
+	mockApp.On("MarkGroupJoinRequestNotificationExpired",
+		mock.Anything,
+		[]int64{123}, // approverIDs, the owner when none are sent
+		int64(456),   // requesterUserID
+		int64(789),   // groupID
+	).Return(nil)
+
+	// Execute
+	err := eventHandler.Handle(context.Background(), event)
+
+	// Assert
+	assert.NoError(t, err)
+	mockApp.AssertExpectations(t)
+}
+
+func TestEventHandler_HandleGroupJoinRequestExpiredApprovers(t *testing.T) {
+	mockApp := new(MockApplication)
+	eventHandler := &EventHandler{App: mockApp}
+
+	event := &pb.NotificationEvent{
+		EventId:   "test-group-join-request-expired-approvers-event-id",
+		EventType: pb.EventType_GROUP_JOIN_REQUEST_EXPIRED,
+		Payload: &pb.NotificationEvent_GroupJoinRequestExpired{
+			GroupJoinRequestExpired: &pb.GroupJoinRequestExpired{
+				GroupOwnerId:    123,
+				RequesterUserId: 456,
+				GroupId:         789,
+				ApproverIds:     []int64{123, 321},
+			},
+		},
+	}
+
+	// Set up expectations
+	mockApp.On("MarkGroupJoinRequestNotificationExpired",
+		mock.Anything,
+		[]int64{123, 321}, // approverIDs
+		int64(456),        // requesterUserID
+		int64(789),        // groupID
+	).Return(nil)
+
+	// Execute
+	err := eventHandler.Handle(context.Background(), event)
+
+	// Assert
+	assert.NoError(t, err)
+	mockApp.AssertExpectations(t)
+}
+
+func TestEventHandler_HandleGroupAnnouncementCreated(t *testing.T) {
+	mockApp := new(MockApplication)
+	eventHandler := &EventHandler{App: mockApp}
+
+	event := &pb.NotificationEvent{
+		EventId:   "test-group-announcement-event-id",
+		EventType: pb.EventType_GROUP_ANNOUNCEMENT_CREATED,
+		Payload: &pb.NotificationEvent_GroupAnnouncementCreated{
+			GroupAnnouncementCreated: &pb.GroupAnnouncementCreated{
+				UserId:      []int64{123, 456},
+				AuthorId:    123,
+				GroupId:     789,
+				PostId:      101,
+				GroupName:   "Test Group",
+				PostContent: "Meeting moved to friday",
+			},
+		},
+	}
+
+	// Set up expectations
+	mockApp.On("CreateGroupAnnouncementForMultipleUsers",
+		mock.Anything,
+		[]int64{123, 456},         // userIDs
+		int64(123),                // authorID
+		int64(789),                // groupID
+		int64(101),                // postID
+		"Test Group",              // groupName
+		"Meeting moved to friday", // postContent
+	).Return(nil)
+
+	// Execute
+	err := eventHandler.Handle(context.Background(), event)
+
+	// Assert
+	assert.NoError(t, err)
+	mockApp.AssertExpectations(t)
+}
+
+func TestEventHandler_HandleGroupPostReviewed(t *testing.T) {
+	mockApp := new(MockApplication)
+	eventHandler := &EventHandler{App: mockApp}
+
+	event := &pb.NotificationEvent{
+		EventId:   "test-group-post-reviewed-event-id",
+		EventType: pb.EventType_GROUP_POST_REVIEWED,
+		Payload: &pb.NotificationEvent_GroupPostReviewed{
+			GroupPostReviewed: &pb.GroupPostReviewed{
+				AuthorId:   123,
+				ReviewerId: 456,
+				GroupId:    789,
+				PostId:     101,
+				GroupName:  "Test Group",
+				Approved:   false,
+				Reason:     "Off topic",
+			},
+		},
+	}
+
+	// Set up expectations
+	mockApp.On("CreateGroupPostReviewedNotification",
+		mock.Anything,
+		int64(123),   // authorID
+		int64(456),   // reviewerID
+		int64(789),   // groupID
+		int64(101),   // postID
+		"Test Group", // groupName
+		false,        // approved
+		"Off topic",  // reason
+	).Return(nil)
+
+	// Execute
+	err := eventHandler.Handle(context.Background(), event)
+
+	// Assert
+	assert.NoError(t, err)
+	mockApp.AssertExpectations(t)
+}
+
+func TestEventHandler_HandleScheduledPostPublished(t *testing.T) {
+	mockApp := new(MockApplication)
+	eventHandler := &EventHandler{App: mockApp}
+
+	event := &pb.NotificationEvent{
+		EventId:   "test-scheduled-post-published-event-id",
+		EventType: pb.EventType_SCHEDULED_POST_PUBLISHED,
+		Payload: &pb.NotificationEvent_ScheduledPostPublished{
+			ScheduledPostPublished: &pb.ScheduledPostPublished{
+				AuthorId:        123,
+				PostId:          101,
+				GroupId:         789,
+				PendingApproval: true,
+			},
+		},
+	}
+
+	// Set up expectations
+	mockApp.On("CreateScheduledPostPublishedNotification",
+		mock.Anything,
+		int64(123), // authorID
+		int64(101), // postID
+		int64(789), // groupID
+		true,       // pendingApproval
+	).Return(nil)
+
+	// Execute
+	err := eventHandler.Handle(context.Background(), event)
+
+	// Assert
+	assert.NoError(t, err)
+	mockApp.AssertExpectations(t)
+}
+
 func TestEventHandler_HandleNewFollowerCreated(t *testing.T) {
 	mockApp := new(MockApplication)
 	eventHandler := &EventHandler{App: mockApp}
@@ -47,7 +47,7 @@ func (suite *IntegrationTestSuite) TestProcessEventTypesIntegration() {
 	assert.NoError(suite.T(), err)
 
 	// Test PostLiked
-	suite.mockApp.On("CreatePostLikeNotification", mock.Anything, int64(123), int64(456), int64(123), "user2", true).Return(nil)
+	suite.mockApp.On("CreatePostLikeNotification", mock.Anything, int64(123), int64(456), int64(123), "user2", "", true).Return(nil)
 	event2 := &pb.NotificationEvent{
 		EventId:   "test-2",
 		EventType: pb.EventType_POST_LIKED,
@@ -235,7 +235,7 @@ func (suite *IntegrationTestSuite) TestSequentialProcessingIntegration() {
 
 	// Set up expectations for all events
 	suite.mockApp.On("CreatePostCommentNotification", mock.Anything, int64(123), int64(456), int64(123), "user1", "comment body", true).Return(nil)
-	suite.mockApp.On("CreatePostLikeNotification", mock.Anything, int64(123), int64(456), int64(123), "user2", true).Return(nil)
+	suite.mockApp.On("CreatePostLikeNotification", mock.Anything, int64(123), int64(456), int64(123), "user2", "", true).Return(nil)
 	suite.mockApp.On("CreateFollowRequestNotification", mock.Anything, int64(123), int64(456), "user3").Return(nil)
 	suite.mockApp.On("DeleteFollowRequestNotification", mock.Anything, int64(123), int64(456)).Return(nil)
 
@@ -14,7 +14,9 @@ type EventProcessor interface {
 // ApplicationService defines the interface for the application layer
 type ApplicationService interface {
 	CreatePostCommentNotification(ctx context.Context, userID, commenterID, postID int64, commenterUsername, commentContent string, aggregate bool) error
-	CreatePostLikeNotification(ctx context.Context, userID, likerID, postID int64, likerUsername string, aggregate bool) error
+	CreateCommentReplyNotification(ctx context.Context, userID, replierID, postID, commentID, replyID int64, replierUsername, commentContent string, aggregate bool) error
+	CreatePostSharedNotification(ctx context.Context, userID, sharerID, postID, shareID int64, sharerUsername, quoteContent string, quote, aggregate bool) error
+	CreatePostLikeNotification(ctx context.Context, userID, likerID, postID int64, likerUsername, reactionType string, aggregate bool) error
 	CreateFollowRequestNotification(ctx context.Context, targetUserID, requesterUserID int64, requesterUsername string) error
 	CreateNewFollowerNotification(ctx context.Context, targetUserID, followerUserID int64, followerUsername string, aggregate bool) error
 	CreateGroupInviteNotification(ctx context.Context, invitedUserID, inviterUserID, groupID int64, groupName, inviterUsername string) error
@@ -22,7 +24,10 @@ type ApplicationService interface {
 	CreateGroupJoinRequestNotification(ctx context.Context, groupOwnerID, requesterID, groupID int64, groupName, requesterUsername string) error
 	CreateNewEventNotification(ctx context.Context, userID, eventCreatorID, groupID, eventID int64, groupName, eventTitle string) error
 	CreateNewEventForMultipleUsers(ctx context.Context, userIDs []int64, eventCreatorID int64, groupID, eventID int64, groupName, eventTitle string) error
-	CreateMentionNotification(ctx context.Context, userID, mentionerID, postID int64, mentionerUsername, postContent, mentionText string) error
+	CreateGroupAnnouncementForMultipleUsers(ctx context.Context, userIDs []int64, authorID, groupID, postID int64, groupName, postContent string) error
+	CreateGroupPostReviewedNotification(ctx context.Context, authorID, reviewerID, groupID, postID int64, groupName string, approved bool, reason string) error
+	CreateScheduledPostPublishedNotification(ctx context.Context, authorID, postID, groupID int64, pendingApproval bool) error
+	CreateMentionNotification(ctx context.Context, userID, mentionerID, postID, commentID int64, mentionerUsername, postContent, mentionText string) error
 	CreateNewMessageNotification(ctx context.Context, userID, senderID, chatID int64, senderUsername, messageContent string, aggregate bool) error
 	CreateNewMessageForMultipleUsers(ctx context.Context, userIDs []int64, senderID, chatID int64, senderUsername, messageContent string, aggregate bool) error
 	CreateFollowRequestAcceptedNotification(ctx context.Context, requesterUserID, targetUserID int64, targetUsername string) error
@@ -33,6 +38,9 @@ type ApplicationService interface {
 	CreateGroupJoinRequestRejectedNotification(ctx context.Context, requesterUserID, groupOwnerID, groupID int64, groupName string) error
 	DeleteFollowRequestNotification(ctx context.Context, targetUserID, requesterUserID int64) error
 	DeleteGroupJoinRequestNotification(ctx context.Context, groupOwnerID, requesterUserID, groupID int64) error
+	DeleteGroupRequestNotifications(ctx context.Context, groupID int64) error
+	MarkGroupInviteNotificationExpired(ctx context.Context, invitedUserID, groupID int64) error
+	MarkGroupJoinRequestNotificationExpired(ctx context.Context, approverIDs []int64, requesterUserID, groupID int64) error
 	CreateDefaultNotificationTypes(ctx context.Context) error
 	GetNotification(ctx context.Context, notificationID, userID int64) (*application.Notification, error)
 	GetUserNotifications(ctx context.Context, userID int64, limit, offset int32) ([]*application.Notification, error)
@@ -45,6 +45,9 @@ func (s *Server) CreateNotification(ctx context.Context, req *pb.CreateNotificat
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create notification: %v", err)
 	}
+	if notification == nil { // recipient is deactivated
+		return &pb.Notification{}, nil
+	}
 
 	return s.convertToProtoNotification(notification), nil
 }
@@ -79,6 +82,9 @@ func (s *Server) CreateNotifications(ctx context.Context, req *pb.CreateNotifica
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to create notification: %v", err)
 		}
+		if notification == nil { // recipient is deactivated
+			continue
+		}
 
 		createdNotifications = append(createdNotifications, notification)
 	}
@@ -399,7 +405,7 @@ func (s *Server) CreatePostLike(ctx context.Context, req *pb.CreatePostLikeReque
 		return nil, status.Error(codes.InvalidArgument, "user_id, liker_user_id, and post_id are required")
 	}
 
-	err := s.Application.CreatePostLikeNotification(ctx, req.UserId, req.LikerUserId, req.PostId, req.LikerUsername, req.Aggregate)
+	err := s.Application.CreatePostLikeNotification(ctx, req.UserId, req.LikerUserId, req.PostId, req.LikerUsername, req.ReactionType, req.Aggregate)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create post like notification: %v", err)
 	}
@@ -460,16 +466,21 @@ func (s *Server) CreateMention(ctx context.Context, req *pb.CreateMentionRequest
 		return nil, status.Error(codes.InvalidArgument, "user_id, mentioner_user_id, and post_id are required")
 	}
 
-	err := s.Application.CreateMentionNotification(ctx, req.UserId, req.MentionerUserId, req.PostId, req.MentionerUsername, req.PostContent, req.MentionText)
+	err := s.Application.CreateMentionNotification(ctx, req.UserId, req.MentionerUserId, req.PostId, req.CommentId, req.MentionerUsername, req.PostContent, req.MentionText)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create mention notification: %v", err)
 	}
 
+	message := fmt.Sprintf("%s mentioned you in a post", req.MentionerUsername)
+	if req.CommentId != 0 {
+		message = fmt.Sprintf("%s mentioned you in a comment", req.MentionerUsername)
+	}
+
 	// Return a basic notification as the internal function returns only error
 	notification := s.convertToProtoNotification(&application.Notification{
 		Type:           application.Mention,
 		Title:          "You were mentioned",
-		Message:        fmt.Sprintf("%s mentioned you in a post", req.MentionerUsername),
+		Message:        message,
 		SourceEntityID: ct.Id(req.PostId),
 		SourceService:  "posts",
 		NeedsAction:    false,
@@ -836,6 +847,20 @@ func convertApplicationNotificationTypeToProto(appType application.NotificationT
 		return pb.NotificationType_NOTIFICATION_TYPE_GROUP_JOIN_REQUEST_ACCEPTED
 	case application.GroupJoinRequestRejected:
 		return pb.NotificationType_NOTIFICATION_TYPE_GROUP_JOIN_REQUEST_REJECTED
+	case application.GroupAnnouncement:
+		return pb.NotificationType_NOTIFICATION_TYPE_GROUP_ANNOUNCEMENT
+	case application.GroupPostApproved:
+		return pb.NotificationType_NOTIFICATION_TYPE_GROUP_POST_APPROVED
+	case application.GroupPostRejected:
+		return pb.NotificationType_NOTIFICATION_TYPE_GROUP_POST_REJECTED
+	case application.ScheduledPostPublished:
+		return pb.NotificationType_NOTIFICATION_TYPE_SCHEDULED_POST_PUBLISHED
+	case application.CommentReply:
+		return pb.NotificationType_NOTIFICATION_TYPE_COMMENT_REPLY
+	case application.PostRepost:
+		return pb.NotificationType_NOTIFICATION_TYPE_REPOST
+	case application.PostQuote:
+		return pb.NotificationType_NOTIFICATION_TYPE_QUOTE
 	default:
 		return pb.NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
 	}
@@ -872,6 +897,20 @@ func convertProtoNotificationTypeToApplication(protoType pb.NotificationType) ap
 		return application.GroupJoinRequestAccepted
 	case pb.NotificationType_NOTIFICATION_TYPE_GROUP_JOIN_REQUEST_REJECTED:
 		return application.GroupJoinRequestRejected
+	case pb.NotificationType_NOTIFICATION_TYPE_GROUP_ANNOUNCEMENT:
+		return application.GroupAnnouncement
+	case pb.NotificationType_NOTIFICATION_TYPE_GROUP_POST_APPROVED:
+		return application.GroupPostApproved
+	case pb.NotificationType_NOTIFICATION_TYPE_GROUP_POST_REJECTED:
+		return application.GroupPostRejected
+	case pb.NotificationType_NOTIFICATION_TYPE_SCHEDULED_POST_PUBLISHED:
+		return application.ScheduledPostPublished
+	case pb.NotificationType_NOTIFICATION_TYPE_COMMENT_REPLY:
+		return application.CommentReply
+	case pb.NotificationType_NOTIFICATION_TYPE_REPOST:
+		return application.PostRepost
+	case pb.NotificationType_NOTIFICATION_TYPE_QUOTE:
+		return application.PostQuote
 	default:
 		return application.NotificationType("")
 	}
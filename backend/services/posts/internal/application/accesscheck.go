@@ -5,10 +5,12 @@ import (
 	"fmt"
 	ds "social-network/services/posts/internal/db/dbservice"
 	ce "social-network/shared/go/commonerrors"
+	ct "social-network/shared/go/ct"
 	tele "social-network/shared/go/telemetry"
 )
 
-// group and post audience=group: only members can see
+// group and post audience=group: only members can see, or everyone if the group is public
+// (interactions with group entities always need membership, see accessContext.requireMembership)
 // post audience=everyone: everyone can see (can we check this before all the fetches from users?)
 // post audience=followers: requester can see if they follow creator
 // post audience=selected: requester can see if they are in post audience table
@@ -39,6 +41,9 @@ func (s *Application) hasRightToView(ctx context.Context, req accessContext) (bo
 		if err != nil {
 			return false, ce.DecodeProto(err, input)
 		}
+		if req.requireMembership && !isMember {
+			return false, nil
+		}
 	}
 
 	entityID := req.entityId //this is the event or post id - in case of a comment we take the parent post id
@@ -57,3 +62,129 @@ func (s *Application) hasRightToView(ctx context.Context, req accessContext) (bo
 	}
 	return canSee, nil
 }
+
+// batch version of hasRightToView for a post: returns those of userIds who can see it,
+// with a single call to users for the creator's followers or the group's members
+func (s *Application) usersWhoCanViewPost(ctx context.Context, postId, creatorId, groupId int64, userIds ct.Ids) (ct.Ids, error) {
+	input := fmt.Sprintf("post: %v, creator: %v, group: %v, users: %v", postId, creatorId, groupId, userIds)
+
+	var followerIds, memberIds []int64
+	var err error
+	if groupId > 0 {
+		memberIds, err = s.clients.GetAllGroupMemberIds(ctx, groupId)
+	} else {
+		followerIds, err = s.clients.GetFollowerIds(ctx, creatorId)
+	}
+	if err != nil {
+		return nil, ce.DecodeProto(err, input)
+	}
+
+	ids, err := s.db.GetUsersWhoCanSeePost(ctx, ds.GetUsersWhoCanSeePostParams{
+		PostID:      postId,
+		UserIds:     userIds.Int64(),
+		FollowerIds: followerIds,
+		MemberIds:   memberIds,
+	})
+	if err != nil {
+		return nil, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	return ct.FromInt64s(ids), nil
+}
+
+// batch version of hasRightToView for a user: returns those of postIds they can see,
+// with a single call to users for the users they follow and one for their groups
+func (s *Application) postsUserCanView(ctx context.Context, userId int64, postIds ct.Ids) (ct.Ids, error) {
+	input := fmt.Sprintf("user: %v, posts: %v", userId, postIds)
+
+	followingIds, err := s.clients.GetFollowingIds(ctx, userId)
+	if err != nil {
+		return nil, ce.DecodeProto(err, input)
+	}
+	groupIds, err := s.clients.GetUserGroupIds(ctx, userId)
+	if err != nil {
+		return nil, ce.DecodeProto(err, input)
+	}
+
+	ids, err := s.db.GetPostsUserCanSee(ctx, ds.GetPostsUserCanSeeParams{
+		Ids:          postIds.Int64(),
+		UserID:       userId,
+		FollowingIds: followingIds,
+		GroupIds:     groupIds,
+	})
+	if err != nil {
+		return nil, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	return ct.FromInt64s(ids), nil
+}
+
+// members can always read the posts and events of a group, everyone else only if the group is public
+func (s *Application) canReadGroup(ctx context.Context, requesterId, groupId int64) (bool, error) {
+	input := fmt.Sprintf("requester: %v, group: %v", requesterId, groupId)
+
+	isMember, err := s.clients.IsGroupMember(ctx, requesterId, groupId)
+	if err != nil {
+		return false, ce.DecodeProto(err, input)
+	}
+	if isMember {
+		return true, nil
+	}
+
+	isPublic, err := s.db.IsGroupPublic(ctx, groupId)
+	if err != nil {
+		return false, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	return isPublic, nil
+}
+
+// returns the creator id to use when modifying a group entity:
+// the actual creator if requester's group role grants perm, requester otherwise
+// (so the creator check in the query keeps applying)
+func (s *Application) moderatedCreatorId(ctx context.Context, requesterId, entityId int64, perm ct.GroupPermission) (int64, error) {
+	input := fmt.Sprintf("requester: %v, entity: %v, permission: %v", requesterId, entityId, perm)
+
+	row, err := s.db.GetEntityCreatorAndGroup(ctx, entityId)
+	if err != nil {
+		return 0, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if row.CreatorID == requesterId || row.GroupID == 0 {
+		return requesterId, nil
+	}
+
+	allowed, err := s.clients.HasGroupPermission(ctx, requesterId, row.GroupID, perm)
+	if err != nil {
+		return 0, ce.DecodeProto(err, input)
+	}
+	if !allowed {
+		return requesterId, nil
+	}
+	tele.Info(ctx, "user @1 acting on entity @2 of user @3 as group staff", "requesterId", requesterId, "entityId", entityId, "creatorId", row.CreatorID)
+	return row.CreatorID, nil
+}
+
+// returns failed precondition if the group is archived (read-only)
+func (s *Application) checkGroupWritable(ctx context.Context, groupId int64) error {
+	input := fmt.Sprintf("group: %v", groupId)
+
+	archived, err := s.db.IsGroupArchived(ctx, groupId)
+	if err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if archived {
+		return ce.New(ce.ErrFailedPrecondition, fmt.Errorf("group %v is archived", groupId), input).WithPublic("this group is archived")
+	}
+	return nil
+}
+
+// returns failed precondition if the post, event or comment belongs to an archived group
+func (s *Application) checkEntityWritable(ctx context.Context, entityId int64) error {
+	input := fmt.Sprintf("entity: %v", entityId)
+
+	row, err := s.db.GetEntityCreatorAndGroup(ctx, entityId)
+	if err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if row.GroupID == 0 {
+		return nil
+	}
+	return s.checkGroupWritable(ctx, row.GroupID)
+}
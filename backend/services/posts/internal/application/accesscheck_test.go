@@ -0,0 +1,140 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	ds "social-network/services/posts/internal/db/dbservice"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// accessQuerier serves the queries behind hasRightToView from memory and records what it was asked,
+// any other query panics on the nil embedded interface
+type accessQuerier struct {
+	ds.Querier
+	row     ds.GetEntityCreatorAndGroupRow
+	canSee  bool
+	seeArgs *ds.CanUserSeeEntityParams
+}
+
+func (q *accessQuerier) GetEntityCreatorAndGroup(ctx context.Context, id int64) (ds.GetEntityCreatorAndGroupRow, error) {
+	return q.row, nil
+}
+
+func (q *accessQuerier) CanUserSeeEntity(ctx context.Context, arg ds.CanUserSeeEntityParams) (bool, error) {
+	q.seeArgs = &arg
+	return q.canSee, nil
+}
+
+// accessClients answers follow and membership checks from memory
+type accessClients struct {
+	ClientsInterface
+	following       map[int64]bool // followed user ids
+	memberOf        map[int64]bool // group ids
+	followedTargets []int64
+}
+
+func (c *accessClients) IsFollowing(ctx context.Context, userId, targetUserId int64) (bool, error) {
+	c.followedTargets = append(c.followedTargets, targetUserId)
+	return c.following[targetUserId], nil
+}
+
+func (c *accessClients) IsGroupMember(ctx context.Context, userId, groupId int64) (bool, error) {
+	return c.memberOf[groupId], nil
+}
+
+func TestHasRightToView(t *testing.T) {
+	const requester = 1
+
+	tests := []struct {
+		name        string
+		req         accessContext
+		row         ds.GetEntityCreatorAndGroupRow
+		following   map[int64]bool
+		memberOf    map[int64]bool
+		canSee      bool
+		want        bool
+		wantSeeArgs *ds.CanUserSeeEntityParams // nil if the database must not be asked
+		wantTarget  int64                      // user whose followers are checked
+	}{
+		{
+			name:        "post outside groups is decided by the database",
+			req:         accessContext{requesterId: requester, entityId: 10},
+			row:         ds.GetEntityCreatorAndGroupRow{CreatorID: 2},
+			following:   map[int64]bool{2: true},
+			canSee:      true,
+			want:        true,
+			wantSeeArgs: &ds.CanUserSeeEntityParams{UserID: requester, EntityID: 10, IsFollowing: true},
+			wantTarget:  2,
+		},
+		{
+			name:        "comment is checked against its post and the post creator",
+			req:         accessContext{requesterId: requester, entityId: 11},
+			row:         ds.GetEntityCreatorAndGroupRow{CreatorID: 3, ParentCreatorID: 2, ParentID: 10},
+			canSee:      false,
+			want:        false,
+			wantSeeArgs: &ds.CanUserSeeEntityParams{UserID: requester, EntityID: 10},
+			wantTarget:  2,
+		},
+		{
+			name:        "non member can view a group post when membership isn't required",
+			req:         accessContext{requesterId: requester, entityId: 10},
+			row:         ds.GetEntityCreatorAndGroupRow{CreatorID: 2, GroupID: 7},
+			canSee:      true,
+			want:        true,
+			wantSeeArgs: &ds.CanUserSeeEntityParams{UserID: requester, EntityID: 10},
+			wantTarget:  2,
+		},
+		{
+			name:       "non member can't interact with a group post",
+			req:        accessContext{requesterId: requester, entityId: 10, requireMembership: true},
+			row:        ds.GetEntityCreatorAndGroupRow{CreatorID: 2, GroupID: 7},
+			canSee:     true,
+			want:       false,
+			wantTarget: 2,
+		},
+		{
+			name:       "creator who left the group can't interact with their post",
+			req:        accessContext{requesterId: requester, entityId: 10, requireMembership: true},
+			row:        ds.GetEntityCreatorAndGroupRow{CreatorID: requester, GroupID: 7},
+			canSee:     true,
+			want:       false,
+			wantTarget: requester,
+		},
+		{
+			name:        "member can interact with a group post",
+			req:         accessContext{requesterId: requester, entityId: 10, requireMembership: true},
+			row:         ds.GetEntityCreatorAndGroupRow{CreatorID: 2, GroupID: 7},
+			memberOf:    map[int64]bool{7: true},
+			canSee:      true,
+			want:        true,
+			wantSeeArgs: &ds.CanUserSeeEntityParams{UserID: requester, EntityID: 10, IsMember: true},
+			wantTarget:  2,
+		},
+		{
+			name:        "membership of another group doesn't count",
+			req:         accessContext{requesterId: requester, entityId: 10, requireMembership: true},
+			row:         ds.GetEntityCreatorAndGroupRow{CreatorID: 2, GroupID: 7},
+			memberOf:    map[int64]bool{8: true},
+			canSee:      true,
+			want:        false,
+			wantSeeArgs: nil,
+			wantTarget:  2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := &accessQuerier{row: tt.row, canSee: tt.canSee}
+			clients := &accessClients{following: tt.following, memberOf: tt.memberOf}
+			s := NewApplicationWithMocks(q, clients)
+
+			got, err := s.hasRightToView(context.Background(), tt.req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantSeeArgs, q.seeArgs)
+			assert.Equal(t, []int64{tt.wantTarget}, clients.followedTargets)
+		})
+	}
+}
@@ -25,7 +25,7 @@ type TxRunner interface {
 }
 
 type Application struct {
-	db             *ds.Queries
+	db             ds.Querier
 	txRunner       TxRunner
 	clients        ClientsInterface
 	userRetriever  UserRetriever
@@ -55,12 +55,18 @@ type UserRetriever interface {
 type ClientsInterface interface {
 	IsFollowing(ctx context.Context, userId, targetUserId int64) (bool, error)
 	IsGroupMember(ctx context.Context, userId, groupId int64) (bool, error)
+	HasGroupPermission(ctx context.Context, userId, groupId int64, perm ct.GroupPermission) (bool, error)
+	CanInteract(ctx context.Context, actorId, targetId int64, action ct.PrivacyAction) (bool, error)
 	GetFollowingIds(ctx context.Context, userId int64) ([]int64, error)
+	GetFollowerIds(ctx context.Context, userId int64) ([]int64, error)
 	CreateNewEvent(ctx context.Context, userId, groupId, eventId int64, groupName, eventTitle string) error
 	CreatePostLike(ctx context.Context, userId, likerUserId, postId int64, likerUsername string) error
 	CreatePostComment(ctx context.Context, userId, commenterId, postId int64, commenterUsername, commentContent string) error
 	GetGroupBasicInfo(ctx context.Context, groupId int64) (models.Group, error)
 	GetAllGroupMemberIds(ctx context.Context, groupId int64) ([]int64, error)
+	GetUserGroupIds(ctx context.Context, userId int64) ([]int64, error)
+	ResolveHandle(ctx context.Context, handle string) (int64, error)
+	DeleteImages(ctx context.Context, imageIds []int64) error
 }
 
 // NewApplication constructs a new Application with transaction support
@@ -86,13 +92,13 @@ func NewApplication(db *ds.Queries, pool *pgxpool.Pool, clients *client.Clients,
 	}, nil
 }
 
-func NewApplicationWithMocks(db *ds.Queries, clients ClientsInterface) *Application {
+func NewApplicationWithMocks(db ds.Querier, clients ClientsInterface) *Application {
 	return &Application{
 		db:      db,
 		clients: clients,
 	}
 }
-func NewApplicationWithMocksTx(db *ds.Queries, clients ClientsInterface, txRunner TxRunner) *Application {
+func NewApplicationWithMocksTx(db ds.Querier, clients ClientsInterface, txRunner TxRunner) *Application {
 	return &Application{
 		db:       db,
 		clients:  clients,
@@ -2,6 +2,7 @@ package application
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	ds "social-network/services/posts/internal/db/dbservice"
 	"social-network/shared/gen-go/media"
@@ -10,11 +11,20 @@ import (
 	ct "social-network/shared/go/ct"
 	"social-network/shared/go/models"
 	tele "social-network/shared/go/telemetry"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const genericPublic = "posts service error"
 
 func (s *Application) CreateComment(ctx context.Context, req models.CreateCommentReq) (commentId int64, err error) {
+	return s.createComment(ctx, req, nil)
+}
+
+// NOT GRPC
+// claim, if given, runs first in the tx creating the comment
+func (s *Application) createComment(ctx context.Context, req models.CreateCommentReq, claim txClaim) (commentId int64, err error) {
 	input := fmt.Sprintf("%#v", req)
 
 	if err := ct.ValidateStruct(req); err != nil {
@@ -22,8 +32,9 @@ func (s *Application) CreateComment(ctx context.Context, req models.CreateCommen
 	}
 
 	accessCtx := accessContext{
-		requesterId: req.CreatorId.Int64(),
-		entityId:    req.ParentId.Int64(),
+		requesterId:       req.CreatorId.Int64(),
+		entityId:          req.ParentId.Int64(),
+		requireMembership: true,
 	}
 
 	hasAccess, err := s.hasRightToView(ctx, accessCtx)
@@ -34,11 +45,60 @@ func (s *Application) CreateComment(ctx context.Context, req models.CreateCommen
 		return 0, ce.New(ce.ErrPermissionDenied, fmt.Errorf("user has no permission to comment on post: %v", req.ParentId), input).WithPublic("permission denied")
 	}
 
+	if err := s.checkEntityWritable(ctx, req.ParentId.Int64()); err != nil {
+		return 0, ce.Wrap(nil, err)
+	}
+
+	basicPost, err := s.db.GetBasicPostByID(ctx, req.ParentId.Int64())
+	if err != nil {
+		return 0, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+
+	//a reply goes one level under a live comment of the same post
+	var replyTarget ds.GetCommentReplyTargetRow
+	if req.ReplyToId != 0 {
+		replyTarget, err = s.db.GetCommentReplyTarget(ctx, req.ReplyToId.Int64())
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return 0, ce.New(ce.ErrInvalidArgument, fmt.Errorf("comment %v to reply to not found or deleted", req.ReplyToId), input).WithPublic("the comment you're replying to no longer exists")
+			}
+			return 0, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		if replyTarget.ParentID != req.ParentId.Int64() {
+			return 0, ce.New(ce.ErrInvalidArgument, fmt.Errorf("comment %v is not on post %v", req.ReplyToId, req.ParentId), input).WithPublic("invalid data received")
+		}
+		if replyTarget.Depth >= ct.MaxCommentDepth {
+			return 0, ce.New(ce.ErrInvalidArgument, fmt.Errorf("comment %v is already at max depth %v", req.ReplyToId, ct.MaxCommentDepth), input).WithPublic("this comment can't be replied to")
+		}
+	}
+
+	//post creator's privacy settings decide who can comment
+	canComment, err := s.clients.CanInteract(ctx, req.CreatorId.Int64(), basicPost.CreatorID, ct.ActionComment)
+	if err != nil {
+		return 0, ce.DecodeProto(err, input)
+	}
+	if !canComment {
+		return 0, ce.New(ce.ErrPermissionDenied, fmt.Errorf("user %v does not allow comments from user %v", basicPost.CreatorID, req.CreatorId), input).WithPublic("this user doesn't accept comments from you")
+	}
+
+	mentions, err := s.resolveMentions(ctx, req.Body.String())
+	if err != nil {
+		return 0, ce.Wrap(nil, err, input)
+	}
+
 	err = s.txRunner.RunTx(ctx, func(q *ds.Queries) error {
+		if claim != nil {
+			if err := claim(q); err != nil {
+				return err
+			}
+		}
+
 		commentId, err = q.CreateComment(ctx, ds.CreateCommentParams{
 			CommentCreatorID: req.CreatorId.Int64(),
 			ParentID:         req.ParentId.Int64(),
 			CommentBody:      req.Body.String(),
+			ReplyToID:        pgtype.Int8{Int64: req.ReplyToId.Int64(), Valid: req.ReplyToId != 0},
+			Depth:            depthUnder(req.ReplyToId, replyTarget.Depth),
 		})
 
 		if err != nil {
@@ -54,21 +114,61 @@ func (s *Application) CreateComment(ctx context.Context, req models.CreateCommen
 				return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
 			}
 		}
+
+		if len(mentions) > 0 {
+			if _, err := setMentions(ctx, q, commentId, mentions, input); err != nil {
+				return err
+			}
+		}
 		return nil
 	})
 	if err != nil {
 		return 0, ce.Wrap(nil, err)
 	}
 
+	//the post and replied comment authors already hear about it as a comment or reply
+	s.notifyMentions(ctx, mentionSource{
+		authorId:  req.CreatorId.Int64(),
+		postId:    req.ParentId.Int64(),
+		commentId: commentId,
+		body:      req.Body.String(),
+		mentions:  mentions,
+		notified:  []int64{basicPost.CreatorID, replyTarget.CommentCreatorID},
+	})
+
 	//create notification
 	commenter, err := s.userRetriever.GetUser(ctx, ct.Id(req.CreatorId))
 	if err != nil {
 		tele.Error(ctx, "Could not get basic user info for id @1 for comment created event: @2", "userId", req.CreatorId, "error", err.Error())
 	}
 
-	basicPost, err := s.db.GetBasicPostByID(ctx, req.ParentId.Int64())
-	if err != nil {
-		tele.Error(ctx, "Could not get basic post info for id @1 for comment created event: @2", "postId", req.ParentId, "error", err.Error())
+	//the author of the replied comment gets a reply notification, unless replying to themselves
+	if req.ReplyToId != 0 && replyTarget.CommentCreatorID != req.CreatorId.Int64() {
+		event := &notifpb.NotificationEvent{
+			EventType: notifpb.EventType_COMMENT_REPLY_CREATED,
+			Payload: &notifpb.NotificationEvent_CommentReplyCreated{
+				CommentReplyCreated: &notifpb.CommentReplyCreated{
+					CommentCreatorId: replyTarget.CommentCreatorID,
+					PostId:           req.ParentId.Int64(),
+					CommentId:        req.ReplyToId.Int64(),
+					ReplyId:          commentId,
+					ReplierUserId:    req.CreatorId.Int64(),
+					ReplierUsername:  commenter.Username.String(),
+					Body:             replyTarget.CommentBody,
+					Aggregate:        true,
+				},
+			},
+		}
+
+		if err := s.eventProducer.CreateAndSendNotificationEvent(ctx, event); err != nil {
+			tele.Error(ctx, "failed to send comment reply notification: @1", "error", err.Error())
+		}
+		tele.Info(ctx, "comment reply notification event created")
+
+		//post creator already heard about it as a reply
+		if replyTarget.CommentCreatorID == basicPost.CreatorID {
+			return commentId, nil
+		}
 	}
 
 	//if commenter is parent creator, do not create notification
@@ -120,7 +220,26 @@ func (s *Application) EditComment(ctx context.Context, req models.EditCommentReq
 		return ce.New(ce.ErrPermissionDenied, fmt.Errorf("user has no permission to view or edit entity %v", req.CommentId), input).WithPublic("permission denied")
 	}
 
+	if err := s.checkEntityWritable(ctx, req.CommentId.Int64()); err != nil {
+		return ce.Wrap(nil, err)
+	}
+
+	mentions, err := s.resolveMentions(ctx, req.Body.String())
+	if err != nil {
+		return ce.Wrap(nil, err, input)
+	}
+
+	var previouslyMentioned []int64
 	err = s.txRunner.RunTx(ctx, func(q *ds.Queries) error {
+		_, err := q.InsertCommentRevision(ctx, ds.InsertCommentRevisionParams{
+			ID:               req.CommentId.Int64(),
+			CommentCreatorID: req.CreatorId.Int64(),
+			NewBody:          req.Body.String(),
+		})
+		if err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+
 		rowsAffected, err := q.EditComment(ctx, ds.EditCommentParams{
 			CommentBody:      req.Body.String(),
 			ID:               req.CommentId.Int64(),
@@ -133,6 +252,11 @@ func (s *Application) EditComment(ctx context.Context, req models.EditCommentReq
 			return ce.New(ce.ErrNotFound, fmt.Errorf("comment %v not found or not owned by user %v", req.CommentId, req.CreatorId), input).WithPublic("not found")
 		}
 
+		previouslyMentioned, err = setMentions(ctx, q, req.CommentId.Int64(), mentions, input)
+		if err != nil {
+			return err
+		}
+
 		if req.ImageId > 0 {
 			err := q.UpsertImage(ctx, ds.UpsertImageParams{
 				ID:       req.ImageId.Int64(),
@@ -158,6 +282,22 @@ func (s *Application) EditComment(ctx context.Context, req models.EditCommentReq
 		return ce.Wrap(nil, err)
 	}
 
+	//only users added by this edit hear about it
+	if len(mentions) > 0 {
+		row, err := s.db.GetEntityCreatorAndGroup(ctx, req.CommentId.Int64())
+		if err != nil {
+			tele.Error(ctx, "could not get post of comment @1 for mention events: @2", "commentId", req.CommentId, "error", err.Error())
+			return nil
+		}
+		s.notifyMentions(ctx, mentionSource{
+			authorId:  req.CreatorId.Int64(),
+			postId:    row.ParentID,
+			commentId: req.CommentId.Int64(),
+			body:      req.Body.String(),
+			mentions:  mentions,
+			notified:  previouslyMentioned,
+		})
+	}
 	return nil
 }
 func (s *Application) DeleteComment(ctx context.Context, req models.GenericReq) error {
@@ -228,25 +368,45 @@ func (s *Application) GetCommentsByParentId(ctx context.Context, req models.Enti
 		return []models.Comment{}, nil
 	}
 
+	//deleted comments have no body left to point into
+	commentIds := make([]int64, 0, len(rows))
+	for _, r := range rows {
+		if !r.Deleted {
+			commentIds = append(commentIds, r.ID)
+		}
+	}
+	mentions := s.getMentions(ctx, commentIds)
+
 	comments := make([]models.Comment, 0, len(rows))
 	userIDs := make(ct.Ids, 0, len(rows))
 	commentImageIds := make(ct.Ids, 0, len(rows))
 
 	for _, r := range rows {
-		userIDs = append(userIDs, ct.Id(r.CommentCreatorID))
+		//deleted comments are only there to hold their replies, they don't show who wrote them
+		var user models.User
+		if !r.Deleted {
+			user.UserId = ct.Id(r.CommentCreatorID)
+			userIDs = append(userIDs, user.UserId)
+		}
 
 		comments = append(comments, models.Comment{
-			CommentId: ct.Id(r.ID),
-			ParentId:  req.EntityId,
-			Body:      ct.CommentBody(r.CommentBody),
-			User: models.User{
-				UserId: ct.Id(r.CommentCreatorID),
-			},
+			CommentId:      ct.Id(r.ID),
+			ParentId:       ct.Id(r.ParentID),
+			Body:           ct.CommentBody(r.CommentBody),
+			User:           user,
 			ReactionsCount: int(r.ReactionsCount),
+			ReactionCounts: reactionCounts(r.ReactionCounts),
 			CreatedAt:      ct.GenDateTime(r.CreatedAt.Time),
 			UpdatedAt:      ct.GenDateTime(r.UpdatedAt.Time),
+			Edited:         r.Edited,
 			LikedByUser:    r.LikedByUser,
+			UserReaction:   r.UserReaction,
 			ImageId:        ct.Id(r.Image),
+			ReplyToId:      ct.Id(r.ReplyToID),
+			Depth:          int(r.Depth),
+			RepliesCount:   int(r.RepliesCount),
+			Deleted:        r.Deleted,
+			Mentions:       mentions[r.ID],
 		})
 
 		if r.Image > 0 {
@@ -281,6 +441,14 @@ func (s *Application) GetCommentsByParentId(ctx context.Context, req models.Enti
 	return comments, nil
 }
 
+// depth of a new comment replying to replyToId, 0 for top level comments
+func depthUnder(replyToId ct.Id, targetDepth int16) int16 {
+	if replyToId == 0 {
+		return 0
+	}
+	return targetDepth + 1
+}
+
 func (s *Application) GetPostAudienceForComment(ctx context.Context, postId int64) (string, error) {
 	input := fmt.Sprintf("commentId: %v", postId)
 	audience, err := s.db.GetPostAudienceForComment(ctx, postId)
@@ -0,0 +1,29 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	ce "social-network/shared/go/commonerrors"
+	ct "social-network/shared/go/ct"
+)
+
+// Hides (or restores) every post, comment and event created by the given user.
+// Content is filtered at query time, nothing is deleted.
+func (s *Application) SetUserDeactivated(ctx context.Context, userId ct.Id, deactivated bool) error {
+	input := fmt.Sprintf("user id: %v, deactivated: %v", userId, deactivated)
+
+	if err := userId.Validate(); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	var err error
+	if deactivated {
+		err = s.db.InsertDeactivatedUser(ctx, userId.Int64())
+	} else {
+		err = s.db.DeleteDeactivatedUser(ctx, userId.Int64())
+	}
+	if err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	return nil
+}
@@ -0,0 +1,411 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	ds "social-network/services/posts/internal/db/dbservice"
+	"social-network/shared/gen-go/media"
+	ce "social-network/shared/go/commonerrors"
+	ct "social-network/shared/go/ct"
+	"social-network/shared/go/models"
+	tele "social-network/shared/go/telemetry"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	draftTTL                    = 30 * 24 * time.Hour // how long a draft is kept without being saved again
+	defaultDraftsExpiryInterval = time.Hour           // used when the configured interval isn't positive
+)
+
+// Saves a new post or comment draft for the requester.
+func (s *Application) CreateDraft(ctx context.Context, req models.CreateDraftReq) (draftId int64, err error) {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return 0, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	imageIds, err := draftContent(req.Kind, req.ParentId, req.GroupId, req.Audience, req.AudienceIds, req.ImageIds)
+	if err != nil {
+		return 0, ce.New(ce.ErrInvalidArgument, err, input).WithPublic(err.Error())
+	}
+
+	if req.Kind == ct.DraftKindComment {
+		hasAccess, err := s.hasRightToView(ctx, accessContext{
+			requesterId: req.CreatorId.Int64(),
+			entityId:    req.ParentId.Int64(),
+		})
+		if err != nil {
+			return 0, ce.Wrap(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		if !hasAccess {
+			return 0, ce.New(ce.ErrPermissionDenied, fmt.Errorf("user has no permission to view post %v", req.ParentId), input).WithPublic("permission denied")
+		}
+	}
+
+	draftId, err = s.db.CreateDraft(ctx, ds.CreateDraftParams{
+		CreatorID:   req.CreatorId.Int64(),
+		Kind:        ds.DraftKind(req.Kind),
+		Body:        req.Body.String(),
+		ParentID:    pgtype.Int8{Int64: req.ParentId.Int64(), Valid: req.ParentId != 0},
+		GroupID:     pgtype.Int8{Int64: req.GroupId.Int64(), Valid: req.GroupId != 0},
+		Audience:    draftAudience(req.Audience),
+		AudienceIds: req.AudienceIds.Unique().Int64(),
+		ImageIds:    imageIds.Int64(),
+		ExpiresAt:   draftExpiry(),
+		MaxDrafts:   ct.MaxDraftsPerUser,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, ce.New(ce.ErrFailedPrecondition, fmt.Errorf("user %v has reached the drafts limit", req.CreatorId), input).WithPublic(fmt.Sprintf("you can keep up to %d drafts", ct.MaxDraftsPerUser))
+		}
+		return 0, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	return draftId, nil
+}
+
+// Replaces the content of one of the requester's drafts and pushes back its expiry.
+func (s *Application) UpdateDraft(ctx context.Context, req models.UpdateDraftReq) error {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	draft, err := s.getDraft(ctx, req.RequesterId, req.DraftId)
+	if err != nil {
+		return ce.Wrap(nil, err)
+	}
+
+	imageIds, err := draftContent(ct.DraftKind(draft.Kind), ct.Id(draft.ParentID.Int64), req.GroupId, req.Audience, req.AudienceIds, req.ImageIds)
+	if err != nil {
+		return ce.New(ce.ErrInvalidArgument, err, input).WithPublic(err.Error())
+	}
+
+	oldImageIds, err := s.db.UpdateDraft(ctx, ds.UpdateDraftParams{
+		ID:          req.DraftId.Int64(),
+		CreatorID:   req.RequesterId.Int64(),
+		Body:        req.Body.String(),
+		GroupID:     pgtype.Int8{Int64: req.GroupId.Int64(), Valid: req.GroupId != 0},
+		Audience:    draftAudience(req.Audience),
+		AudienceIds: req.AudienceIds.Unique().Int64(),
+		ImageIds:    imageIds.Int64(),
+		ExpiresAt:   draftExpiry(),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ce.New(ce.ErrNotFound, fmt.Errorf("draft %v not found for user %v", req.DraftId, req.RequesterId), input).WithPublic("not found")
+		}
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+
+	kept := make(map[int64]struct{}, len(imageIds))
+	for _, id := range imageIds {
+		kept[id.Int64()] = struct{}{}
+	}
+	dropped := []int64{}
+	for _, id := range oldImageIds {
+		if _, ok := kept[id]; !ok {
+			dropped = append(dropped, id)
+		}
+	}
+	s.deleteDraftImagesAsync(ctx, dropped)
+	return nil
+}
+
+// Returns the requester's drafts, most recently saved first.
+func (s *Application) GetDrafts(ctx context.Context, req models.GenericPaginatedReq) ([]models.Draft, error) {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return nil, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	rows, err := s.db.GetDrafts(ctx, ds.GetDraftsParams{
+		CreatorID: req.RequesterId.Int64(),
+		Limit:     req.Limit.Int32(),
+		Offset:    req.Offset.Int32(),
+	})
+	if err != nil {
+		return nil, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+
+	drafts := make([]models.Draft, 0, len(rows))
+	for _, r := range rows {
+		drafts = append(drafts, models.Draft{
+			DraftId:     ct.Id(r.ID),
+			Kind:        ct.DraftKind(r.Kind),
+			Body:        ct.PostBody(r.Body),
+			ParentId:    ct.Id(r.ParentID.Int64),
+			GroupId:     ct.Id(r.GroupID.Int64),
+			Audience:    ct.Audience(r.Audience.IntendedAudience),
+			AudienceIds: ct.FromInt64s(r.AudienceIds),
+			ImageIds:    ct.FromInt64s(r.ImageIds),
+			CreatedAt:   ct.GenDateTime(r.CreatedAt.Time),
+			UpdatedAt:   ct.GenDateTime(r.UpdatedAt.Time),
+			ExpiresAt:   ct.GenDateTime(r.ExpiresAt.Time),
+		})
+	}
+	s.attachDraftImages(ctx, drafts)
+
+	return drafts, nil
+}
+
+// Deletes one of the requester's drafts.
+func (s *Application) DeleteDraft(ctx context.Context, req models.GenericReq) error {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	imageIds, err := s.db.DeleteDraft(ctx, ds.DeleteDraftParams{
+		ID:        req.EntityId.Int64(),
+		CreatorID: req.RequesterId.Int64(),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ce.New(ce.ErrNotFound, fmt.Errorf("draft %v not found for user %v", req.EntityId, req.RequesterId), input).WithPublic("not found")
+		}
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	s.deleteDraftImagesAsync(ctx, imageIds)
+	return nil
+}
+
+// Publishes one of the requester's drafts through CreatePost or CreateComment,
+// so it goes through exactly the same checks. The draft is deleted in the same tx
+// that creates the content, so it's kept if publishing fails and a draft published
+// twice concurrently only creates content once. Returns the id of the new post or comment.
+func (s *Application) PublishDraft(ctx context.Context, req models.PublishDraftReq) (int64, error) {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return 0, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	draft, err := s.getDraft(ctx, req.RequesterId, req.DraftId)
+	if err != nil {
+		return 0, ce.Wrap(nil, err)
+	}
+
+	var id int64
+	switch draft.Kind {
+	case ds.DraftKindPost:
+		id, err = s.createPost(ctx, models.CreatePostReq{
+			CreatorId:   req.RequesterId,
+			Body:        ct.PostBody(draft.Body),
+			GroupId:     ct.Id(draft.GroupID.Int64),
+			Audience:    ct.Audience(draft.Audience.IntendedAudience),
+			AudienceIds: ct.FromInt64s(draft.AudienceIds),
+			ImageIds:    ct.FromInt64s(draft.ImageIds),
+			PublishAt:   req.PublishAt,
+		}, claimDraft(ctx, draft, input))
+	case ds.DraftKindComment:
+		if req.PublishAt.Validate() == nil {
+			return 0, ce.New(ce.ErrInvalidArgument, fmt.Errorf("comment draft %v can't be scheduled", req.DraftId), input).WithPublic("only post drafts can be scheduled")
+		}
+		id, err = s.createComment(ctx, models.CreateCommentReq{
+			CreatorId: req.RequesterId,
+			ParentId:  ct.Id(draft.ParentID.Int64),
+			Body:      ct.CommentBody(draft.Body),
+			ImageId:   coverImage(draft.ImageIds),
+		}, claimDraft(ctx, draft, input))
+	default:
+		return 0, ce.New(ce.ErrInternal, fmt.Errorf("unknown draft kind %v", draft.Kind), input).WithPublic(genericPublic)
+	}
+	if err != nil {
+		return 0, ce.Wrap(nil, err, input)
+	}
+	return id, nil
+}
+
+// runs first in the tx creating a post or comment, its error rolls the tx back
+type txClaim func(q *ds.Queries) error
+
+// deletes the draft being published. The delete waits on a concurrent publish
+// of the same draft and finds no row once it commits, so only one goes through.
+// The draft's images now belong to the published content and are left alone.
+func claimDraft(ctx context.Context, draft ds.Draft, input string) txClaim {
+	return func(q *ds.Queries) error {
+		if _, err := q.DeleteDraft(ctx, ds.DeleteDraftParams{ID: draft.ID, CreatorID: draft.CreatorID}); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ce.New(ce.ErrNotFound, fmt.Errorf("draft %v was already published or deleted", draft.ID), input).WithPublic("not found")
+			}
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		return nil
+	}
+}
+
+// StartDraftsExpiryWorker starts a background worker that periodically drops
+// drafts that haven't been saved within draftTTL, then deletes their images
+// in media service unless a post or another draft holds them.
+func (s *Application) StartDraftsExpiryWorker(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		tele.Warn(ctx, "Invalid drafts expiry interval @1, using @2", "interval", interval.String(), "default", defaultDraftsExpiryInterval.String())
+		interval = defaultDraftsExpiryInterval
+	}
+	tele.Info(ctx, "Initiating drafts expiry worker. @1", "interval", interval.String())
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				deleted, err := s.db.DeleteExpiredDrafts(ctx)
+				if err != nil {
+					tele.Error(ctx, "Error deleting expired drafts. @1", "error", err.Error())
+					continue
+				}
+				if len(deleted) == 0 {
+					continue
+				}
+				tele.Info(ctx, "Deleted expired drafts. @1", "count", len(deleted))
+
+				imageIds := []int64{}
+				for _, d := range deleted {
+					imageIds = append(imageIds, d.ImageIds...)
+				}
+				s.deleteDraftImages(ctx, imageIds)
+			case <-ctx.Done():
+				tele.Info(ctx, "Drafts expiry worker stopped")
+				return
+			}
+		}
+	}()
+}
+
+// NOT GRPC
+// deletes in media service the images a draft no longer holds.
+// Images of posts, comments, events and other drafts are kept, so are images
+// published from the draft. Failures are logged and leave the images behind.
+func (s *Application) deleteDraftImages(ctx context.Context, imageIds []int64) {
+	if len(imageIds) == 0 {
+		return
+	}
+
+	unreferenced, err := s.db.GetUnreferencedImageIds(ctx, imageIds)
+	if err != nil {
+		tele.Error(ctx, "failed to look up references of draft images @1: @2", "imageIds", imageIds, "error", err.Error())
+		return
+	}
+	if len(unreferenced) == 0 {
+		return
+	}
+
+	if err := s.clients.DeleteImages(ctx, unreferenced); err != nil {
+		tele.Error(ctx, "failed to delete draft images @1 in media: @2", "imageIds", unreferenced, "error", err.Error())
+		return
+	}
+	tele.Info(ctx, "deleted draft images @1", "imageIds", unreferenced)
+}
+
+func (s *Application) deleteDraftImagesAsync(ctx context.Context, imageIds []int64) {
+	go s.deleteDraftImages(context.WithoutCancel(ctx), imageIds)
+}
+
+// NOT GRPC
+// returns the requester's draft, not found if it doesn't exist, isn't theirs or has expired
+func (s *Application) getDraft(ctx context.Context, requesterId, draftId ct.Id) (ds.Draft, error) {
+	input := fmt.Sprintf("requester: %v, draft: %v", requesterId, draftId)
+
+	draft, err := s.db.GetDraft(ctx, ds.GetDraftParams{
+		ID:        draftId.Int64(),
+		CreatorID: requesterId.Int64(),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return draft, ce.New(ce.ErrNotFound, err, input).WithPublic("not found")
+		}
+		return draft, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	return draft, nil
+}
+
+// NOT GRPC
+// checks that the fields set on a draft fit its kind and returns its gallery.
+// Drafts can be incomplete, anything else is left to CreatePost and CreateComment on publish.
+// The returned error is meant for the user.
+func draftContent(kind ct.DraftKind, parentId, groupId ct.Id, audience ct.Audience, audienceIds, imageIds ct.Ids) (ct.Ids, error) {
+	switch kind {
+	case ct.DraftKindComment:
+		if parentId == 0 {
+			return nil, fmt.Errorf("comment drafts need the post they reply to")
+		}
+		if groupId != 0 || audience != "" || len(audienceIds) > 0 {
+			return nil, fmt.Errorf("comment drafts can't have a group or audience")
+		}
+		if len(imageIds) > 1 {
+			return nil, fmt.Errorf("comment drafts can have up to 1 image")
+		}
+	case ct.DraftKindPost:
+		if parentId != 0 {
+			return nil, fmt.Errorf("post drafts can't reply to a post")
+		}
+	}
+
+	gallery, err := postGallery(imageIds, 0)
+	if err != nil {
+		return nil, fmt.Errorf("a draft can have up to %d distinct images", ct.MaxPostImages)
+	}
+	return gallery, nil
+}
+
+// NOT GRPC
+// maps an optional draft audience to its db value
+func draftAudience(audience ct.Audience) ds.NullIntendedAudience {
+	return ds.NullIntendedAudience{
+		IntendedAudience: ds.IntendedAudience(audience.String()),
+		Valid:            audience != "",
+	}
+}
+
+// NOT GRPC
+// expiry of a draft saved now
+func draftExpiry() pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: time.Now().Add(draftTTL), Valid: true}
+}
+
+// NOT GRPC
+// fetches the urls of all images of the given drafts with a single call to media.
+// Failed images are left out of their draft. On media errors all urls are left empty.
+func (s *Application) attachDraftImages(ctx context.Context, drafts []models.Draft) {
+	imageIds := ct.Ids{}
+	for _, d := range drafts {
+		imageIds = append(imageIds, d.ImageIds...)
+	}
+	if len(imageIds) == 0 {
+		return
+	}
+
+	imageMap, failedImageIds, err := s.mediaRetriever.GetImages(ctx, imageIds.Unique(), media.FileVariant_MEDIUM)
+	if err != nil {
+		tele.Error(ctx, "media retriever failed for @1", "request", imageIds, "error", err.Error()) //log error instead of returning
+		return
+	}
+
+	failed := make(map[int64]struct{}, len(failedImageIds))
+	for _, id := range failedImageIds {
+		failed[id] = struct{}{}
+	}
+
+	for i := range drafts {
+		ids := make(ct.Ids, 0, len(drafts[i].ImageIds))
+		urls := make([]string, 0, len(drafts[i].ImageIds))
+		for _, id := range drafts[i].ImageIds {
+			if _, ok := failed[id.Int64()]; ok {
+				continue
+			}
+			ids = append(ids, id)
+			urls = append(urls, imageMap[id.Int64()])
+		}
+		drafts[i].ImageIds = ids
+		drafts[i].ImageUrls = urls
+	}
+}
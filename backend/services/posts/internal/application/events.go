@@ -32,6 +32,9 @@ func (s *Application) CreateEvent(ctx context.Context, req models.CreateEventReq
 	if !isMember {
 		return 0, ce.New(ce.ErrPermissionDenied, fmt.Errorf("user is not group member"), input).WithPublic("permission denied")
 	}
+	if err := s.checkGroupWritable(ctx, req.GroupId.Int64()); err != nil {
+		return 0, ce.Wrap(nil, err)
+	}
 
 	// convert date
 	eventDate := pgtype.Date{
@@ -122,9 +125,15 @@ func (s *Application) DeleteEvent(ctx context.Context, req models.GenericReq) er
 		return ce.New(ce.ErrPermissionDenied, fmt.Errorf("user has no permission to view or edit entity: %v", req.EntityId), input).WithPublic("permission denied")
 	}
 
+	// group staff can delete other members' events
+	creatorId, err := s.moderatedCreatorId(ctx, req.RequesterId.Int64(), req.EntityId.Int64(), ct.PermManageEvents)
+	if err != nil {
+		return ce.Wrap(nil, err)
+	}
+
 	rowsAffected, err := s.db.DeleteEvent(ctx, ds.DeleteEventParams{
 		ID:             req.EntityId.Int64(),
-		EventCreatorID: req.RequesterId.Int64(),
+		EventCreatorID: creatorId,
 	})
 	if err != nil {
 		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
@@ -157,6 +166,16 @@ func (s *Application) EditEvent(ctx context.Context, req models.EditEventReq) er
 		return ce.New(ce.ErrPermissionDenied, fmt.Errorf("user has no permission to edit event: %v", req.EventId), input).WithPublic("permission denied")
 	}
 
+	if err := s.checkEntityWritable(ctx, req.EventId.Int64()); err != nil {
+		return ce.Wrap(nil, err)
+	}
+
+	// group staff can edit other members' events
+	creatorId, err := s.moderatedCreatorId(ctx, req.RequesterId.Int64(), req.EventId.Int64(), ct.PermManageEvents)
+	if err != nil {
+		return ce.Wrap(nil, err)
+	}
+
 	return s.txRunner.RunTx(ctx, func(q *ds.Queries) error {
 		// convert date
 		eventDate := pgtype.Date{
@@ -168,7 +187,7 @@ func (s *Application) EditEvent(ctx context.Context, req models.EditEventReq) er
 			EventBody:      req.Body.String(),
 			EventDate:      eventDate,
 			ID:             req.EventId.Int64(),
-			EventCreatorID: req.RequesterId.Int64(),
+			EventCreatorID: creatorId,
 		})
 		if err != nil {
 			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
@@ -207,11 +226,11 @@ func (s *Application) GetEventsByGroupId(ctx context.Context, req models.EntityI
 		return nil, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
 	}
 
-	isMember, err := s.clients.IsGroupMember(ctx, req.RequesterId.Int64(), req.EntityId.Int64())
+	canRead, err := s.canReadGroup(ctx, req.RequesterId.Int64(), req.EntityId.Int64())
 	if err != nil {
-		return []models.Event{}, ce.DecodeProto(err, input)
+		return []models.Event{}, ce.Wrap(nil, err, input)
 	}
-	if !isMember {
+	if !canRead {
 		return []models.Event{}, ce.New(ce.ErrPermissionDenied, fmt.Errorf("user is not group member"), input).WithPublic("permission denied")
 	}
 
@@ -298,8 +317,9 @@ func (s *Application) RespondToEvent(ctx context.Context, req models.RespondToEv
 	}
 
 	accessCtx := accessContext{
-		requesterId: req.ResponderId.Int64(),
-		entityId:    req.EventId.Int64(),
+		requesterId:       req.ResponderId.Int64(),
+		entityId:          req.EventId.Int64(),
+		requireMembership: true,
 	}
 
 	hasAccess, err := s.hasRightToView(ctx, accessCtx)
@@ -310,6 +330,10 @@ func (s *Application) RespondToEvent(ctx context.Context, req models.RespondToEv
 		return ce.New(ce.ErrPermissionDenied, fmt.Errorf("user has no permission to respond to event %v", req.EventId), input).WithPublic("permission denied")
 	}
 
+	if err := s.checkEntityWritable(ctx, req.EventId.Int64()); err != nil {
+		return ce.Wrap(nil, err)
+	}
+
 	_, err = s.db.UpsertEventResponse(ctx, ds.UpsertEventResponseParams{
 		EventID: req.EventId.Int64(),
 		UserID:  req.ResponderId.Int64(),
@@ -344,6 +368,10 @@ func (s *Application) RemoveEventResponse(ctx context.Context, req models.Generi
 		return ce.New(ce.ErrPermissionDenied, fmt.Errorf("user has no permission to remove response with id %v", req.EntityId), input).WithPublic("permission denied")
 	}
 
+	if err := s.checkEntityWritable(ctx, req.EntityId.Int64()); err != nil {
+		return ce.Wrap(nil, err)
+	}
+
 	rowsAffected, err := s.db.DeleteEventResponse(ctx, ds.DeleteEventResponseParams{
 		EventID: req.EntityId.Int64(),
 		UserID:  req.RequesterId.Int64(),
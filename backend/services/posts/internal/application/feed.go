@@ -4,11 +4,9 @@ import (
 	"context"
 	"fmt"
 	ds "social-network/services/posts/internal/db/dbservice"
-	"social-network/shared/gen-go/media"
 	ce "social-network/shared/go/commonerrors"
 	ct "social-network/shared/go/ct"
 	"social-network/shared/go/models"
-	tele "social-network/shared/go/telemetry"
 
 	"github.com/jackc/pgx/v5/pgtype"
 )
@@ -41,7 +39,6 @@ func (s *Application) GetPersonalizedFeed(ctx context.Context, req models.GetPer
 
 	posts := make([]models.Post, 0, len(rows))
 	userIDs := make(ct.Ids, 0, len(rows))
-	postImageIds := make(ct.Ids, 0, len(rows))
 
 	for _, r := range rows {
 		uid := r.CreatorID
@@ -56,17 +53,17 @@ func (s *Application) GetPersonalizedFeed(ctx context.Context, req models.GetPer
 			},
 			CommentsCount:   int(r.CommentsCount),
 			ReactionsCount:  int(r.ReactionsCount),
+			ReactionCounts:  reactionCounts(r.ReactionCounts),
 			LastCommentedAt: ct.GenDateTime(r.LastCommentedAt.Time),
 			CreatedAt:       ct.GenDateTime(r.CreatedAt.Time),
 			UpdatedAt:       ct.GenDateTime(r.UpdatedAt.Time),
+			Edited:          r.Edited,
 			LikedByUser:     r.LikedByUser,
-			ImageId:         ct.Id(r.Image),
+			UserReaction:    r.UserReaction,
+			ImageId:         coverImage(r.Images),
+			ImageIds:        ct.FromInt64s(r.Images),
 		})
 
-		if r.Image > 0 {
-			postImageIds = append(postImageIds, ct.Id(r.Image))
-		}
-
 	}
 
 	userMap, err := s.userRetriever.GetUsers(ctx, userIDs)
@@ -74,24 +71,15 @@ func (s *Application) GetPersonalizedFeed(ctx context.Context, req models.GetPer
 		return nil, ce.Wrap(nil, err, input).WithPublic("error retrieving user's info")
 	}
 
-	var imageMap map[int64]string
-	var failedImageIds []int64
-	if len(postImageIds) > 0 {
-		imageMap, failedImageIds, err = s.mediaRetriever.GetImages(ctx, postImageIds, media.FileVariant_MEDIUM)
-	}
-	if err != nil {
-		tele.Error(ctx, "media retriever failed for @1", "request", postImageIds, "error", err.Error()) //log error instead of returning
-	} else {
-
-		for i := range posts {
-			uid := posts[i].User.UserId
-			if u, ok := userMap[uid]; ok {
-				posts[i].User = u
-			}
-			posts[i].ImageUrl = imageMap[posts[i].ImageId.Int64()]
+	for i := range posts {
+		uid := posts[i].User.UserId
+		if u, ok := userMap[uid]; ok {
+			posts[i].User = u
 		}
-		s.removeFailedImagesAsync(ctx, failedImageIds)
 	}
+	s.attachPostImages(ctx, posts)
+	s.attachPostMentions(ctx, posts)
+	s.attachSharedPosts(ctx, req.RequesterId.Int64(), posts)
 
 	return posts, nil
 }
@@ -118,7 +106,6 @@ func (s *Application) GetPublicFeed(ctx context.Context, req models.GenericPagin
 
 	posts := make([]models.Post, 0, len(rows))
 	userIDs := make(ct.Ids, 0, len(rows))
-	postImageIds := make(ct.Ids, 0, len(rows))
 
 	for _, r := range rows {
 		uid := r.CreatorID
@@ -132,15 +119,16 @@ func (s *Application) GetPublicFeed(ctx context.Context, req models.GenericPagin
 			},
 			CommentsCount:   int(r.CommentsCount),
 			ReactionsCount:  int(r.ReactionsCount),
+			ReactionCounts:  reactionCounts(r.ReactionCounts),
 			LastCommentedAt: ct.GenDateTime(r.LastCommentedAt.Time),
 			CreatedAt:       ct.GenDateTime(r.CreatedAt.Time),
 			UpdatedAt:       ct.GenDateTime(r.UpdatedAt.Time),
+			Edited:          r.Edited,
 			LikedByUser:     r.LikedByUser,
-			ImageId:         ct.Id(r.Image),
+			UserReaction:    r.UserReaction,
+			ImageId:         coverImage(r.Images),
+			ImageIds:        ct.FromInt64s(r.Images),
 		})
-		if r.Image > 0 {
-			postImageIds = append(postImageIds, ct.Id(r.Image))
-		}
 
 	}
 
@@ -149,24 +137,15 @@ func (s *Application) GetPublicFeed(ctx context.Context, req models.GenericPagin
 		return nil, ce.Wrap(nil, err, input).WithPublic("error retrieving user's info")
 	}
 
-	var imageMap map[int64]string
-	var failedImageIds []int64
-	if len(postImageIds) > 0 {
-		imageMap, failedImageIds, err = s.mediaRetriever.GetImages(ctx, postImageIds, media.FileVariant_MEDIUM)
-	}
-	if err != nil {
-		tele.Error(ctx, "media retriever failed for @1", "request", postImageIds, "error", err.Error()) //log error instead of returning
-	} else {
-
-		for i := range posts {
-			uid := posts[i].User.UserId
-			if u, ok := userMap[uid]; ok {
-				posts[i].User = u
-			}
-			posts[i].ImageUrl = imageMap[posts[i].ImageId.Int64()]
+	for i := range posts {
+		uid := posts[i].User.UserId
+		if u, ok := userMap[uid]; ok {
+			posts[i].User = u
 		}
-		s.removeFailedImagesAsync(ctx, failedImageIds)
 	}
+	s.attachPostImages(ctx, posts)
+	s.attachPostMentions(ctx, posts)
+	s.attachSharedPosts(ctx, req.RequesterId.Int64(), posts)
 
 	return posts, nil
 }
@@ -200,7 +179,6 @@ func (s *Application) GetUserPostsPaginated(ctx context.Context, req models.GetU
 
 	posts := make([]models.Post, 0, len(rows))
 	userIDs := make(ct.Ids, 0, len(rows))
-	postImageIds := make(ct.Ids, 0, len(rows))
 
 	for _, r := range rows {
 		uid := r.CreatorID
@@ -214,15 +192,16 @@ func (s *Application) GetUserPostsPaginated(ctx context.Context, req models.GetU
 			},
 			CommentsCount:   int(r.CommentsCount),
 			ReactionsCount:  int(r.ReactionsCount),
+			ReactionCounts:  reactionCounts(r.ReactionCounts),
 			LastCommentedAt: ct.GenDateTime(r.LastCommentedAt.Time),
 			CreatedAt:       ct.GenDateTime(r.CreatedAt.Time),
 			UpdatedAt:       ct.GenDateTime(r.UpdatedAt.Time),
+			Edited:          r.Edited,
 			LikedByUser:     r.LikedByUser,
-			ImageId:         ct.Id(r.Image),
+			UserReaction:    r.UserReaction,
+			ImageId:         coverImage(r.Images),
+			ImageIds:        ct.FromInt64s(r.Images),
 		})
-		if r.Image > 0 {
-			postImageIds = append(postImageIds, ct.Id(r.Image))
-		}
 
 	}
 
@@ -231,24 +210,15 @@ func (s *Application) GetUserPostsPaginated(ctx context.Context, req models.GetU
 		return nil, ce.Wrap(nil, err, input).WithPublic("error retrieving user's info")
 	}
 
-	var imageMap map[int64]string
-	var failedImageIds []int64
-	if len(postImageIds) > 0 {
-		imageMap, failedImageIds, err = s.mediaRetriever.GetImages(ctx, postImageIds, media.FileVariant_MEDIUM)
-	}
-	if err != nil {
-		tele.Error(ctx, "media retriever failed for @1", "request", postImageIds, "error", err.Error()) //log error instead of returning
-	} else {
-
-		for i := range posts {
-			uid := posts[i].User.UserId
-			if u, ok := userMap[uid]; ok {
-				posts[i].User = u
-			}
-			posts[i].ImageUrl = imageMap[posts[i].ImageId.Int64()]
+	for i := range posts {
+		uid := posts[i].User.UserId
+		if u, ok := userMap[uid]; ok {
+			posts[i].User = u
 		}
-		s.removeFailedImagesAsync(ctx, failedImageIds)
 	}
+	s.attachPostImages(ctx, posts)
+	s.attachPostMentions(ctx, posts)
+	s.attachSharedPosts(ctx, req.RequesterId.Int64(), posts)
 
 	return posts, nil
 }
@@ -268,11 +238,11 @@ func (s *Application) GetGroupPostsPaginated(ctx context.Context, req models.Get
 	}
 	groupId.Valid = true
 
-	isMember, err := s.clients.IsGroupMember(ctx, req.RequesterId.Int64(), req.GroupId.Int64())
+	canRead, err := s.canReadGroup(ctx, req.RequesterId.Int64(), req.GroupId.Int64())
 	if err != nil {
-		return nil, ce.DecodeProto(err, input)
+		return nil, ce.Wrap(nil, err, input)
 	}
-	if !isMember {
+	if !canRead {
 		return nil, ce.New(ce.ErrPermissionDenied, fmt.Errorf("user is not group member"), input).WithPublic("permission denied")
 	}
 
@@ -290,7 +260,6 @@ func (s *Application) GetGroupPostsPaginated(ctx context.Context, req models.Get
 	}
 	posts := make([]models.Post, 0, len(rows))
 	userIDs := make(ct.Ids, 0, len(rows))
-	postImageIds := make(ct.Ids, 0, len(rows))
 
 	for _, r := range rows {
 		uid := r.CreatorID
@@ -306,16 +275,20 @@ func (s *Application) GetGroupPostsPaginated(ctx context.Context, req models.Get
 			Audience:        ct.Audience(r.Audience),
 			CommentsCount:   int(r.CommentsCount),
 			ReactionsCount:  int(r.ReactionsCount),
+			ReactionCounts:  reactionCounts(r.ReactionCounts),
 			LastCommentedAt: ct.GenDateTime(r.LastCommentedAt.Time),
 			CreatedAt:       ct.GenDateTime(r.CreatedAt.Time),
 			UpdatedAt:       ct.GenDateTime(r.UpdatedAt.Time),
+			Edited:          r.Edited,
 			LikedByUser:     r.LikedByUser,
-			ImageId:         ct.Id(r.Image),
+			UserReaction:    r.UserReaction,
+			ImageId:         coverImage(r.Images),
+			ImageIds:        ct.FromInt64s(r.Images),
+			Pinned:          r.Pinned,
+			Announcement:    r.IsAnnouncement,
+			ApprovalStatus:  string(r.ApprovalStatus),
 		})
 
-		if r.Image > 0 {
-			postImageIds = append(postImageIds, ct.Id(r.Image))
-		}
 	}
 
 	userMap, err := s.userRetriever.GetUsers(ctx, userIDs)
@@ -323,24 +296,15 @@ func (s *Application) GetGroupPostsPaginated(ctx context.Context, req models.Get
 		return nil, ce.Wrap(nil, err, input).WithPublic("error retrieving user's info")
 	}
 
-	var imageMap map[int64]string
-	var failedImageIds []int64
-	if len(postImageIds) > 0 {
-		imageMap, failedImageIds, err = s.mediaRetriever.GetImages(ctx, postImageIds, media.FileVariant_MEDIUM)
-	}
-	if err != nil {
-		tele.Error(ctx, "media retriever failed for @1", "request", postImageIds, "error", err.Error()) //log error instead of returning
-	} else {
-
-		for i := range posts {
-			uid := posts[i].User.UserId
-			if u, ok := userMap[uid]; ok {
-				posts[i].User = u
-			}
-			posts[i].ImageUrl = imageMap[posts[i].ImageId.Int64()]
+	for i := range posts {
+		uid := posts[i].User.UserId
+		if u, ok := userMap[uid]; ok {
+			posts[i].User = u
 		}
-		s.removeFailedImagesAsync(ctx, failedImageIds)
 	}
+	s.attachPostImages(ctx, posts)
+	s.attachPostMentions(ctx, posts)
+	s.attachSharedPosts(ctx, req.RequesterId.Int64(), posts)
 
 	return posts, nil
 }
@@ -0,0 +1,95 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	ds "social-network/services/posts/internal/db/dbservice"
+	ce "social-network/shared/go/commonerrors"
+	ct "social-network/shared/go/ct"
+	"social-network/shared/go/models"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// how many members are listed as most active in group insights
+const groupInsightsPostersLimit = 10
+
+// Aggregates a group's posts, comments and events since the given time.
+// Only ids are returned for posters, users service fills in the rest.
+func (s *Application) GetGroupContentInsights(ctx context.Context, groupId ct.Id, since time.Time) (models.GroupContentInsights, error) {
+	input := fmt.Sprintf("group id: %v, since: %v", groupId, since)
+
+	var insights models.GroupContentInsights
+	if err := groupId.Validate(); err != nil {
+		return insights, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+	sinceTs := pgtype.Timestamptz{Time: since, Valid: true}
+
+	postRows, err := s.db.GetGroupDailyPostCounts(ctx, ds.GetGroupDailyPostCountsParams{
+		GroupID: groupId.Int64(),
+		Since:   sinceTs,
+	})
+	if err != nil {
+		return insights, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	insights.PostsPerDay = make([]models.DailyCount, 0, len(postRows))
+	for _, r := range postRows {
+		insights.PostsPerDay = append(insights.PostsPerDay, models.DailyCount{
+			Day:   ct.GenDateTime(r.Day.Time),
+			Count: r.Count,
+		})
+	}
+
+	commentRows, err := s.db.GetGroupDailyCommentCounts(ctx, ds.GetGroupDailyCommentCountsParams{
+		GroupID: groupId.Int64(),
+		Since:   sinceTs,
+	})
+	if err != nil {
+		return insights, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	insights.CommentsPerDay = make([]models.DailyCount, 0, len(commentRows))
+	for _, r := range commentRows {
+		insights.CommentsPerDay = append(insights.CommentsPerDay, models.DailyCount{
+			Day:   ct.GenDateTime(r.Day.Time),
+			Count: r.Count,
+		})
+	}
+
+	posterRows, err := s.db.GetGroupActivePosters(ctx, ds.GetGroupActivePostersParams{
+		GroupID: groupId.Int64(),
+		Since:   sinceTs,
+		Limit:   groupInsightsPostersLimit,
+	})
+	if err != nil {
+		return insights, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	insights.ActivePosters = make([]models.GroupActivePoster, 0, len(posterRows))
+	for _, r := range posterRows {
+		insights.ActivePosters = append(insights.ActivePosters, models.GroupActivePoster{
+			User:         models.User{UserId: ct.Id(r.UserID)},
+			PostCount:    r.PostCount,
+			CommentCount: r.CommentCount,
+		})
+	}
+
+	eventRows, err := s.db.GetGroupEventAttendance(ctx, ds.GetGroupEventAttendanceParams{
+		GroupID: groupId.Int64(),
+		Since:   pgtype.Date{Time: since, Valid: true},
+	})
+	if err != nil {
+		return insights, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	insights.Events = make([]models.GroupEventAttendance, 0, len(eventRows))
+	for _, r := range eventRows {
+		insights.Events = append(insights.Events, models.GroupEventAttendance{
+			EventId:       ct.Id(r.ID),
+			Title:         ct.Title(r.EventTitle),
+			EventDate:     ct.GenDateTime(r.EventDate.Time),
+			GoingCount:    r.GoingCount,
+			NotGoingCount: r.NotGoingCount,
+		})
+	}
+
+	return insights, nil
+}
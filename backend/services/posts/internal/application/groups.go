@@ -0,0 +1,99 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	ds "social-network/services/posts/internal/db/dbservice"
+	ce "social-network/shared/go/commonerrors"
+	ct "social-network/shared/go/ct"
+	tele "social-network/shared/go/telemetry"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Makes the posts and events of the given group read-only, or writable again.
+// Nothing is hidden, archived groups can still be browsed.
+func (s *Application) SetGroupArchived(ctx context.Context, groupId ct.Id, archived bool) error {
+	input := fmt.Sprintf("group id: %v, archived: %v", groupId, archived)
+
+	if err := groupId.Validate(); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	var err error
+	if archived {
+		err = s.db.InsertArchivedGroup(ctx, groupId.Int64())
+	} else {
+		err = s.db.DeleteArchivedGroup(ctx, groupId.Int64())
+	}
+	if err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	return nil
+}
+
+// Makes the posts and events of the given group readable by anyone (public visibility),
+// or by members only (private and secret).
+func (s *Application) SetGroupVisibility(ctx context.Context, groupId ct.Id, visibility ct.GroupVisibility) error {
+	input := fmt.Sprintf("group id: %v, visibility: %v", groupId, visibility)
+
+	if err := errors.Join(groupId.Validate(), visibility.Validate()); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	var err error
+	if visibility == ct.GroupVisibilityPublic {
+		err = s.db.InsertPublicGroup(ctx, groupId.Int64())
+	} else {
+		err = s.db.DeletePublicGroup(ctx, groupId.Int64())
+	}
+	if err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	return nil
+}
+
+// Returns how many posts were created in each group since the given time.
+// Groups without recent posts are left out of the map.
+func (s *Application) GetGroupsPostActivity(ctx context.Context, groupIds ct.Ids, since time.Time) (map[int64]int64, error) {
+	input := fmt.Sprintf("group ids: %v, since: %v", groupIds, since)
+
+	if len(groupIds) == 0 {
+		return map[int64]int64{}, nil
+	}
+	if err := groupIds.Validate(); err != nil {
+		return nil, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	rows, err := s.db.CountRecentGroupPosts(ctx, ds.CountRecentGroupPostsParams{
+		GroupIds: groupIds.Int64(),
+		Since:    pgtype.Timestamptz{Time: since, Valid: true},
+	})
+	if err != nil {
+		return nil, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+
+	counts := make(map[int64]int64, len(rows))
+	for _, r := range rows {
+		counts[r.GroupID] = r.PostCount
+	}
+	return counts, nil
+}
+
+// Hides every post and event of a deleted group, on the GroupDeleted event.
+// Content is kept as it was, so handling the same event twice is harmless.
+func (s *Application) HideGroupContent(ctx context.Context, groupId ct.Id) error {
+	input := fmt.Sprintf("group id: %v", groupId)
+
+	if err := groupId.Validate(); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	if err := s.db.InsertDeletedGroup(ctx, groupId.Int64()); err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	tele.Info(ctx, "hid content of deleted group @1", "groupId", groupId)
+	return nil
+}
@@ -0,0 +1,185 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	ds "social-network/services/posts/internal/db/dbservice"
+	ce "social-network/shared/go/commonerrors"
+	ct "social-network/shared/go/ct"
+	"social-network/shared/go/models"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	maxHashtagsPerPost         = 30 // distinct tags indexed per post, further ones are left as plain text
+	defaultTrendingWindowHours = 24
+	maxTrendingWindowHours     = 7 * 24
+)
+
+// same charset as ct.Hashtag, length and the letter requirement are checked on the match
+var hashtagTokenRegex = regexp.MustCompile(`#([\p{L}\p{M}\p{N}_]+)`)
+
+// Returns the posts with the given tag the requester can see, newest first.
+// Group posts are only included from public groups.
+func (s *Application) GetHashtagFeed(ctx context.Context, req models.GetHashtagFeedReq) ([]models.Post, error) {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return nil, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	idsRequesterFollows, err := s.clients.GetFollowingIds(ctx, req.RequesterId.Int64())
+	if err != nil {
+		return nil, ce.DecodeProto(err, input)
+	}
+
+	rows, err := s.db.GetHashtagFeed(ctx, ds.GetHashtagFeedParams{
+		UserID:       req.RequesterId.Int64(),
+		Tag:          req.Tag.String(),
+		FollowingIds: idsRequesterFollows,
+		Offset:       req.Offset.Int32(),
+		Limit:        req.Limit.Int32(),
+	})
+	if err != nil {
+		return nil, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+
+	if len(rows) == 0 {
+		return []models.Post{}, nil
+	}
+
+	posts := make([]models.Post, 0, len(rows))
+	userIDs := make(ct.Ids, 0, len(rows))
+
+	for _, r := range rows {
+		userIDs = append(userIDs, ct.Id(r.CreatorID))
+
+		posts = append(posts, models.Post{
+			PostId: ct.Id(r.ID),
+			Body:   ct.PostBody(r.PostBody),
+			User: models.User{
+				UserId: ct.Id(r.CreatorID),
+			},
+			GroupId:         ct.Id(r.GroupID),
+			Audience:        ct.Audience(r.Audience),
+			CommentsCount:   int(r.CommentsCount),
+			ReactionsCount:  int(r.ReactionsCount),
+			ReactionCounts:  reactionCounts(r.ReactionCounts),
+			LastCommentedAt: ct.GenDateTime(r.LastCommentedAt.Time),
+			CreatedAt:       ct.GenDateTime(r.CreatedAt.Time),
+			UpdatedAt:       ct.GenDateTime(r.UpdatedAt.Time),
+			Edited:          r.Edited,
+			LikedByUser:     r.LikedByUser,
+			UserReaction:    r.UserReaction,
+			ImageId:         coverImage(r.Images),
+			ImageIds:        ct.FromInt64s(r.Images),
+		})
+	}
+
+	userMap, err := s.userRetriever.GetUsers(ctx, userIDs.Unique())
+	if err != nil {
+		return nil, ce.Wrap(nil, err, input).WithPublic("error retrieving user's info")
+	}
+
+	for i := range posts {
+		if u, ok := userMap[posts[i].User.UserId]; ok {
+			posts[i].User = u
+		}
+	}
+	s.attachPostImages(ctx, posts)
+	s.attachPostMentions(ctx, posts)
+	s.attachSharedPosts(ctx, req.RequesterId.Int64(), posts)
+
+	return posts, nil
+}
+
+// Returns the most used tags in public posts of the last WindowHours hours (24 if unset).
+// Posts of private groups and posts with a restricted audience don't count.
+func (s *Application) GetTrendingHashtags(ctx context.Context, req models.GetTrendingHashtagsReq) ([]models.TrendingHashtag, error) {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return nil, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	window := req.WindowHours
+	if window == 0 {
+		window = defaultTrendingWindowHours
+	}
+	if window < 1 || window > maxTrendingWindowHours {
+		return nil, ce.New(ce.ErrInvalidArgument, fmt.Errorf("trending window of %v hours", req.WindowHours), input).WithPublic(fmt.Sprintf("window must be between 1 and %d hours", maxTrendingWindowHours))
+	}
+
+	rows, err := s.db.GetTrendingHashtags(ctx, ds.GetTrendingHashtagsParams{
+		Since: pgtype.Timestamptz{Time: time.Now().Add(-time.Duration(window) * time.Hour), Valid: true},
+		Limit: req.Limit.Int32(),
+	})
+	if err != nil {
+		return nil, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+
+	tags := make([]models.TrendingHashtag, 0, len(rows))
+	for _, r := range rows {
+		tags = append(tags, models.TrendingHashtag{
+			Tag:          ct.Hashtag(r.Tag),
+			PostsCount:   int(r.PostsCount),
+			AuthorsCount: int(r.AuthorsCount),
+		})
+	}
+	return tags, nil
+}
+
+// NOT GRPC
+// finds the distinct #tags of a body, normalized, in order of first use.
+// A tag glued to a word, to another # or to an html entity isn't one (e.g. "c#", "##tag", "&#39;").
+func parseHashtags(body string) []string {
+	var tags []string
+	seen := make(map[ct.Hashtag]struct{})
+	for _, m := range hashtagTokenRegex.FindAllStringSubmatchIndex(body, -1) {
+		if m[0] > 0 {
+			prev, _ := utf8.DecodeLastRuneInString(body[:m[0]])
+			if prev == '#' || prev == '&' || isTagRune(prev) {
+				continue
+			}
+		}
+
+		tag := ct.NormalizeHashtag(body[m[2]:m[3]])
+		if tag.Validate() != nil { // too long or no letter
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		tags = append(tags, tag.String())
+		if len(tags) == maxHashtagsPerPost {
+			break
+		}
+	}
+	return tags
+}
+
+func isTagRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsNumber(r)
+}
+
+// NOT GRPC
+// replaces the tags indexed for a post, an empty list clears them
+func setPostHashtags(ctx context.Context, q *ds.Queries, postId int64, tags []string, input string) error {
+	if tags == nil {
+		tags = []string{}
+	}
+	err := q.SetPostHashtags(ctx, ds.SetPostHashtagsParams{
+		PostID: postId,
+		Tags:   tags,
+	})
+	if err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	return nil
+}
@@ -0,0 +1,254 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	ds "social-network/services/posts/internal/db/dbservice"
+	notifpb "social-network/shared/gen-go/notifications"
+	ce "social-network/shared/go/commonerrors"
+	ct "social-network/shared/go/ct"
+	"social-network/shared/go/models"
+	tele "social-network/shared/go/telemetry"
+	"unicode/utf8"
+)
+
+// distinct usernames resolved per body, further ones are left as plain text
+const maxMentionsPerBody = 20
+
+// same charset and length as ct.Username
+var mentionRegex = regexp.MustCompile(`@([a-zA-Z0-9_]{3,32})`)
+
+// an @username found in a body, not resolved yet
+type mentionToken struct {
+	username string // without the @
+	start    int    // in characters
+	length   int    // in characters, @ included
+}
+
+// NOT GRPC
+// finds the @username tokens of a body in order.
+// A token glued to a word, to another @ or to a longer name isn't a mention (e.g. email addresses).
+func parseMentions(body string) []mentionToken {
+	var tokens []mentionToken
+	for _, m := range mentionRegex.FindAllStringSubmatchIndex(body, -1) {
+		start, end := m[0], m[1]
+		if (start > 0 && isHandleChar(body[start-1])) || (end < len(body) && isHandleChar(body[end])) {
+			continue
+		}
+		tokens = append(tokens, mentionToken{
+			username: body[m[2]:m[3]],
+			start:    utf8.RuneCountInString(body[:start]),
+			length:   end - start, // usernames are ascii
+		})
+	}
+	return tokens
+}
+
+func isHandleChar(b byte) bool {
+	return b == '_' || b == '@' || ('0' <= b && b <= '9') || ('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z')
+}
+
+// NOT GRPC
+// resolves the @usernames of a body through the users service and returns them as spans.
+// Unknown usernames are left as plain text.
+func (s *Application) resolveMentions(ctx context.Context, body string) ([]models.Mention, error) {
+	tokens := parseMentions(body)
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	userIds := make(map[string]int64, len(tokens))
+	mentions := make([]models.Mention, 0, len(tokens))
+	for _, t := range tokens {
+		userId, resolved := userIds[t.username]
+		if !resolved {
+			if len(userIds) >= maxMentionsPerBody {
+				continue
+			}
+			var err error
+			userId, err = s.clients.ResolveHandle(ctx, t.username)
+			if err != nil {
+				decoded := ce.DecodeProto(err, fmt.Sprintf("username: %v", t.username))
+				if !decoded.IsClass(ce.ErrNotFound) {
+					return nil, decoded
+				}
+				userId = 0
+			}
+			userIds[t.username] = userId
+		}
+		if userId == 0 {
+			continue
+		}
+		mentions = append(mentions, models.Mention{
+			UserId: ct.Id(userId),
+			Start:  t.start,
+			Length: t.length,
+		})
+	}
+	return mentions, nil
+}
+
+// NOT GRPC
+// replaces the mentions stored for a post or comment, returns the users mentioned before
+func setMentions(ctx context.Context, q *ds.Queries, contentId int64, mentions []models.Mention, input string) ([]int64, error) {
+	previous, err := q.ClearMentions(ctx, contentId)
+	if err != nil {
+		return nil, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if len(mentions) == 0 {
+		return previous, nil
+	}
+
+	params := ds.InsertMentionsParams{ContentID: contentId}
+	for _, m := range mentions {
+		params.UserIds = append(params.UserIds, m.UserId.Int64())
+		params.StartOffsets = append(params.StartOffsets, int32(m.Start))
+		params.Lengths = append(params.Lengths, int32(m.Length))
+	}
+	if _, err := q.InsertMentions(ctx, params); err != nil {
+		return nil, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	return previous, nil
+}
+
+// a post or comment whose mentioned users should hear about it
+type mentionSource struct {
+	authorId  int64
+	postId    int64
+	commentId int64 // 0 for the post itself
+	body      string
+	mentions  []models.Mention
+	notified  []int64 // mentioned in an earlier version, already told
+}
+
+// NOT GRPC
+// sends a mention notification to every user mentioned in src who can see it, except its author.
+// Users who can't see it yet (scheduled or pending posts) are told once it's published.
+func (s *Application) notifyMentions(ctx context.Context, src mentionSource) {
+	skip := make(map[int64]struct{}, len(src.notified)+1)
+	skip[src.authorId] = struct{}{}
+	for _, id := range src.notified {
+		skip[id] = struct{}{}
+	}
+
+	candidates := ct.Ids{}
+	for _, m := range src.mentions {
+		if _, ok := skip[m.UserId.Int64()]; ok {
+			continue
+		}
+		skip[m.UserId.Int64()] = struct{}{}
+		candidates = append(candidates, m.UserId)
+	}
+	if len(candidates) == 0 {
+		return
+	}
+
+	// a comment is visible to whoever can see its post
+	post, err := s.db.GetEntityCreatorAndGroup(ctx, src.postId)
+	if err != nil {
+		tele.Error(ctx, "could not get creator and group of post @1 for mentions: @2", "postId", src.postId, "error", err.Error())
+		return
+	}
+	viewers, err := s.usersWhoCanViewPost(ctx, src.postId, post.CreatorID, post.GroupID, candidates)
+	if err != nil {
+		tele.Error(ctx, "could not check which mentioned users can see post @1: @2", "postId", src.postId, "error", err.Error())
+		return
+	}
+	canSee := make(map[int64]struct{}, len(viewers))
+	for _, id := range viewers {
+		canSee[id.Int64()] = struct{}{}
+	}
+
+	var mentioner *models.User
+	body := []rune(src.body)
+	for _, m := range src.mentions {
+		userId := m.UserId.Int64()
+		if _, ok := canSee[userId]; !ok {
+			continue
+		}
+		// a user mentioned twice is notified once
+		delete(canSee, userId)
+
+		if mentioner == nil {
+			u, err := s.userRetriever.GetUser(ctx, ct.Id(src.authorId))
+			if err != nil {
+				tele.Error(ctx, "Could not get basic user info for id @1 for mention event: @2", "userId", src.authorId, "error", err.Error())
+			}
+			mentioner = &u
+		}
+
+		var mentionText string
+		if m.Start+m.Length <= len(body) {
+			mentionText = string(body[m.Start : m.Start+m.Length])
+		}
+
+		event := &notifpb.NotificationEvent{
+			EventType: notifpb.EventType_MENTION_CREATED,
+			Payload: &notifpb.NotificationEvent_MentionCreated{
+				MentionCreated: &notifpb.MentionCreated{
+					MentionedUserId:   userId,
+					MentionerUserId:   src.authorId,
+					PostId:            src.postId,
+					CommentId:         src.commentId,
+					MentionerUsername: mentioner.Username.String(),
+					PostContent:       src.body,
+					MentionText:       mentionText,
+				},
+			},
+		}
+		if err := s.eventProducer.CreateAndSendNotificationEvent(ctx, event); err != nil {
+			tele.Error(ctx, "failed to send mention notification: @1", "error", err.Error())
+		}
+	}
+}
+
+// NOT GRPC
+// tells the users mentioned in a post that just became visible, on publishing or approval
+func (s *Application) notifyPostMentions(ctx context.Context, postId, authorId int64, body string) {
+	mentions := s.getMentions(ctx, []int64{postId})[postId]
+	if len(mentions) == 0 {
+		return
+	}
+	s.notifyMentions(ctx, mentionSource{
+		authorId: authorId,
+		postId:   postId,
+		body:     body,
+		mentions: mentions,
+	})
+}
+
+// NOT GRPC
+// mentions of the given posts and comments by id, logged and left out on error
+func (s *Application) getMentions(ctx context.Context, contentIds []int64) map[int64][]models.Mention {
+	if len(contentIds) == 0 {
+		return nil
+	}
+	rows, err := s.db.GetMentions(ctx, contentIds)
+	if err != nil {
+		tele.Error(ctx, "failed to get mentions for @1: @2", "contentIds", contentIds, "error", err.Error())
+		return nil
+	}
+
+	mentions := make(map[int64][]models.Mention, len(contentIds))
+	for _, r := range rows {
+		mentions[r.ContentID] = append(mentions[r.ContentID], models.Mention{
+			UserId: ct.Id(r.MentionedUserID),
+			Start:  int(r.StartOffset),
+			Length: int(r.Length),
+		})
+	}
+	return mentions
+}
+
+// NOT GRPC
+func (s *Application) attachPostMentions(ctx context.Context, posts []models.Post) {
+	ids := make([]int64, 0, len(posts))
+	for _, p := range posts {
+		ids = append(ids, p.PostId.Int64())
+	}
+	mentions := s.getMentions(ctx, ids)
+	for i := range posts {
+		posts[i].Mentions = mentions[posts[i].PostId.Int64()]
+	}
+}
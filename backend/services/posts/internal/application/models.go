@@ -3,4 +3,7 @@ package application
 type accessContext struct {
 	requesterId int64
 	entityId    int64
+	// set for interactions (commenting, reacting, responding): group entities
+	// then need membership even if the group is public
+	requireMembership bool
 }
@@ -0,0 +1,164 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	ds "social-network/services/posts/internal/db/dbservice"
+	notifpb "social-network/shared/gen-go/notifications"
+	ce "social-network/shared/go/commonerrors"
+	ct "social-network/shared/go/ct"
+	"social-network/shared/go/models"
+	tele "social-network/shared/go/telemetry"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// how many posts a group can have pinned at the same time
+const maxPinnedPostsPerGroup = 3
+
+// Pins or unpins a group post. Pinned posts are listed first in the group feed.
+func (s *Application) SetPostPinned(ctx context.Context, req models.SetPostFlagReq) error {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	post, err := s.getGroupPostForFlag(ctx, req, ct.PermPinPosts)
+	if err != nil {
+		return ce.Wrap(nil, err)
+	}
+
+	if !req.Value {
+		if _, err := s.db.UnpinPost(ctx, post.ID); err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		return nil
+	}
+
+	if post.PinnedAt.Valid {
+		return nil
+	}
+	// the count and the pin run under a per group lock, so concurrent pins can't go over the limit
+	err = s.txRunner.RunTx(ctx, func(q *ds.Queries) error {
+		if err := q.LockGroupPins(ctx, post.GroupID); err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		count, err := q.CountPinnedGroupPosts(ctx, pgtype.Int8{Int64: post.GroupID, Valid: true})
+		if err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		if count >= maxPinnedPostsPerGroup {
+			return ce.New(ce.ErrFailedPrecondition, fmt.Errorf("group %v already has %v pinned posts", post.GroupID, count), input).WithPublic(fmt.Sprintf("a group can't have more than %d pinned posts", maxPinnedPostsPerGroup))
+		}
+
+		_, err = q.PinPost(ctx, ds.PinPostParams{
+			ID:       post.ID,
+			PinnedBy: pgtype.Int8{Int64: req.RequesterId.Int64(), Valid: true},
+		})
+		if err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		return nil
+	})
+	if err != nil {
+		return ce.Wrap(nil, err)
+	}
+	return nil
+}
+
+// Marks or unmarks a group post as an announcement.
+// Marking notifies every group member except the author.
+func (s *Application) SetPostAnnouncement(ctx context.Context, req models.SetPostFlagReq) error {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	post, err := s.getGroupPostForFlag(ctx, req, ct.PermAnnounce)
+	if err != nil {
+		return ce.Wrap(nil, err)
+	}
+
+	rows, err := s.db.SetPostAnnouncement(ctx, ds.SetPostAnnouncementParams{
+		ID:             post.ID,
+		IsAnnouncement: req.Value,
+	})
+	if err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	// nothing changed or announcement removed, nobody to notify
+	if rows == 0 || !req.Value {
+		return nil
+	}
+
+	//create notification
+	group, err := s.clients.GetGroupBasicInfo(ctx, post.GroupID)
+	if err != nil {
+		tele.Error(ctx, "Could not get basic group info for id @1 for announcement notif: @2", "groupId", post.GroupID, "error", err.Error())
+	}
+
+	groupMembers, err := s.clients.GetAllGroupMemberIds(ctx, post.GroupID)
+	if err != nil {
+		tele.Error(ctx, "Could not get group members ids for group @1 for announcement notif: @2", "groupId", post.GroupID, "error", err.Error())
+	}
+
+	event := &notifpb.NotificationEvent{
+		EventType: notifpb.EventType_GROUP_ANNOUNCEMENT_CREATED,
+		Payload: &notifpb.NotificationEvent_GroupAnnouncementCreated{
+			GroupAnnouncementCreated: &notifpb.GroupAnnouncementCreated{
+				UserId:      groupMembers,
+				AuthorId:    post.CreatorID,
+				GroupId:     post.GroupID,
+				PostId:      post.ID,
+				GroupName:   group.GroupTitle.String(),
+				PostContent: post.PostBody,
+			},
+		},
+	}
+
+	if err := s.eventProducer.CreateAndSendNotificationEvent(ctx, event); err != nil {
+		tele.Error(ctx, "failed to send group announcement notification: @1", "error", err.Error())
+	}
+	tele.Info(ctx, "group announcement notification event created")
+
+	return nil
+}
+
+// NOT GRPC
+// returns the post if it belongs to a writable group where the requester has perm
+func (s *Application) getGroupPostForFlag(ctx context.Context, req models.SetPostFlagReq, perm ct.GroupPermission) (ds.GetPostGroupFlagsRow, error) {
+	input := fmt.Sprintf("%#v, permission: %v", req, perm)
+
+	post, err := s.db.GetPostGroupFlags(ctx, req.PostId.Int64())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return post, ce.New(ce.ErrNotFound, err, input).WithPublic("not found")
+		}
+		return post, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if post.GroupID == 0 {
+		return post, ce.New(ce.ErrInvalidArgument, fmt.Errorf("post %v is not a group post", post.ID), input).WithPublic("only group posts can be pinned or announced")
+	}
+	if post.ApprovalStatus != ds.PostApprovalStatusApproved {
+		return post, ce.New(ce.ErrFailedPrecondition, fmt.Errorf("post %v is %v", post.ID, post.ApprovalStatus), input).WithPublic("only approved posts can be pinned or announced")
+	}
+	if post.Scheduled {
+		return post, ce.New(ce.ErrFailedPrecondition, fmt.Errorf("post %v is scheduled", post.ID), input).WithPublic("only published posts can be pinned or announced")
+	}
+
+	allowed, err := s.clients.HasGroupPermission(ctx, req.RequesterId.Int64(), post.GroupID, perm)
+	if err != nil {
+		return post, ce.DecodeProto(err, input)
+	}
+	if !allowed {
+		return post, ce.New(ce.ErrPermissionDenied, fmt.Errorf("user %v lacks %v in group %v", req.RequesterId, perm, post.GroupID), input).WithPublic("permission denied")
+	}
+	if err := s.checkGroupWritable(ctx, post.GroupID); err != nil {
+		return post, ce.Wrap(nil, err)
+	}
+	return post, nil
+}
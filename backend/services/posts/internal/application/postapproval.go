@@ -0,0 +1,229 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	ds "social-network/services/posts/internal/db/dbservice"
+	notifpb "social-network/shared/gen-go/notifications"
+	ce "social-network/shared/go/commonerrors"
+	ct "social-network/shared/go/ct"
+	"social-network/shared/go/models"
+	tele "social-network/shared/go/telemetry"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const maxRejectionReasonChars = 500
+
+// Makes new posts of the given group wait for approval by group staff, or publishes them directly.
+// Posts already waiting are left in the queue either way.
+func (s *Application) SetGroupPostApproval(ctx context.Context, groupId ct.Id, required bool) error {
+	input := fmt.Sprintf("group id: %v, required: %v", groupId, required)
+
+	if err := groupId.Validate(); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	var err error
+	if required {
+		err = s.db.InsertModeratedGroup(ctx, groupId.Int64())
+	} else {
+		err = s.db.DeleteModeratedGroup(ctx, groupId.Int64())
+	}
+	if err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	return nil
+}
+
+// Returns the posts of a group awaiting approval, oldest first.
+// Only members whose role allows approving posts can see the queue.
+func (s *Application) GetPendingGroupPosts(ctx context.Context, req models.GetGroupPostsReq) ([]models.Post, error) {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return nil, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	allowed, err := s.clients.HasGroupPermission(ctx, req.RequesterId.Int64(), req.GroupId.Int64(), ct.PermApprovePosts)
+	if err != nil {
+		return nil, ce.DecodeProto(err, input)
+	}
+	if !allowed {
+		return nil, ce.New(ce.ErrPermissionDenied, fmt.Errorf("user %v lacks %v in group %v", req.RequesterId, ct.PermApprovePosts, req.GroupId), input).WithPublic("permission denied")
+	}
+
+	rows, err := s.db.GetPendingGroupPosts(ctx, ds.GetPendingGroupPostsParams{
+		GroupID: pgtype.Int8{Int64: req.GroupId.Int64(), Valid: true},
+		Limit:   req.Limit.Int32(),
+		Offset:  req.Offset.Int32(),
+	})
+	if err != nil {
+		return nil, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if len(rows) == 0 {
+		return []models.Post{}, nil
+	}
+
+	posts := make([]models.Post, 0, len(rows))
+	userIDs := make(ct.Ids, 0, len(rows))
+	for _, r := range rows {
+		userIDs = append(userIDs, ct.Id(r.CreatorID))
+		posts = append(posts, models.Post{
+			PostId:         ct.Id(r.ID),
+			Body:           ct.PostBody(r.PostBody),
+			User:           models.User{UserId: ct.Id(r.CreatorID)},
+			GroupId:        req.GroupId,
+			Audience:       ct.Audience(r.Audience),
+			CreatedAt:      ct.GenDateTime(r.CreatedAt.Time),
+			UpdatedAt:      ct.GenDateTime(r.UpdatedAt.Time),
+			Edited:         r.Edited,
+			ImageId:        coverImage(r.Images),
+			ImageIds:       ct.FromInt64s(r.Images),
+			ApprovalStatus: string(ds.PostApprovalStatusPending),
+		})
+	}
+
+	userMap, err := s.userRetriever.GetUsers(ctx, userIDs.Unique())
+	if err != nil {
+		return nil, ce.Wrap(nil, err, input).WithPublic("error retrieving user's info")
+	}
+	for i := range posts {
+		if u, ok := userMap[posts[i].User.UserId]; ok {
+			posts[i].User = u
+		}
+	}
+
+	s.attachPostImages(ctx, posts)
+	s.attachPostMentions(ctx, posts)
+
+	return posts, nil
+}
+
+// Publishes a pending group post and notifies its author.
+func (s *Application) ApprovePost(ctx context.Context, req models.ReviewPostReq) error {
+	return s.reviewPost(ctx, req, true)
+}
+
+// Rejects a pending group post and notifies its author with the optional reason.
+// The post stays visible to its author only.
+func (s *Application) RejectPost(ctx context.Context, req models.ReviewPostReq) error {
+	return s.reviewPost(ctx, req, false)
+}
+
+// NOT GRPC
+// returns the status a new post in the group starts with:
+// pending if the group needs approval and the creator can't approve posts themselves
+func (s *Application) newGroupPostStatus(ctx context.Context, creatorId, groupId int64) (ds.PostApprovalStatus, error) {
+	input := fmt.Sprintf("creator: %v, group: %v", creatorId, groupId)
+
+	moderated, err := s.db.IsGroupModerated(ctx, groupId)
+	if err != nil {
+		return "", ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if !moderated {
+		return ds.PostApprovalStatusApproved, nil
+	}
+
+	isStaff, err := s.clients.HasGroupPermission(ctx, creatorId, groupId, ct.PermApprovePosts)
+	if err != nil {
+		return "", ce.DecodeProto(err, input)
+	}
+	if isStaff {
+		return ds.PostApprovalStatusApproved, nil
+	}
+	return ds.PostApprovalStatusPending, nil
+}
+
+// NOT GRPC
+func (s *Application) reviewPost(ctx context.Context, req models.ReviewPostReq, approve bool) error {
+	input := fmt.Sprintf("%#v, approve: %v", req, approve)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if approve {
+		reason = ""
+	}
+	if utf8.RuneCountInString(reason) > maxRejectionReasonChars {
+		return ce.New(ce.ErrInvalidArgument, fmt.Errorf("rejection reason of %v characters", utf8.RuneCountInString(reason)), input).WithPublic(fmt.Sprintf("reason can't be longer than %d characters", maxRejectionReasonChars))
+	}
+
+	post, err := s.db.GetPostGroupFlags(ctx, req.PostId.Int64())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ce.New(ce.ErrNotFound, err, input).WithPublic("not found")
+		}
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if post.GroupID == 0 {
+		return ce.New(ce.ErrInvalidArgument, fmt.Errorf("post %v is not a group post", post.ID), input).WithPublic("only group posts can be reviewed")
+	}
+
+	allowed, err := s.clients.HasGroupPermission(ctx, req.RequesterId.Int64(), post.GroupID, ct.PermApprovePosts)
+	if err != nil {
+		return ce.DecodeProto(err, input)
+	}
+	if !allowed {
+		return ce.New(ce.ErrPermissionDenied, fmt.Errorf("user %v lacks %v in group %v", req.RequesterId, ct.PermApprovePosts, post.GroupID), input).WithPublic("permission denied")
+	}
+	if err := s.checkGroupWritable(ctx, post.GroupID); err != nil {
+		return ce.Wrap(nil, err)
+	}
+
+	status := ds.PostApprovalStatusRejected
+	if approve {
+		status = ds.PostApprovalStatusApproved
+	}
+	rows, err := s.db.ReviewPost(ctx, ds.ReviewPostParams{
+		ID:              post.ID,
+		ApprovalStatus:  status,
+		ReviewedBy:      pgtype.Int8{Int64: req.RequesterId.Int64(), Valid: true},
+		RejectionReason: pgtype.Text{String: reason, Valid: reason != ""},
+	})
+	if err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if rows == 0 {
+		return ce.New(ce.ErrFailedPrecondition, fmt.Errorf("post %v is %v", post.ID, post.ApprovalStatus), input).WithPublic("post is not awaiting approval")
+	}
+
+	//create notification
+	group, err := s.clients.GetGroupBasicInfo(ctx, post.GroupID)
+	if err != nil {
+		tele.Error(ctx, "Could not get basic group info for id @1 for post review notif: @2", "groupId", post.GroupID, "error", err.Error())
+	}
+
+	event := &notifpb.NotificationEvent{
+		EventType: notifpb.EventType_GROUP_POST_REVIEWED,
+		Payload: &notifpb.NotificationEvent_GroupPostReviewed{
+			GroupPostReviewed: &notifpb.GroupPostReviewed{
+				AuthorId:   post.CreatorID,
+				ReviewerId: req.RequesterId.Int64(),
+				GroupId:    post.GroupID,
+				PostId:     post.ID,
+				GroupName:  group.GroupTitle.String(),
+				Approved:   approve,
+				Reason:     reason,
+			},
+		},
+	}
+
+	if err := s.eventProducer.CreateAndSendNotificationEvent(ctx, event); err != nil {
+		tele.Error(ctx, "failed to send post review notification: @1", "error", err.Error())
+	}
+	tele.Info(ctx, "post review notification event created")
+
+	//mentioned users couldn't see the post until now
+	if approve && !post.Scheduled {
+		s.notifyPostMentions(ctx, post.ID, post.CreatorID, post.PostBody)
+	}
+
+	return nil
+}
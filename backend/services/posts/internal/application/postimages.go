@@ -0,0 +1,116 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	ds "social-network/services/posts/internal/db/dbservice"
+	"social-network/shared/gen-go/media"
+	ce "social-network/shared/go/commonerrors"
+	ct "social-network/shared/go/ct"
+	"social-network/shared/go/models"
+	tele "social-network/shared/go/telemetry"
+)
+
+// NOT GRPC
+// returns the gallery requested for a post, falling back to the single image id
+// for clients that send only one. Returns nil if no image was given.
+func postGallery(imageIds ct.Ids, imageId ct.Id) (ct.Ids, error) {
+	if len(imageIds) == 0 {
+		if imageId == 0 {
+			return nil, nil
+		}
+		imageIds = ct.Ids{imageId}
+	}
+	if err := imageIds.Validate(); err != nil {
+		return nil, err
+	}
+	if len(imageIds.Unique()) != len(imageIds) {
+		return nil, fmt.Errorf("duplicate image ids in %v", imageIds)
+	}
+	if len(imageIds) > ct.MaxPostImages {
+		return nil, fmt.Errorf("%v images given, max is %v", len(imageIds), ct.MaxPostImages)
+	}
+	return imageIds, nil
+}
+
+// NOT GRPC
+// replaces the gallery of the post with the given images, in the given order
+// images attached to any other post, comment or event are rejected.
+// Unattached ids aren't checked against their uploader, media doesn't record it
+func setPostImages(ctx context.Context, q *ds.Queries, postId int64, imageIds ct.Ids, input string) error {
+	foreign, err := q.GetForeignImageIds(ctx, ds.GetForeignImageIdsParams{
+		ParentID: postId,
+		Ids:      imageIds.Int64(),
+	})
+	if err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if len(foreign) > 0 {
+		return ce.New(ce.ErrPermissionDenied, fmt.Errorf("images %v don't belong to post %v", foreign, postId), input).WithPublic("image doesn't belong to this post")
+	}
+
+	err = q.SetPostImages(ctx, ds.SetPostImagesParams{
+		ParentID: postId,
+		Ids:      imageIds.Int64(),
+	})
+	if err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	return nil
+}
+
+// NOT GRPC
+// returns the cover image of a gallery returned by the db, 0 if it's empty
+func coverImage(imageIds []int64) ct.Id {
+	if len(imageIds) == 0 {
+		return 0
+	}
+	return ct.Id(imageIds[0])
+}
+
+// NOT GRPC
+// fetches the urls of all images of the given posts with a single call to media.
+// Failed images are left out of their post's gallery and removed in the background,
+// without affecting the other images of the post. Images still uploading keep an empty url.
+// On media errors the ids are kept and all urls left empty.
+func (s *Application) attachPostImages(ctx context.Context, posts []models.Post) {
+	imageIds := ct.Ids{}
+	for _, p := range posts {
+		imageIds = append(imageIds, p.ImageIds...)
+	}
+	if len(imageIds) == 0 {
+		return
+	}
+
+	imageMap, failedImageIds, err := s.mediaRetriever.GetImages(ctx, imageIds.Unique(), media.FileVariant_MEDIUM)
+	if err != nil {
+		tele.Error(ctx, "media retriever failed for @1", "request", imageIds, "error", err.Error()) //log error instead of returning
+		return
+	}
+
+	failed := make(map[int64]struct{}, len(failedImageIds))
+	for _, id := range failedImageIds {
+		failed[id] = struct{}{}
+	}
+
+	for i := range posts {
+		ids := make(ct.Ids, 0, len(posts[i].ImageIds))
+		urls := make([]string, 0, len(posts[i].ImageIds))
+		for _, id := range posts[i].ImageIds {
+			if _, ok := failed[id.Int64()]; ok {
+				continue
+			}
+			ids = append(ids, id)
+			urls = append(urls, imageMap[id.Int64()])
+		}
+		posts[i].ImageIds = ids
+		posts[i].ImageUrls = urls
+		posts[i].ImageId = 0
+		posts[i].ImageUrl = ""
+		if len(ids) > 0 {
+			posts[i].ImageId = ids[0]
+			posts[i].ImageUrl = urls[0]
+		}
+	}
+	s.removeFailedImagesAsync(ctx, failedImageIds)
+}
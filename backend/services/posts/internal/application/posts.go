@@ -6,7 +6,6 @@ import (
 	"errors"
 	"fmt"
 	ds "social-network/services/posts/internal/db/dbservice"
-	"social-network/shared/gen-go/media"
 	ce "social-network/shared/go/commonerrors"
 	ct "social-network/shared/go/ct"
 	"social-network/shared/go/models"
@@ -16,6 +15,12 @@ import (
 )
 
 func (s *Application) CreatePost(ctx context.Context, req models.CreatePostReq) (postId int64, err error) {
+	return s.createPost(ctx, req, nil)
+}
+
+// NOT GRPC
+// claim, if given, runs first in the tx creating the post
+func (s *Application) createPost(ctx context.Context, req models.CreatePostReq, claim txClaim) (postId int64, err error) {
 	input := fmt.Sprintf("%#v", req)
 
 	if err := ct.ValidateStruct(req); err != nil {
@@ -36,6 +41,22 @@ func (s *Application) CreatePost(ctx context.Context, req models.CreatePostReq)
 		return 0, ce.New(ce.ErrInvalidArgument, fmt.Errorf("no group id given"), input).WithPublic("invalid arguments")
 	}
 
+	imageIds, err := postGallery(req.ImageIds, req.ImageId)
+	if err != nil {
+		return 0, ce.New(ce.ErrInvalidArgument, err, input).WithPublic(fmt.Sprintf("a post can have up to %d distinct images", ct.MaxPostImages))
+	}
+
+	publishAt, err := publishTime(req.PublishAt)
+	if err != nil {
+		return 0, ce.Wrap(nil, err)
+	}
+
+	mentions, err := s.resolveMentions(ctx, req.Body.String())
+	if err != nil {
+		return 0, ce.Wrap(nil, err, input)
+	}
+
+	approvalStatus := ds.PostApprovalStatusApproved
 	if groupId.Valid {
 		isMember, err := s.clients.IsGroupMember(ctx, req.CreatorId.Int64(), req.GroupId.Int64())
 		if err != nil {
@@ -45,14 +66,28 @@ func (s *Application) CreatePost(ctx context.Context, req models.CreatePostReq)
 			return 0, ce.New(ce.ErrPermissionDenied, fmt.Errorf("user is not a member of group %v", req.GroupId), input).WithPublic("permission denied")
 
 		}
+		if err := s.checkGroupWritable(ctx, req.GroupId.Int64()); err != nil {
+			return 0, ce.Wrap(nil, err)
+		}
+		approvalStatus, err = s.newGroupPostStatus(ctx, req.CreatorId.Int64(), req.GroupId.Int64())
+		if err != nil {
+			return 0, ce.Wrap(nil, err)
+		}
 	}
 	err = s.txRunner.RunTx(ctx, func(q *ds.Queries) error {
+		if claim != nil {
+			if err := claim(q); err != nil {
+				return err
+			}
+		}
 
 		postId, err = q.CreatePost(ctx, ds.CreatePostParams{
-			PostBody:  req.Body.String(),
-			CreatorID: req.CreatorId.Int64(),
-			GroupID:   groupId,
-			Audience:  audience,
+			PostBody:       req.Body.String(),
+			CreatorID:      req.CreatorId.Int64(),
+			GroupID:        groupId,
+			Audience:       audience,
+			ApprovalStatus: approvalStatus,
+			PublishAt:      publishAt,
 		})
 		if err != nil {
 			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
@@ -75,13 +110,21 @@ func (s *Application) CreatePost(ctx context.Context, req models.CreatePostReq)
 			}
 		}
 
-		if req.ImageId != 0 {
-			err = q.UpsertImage(ctx, ds.UpsertImageParams{
-				ID:       req.ImageId.Int64(),
-				ParentID: postId,
-			})
-			if err != nil {
-				return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		if len(imageIds) > 0 {
+			if err := setPostImages(ctx, q, postId, imageIds, input); err != nil {
+				return err
+			}
+		}
+
+		if len(mentions) > 0 {
+			if _, err := setMentions(ctx, q, postId, mentions, input); err != nil {
+				return err
+			}
+		}
+
+		if tags := parseHashtags(req.Body.String()); len(tags) > 0 {
+			if err := setPostHashtags(ctx, q, postId, tags, input); err != nil {
+				return err
 			}
 		}
 
@@ -90,6 +133,16 @@ func (s *Application) CreatePost(ctx context.Context, req models.CreatePostReq)
 	if err != nil {
 		return 0, ce.Wrap(nil, err)
 	}
+
+	//scheduled and pending posts notify their mentions once visible
+	if !publishAt.Valid && approvalStatus == ds.PostApprovalStatusApproved {
+		s.notifyMentions(ctx, mentionSource{
+			authorId: req.CreatorId.Int64(),
+			postId:   postId,
+			body:     req.Body.String(),
+			mentions: mentions,
+		})
+	}
 	return postId, nil
 }
 
@@ -113,9 +166,15 @@ func (s *Application) DeletePost(ctx context.Context, req models.GenericReq) err
 		return ce.New(ce.ErrPermissionDenied, fmt.Errorf("user has no permission to delete entity %v", req.EntityId), input).WithPublic("permission denied")
 	}
 
+	// group staff can delete other members' posts
+	creatorId, err := s.moderatedCreatorId(ctx, req.RequesterId.Int64(), req.EntityId.Int64(), ct.PermDeletePosts)
+	if err != nil {
+		return ce.Wrap(nil, err)
+	}
+
 	rowsAffected, err := s.db.DeletePost(ctx, ds.DeletePostParams{
 		ID:        int64(req.EntityId),
-		CreatorID: req.RequesterId.Int64(),
+		CreatorID: creatorId,
 	})
 	if err != nil {
 		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
@@ -146,9 +205,40 @@ func (s *Application) EditPost(ctx context.Context, req models.EditPostReq) erro
 		return ce.New(ce.ErrPermissionDenied, fmt.Errorf("user has no permission to view or edit entity %v", req.PostId), input).WithPublic("permission denied")
 	}
 
-	return s.txRunner.RunTx(ctx, func(q *ds.Queries) error {
-		//edit content
+	if err := s.checkEntityWritable(ctx, req.PostId.Int64()); err != nil {
+		return ce.Wrap(nil, err)
+	}
+
+	if err := s.checkShareEdit(ctx, req, input); err != nil {
+		return ce.Wrap(nil, err)
+	}
+
+	imageIds, err := postGallery(req.ImageIds, req.ImageId)
+	if err != nil {
+		return ce.New(ce.ErrInvalidArgument, err, input).WithPublic(fmt.Sprintf("a post can have up to %d distinct images", ct.MaxPostImages))
+	}
+
+	var mentions []models.Mention
+	var previouslyMentioned []int64
+	if len(req.NewBody) > 0 {
+		mentions, err = s.resolveMentions(ctx, req.NewBody.String())
+		if err != nil {
+			return ce.Wrap(nil, err, input)
+		}
+	}
+
+	err = s.txRunner.RunTx(ctx, func(q *ds.Queries) error {
+		//edit content, keeping the replaced body as a revision
 		if len(req.NewBody) > 0 {
+			_, err := q.InsertPostRevision(ctx, ds.InsertPostRevisionParams{
+				ID:        req.PostId.Int64(),
+				CreatorID: req.RequesterId.Int64(),
+				NewBody:   req.NewBody.String(),
+			})
+			if err != nil {
+				return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+			}
+
 			rowsAffected, err := q.EditPostContent(ctx, ds.EditPostContentParams{
 				PostBody:  req.NewBody.String(),
 				ID:        req.PostId.Int64(),
@@ -160,26 +250,31 @@ func (s *Application) EditPost(ctx context.Context, req models.EditPostReq) erro
 			if rowsAffected != 1 {
 				return ce.New(ce.ErrNotFound, fmt.Errorf("post %v not found or not owned by user %v", req.PostId, req.RequesterId), input).WithPublic("not found")
 			}
-		}
 
-		//edit image
-		if req.ImageId > 0 {
-			err := q.UpsertImage(ctx, ds.UpsertImageParams{
-				ID:       req.ImageId.Int64(),
-				ParentID: req.PostId.Int64(),
-			})
+			previouslyMentioned, err = setMentions(ctx, q, req.PostId.Int64(), mentions, input)
 			if err != nil {
-				return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+				return err
+			}
+
+			if err := setPostHashtags(ctx, q, req.PostId.Int64(), parseHashtags(req.NewBody.String()), input); err != nil {
+				return err
 			}
 		}
-		//delete image
-		if req.DeleteImage {
+
+		//replace and reorder images
+		if len(imageIds) > 0 {
+			if err := setPostImages(ctx, q, req.PostId.Int64(), imageIds, input); err != nil {
+				return err
+			}
+		}
+		//delete images
+		if req.DeleteImage && len(imageIds) == 0 {
 			rowsAffected, err := q.DeleteImage(ctx, req.PostId.Int64())
 			if err != nil {
 				return ce.Wrap(ce.ErrInternal, err, fmt.Sprintf("post id: %v", req.PostId)).WithPublic(genericPublic)
 			}
-			if rowsAffected != 1 {
-				tele.Warn(ctx, "image @1 for post @2 could not be deleted: not found.", "image id", req.ImageId, "post id", req.PostId)
+			if rowsAffected == 0 {
+				tele.Warn(ctx, "images for post @1 could not be deleted: not found.", "post id", req.PostId)
 			}
 		}
 		// edit audience
@@ -221,7 +316,21 @@ func (s *Application) EditPost(ctx context.Context, req models.EditPostReq) erro
 
 		return nil
 	})
+	if err != nil {
+		return ce.Wrap(nil, err)
+	}
 
+	//only users added by this edit hear about it
+	if len(req.NewBody) > 0 {
+		s.notifyMentions(ctx, mentionSource{
+			authorId: req.RequesterId.Int64(),
+			postId:   req.PostId.Int64(),
+			body:     req.NewBody.String(),
+			mentions: mentions,
+			notified: previouslyMentioned,
+		})
+	}
+	return nil
 }
 
 func (s *Application) GetMostPopularPostInGroup(ctx context.Context, req models.SimpleIdReq) (models.Post, error) {
@@ -259,24 +368,20 @@ func (s *Application) GetMostPopularPostInGroup(ctx context.Context, req models.
 		Audience:        ct.Audience(p.Audience),
 		CommentsCount:   int(p.CommentsCount),
 		ReactionsCount:  int(p.ReactionsCount),
+		ReactionCounts:  reactionCounts(p.ReactionCounts),
 		LastCommentedAt: ct.GenDateTime(p.LastCommentedAt.Time),
 		CreatedAt:       ct.GenDateTime(p.CreatedAt.Time),
 		UpdatedAt:       ct.GenDateTime(p.UpdatedAt.Time),
-		ImageId:         ct.Id(p.Image),
+		Edited:          p.Edited,
+		ImageId:         coverImage(p.Images),
+		ImageIds:        ct.FromInt64s(p.Images),
 	}
 
-	if post.ImageId > 0 {
-		imageUrl, err := s.mediaRetriever.GetImage(ctx, p.Image, media.FileVariant_MEDIUM)
-		if err != nil {
-			tele.Error(ctx, "media retriever failed for @1", "request", p.Image, "error", err.Error()) //log error instead of returning
-			s.removeFailedImageAsync(ctx, err, p.Image)
-		} else {
-
-			post.ImageUrl = imageUrl
-		}
-	}
+	posts := []models.Post{post}
+	s.attachPostImages(ctx, posts)
+	s.attachPostMentions(ctx, posts)
 
-	return post, nil
+	return posts[0], nil
 }
 
 func (s *Application) GetPostById(ctx context.Context, req models.GenericReq) (models.Post, error) {
@@ -342,24 +447,27 @@ func (s *Application) GetPostById(ctx context.Context, req models.GenericReq) (m
 		Audience:              ct.Audience(p.Audience),
 		CommentsCount:         int(p.CommentsCount),
 		ReactionsCount:        int(p.ReactionsCount),
+		ReactionCounts:        reactionCounts(p.ReactionCounts),
 		LastCommentedAt:       ct.GenDateTime(p.LastCommentedAt.Time),
 		CreatedAt:             ct.GenDateTime(p.CreatedAt.Time),
 		UpdatedAt:             ct.GenDateTime(p.UpdatedAt.Time),
+		Edited:                p.Edited,
 		LikedByUser:           p.LikedByUser,
-		ImageId:               ct.Id(p.Image),
+		UserReaction:          p.UserReaction,
+		ImageId:               coverImage(p.Images),
+		ImageIds:              ct.FromInt64s(p.Images),
 		SelectedAudienceUsers: selectedUsers,
+		Pinned:                p.Pinned,
+		Announcement:          p.IsAnnouncement,
+		ApprovalStatus:        string(p.ApprovalStatus),
+		RejectionReason:       p.RejectionReason,
+		PublishAt:             ct.GenDateTime(p.PublishAt.Time),
 	}
 
-	if post.ImageId > 0 {
-		imageUrl, err := s.mediaRetriever.GetImage(ctx, p.Image, media.FileVariant_MEDIUM)
-		if err != nil {
-			tele.Error(ctx, "media retriever failed for @1", "request", p.Image, "error", err.Error()) //log error instead of returning
-			s.removeFailedImageAsync(ctx, err, p.Image)
-		} else {
-
-			post.ImageUrl = imageUrl
-		}
-	}
+	posts := []models.Post{post}
+	s.attachPostImages(ctx, posts)
+	s.attachPostMentions(ctx, posts)
+	s.attachSharedPosts(ctx, req.RequesterId.Int64(), posts)
 
-	return post, nil
+	return posts[0], nil
 }
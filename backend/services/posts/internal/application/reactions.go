@@ -11,7 +11,7 @@ import (
 	tele "social-network/shared/go/telemetry"
 )
 
-func (s *Application) ToggleOrInsertReaction(ctx context.Context, req models.GenericReq) error {
+func (s *Application) ToggleOrInsertReaction(ctx context.Context, req models.ReactionReq) error {
 	input := fmt.Sprintf("%#v", req)
 
 	if err := ct.ValidateStruct(req); err != nil {
@@ -20,8 +20,9 @@ func (s *Application) ToggleOrInsertReaction(ctx context.Context, req models.Gen
 	}
 
 	accessCtx := accessContext{
-		requesterId: req.RequesterId.Int64(),
-		entityId:    req.EntityId.Int64(),
+		requesterId:       req.RequesterId.Int64(),
+		entityId:          req.EntityId.Int64(),
+		requireMembership: true,
 	}
 
 	hasAccess, err := s.hasRightToView(ctx, accessCtx)
@@ -32,9 +33,14 @@ func (s *Application) ToggleOrInsertReaction(ctx context.Context, req models.Gen
 		return ce.New(ce.ErrPermissionDenied, fmt.Errorf("user has no permission to react to entity %v", req.EntityId), input).WithPublic("permission denied")
 	}
 
+	if err := s.checkEntityWritable(ctx, req.EntityId.Int64()); err != nil {
+		return ce.Wrap(nil, err)
+	}
+
 	res, err := s.db.ToggleOrInsertReaction(ctx, ds.ToggleOrInsertReactionParams{
-		ContentID: req.EntityId.Int64(),
-		UserID:    req.RequesterId.Int64(),
+		ContentID:    req.EntityId.Int64(),
+		UserID:       req.RequesterId.Int64(),
+		ReactionType: ds.ReactionType(req.ReactionType),
 	})
 	if err != nil {
 		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
@@ -67,6 +73,7 @@ func (s *Application) ToggleOrInsertReaction(ctx context.Context, req models.Gen
 					LikerUserId:     req.RequesterId.Int64(),
 					LikerUsername:   liker.Username.String(),
 					Aggregate:       true,
+					ReactionType:    req.ReactionType.String(),
 				},
 			},
 		}
@@ -80,7 +87,7 @@ func (s *Application) ToggleOrInsertReaction(ctx context.Context, req models.Gen
 	return nil
 }
 
-func (s *Application) GetWhoLikedEntityId(ctx context.Context, req models.GenericReq) ([]models.User, error) {
+func (s *Application) GetWhoLikedEntityId(ctx context.Context, req models.GetReactionsReq) ([]models.User, error) {
 	input := fmt.Sprintf("%#v", req)
 
 	if err := ct.ValidateStruct(req); err != nil {
@@ -88,7 +95,10 @@ func (s *Application) GetWhoLikedEntityId(ctx context.Context, req models.Generi
 
 	}
 
-	userIDs, err := s.db.GetWhoLikedEntityId(ctx, req.EntityId.Int64())
+	userIDs, err := s.db.GetWhoLikedEntityId(ctx, ds.GetWhoLikedEntityIdParams{
+		ContentID:    req.EntityId.Int64(),
+		ReactionType: req.ReactionType.String(),
+	})
 	if err != nil {
 		return nil, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
 	}
@@ -110,3 +120,14 @@ func (s *Application) GetWhoLikedEntityId(ctx context.Context, req models.Generi
 
 	return users, nil
 }
+
+// per type reaction counts as stored, types nobody reacted with are left out
+func reactionCounts(counts map[string]int32) map[string]int {
+	res := make(map[string]int, len(counts))
+	for t, n := range counts {
+		if n > 0 {
+			res[t] = int(n)
+		}
+	}
+	return res
+}
@@ -0,0 +1,52 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	ds "social-network/services/posts/internal/db/dbservice"
+	ce "social-network/shared/go/commonerrors"
+	ct "social-network/shared/go/ct"
+	"social-network/shared/go/models"
+)
+
+// Returns the previous versions of a post or comment body, most recent first.
+// Visible to whoever can see the post, or the parent post of a comment.
+func (s *Application) GetRevisions(ctx context.Context, req models.EntityIdPaginatedReq) ([]models.Revision, error) {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return nil, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	accessCtx := accessContext{
+		requesterId: req.RequesterId.Int64(),
+		entityId:    req.EntityId.Int64(),
+	}
+
+	hasAccess, err := s.hasRightToView(ctx, accessCtx)
+	if err != nil {
+		return nil, ce.Wrap(ce.ErrInternal, err, fmt.Sprintf("%#v", accessCtx)).WithPublic(genericPublic)
+	}
+	if !hasAccess {
+		return nil, ce.New(ce.ErrPermissionDenied, fmt.Errorf("user has no permission to view history of entity %v", req.EntityId), input).WithPublic("permission denied")
+	}
+
+	rows, err := s.db.GetRevisions(ctx, ds.GetRevisionsParams{
+		ContentID: req.EntityId.Int64(),
+		Limit:     req.Limit.Int32(),
+		Offset:    req.Offset.Int32(),
+	})
+	if err != nil {
+		return nil, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+
+	revisions := make([]models.Revision, 0, len(rows))
+	for _, r := range rows {
+		revisions = append(revisions, models.Revision{
+			RevisionId: ct.Id(r.ID),
+			Body:       r.Body,
+			CreatedAt:  ct.GenDateTime(r.CreatedAt.Time),
+		})
+	}
+	return revisions, nil
+}
@@ -0,0 +1,292 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	ds "social-network/services/posts/internal/db/dbservice"
+	notifpb "social-network/shared/gen-go/notifications"
+	ce "social-network/shared/go/commonerrors"
+	ct "social-network/shared/go/ct"
+	"social-network/shared/go/models"
+	tele "social-network/shared/go/telemetry"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	maxScheduleMonthsAhead = 6
+	publishBatchSize       = 100
+
+	defaultScheduledPostsInterval = 30 * time.Second // used when the configured interval isn't positive
+	scheduledPostRetryDelay       = time.Minute      // before a post that couldn't be checked is tried again
+)
+
+// Returns the requester's posts waiting to be published, next to go out first.
+func (s *Application) GetScheduledPosts(ctx context.Context, req models.GenericPaginatedReq) ([]models.Post, error) {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return nil, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	rows, err := s.db.GetScheduledPosts(ctx, ds.GetScheduledPostsParams{
+		CreatorID: req.RequesterId.Int64(),
+		Limit:     req.Limit.Int32(),
+		Offset:    req.Offset.Int32(),
+	})
+	if err != nil {
+		return nil, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if len(rows) == 0 {
+		return []models.Post{}, nil
+	}
+
+	userMap, err := s.userRetriever.GetUsers(ctx, ct.Ids{req.RequesterId})
+	if err != nil {
+		return nil, ce.Wrap(nil, err, input).WithPublic("error retrieving user's info")
+	}
+
+	posts := make([]models.Post, 0, len(rows))
+	for _, r := range rows {
+		posts = append(posts, models.Post{
+			PostId:         ct.Id(r.ID),
+			Body:           ct.PostBody(r.PostBody),
+			User:           userMap[req.RequesterId],
+			GroupId:        ct.Id(r.GroupID),
+			Audience:       ct.Audience(r.Audience),
+			CreatedAt:      ct.GenDateTime(r.CreatedAt.Time),
+			UpdatedAt:      ct.GenDateTime(r.UpdatedAt.Time),
+			Edited:         r.Edited,
+			ImageId:        coverImage(r.Images),
+			ImageIds:       ct.FromInt64s(r.Images),
+			ApprovalStatus: string(r.ApprovalStatus),
+			PublishAt:      ct.GenDateTime(r.PublishAt.Time),
+		})
+	}
+	s.attachPostImages(ctx, posts)
+	s.attachPostMentions(ctx, posts)
+
+	return posts, nil
+}
+
+// Moves the publish time of one of the requester's scheduled posts.
+func (s *Application) ReschedulePost(ctx context.Context, req models.ReschedulePostReq) error {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	publishAt, err := publishTime(req.PublishAt)
+	if err != nil {
+		return ce.Wrap(nil, err, input)
+	}
+
+	rowsAffected, err := s.db.ReschedulePost(ctx, ds.ReschedulePostParams{
+		ID:        req.PostId.Int64(),
+		CreatorID: req.RequesterId.Int64(),
+		PublishAt: publishAt,
+	})
+	if err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if rowsAffected != 1 {
+		return ce.New(ce.ErrNotFound, fmt.Errorf("scheduled post %v not found for user %v", req.PostId, req.RequesterId), input).WithPublic("not found")
+	}
+	return nil
+}
+
+// Deletes one of the requester's scheduled posts before it's published.
+func (s *Application) CancelScheduledPost(ctx context.Context, req models.GenericReq) error {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	rowsAffected, err := s.db.CancelScheduledPost(ctx, ds.CancelScheduledPostParams{
+		ID:        req.EntityId.Int64(),
+		CreatorID: req.RequesterId.Int64(),
+	})
+	if err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if rowsAffected != 1 {
+		return ce.New(ce.ErrNotFound, fmt.Errorf("scheduled post %v not found for user %v", req.EntityId, req.RequesterId), input).WithPublic("not found")
+	}
+	return nil
+}
+
+// StartScheduledPostsWorker starts a background worker that periodically publishes
+// scheduled posts whose time has come. Each post is claimed by a single replica,
+// so running the worker on every instance publishes it exactly once.
+func (s *Application) StartScheduledPostsWorker(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		tele.Warn(ctx, "Invalid scheduled posts interval @1, using @2", "interval", interval.String(), "default", defaultScheduledPostsInterval.String())
+		interval = defaultScheduledPostsInterval
+	}
+	tele.Info(ctx, "Initiating scheduled posts worker. @1", "interval", interval.String())
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := s.publishDuePosts(ctx); err != nil {
+					tele.Error(ctx, "Error publishing scheduled posts. @1", "error", err.Error())
+				}
+			case <-ctx.Done():
+				tele.Info(ctx, "Scheduled posts worker stopped")
+				return
+			}
+		}
+	}()
+}
+
+// NOT GRPC
+// publishes due posts in batches until none are left and notifies their authors.
+// Group posts whose creator can no longer post in the group are dropped instead.
+// A post whose creator couldn't be checked goes back in the schedule for a retry, without holding up the rest.
+// A batch is rolled back as a whole on database errors and picked up again on the next tick.
+func (s *Application) publishDuePosts(ctx context.Context) error {
+	for {
+		var claimed int
+		var published []ds.PublishDuePostsRow
+
+		memberships, err := s.dueGroupPostMemberships(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = s.txRunner.RunTx(ctx, func(q *ds.Queries) error {
+			rows, err := q.PublishDuePosts(ctx, publishBatchSize)
+			if err != nil {
+				return err
+			}
+			claimed = len(rows)
+
+			for _, r := range rows {
+				if r.GroupID != 0 {
+					canPost, err := canStillPostInGroup(ctx, q, memberships, r.CreatorID, r.GroupID)
+					if err != nil {
+						tele.Error(ctx, "Could not check scheduled post @1 in group @2, retrying later: @3", "postId", r.ID, "groupId", r.GroupID, "error", err.Error())
+						if err := q.DeferDuePost(ctx, ds.DeferDuePostParams{
+							ID:        r.ID,
+							PublishAt: pgtype.Timestamptz{Time: time.Now().Add(scheduledPostRetryDelay), Valid: true},
+						}); err != nil {
+							return err
+						}
+						continue
+					}
+					if !canPost {
+						if _, err := q.DeletePost(ctx, ds.DeletePostParams{ID: r.ID, CreatorID: r.CreatorID}); err != nil {
+							return err
+						}
+						tele.Info(ctx, "Dropped scheduled post @1: creator can no longer post in group @2", "postId", r.ID, "groupId", r.GroupID)
+						continue
+					}
+				}
+				published = append(published, r)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, r := range published {
+			event := &notifpb.NotificationEvent{
+				EventType: notifpb.EventType_SCHEDULED_POST_PUBLISHED,
+				Payload: &notifpb.NotificationEvent_ScheduledPostPublished{
+					ScheduledPostPublished: &notifpb.ScheduledPostPublished{
+						AuthorId:        r.CreatorID,
+						PostId:          r.ID,
+						GroupId:         r.GroupID,
+						PendingApproval: r.ApprovalStatus == ds.PostApprovalStatusPending,
+					},
+				},
+			}
+			if err := s.eventProducer.CreateAndSendNotificationEvent(ctx, event); err != nil {
+				tele.Error(ctx, "failed to send scheduled post published notification: @1", "error", err.Error())
+			}
+			s.notifyPostMentions(ctx, r.ID, r.CreatorID, r.PostBody)
+		}
+
+		if claimed > 0 {
+			tele.Info(ctx, "Published scheduled posts. @1 @2", "claimed", claimed, "published", len(published))
+		}
+		if claimed < publishBatchSize {
+			return nil
+		}
+	}
+}
+
+type groupMember struct {
+	userId, groupId int64
+}
+
+// NOT GRPC
+// checks with users whether the creators of the next due group posts are still members,
+// before PublishDuePosts locks them, so no call to users is made while holding the locks.
+// Creators that couldn't be checked are left out.
+func (s *Application) dueGroupPostMemberships(ctx context.Context) (map[groupMember]bool, error) {
+	due, err := s.db.GetDueGroupPosts(ctx, publishBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	memberships := make(map[groupMember]bool, len(due))
+	for _, p := range due {
+		key := groupMember{userId: p.CreatorID, groupId: p.GroupID}
+		if _, ok := memberships[key]; ok {
+			continue
+		}
+		isMember, err := s.clients.IsGroupMember(ctx, p.CreatorID, p.GroupID)
+		if err != nil {
+			tele.Error(ctx, "Could not check membership of user @1 in group @2 for scheduled post @3: @4", "userId", p.CreatorID, "groupId", p.GroupID, "postId", p.ID, "error", err.Error())
+			continue
+		}
+		memberships[key] = isMember
+	}
+	return memberships, nil
+}
+
+// NOT GRPC
+// whether the creator of a scheduled group post is still a member and the group isn't archived.
+// Membership comes from dueGroupPostMemberships, a creator missing there is an error so the post is retried later
+func canStillPostInGroup(ctx context.Context, q *ds.Queries, memberships map[groupMember]bool, creatorId, groupId int64) (bool, error) {
+	archived, err := q.IsGroupArchived(ctx, groupId)
+	if err != nil {
+		return false, err
+	}
+	if archived {
+		return false, nil
+	}
+	isMember, ok := memberships[groupMember{userId: creatorId, groupId: groupId}]
+	if !ok {
+		return false, fmt.Errorf("membership of user %v in group %v wasn't checked", creatorId, groupId)
+	}
+	return isMember, nil
+}
+
+// NOT GRPC
+// returns the time a post scheduled at t goes out, or a null time if t is zero
+// meaning the post is published right away
+func publishTime(t ct.GenDateTime) (pgtype.Timestamptz, error) {
+	input := fmt.Sprintf("publish at: %v", t.Time())
+
+	if t.Validate() != nil {
+		return pgtype.Timestamptz{}, nil
+	}
+
+	now := time.Now()
+	if !t.Time().After(now) {
+		return pgtype.Timestamptz{}, ce.New(ce.ErrInvalidArgument, fmt.Errorf("publish time %v is in the past", t.Time()), input).WithPublic("publish time must be in the future")
+	}
+	if t.Time().After(now.AddDate(0, maxScheduleMonthsAhead, 0)) {
+		return pgtype.Timestamptz{}, ce.New(ce.ErrInvalidArgument, fmt.Errorf("publish time %v is too far ahead", t.Time()), input).WithPublic(fmt.Sprintf("posts can be scheduled up to %d months ahead", maxScheduleMonthsAhead))
+	}
+	return pgtype.Timestamptz{Time: t.Time(), Valid: true}, nil
+}
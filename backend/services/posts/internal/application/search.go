@@ -0,0 +1,76 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	ds "social-network/services/posts/internal/db/dbservice"
+	ce "social-network/shared/go/commonerrors"
+	ct "social-network/shared/go/ct"
+	"social-network/shared/go/models"
+)
+
+// Full-text search over posts, comments and events, filtered with the same rules as hasRightToView.
+// Follows and group memberships are resolved first so the query can rank and paginate on its own.
+func (s *Application) SearchContent(ctx context.Context, req models.SearchContentReq) ([]models.SearchResult, error) {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return nil, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	idsRequesterFollows, err := s.clients.GetFollowingIds(ctx, req.RequesterId.Int64())
+	if err != nil {
+		return nil, ce.DecodeProto(err, input)
+	}
+
+	memberGroupIds, err := s.clients.GetUserGroupIds(ctx, req.RequesterId.Int64())
+	if err != nil {
+		return nil, ce.DecodeProto(err, input)
+	}
+
+	rows, err := s.db.SearchContent(ctx, ds.SearchContentParams{
+		UserID:         req.RequesterId.Int64(),
+		Query:          req.SearchTerm.String(),
+		FollowingIds:   idsRequesterFollows,
+		MemberGroupIds: memberGroupIds,
+		Offset:         req.Offset.Int32(),
+		Limit:          req.Limit.Int32(),
+	})
+	if err != nil {
+		return nil, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+
+	if len(rows) == 0 {
+		return []models.SearchResult{}, nil
+	}
+
+	results := make([]models.SearchResult, 0, len(rows))
+	userIDs := make(ct.Ids, 0, len(rows))
+	for _, r := range rows {
+		userIDs = append(userIDs, ct.Id(r.CreatorID))
+		results = append(results, models.SearchResult{
+			EntityType: r.EntityType,
+			EntityId:   ct.Id(r.ID),
+			PostId:     ct.Id(r.PostID),
+			GroupId:    ct.Id(r.GroupID),
+			User: models.User{
+				UserId: ct.Id(r.CreatorID),
+			},
+			Title:     r.Title,
+			Body:      r.Body,
+			CreatedAt: ct.GenDateTime(r.CreatedAt.Time),
+		})
+	}
+
+	userMap, err := s.userRetriever.GetUsers(ctx, userIDs.Unique())
+	if err != nil {
+		return nil, ce.Wrap(nil, err, input).WithPublic("error retrieving user's info")
+	}
+	for i := range results {
+		if u, ok := userMap[results[i].User.UserId]; ok {
+			results[i].User = u
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,376 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	ds "social-network/services/posts/internal/db/dbservice"
+	notifpb "social-network/shared/gen-go/notifications"
+	ce "social-network/shared/go/commonerrors"
+	ct "social-network/shared/go/ct"
+	"social-network/shared/go/models"
+	tele "social-network/shared/go/telemetry"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Reposts a post, or quotes it when a body is given. Sharing a repost shares the post it reposts.
+// Shares are personal posts, published right away and never in a group.
+// A share can't show the post to anyone who can't see it: public posts can be shared with any audience,
+// the author's own followers-only posts with followers too, anything else only with selected users who can see it.
+func (s *Application) SharePost(ctx context.Context, req models.SharePostReq) (shareId int64, err error) {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return 0, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	audience := ds.IntendedAudience(req.Audience.String())
+	if audience == ds.IntendedAudienceGroup {
+		return 0, ce.New(ce.ErrInvalidArgument, fmt.Errorf("group audience on a share"), input).WithPublic("a post can't be shared to a group")
+	}
+
+	target, err := s.getShareTarget(ctx, req.PostId.Int64(), input)
+	if err != nil {
+		return 0, ce.Wrap(nil, err)
+	}
+	if target.IsRepost {
+		target, err = s.getShareTarget(ctx, target.SharedPostID, input)
+		if err != nil {
+			return 0, ce.Wrap(nil, err)
+		}
+	}
+
+	hasAccess, err := s.hasRightToView(ctx, accessContext{
+		requesterId: req.RequesterId.Int64(),
+		entityId:    target.ID,
+	})
+	if err != nil {
+		return 0, ce.Wrap(nil, err, input)
+	}
+	if !hasAccess || !target.Published {
+		return 0, ce.New(ce.ErrPermissionDenied, fmt.Errorf("user %v can't share post %v", req.RequesterId, target.ID), input).WithPublic("permission denied")
+	}
+
+	audienceIds := ct.Ids(req.AudienceIds).Unique()
+	if audience == ds.IntendedAudienceSelected && len(audienceIds) < 1 {
+		return 0, ce.New(ce.ErrInvalidArgument, fmt.Errorf("no audience given for share with audience=selected"), input).WithPublic(genericPublic)
+	}
+	if err := s.checkShareAudience(ctx, req.RequesterId.Int64(), target, audience, audienceIds); err != nil {
+		return 0, ce.Wrap(nil, err, input)
+	}
+
+	kind := ds.ShareKindRepost
+	if req.Body != "" {
+		kind = ds.ShareKindQuote
+	}
+
+	var mentions []models.Mention
+	if kind == ds.ShareKindQuote {
+		mentions, err = s.resolveMentions(ctx, req.Body.String())
+		if err != nil {
+			return 0, ce.Wrap(nil, err, input)
+		}
+	}
+
+	err = s.txRunner.RunTx(ctx, func(q *ds.Queries) error {
+		shareId, err = q.CreateSharedPost(ctx, ds.CreateSharedPostParams{
+			PostBody:     req.Body.String(),
+			CreatorID:    req.RequesterId.Int64(),
+			Audience:     audience,
+			SharedPostID: target.ID,
+			ShareKind:    kind,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ce.New(ce.ErrAlreadyExists, fmt.Errorf("user %v already reposted post %v", req.RequesterId, target.ID), input).WithPublic("post already reposted")
+		}
+		if err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+
+		if audience == ds.IntendedAudienceSelected {
+			rowsAffected, err := q.InsertPostAudience(ctx, ds.InsertPostAudienceParams{
+				PostID:         shareId,
+				AllowedUserIds: audienceIds.Int64(),
+			})
+			if err != nil {
+				return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+			}
+			if rowsAffected < int64(len(audienceIds)) {
+				return ce.New(ce.ErrInternal, fmt.Errorf("unexpected rows returned: expected %v, got %v", len(audienceIds), rowsAffected), input).WithPublic(genericPublic)
+			}
+		}
+
+		if len(mentions) > 0 {
+			if _, err := setMentions(ctx, q, shareId, mentions, input); err != nil {
+				return err
+			}
+		}
+
+		if tags := parseHashtags(req.Body.String()); len(tags) > 0 {
+			if err := setPostHashtags(ctx, q, shareId, tags, input); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, ce.Wrap(nil, err)
+	}
+
+	s.notifyPostShared(ctx, target.CreatorID, target.ID, shareId, req.RequesterId.Int64(), kind, req.Body.String())
+	s.notifyMentions(ctx, mentionSource{
+		authorId: req.RequesterId.Int64(),
+		postId:   shareId,
+		body:     req.Body.String(),
+		mentions: mentions,
+	})
+	return shareId, nil
+}
+
+// Deletes the requester's repost of a post, EntityId being the reposted post.
+// Quotes are deleted like any post.
+func (s *Application) UndoRepost(ctx context.Context, req models.GenericReq) error {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	rowsAffected, err := s.db.UndoRepost(ctx, ds.UndoRepostParams{
+		SharedPostID: req.EntityId.Int64(),
+		CreatorID:    req.RequesterId.Int64(),
+	})
+	if err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if rowsAffected == 0 {
+		return ce.New(ce.ErrNotFound, fmt.Errorf("user %v has no repost of post %v", req.RequesterId, req.EntityId), input).WithPublic("not found")
+	}
+	return nil
+}
+
+// NOT GRPC
+func (s *Application) getShareTarget(ctx context.Context, postId int64, input string) (ds.GetShareTargetRow, error) {
+	target, err := s.db.GetShareTarget(ctx, postId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return target, ce.New(ce.ErrNotFound, fmt.Errorf("post %v not found", postId), input).WithPublic("not found")
+	}
+	if err != nil {
+		return target, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	return target, nil
+}
+
+// NOT GRPC
+// checks that everyone in the audience of a share can see the shared post
+func (s *Application) checkShareAudience(ctx context.Context, requesterId int64, target ds.GetShareTargetRow, audience ds.IntendedAudience, audienceIds ct.Ids) error {
+	input := fmt.Sprintf("requester: %v, target: %#v, audience: %v, audienceIds: %v", requesterId, target, audience, audienceIds)
+
+	if target.IsPublic {
+		return nil
+	}
+	// the author's followers are the ones who see their followers-only posts
+	if audience == ds.IntendedAudienceFollowers && target.CreatorID == requesterId &&
+		target.GroupID == 0 && target.Audience == ds.IntendedAudienceFollowers {
+		return nil
+	}
+
+	denied := ce.New(ce.ErrPermissionDenied, fmt.Errorf("audience can't see post %v", target.ID), input).WithPublic("you can only share this post with people who can see it")
+	if audience != ds.IntendedAudienceSelected {
+		return denied
+	}
+	canSee, err := s.usersWhoCanViewPost(ctx, target.ID, target.CreatorID, target.GroupID, audienceIds)
+	if err != nil {
+		return ce.Wrap(nil, err, input)
+	}
+	if len(canSee) < len(audienceIds) {
+		return denied
+	}
+	return nil
+}
+
+// NOT GRPC
+// tells the author of a post it was reposted or quoted, reposts are aggregated
+func (s *Application) notifyPostShared(ctx context.Context, postCreatorId, postId, shareId, sharerId int64, kind ds.ShareKind, body string) {
+	if postCreatorId == sharerId {
+		return
+	}
+
+	sharer, err := s.userRetriever.GetUser(ctx, ct.Id(sharerId))
+	if err != nil {
+		tele.Error(ctx, "Could not get basic user info for id @1 for share event: @2", "userId", sharerId, "error", err.Error())
+	}
+
+	quote := kind == ds.ShareKindQuote
+	event := &notifpb.NotificationEvent{
+		EventType: notifpb.EventType_POST_SHARED,
+		Payload: &notifpb.NotificationEvent_PostShared{
+			PostShared: &notifpb.PostShared{
+				PostCreatorId:  postCreatorId,
+				PostId:         postId,
+				ShareId:        shareId,
+				SharerUserId:   sharerId,
+				SharerUsername: sharer.Username.String(),
+				Quote:          quote,
+				Body:           body,
+				Aggregate:      !quote,
+			},
+		},
+	}
+	if err := s.eventProducer.CreateAndSendNotificationEvent(ctx, event); err != nil {
+		tele.Error(ctx, "failed to send post shared notification: @1", "error", err.Error())
+	}
+}
+
+// NOT GRPC
+// sets the share counters of the given posts and returns the ids of the posts they share
+func (s *Application) attachShareCounters(ctx context.Context, requesterId int64, posts []models.Post) ct.Ids {
+	ids := make([]int64, 0, len(posts))
+	for _, p := range posts {
+		ids = append(ids, p.PostId.Int64())
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	rows, err := s.db.GetPostShares(ctx, ds.GetPostSharesParams{
+		UserID: requesterId,
+		Ids:    ids,
+	})
+	if err != nil {
+		tele.Error(ctx, "failed to get share counters for @1: @2", "postIds", ids, "error", err.Error())
+		return nil
+	}
+
+	shares := make(map[int64]ds.GetPostSharesRow, len(rows))
+	for _, r := range rows {
+		shares[r.ID] = r
+	}
+
+	sharedIds := ct.Ids{}
+	for i := range posts {
+		r, ok := shares[posts[i].PostId.Int64()]
+		if !ok {
+			continue
+		}
+		posts[i].SharedPostId = ct.Id(r.SharedPostID)
+		posts[i].RepostsCount = int(r.RepostsCount)
+		posts[i].QuotesCount = int(r.QuotesCount)
+		posts[i].RepostedByUser = r.RepostedByUser
+		if r.SharedPostID != 0 {
+			sharedIds = append(sharedIds, ct.Id(r.SharedPostID))
+		}
+	}
+	return sharedIds.Unique()
+}
+
+// NOT GRPC
+// sets the share counters of the given posts and embeds the post shared by each repost and quote
+// that the requester can see. Embedded posts don't embed further.
+func (s *Application) attachSharedPosts(ctx context.Context, requesterId int64, posts []models.Post) {
+	sharedIds := s.attachShareCounters(ctx, requesterId, posts)
+	if len(sharedIds) == 0 {
+		return
+	}
+
+	visibleIds, err := s.postsUserCanView(ctx, requesterId, sharedIds)
+	if err != nil {
+		tele.Error(ctx, "could not check which shared posts @1 user @2 can see: @3", "postIds", sharedIds, "userId", requesterId, "error", err.Error())
+		return
+	}
+	if len(visibleIds) == 0 {
+		return
+	}
+
+	rows, err := s.db.GetSharedPosts(ctx, ds.GetSharedPostsParams{
+		UserID: requesterId,
+		Ids:    visibleIds.Int64(),
+	})
+	if err != nil {
+		tele.Error(ctx, "failed to get shared posts @1: @2", "postIds", visibleIds, "error", err.Error())
+		return
+	}
+	if len(rows) == 0 {
+		return
+	}
+
+	shared := make([]models.Post, 0, len(rows))
+	userIDs := make(ct.Ids, 0, len(rows))
+	for _, r := range rows {
+		userIDs = append(userIDs, ct.Id(r.CreatorID))
+		shared = append(shared, models.Post{
+			PostId: ct.Id(r.ID),
+			Body:   ct.PostBody(r.PostBody),
+			User: models.User{
+				UserId: ct.Id(r.CreatorID),
+			},
+			GroupId:         ct.Id(r.GroupID),
+			Audience:        ct.Audience(r.Audience),
+			CommentsCount:   int(r.CommentsCount),
+			ReactionsCount:  int(r.ReactionsCount),
+			ReactionCounts:  reactionCounts(r.ReactionCounts),
+			LastCommentedAt: ct.GenDateTime(r.LastCommentedAt.Time),
+			CreatedAt:       ct.GenDateTime(r.CreatedAt.Time),
+			UpdatedAt:       ct.GenDateTime(r.UpdatedAt.Time),
+			Edited:          r.Edited,
+			LikedByUser:     r.LikedByUser,
+			UserReaction:    r.UserReaction,
+			ImageId:         coverImage(r.Images),
+			ImageIds:        ct.FromInt64s(r.Images),
+		})
+	}
+
+	userMap, err := s.userRetriever.GetUsers(ctx, userIDs.Unique())
+	if err != nil {
+		tele.Error(ctx, "failed to get users of shared posts @1: @2", "postIds", visibleIds, "error", err.Error())
+		return
+	}
+	for i := range shared {
+		if u, ok := userMap[shared[i].User.UserId]; ok {
+			shared[i].User = u
+		}
+	}
+	s.attachPostImages(ctx, shared)
+	s.attachPostMentions(ctx, shared)
+	s.attachShareCounters(ctx, requesterId, shared)
+
+	byId := make(map[ct.Id]*models.Post, len(shared))
+	for i := range shared {
+		byId[shared[i].PostId] = &shared[i]
+	}
+	for i := range posts {
+		if p, ok := byId[posts[i].SharedPostId]; ok {
+			posts[i].SharedPost = p
+		}
+	}
+}
+
+// NOT GRPC
+// reposts can't be edited, quotes keep the audience rules of SharePost
+func (s *Application) checkShareEdit(ctx context.Context, req models.EditPostReq, input string) error {
+	share, err := s.getShareTarget(ctx, req.PostId.Int64(), input)
+	if err != nil {
+		return ce.Wrap(nil, err)
+	}
+	if share.SharedPostID == 0 {
+		return nil
+	}
+	if share.IsRepost {
+		return ce.New(ce.ErrFailedPrecondition, fmt.Errorf("post %v is a repost", req.PostId), input).WithPublic("a repost can't be edited")
+	}
+
+	audience := ds.IntendedAudience(req.Audience.String())
+	if audience == ds.IntendedAudienceGroup {
+		return ce.New(ce.ErrInvalidArgument, fmt.Errorf("group audience on a share"), input).WithPublic("a post can't be shared to a group")
+	}
+
+	original, err := s.db.GetShareTarget(ctx, share.SharedPostID)
+	if errors.Is(err, pgx.ErrNoRows) { // deleted, nothing left to protect
+		return nil
+	}
+	if err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	return s.checkShareAudience(ctx, req.RequesterId.Int64(), original, audience, ct.Ids(req.AudienceIds).Unique())
+}
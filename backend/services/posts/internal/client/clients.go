@@ -39,6 +39,18 @@ func (c *Clients) IsFollowing(ctx context.Context, userId, targetUserId int64) (
 	return resp.Value, nil
 }
 
+func (c *Clients) CanInteract(ctx context.Context, actorId, targetId int64, action ct.PrivacyAction) (bool, error) {
+	resp, err := c.UserClient.CanInteract(ctx, &userpb.CanInteractRequest{
+		ActorId:  actorId,
+		TargetId: targetId,
+		Action:   action.String(),
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.Value, nil
+}
+
 func (c *Clients) IsGroupMember(ctx context.Context, userId, groupId int64) (bool, error) {
 	resp, err := c.UserClient.IsGroupMember(ctx, &userpb.GeneralGroupRequest{
 		GroupId: groupId,
@@ -50,6 +62,18 @@ func (c *Clients) IsGroupMember(ctx context.Context, userId, groupId int64) (boo
 	return resp.Value, nil
 }
 
+func (c *Clients) HasGroupPermission(ctx context.Context, userId, groupId int64, perm ct.GroupPermission) (bool, error) {
+	resp, err := c.UserClient.HasGroupPermission(ctx, &userpb.GroupPermissionRequest{
+		GroupId:    groupId,
+		UserId:     userId,
+		Permission: perm.String(),
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.Value, nil
+}
+
 // func (c *Clients) GetBatchBasicUserInfo(ctx context.Context, req *cm.UserIds) (*cm.ListUsers, error) {
 // 	resp, err := c.UserClient.GetBatchBasicUserInfo(ctx, req)
 // 	if err != nil {
@@ -85,6 +109,17 @@ func (c *Clients) GetFollowingIds(ctx context.Context, userId int64) ([]int64, e
 	return resp.Values, nil
 }
 
+func (c *Clients) GetFollowerIds(ctx context.Context, userId int64) ([]int64, error) {
+	req := &wrapperspb.Int64Value{Value: userId}
+
+	resp, err := c.UserClient.GetFollowerIds(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Values, nil
+}
+
 func (c *Clients) GetAllGroupMemberIds(ctx context.Context, groupId int64) ([]int64, error) {
 
 	resp, err := c.UserClient.GetAllGroupMemberIds(ctx, &userpb.IdReq{Id: groupId})
@@ -94,6 +129,29 @@ func (c *Clients) GetAllGroupMemberIds(ctx context.Context, groupId int64) ([]in
 	return resp.Ids, nil
 }
 
+func (c *Clients) GetUserGroupIds(ctx context.Context, userId int64) ([]int64, error) {
+	resp, err := c.UserClient.GetUserGroupIds(ctx, wrapperspb.Int64(userId))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Ids, nil
+}
+
+// deletes the given images with all their variants in media service
+func (c *Clients) DeleteImages(ctx context.Context, imageIds []int64) error {
+	_, err := c.MediaClient.DeleteImages(ctx, &mediapb.ImageIds{ImgIds: imageIds})
+	return err
+}
+
+// returns the id of the user with the given current or former username
+func (c *Clients) ResolveHandle(ctx context.Context, handle string) (int64, error) {
+	resp, err := c.UserClient.ResolveHandle(ctx, wrapperspb.String(handle))
+	if err != nil {
+		return 0, err
+	}
+	return resp.UserId, nil
+}
+
 func (c *Clients) CreateNewEvent(ctx context.Context, userId, groupId, eventId int64, groupName, eventTitle string) error {
 	req := &notifpb.CreateNewEventRequest{
 		UserId:     userId,
@@ -7,8 +7,8 @@ import (
 )
 
 const createComment = `-- name: CreateComment :one
-INSERT INTO comments (comment_creator_id, parent_id, comment_body)
-VALUES ($1, $2, $3)
+INSERT INTO comments (comment_creator_id, parent_id, comment_body, reply_to_id, depth)
+VALUES ($1, $2, $3, $4, $5)
 RETURNING id
 `
 
@@ -16,11 +16,20 @@ type CreateCommentParams struct {
 	CommentCreatorID int64
 	ParentID         int64
 	CommentBody      string
+	ReplyToID        pgtype.Int8
+	Depth            int16
 }
 
 // inserts a new comment and returns the id
+// reply_to_id and depth are null and 0 for top level comments
 func (q *Queries) CreateComment(ctx context.Context, arg CreateCommentParams) (int64, error) {
-	row := q.db.QueryRow(ctx, createComment, arg.CommentCreatorID, arg.ParentID, arg.CommentBody)
+	row := q.db.QueryRow(ctx, createComment,
+		arg.CommentCreatorID,
+		arg.ParentID,
+		arg.CommentBody,
+		arg.ReplyToID,
+		arg.Depth,
+	)
 	var id int64
 	err := row.Scan(&id)
 	return id, err
@@ -50,7 +59,11 @@ func (q *Queries) DeleteComment(ctx context.Context, arg DeleteCommentParams) (i
 
 const editComment = `-- name: EditComment :execrows
 UPDATE comments
-SET comment_body = $1
+SET comment_body = $1,
+    edited_at = CASE
+        WHEN comment_body <> $1 THEN CURRENT_TIMESTAMP
+        ELSE edited_at
+    END
 WHERE id = $2 AND comment_creator_id=$3 AND deleted_at IS NULL
 `
 
@@ -61,6 +74,7 @@ type EditCommentParams struct {
 }
 
 // updates the body of a comment with given id and creator id, as long as it's not marked deleted
+// marks the comment edited if the body changed
 // returns rows affected
 // 0 rows could mean no comment fitting the criteria was found, or it was already marked deleted
 func (q *Queries) EditComment(ctx context.Context, arg EditCommentParams) (int64, error) {
@@ -71,14 +85,53 @@ func (q *Queries) EditComment(ctx context.Context, arg EditCommentParams) (int64
 	return result.RowsAffected(), nil
 }
 
+const getCommentReplyTarget = `-- name: GetCommentReplyTarget :one
+SELECT
+    c.parent_id,
+    c.comment_creator_id,
+    c.comment_body,
+    c.depth
+FROM comments c
+WHERE c.id = $1
+  AND c.deleted_at IS NULL
+`
+
+type GetCommentReplyTargetRow struct {
+	ParentID         int64
+	CommentCreatorID int64
+	CommentBody      string
+	Depth            int16
+}
+
+// the comment a new reply would go under
+// returns no rows if the comment doesn't exist or is deleted
+func (q *Queries) GetCommentReplyTarget(ctx context.Context, id int64) (GetCommentReplyTargetRow, error) {
+	row := q.db.QueryRow(ctx, getCommentReplyTarget, id)
+	var i GetCommentReplyTargetRow
+	err := row.Scan(
+		&i.ParentID,
+		&i.CommentCreatorID,
+		&i.CommentBody,
+		&i.Depth,
+	)
+	return i, err
+}
+
 const getCommentsByPostId = `-- name: GetCommentsByPostId :many
 SELECT
     c.id,
     c.comment_creator_id,
-    c.comment_body,
+    c.parent_id,
+    CASE WHEN c.deleted_at IS NULL THEN c.comment_body ELSE '' END::text AS comment_body,
     c.reactions_count,
+    c.reaction_counts,
     c.created_at,
     c.updated_at,
+    c.edited_at IS NOT NULL AS edited,
+    COALESCE(c.reply_to_id, 0)::bigint AS reply_to_id,
+    c.depth,
+    c.replies_count,
+    c.deleted_at IS NOT NULL AS deleted,
 
     EXISTS (
         SELECT 1
@@ -88,11 +141,19 @@ SELECT
           AND r.deleted_at IS NULL
     ) AS liked_by_user,
 
+    COALESCE((   -- and with which reaction
+        SELECT r.reaction_type::text
+        FROM reactions r
+        WHERE r.content_id = c.id
+          AND r.user_id = $2
+          AND r.deleted_at IS NULL
+    ), '') AS user_reaction,
+
 
     COALESCE(
     (SELECT i.id
      FROM images i
-     WHERE i.parent_id = c.id AND i.deleted_at IS NULL
+     WHERE i.parent_id = c.id AND i.deleted_at IS NULL AND c.deleted_at IS NULL
      ORDER BY i.sort_order ASC
      LIMIT 1
     ), 0
@@ -100,9 +161,12 @@ SELECT
 )::bigint AS image
 
 FROM comments c
-WHERE c.parent_id = $1
-  AND c.deleted_at IS NULL
-ORDER BY c.created_at DESC 
+WHERE ((c.parent_id = $1 AND c.reply_to_id IS NULL) OR c.reply_to_id = $1)
+  AND (c.deleted_at IS NULL OR c.shown_replies_count > 0)
+  AND NOT EXISTS (SELECT 1 FROM deactivated_users du WHERE du.user_id = c.comment_creator_id)
+ORDER BY
+    CASE WHEN c.reply_to_id IS NULL THEN c.created_at END DESC,
+    c.created_at ASC
 OFFSET $3
 LIMIT $4
 `
@@ -117,15 +181,25 @@ type GetCommentsByPostIdParams struct {
 type GetCommentsByPostIdRow struct {
 	ID               int64
 	CommentCreatorID int64
+	ParentID         int64
 	CommentBody      string
 	ReactionsCount   int32
+	ReactionCounts   map[string]int32
 	CreatedAt        pgtype.Timestamptz
 	UpdatedAt        pgtype.Timestamptz
+	Edited           bool
+	ReplyToID        int64
+	Depth            int16
+	RepliesCount     int32
+	Deleted          bool
 	LikedByUser      bool
+	UserReaction     string
 	Image            int64
 }
 
-// returns paginated comments of post with given id, in descending created order
+// returns paginated top level comments of post with given id, in descending created order,
+// or the direct replies of comment with given id, in ascending created order
+// deleted comments are kept with an empty body as long as a reply under them is live
 func (q *Queries) GetCommentsByPostId(ctx context.Context, arg GetCommentsByPostIdParams) ([]GetCommentsByPostIdRow, error) {
 	rows, err := q.db.Query(ctx, getCommentsByPostId,
 		arg.ParentID,
@@ -143,11 +217,19 @@ func (q *Queries) GetCommentsByPostId(ctx context.Context, arg GetCommentsByPost
 		if err := rows.Scan(
 			&i.ID,
 			&i.CommentCreatorID,
+			&i.ParentID,
 			&i.CommentBody,
 			&i.ReactionsCount,
+			&i.ReactionCounts,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Edited,
+			&i.ReplyToID,
+			&i.Depth,
+			&i.RepliesCount,
+			&i.Deleted,
 			&i.LikedByUser,
+			&i.UserReaction,
 			&i.Image,
 		); err != nil {
 			return nil, err
@@ -167,8 +249,10 @@ SELECT
     c.parent_id,
     c.comment_body,
     c.reactions_count,
+    c.reaction_counts,
     c.created_at,
     c.updated_at,
+    c.edited_at IS NOT NULL AS edited,
 
     EXISTS (
         SELECT 1 FROM reactions r
@@ -177,6 +261,14 @@ SELECT
           AND r.deleted_at IS NULL
     ) AS liked_by_user,
 
+    COALESCE((   -- and with which reaction
+        SELECT r.reaction_type::text
+        FROM reactions r
+        WHERE r.content_id = c.id
+          AND r.user_id = $2
+          AND r.deleted_at IS NULL
+    ), '') AS user_reaction,
+
 
     COALESCE(
     (SELECT i.id
@@ -192,6 +284,7 @@ SELECT
 FROM comments c
 WHERE c.parent_id = $1
   AND c.deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM deactivated_users du WHERE du.user_id = c.comment_creator_id)
 ORDER BY c.created_at DESC
 LIMIT 1
 `
@@ -207,9 +300,12 @@ type GetLatestCommentforPostIdRow struct {
 	ParentID         int64
 	CommentBody      string
 	ReactionsCount   int32
+	ReactionCounts   map[string]int32
 	CreatedAt        pgtype.Timestamptz
 	UpdatedAt        pgtype.Timestamptz
+	Edited           bool
 	LikedByUser      bool
+	UserReaction     string
 	Image            int64
 }
 
@@ -222,9 +318,12 @@ func (q *Queries) GetLatestCommentforPostId(ctx context.Context, arg GetLatestCo
 		&i.ParentID,
 		&i.CommentBody,
 		&i.ReactionsCount,
+		&i.ReactionCounts,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Edited,
 		&i.LikedByUser,
+		&i.UserReaction,
 		&i.Image,
 	)
 	return i, err
@@ -0,0 +1,69 @@
+package dbservice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeletedCommentsHoldingReplies(t *testing.T) {
+	q, teardown := setupTestDB(t)
+	defer teardown()
+	ctx := context.Background()
+
+	const userId = 1
+	postId, err := q.CreatePost(ctx, CreatePostParams{
+		PostBody:       "thread",
+		CreatorID:      userId,
+		Audience:       IntendedAudienceEveryone,
+		ApprovalStatus: PostApprovalStatusApproved,
+	})
+	require.NoError(t, err)
+
+	comment := func(replyTo int64, depth int16) int64 {
+		id, err := q.CreateComment(ctx, CreateCommentParams{
+			CommentCreatorID: userId,
+			ParentID:         postId,
+			CommentBody:      "comment",
+			ReplyToID:        pgtype.Int8{Int64: replyTo, Valid: replyTo != 0},
+			Depth:            depth,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	remove := func(id int64) {
+		rows, err := q.DeleteComment(ctx, DeleteCommentParams{ID: id, CommentCreatorID: userId})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, rows)
+	}
+	listed := func(parentId int64) []int64 {
+		rows, err := q.GetCommentsByPostId(ctx, GetCommentsByPostIdParams{
+			ParentID: parentId,
+			UserID:   userId,
+			Limit:    10,
+		})
+		require.NoError(t, err)
+		ids := []int64{}
+		for _, r := range rows {
+			ids = append(ids, r.ID)
+		}
+		return ids
+	}
+
+	// A -> B -> C
+	a := comment(0, 0)
+	b := comment(a, 1)
+	c := comment(b, 2)
+
+	remove(b)
+	remove(a)
+	require.Equal(t, []int64{a}, listed(postId), "A holds live C through deleted B")
+	require.Equal(t, []int64{b}, listed(a), "B holds live C")
+	require.Equal(t, []int64{c}, listed(b))
+
+	remove(c)
+	require.Empty(t, listed(postId), "nothing live is left under A")
+	require.Empty(t, listed(a))
+}
@@ -0,0 +1,26 @@
+package dbservice
+
+import (
+	"context"
+)
+
+const insertDeactivatedUser = `-- name: InsertDeactivatedUser :exec
+INSERT INTO deactivated_users (user_id)
+VALUES ($1)
+ON CONFLICT (user_id) DO NOTHING
+`
+
+func (q *Queries) InsertDeactivatedUser(ctx context.Context, userID int64) error {
+	_, err := q.db.Exec(ctx, insertDeactivatedUser, userID)
+	return err
+}
+
+const deleteDeactivatedUser = `-- name: DeleteDeactivatedUser :exec
+DELETE FROM deactivated_users
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteDeactivatedUser(ctx context.Context, userID int64) error {
+	_, err := q.db.Exec(ctx, deleteDeactivatedUser, userID)
+	return err
+}
@@ -0,0 +1,240 @@
+package dbservice
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createDraft = `-- name: CreateDraft :one
+INSERT INTO drafts (
+    creator_id,
+    kind,
+    body,
+    parent_id,
+    group_id,
+    audience,
+    audience_ids,
+    image_ids,
+    expires_at
+)
+SELECT $1, $2, $3, $4, $5, $6, $7::bigint[], $8::bigint[], $9
+WHERE (SELECT COUNT(*) FROM drafts d WHERE d.creator_id = $1) < $10::bigint
+RETURNING id
+`
+
+type CreateDraftParams struct {
+	CreatorID   int64
+	Kind        DraftKind
+	Body        string
+	ParentID    pgtype.Int8
+	GroupID     pgtype.Int8
+	Audience    NullIntendedAudience
+	AudienceIds []int64
+	ImageIds    []int64
+	ExpiresAt   pgtype.Timestamptz
+	MaxDrafts   int64
+}
+
+// inserts a draft unless the creator already has max_drafts of them
+// returns no rows if the limit is reached
+func (q *Queries) CreateDraft(ctx context.Context, arg CreateDraftParams) (int64, error) {
+	row := q.db.QueryRow(ctx, createDraft,
+		arg.CreatorID,
+		arg.Kind,
+		arg.Body,
+		arg.ParentID,
+		arg.GroupID,
+		arg.Audience,
+		arg.AudienceIds,
+		arg.ImageIds,
+		arg.ExpiresAt,
+		arg.MaxDrafts,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const deleteDraft = `-- name: DeleteDraft :one
+DELETE FROM drafts
+WHERE id = $1
+  AND creator_id = $2
+RETURNING image_ids
+`
+
+type DeleteDraftParams struct {
+	ID        int64
+	CreatorID int64
+}
+
+// returns the images the draft held
+// returns no rows if the draft doesn't exist or isn't owned by the creator
+func (q *Queries) DeleteDraft(ctx context.Context, arg DeleteDraftParams) ([]int64, error) {
+	row := q.db.QueryRow(ctx, deleteDraft, arg.ID, arg.CreatorID)
+	var image_ids []int64
+	err := row.Scan(&image_ids)
+	return image_ids, err
+}
+
+const deleteExpiredDrafts = `-- name: DeleteExpiredDrafts :many
+DELETE FROM drafts
+WHERE expires_at <= CURRENT_TIMESTAMP
+RETURNING id, image_ids
+`
+
+type DeleteExpiredDraftsRow struct {
+	ID       int64
+	ImageIds []int64
+}
+
+// drops drafts that weren't saved again before expiring
+// returns the images they held, the images themselves are left to the caller
+func (q *Queries) DeleteExpiredDrafts(ctx context.Context) ([]DeleteExpiredDraftsRow, error) {
+	rows, err := q.db.Query(ctx, deleteExpiredDrafts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []DeleteExpiredDraftsRow{}
+	for rows.Next() {
+		var i DeleteExpiredDraftsRow
+		if err := rows.Scan(&i.ID, &i.ImageIds); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDraft = `-- name: GetDraft :one
+SELECT id, creator_id, kind, body, parent_id, group_id, audience, audience_ids, image_ids, created_at, updated_at, expires_at
+FROM drafts
+WHERE id = $1
+  AND creator_id = $2
+  AND expires_at > CURRENT_TIMESTAMP
+`
+
+type GetDraftParams struct {
+	ID        int64
+	CreatorID int64
+}
+
+// returns no rows if the draft doesn't exist, isn't owned by the creator or has expired
+func (q *Queries) GetDraft(ctx context.Context, arg GetDraftParams) (Draft, error) {
+	row := q.db.QueryRow(ctx, getDraft, arg.ID, arg.CreatorID)
+	var i Draft
+	err := row.Scan(
+		&i.ID,
+		&i.CreatorID,
+		&i.Kind,
+		&i.Body,
+		&i.ParentID,
+		&i.GroupID,
+		&i.Audience,
+		&i.AudienceIds,
+		&i.ImageIds,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const getDrafts = `-- name: GetDrafts :many
+SELECT id, creator_id, kind, body, parent_id, group_id, audience, audience_ids, image_ids, created_at, updated_at, expires_at
+FROM drafts
+WHERE creator_id = $1
+  AND expires_at > CURRENT_TIMESTAMP
+ORDER BY updated_at DESC, id DESC
+LIMIT $2 OFFSET $3
+`
+
+type GetDraftsParams struct {
+	CreatorID int64
+	Limit     int32
+	Offset    int32
+}
+
+// drafts of the creator that haven't expired, most recently saved first
+func (q *Queries) GetDrafts(ctx context.Context, arg GetDraftsParams) ([]Draft, error) {
+	rows, err := q.db.Query(ctx, getDrafts, arg.CreatorID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Draft{}
+	for rows.Next() {
+		var i Draft
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatorID,
+			&i.Kind,
+			&i.Body,
+			&i.ParentID,
+			&i.GroupID,
+			&i.Audience,
+			&i.AudienceIds,
+			&i.ImageIds,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateDraft = `-- name: UpdateDraft :one
+UPDATE drafts d
+SET body = $3,
+    group_id = $4,
+    audience = $5,
+    audience_ids = $6::bigint[],
+    image_ids = $7::bigint[],
+    expires_at = $8
+FROM drafts old
+WHERE d.id = $1
+  AND d.creator_id = $2
+  AND d.expires_at > CURRENT_TIMESTAMP
+  AND old.id = d.id
+RETURNING old.image_ids
+`
+
+type UpdateDraftParams struct {
+	ID          int64
+	CreatorID   int64
+	Body        string
+	GroupID     pgtype.Int8
+	Audience    NullIntendedAudience
+	AudienceIds []int64
+	ImageIds    []int64
+	ExpiresAt   pgtype.Timestamptz
+}
+
+// replaces the content of a draft and pushes back its expiry
+// returns the images the draft held before the update
+// returns no rows if the draft doesn't exist, isn't owned by the creator or has expired
+func (q *Queries) UpdateDraft(ctx context.Context, arg UpdateDraftParams) ([]int64, error) {
+	row := q.db.QueryRow(ctx, updateDraft,
+		arg.ID,
+		arg.CreatorID,
+		arg.Body,
+		arg.GroupID,
+		arg.Audience,
+		arg.AudienceIds,
+		arg.ImageIds,
+		arg.ExpiresAt,
+	)
+	var image_ids []int64
+	err := row.Scan(&image_ids)
+	return image_ids, err
+}
@@ -162,6 +162,8 @@ LEFT JOIN event_responses er
 
 WHERE e.group_id = $1
   AND e.deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM deactivated_users du WHERE du.user_id = e.event_creator_id)
+  AND NOT EXISTS (SELECT 1 FROM deleted_groups dg WHERE dg.group_id = e.group_id)
   AND e.event_date >= CURRENT_DATE
 
 ORDER BY e.event_date DESC
@@ -15,9 +15,14 @@ SELECT
     p.audience,
     p.comments_count,
     p.reactions_count,
+    p.reaction_counts,
     p.last_commented_at,
     p.created_at,
     p.updated_at,
+    p.edited_at IS NOT NULL AS edited,
+    p.pinned_at IS NOT NULL AS pinned,
+    p.is_announcement,
+    p.approval_status,
 
     EXISTS (     -- Has the given user liked the post?
         SELECT 1
@@ -26,15 +31,21 @@ SELECT
           AND r.user_id = $2              -- requesting user (check is member from users service)
           AND r.deleted_at IS NULL
     ) AS liked_by_user,
+
+    COALESCE((   -- and with which reaction
+        SELECT r.reaction_type::text
+        FROM reactions r
+        WHERE r.content_id = p.id
+          AND r.user_id = $2
+          AND r.deleted_at IS NULL
+    ), '') AS user_reaction,
    
 COALESCE(
-    (SELECT i.id
+    (SELECT array_agg(i.id ORDER BY i.sort_order ASC, i.id ASC)
      FROM images i
      WHERE i.parent_id = p.id AND i.deleted_at IS NULL
-     ORDER BY i.sort_order ASC
-     LIMIT 1
-    ), 0
-)::bigint AS image  
+    ), ARRAY[]::bigint[]
+)::bigint[] AS images  
 
   
 FROM posts p
@@ -43,8 +54,16 @@ FROM posts p
 
 WHERE p.group_id = $1                    -- group id filter
   AND p.deleted_at IS NULL
+  AND p.publish_at IS NULL                -- scheduled posts stay hidden until published
+  AND (
+        p.approval_status = 'approved'
+     OR (p.approval_status = 'pending' AND p.creator_id = $2) -- requester's own posts awaiting approval
+      )
+  AND NOT EXISTS (SELECT 1 FROM deactivated_users du WHERE du.user_id = p.creator_id) -- hide deactivated creators
+  AND NOT EXISTS (SELECT 1 FROM deleted_groups dg WHERE dg.group_id = p.group_id) -- hide deleted groups
 GROUP BY p.id
-ORDER BY p.created_at DESC               -- newest first
+ORDER BY p.pinned_at DESC NULLS LAST,   -- pinned first, most recently pinned on top
+         p.created_at DESC               -- then newest first
 LIMIT $3 OFFSET $4
 `
 
@@ -63,11 +82,17 @@ type GetGroupPostsPaginatedRow struct {
 	Audience        IntendedAudience
 	CommentsCount   int32
 	ReactionsCount  int32
+	ReactionCounts  map[string]int32
 	LastCommentedAt pgtype.Timestamptz
 	CreatedAt       pgtype.Timestamptz
 	UpdatedAt       pgtype.Timestamptz
+	Edited          bool
+	Pinned          bool
+	IsAnnouncement  bool
+	ApprovalStatus  PostApprovalStatus
 	LikedByUser     bool
-	Image           int64
+	UserReaction    string
+	Images          []int64
 }
 
 func (q *Queries) GetGroupPostsPaginated(ctx context.Context, arg GetGroupPostsPaginatedParams) ([]GetGroupPostsPaginatedRow, error) {
@@ -92,11 +117,17 @@ func (q *Queries) GetGroupPostsPaginated(ctx context.Context, arg GetGroupPostsP
 			&i.Audience,
 			&i.CommentsCount,
 			&i.ReactionsCount,
+			&i.ReactionCounts,
 			&i.LastCommentedAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Edited,
+			&i.Pinned,
+			&i.IsAnnouncement,
+			&i.ApprovalStatus,
 			&i.LikedByUser,
-			&i.Image,
+			&i.UserReaction,
+			&i.Images,
 		); err != nil {
 			return nil, err
 		}
@@ -115,9 +146,11 @@ SELECT
     p.creator_id,
     p.comments_count,
     p.reactions_count,
+    p.reaction_counts,
     p.last_commented_at,
     p.created_at,
     p.updated_at,
+    p.edited_at IS NOT NULL AS edited,
 
     -- did user like it?
     EXISTS (
@@ -127,21 +160,29 @@ SELECT
           AND r.deleted_at IS NULL
     ) AS liked_by_user,
 
+    COALESCE((   -- and with which reaction
+        SELECT r.reaction_type::text
+        FROM reactions r
+        WHERE r.content_id = p.id
+          AND r.user_id = $1
+          AND r.deleted_at IS NULL
+    ), '') AS user_reaction,
+
     -- image
 COALESCE(
-    (SELECT i.id
+    (SELECT array_agg(i.id ORDER BY i.sort_order ASC, i.id ASC)
      FROM images i
      WHERE i.parent_id = p.id AND i.deleted_at IS NULL
-     ORDER BY i.sort_order ASC
-     LIMIT 1
-    ), 0
-)::bigint AS image   
+    ), ARRAY[]::bigint[]
+)::bigint[] AS images   
 
    FROM posts p
 
 
 
 WHERE p.deleted_at IS NULL
+  AND p.publish_at IS NULL                -- scheduled posts stay hidden until published
+  AND NOT EXISTS (SELECT 1 FROM deactivated_users du WHERE du.user_id = p.creator_id) -- hide deactivated creators
   AND (
        -- SELECTED audience → only manually approved viewers
        (p.audience = 'selected' AND EXISTS (
@@ -169,11 +210,14 @@ type GetPersonalizedFeedRow struct {
 	CreatorID       int64
 	CommentsCount   int32
 	ReactionsCount  int32
+	ReactionCounts  map[string]int32
 	LastCommentedAt pgtype.Timestamptz
 	CreatedAt       pgtype.Timestamptz
 	UpdatedAt       pgtype.Timestamptz
+	Edited          bool
 	LikedByUser     bool
-	Image           int64
+	UserReaction    string
+	Images          []int64
 }
 
 func (q *Queries) GetPersonalizedFeed(ctx context.Context, arg GetPersonalizedFeedParams) ([]GetPersonalizedFeedRow, error) {
@@ -196,11 +240,14 @@ func (q *Queries) GetPersonalizedFeed(ctx context.Context, arg GetPersonalizedFe
 			&i.CreatorID,
 			&i.CommentsCount,
 			&i.ReactionsCount,
+			&i.ReactionCounts,
 			&i.LastCommentedAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Edited,
 			&i.LikedByUser,
-			&i.Image,
+			&i.UserReaction,
+			&i.Images,
 		); err != nil {
 			return nil, err
 		}
@@ -219,9 +266,11 @@ SELECT
     p.creator_id,
     p.comments_count,
     p.reactions_count,
+    p.reaction_counts,
     p.last_commented_at,
     p.created_at,
     p.updated_at,
+    p.edited_at IS NOT NULL AS edited,
 
     EXISTS (
         SELECT 1 FROM reactions r
@@ -230,20 +279,28 @@ SELECT
           AND r.deleted_at IS NULL
     ) AS liked_by_user,
 
+    COALESCE((   -- and with which reaction
+        SELECT r.reaction_type::text
+        FROM reactions r
+        WHERE r.content_id = p.id
+          AND r.user_id = $1
+          AND r.deleted_at IS NULL
+    ), '') AS user_reaction,
+
 COALESCE(
-    (SELECT i.id
+    (SELECT array_agg(i.id ORDER BY i.sort_order ASC, i.id ASC)
      FROM images i
      WHERE i.parent_id = p.id AND i.deleted_at IS NULL
-     ORDER BY i.sort_order ASC
-     LIMIT 1
-    ), 0
-)::bigint AS image
+    ), ARRAY[]::bigint[]
+)::bigint[] AS images
 
    
 FROM posts p
 
 
 WHERE p.deleted_at IS NULL
+  AND p.publish_at IS NULL                -- scheduled posts stay hidden until published
+  AND NOT EXISTS (SELECT 1 FROM deactivated_users du WHERE du.user_id = p.creator_id) -- hide deactivated creators
   AND p.audience = 'everyone'
 ORDER BY p.created_at DESC
 OFFSET $2 LIMIT $3
@@ -261,11 +318,14 @@ type GetPublicFeedRow struct {
 	CreatorID       int64
 	CommentsCount   int32
 	ReactionsCount  int32
+	ReactionCounts  map[string]int32
 	LastCommentedAt pgtype.Timestamptz
 	CreatedAt       pgtype.Timestamptz
 	UpdatedAt       pgtype.Timestamptz
+	Edited          bool
 	LikedByUser     bool
-	Image           int64
+	UserReaction    string
+	Images          []int64
 }
 
 func (q *Queries) GetPublicFeed(ctx context.Context, arg GetPublicFeedParams) ([]GetPublicFeedRow, error) {
@@ -283,11 +343,14 @@ func (q *Queries) GetPublicFeed(ctx context.Context, arg GetPublicFeedParams) ([
 			&i.CreatorID,
 			&i.CommentsCount,
 			&i.ReactionsCount,
+			&i.ReactionCounts,
 			&i.LastCommentedAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Edited,
 			&i.LikedByUser,
-			&i.Image,
+			&i.UserReaction,
+			&i.Images,
 		); err != nil {
 			return nil, err
 		}
@@ -307,9 +370,11 @@ SELECT
     p.creator_id,
     p.comments_count,
     p.reactions_count,
+    p.reaction_counts,
     p.last_commented_at,
     p.created_at,
     p.updated_at,
+    p.edited_at IS NOT NULL AS edited,
 
     EXISTS (    -- Has the requesting user liked the post?
         SELECT 1 FROM reactions r
@@ -318,14 +383,20 @@ SELECT
           AND r.deleted_at IS NULL
     ) AS liked_by_user,
 
+    COALESCE((   -- and with which reaction
+        SELECT r.reaction_type::text
+        FROM reactions r
+        WHERE r.content_id = p.id
+          AND r.user_id = $2
+          AND r.deleted_at IS NULL
+    ), '') AS user_reaction,
+
 COALESCE(
-    (SELECT i.id
+    (SELECT array_agg(i.id ORDER BY i.sort_order ASC, i.id ASC)
      FROM images i
      WHERE i.parent_id = p.id AND i.deleted_at IS NULL
-     ORDER BY i.sort_order ASC
-     LIMIT 1
-    ), 0
-)::bigint AS image   
+    ), ARRAY[]::bigint[]
+)::bigint[] AS images   
 
   
 FROM posts p
@@ -335,6 +406,8 @@ FROM posts p
 WHERE p.creator_id = $1                      -- target user we are viewing
   AND p.group_id IS NULL                     -- exclude group posts
   AND p.deleted_at IS NULL
+  AND p.publish_at IS NULL                -- scheduled posts stay hidden until published
+  AND NOT EXISTS (SELECT 1 FROM deactivated_users du WHERE du.user_id = p.creator_id) -- hide deactivated creators
 
   AND (                    
         p.creator_id = $2    -- If viewer *is* the creator — show all posts                
@@ -370,11 +443,14 @@ type GetUserPostsPaginatedRow struct {
 	CreatorID       int64
 	CommentsCount   int32
 	ReactionsCount  int32
+	ReactionCounts  map[string]int32
 	LastCommentedAt pgtype.Timestamptz
 	CreatedAt       pgtype.Timestamptz
 	UpdatedAt       pgtype.Timestamptz
+	Edited          bool
 	LikedByUser     bool
-	Image           int64
+	UserReaction    string
+	Images          []int64
 }
 
 // pagination
@@ -399,11 +475,14 @@ func (q *Queries) GetUserPostsPaginated(ctx context.Context, arg GetUserPostsPag
 			&i.CreatorID,
 			&i.CommentsCount,
 			&i.ReactionsCount,
+			&i.ReactionCounts,
 			&i.LastCommentedAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Edited,
 			&i.LikedByUser,
-			&i.Image,
+			&i.UserReaction,
+			&i.Images,
 		); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,220 @@
+package dbservice
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getGroupDailyPostCounts = `-- name: GetGroupDailyPostCounts :many
+SELECT
+    date_trunc('day', created_at AT TIME ZONE 'UTC')::date AS day,
+    COUNT(*) AS count
+FROM posts
+WHERE group_id = $1::bigint
+  AND created_at >= $2
+  AND deleted_at IS NULL
+  AND approval_status = 'approved'
+  AND publish_at IS NULL
+GROUP BY day
+ORDER BY day ASC
+`
+
+type GetGroupDailyPostCountsParams struct {
+	GroupID int64
+	Since   pgtype.Timestamptz
+}
+
+type GetGroupDailyPostCountsRow struct {
+	Day   pgtype.Date
+	Count int64
+}
+
+// posts created in a group per UTC day, days without posts are missing
+func (q *Queries) GetGroupDailyPostCounts(ctx context.Context, arg GetGroupDailyPostCountsParams) ([]GetGroupDailyPostCountsRow, error) {
+	rows, err := q.db.Query(ctx, getGroupDailyPostCounts, arg.GroupID, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetGroupDailyPostCountsRow{}
+	for rows.Next() {
+		var i GetGroupDailyPostCountsRow
+		if err := rows.Scan(&i.Day, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGroupDailyCommentCounts = `-- name: GetGroupDailyCommentCounts :many
+SELECT
+    date_trunc('day', c.created_at AT TIME ZONE 'UTC')::date AS day,
+    COUNT(*) AS count
+FROM comments c
+JOIN posts p
+  ON p.id = c.parent_id
+WHERE p.group_id = $1::bigint
+  AND c.created_at >= $2
+  AND c.deleted_at IS NULL
+  AND p.deleted_at IS NULL
+  AND p.approval_status = 'approved'
+  AND p.publish_at IS NULL
+GROUP BY day
+ORDER BY day ASC
+`
+
+type GetGroupDailyCommentCountsParams struct {
+	GroupID int64
+	Since   pgtype.Timestamptz
+}
+
+type GetGroupDailyCommentCountsRow struct {
+	Day   pgtype.Date
+	Count int64
+}
+
+// comments on a group's posts per UTC day, days without comments are missing
+func (q *Queries) GetGroupDailyCommentCounts(ctx context.Context, arg GetGroupDailyCommentCountsParams) ([]GetGroupDailyCommentCountsRow, error) {
+	rows, err := q.db.Query(ctx, getGroupDailyCommentCounts, arg.GroupID, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetGroupDailyCommentCountsRow{}
+	for rows.Next() {
+		var i GetGroupDailyCommentCountsRow
+		if err := rows.Scan(&i.Day, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGroupActivePosters = `-- name: GetGroupActivePosters :many
+WITH activity AS (
+    SELECT p.creator_id AS user_id, 1 AS is_post
+    FROM posts p
+    WHERE p.group_id = $1::bigint
+      AND p.created_at >= $2
+      AND p.deleted_at IS NULL
+      AND p.approval_status = 'approved'
+      AND p.publish_at IS NULL
+
+    UNION ALL
+
+    SELECT c.comment_creator_id AS user_id, 0 AS is_post
+    FROM comments c
+    JOIN posts p
+      ON p.id = c.parent_id
+    WHERE p.group_id = $1::bigint
+      AND c.created_at >= $2
+      AND c.deleted_at IS NULL
+      AND p.deleted_at IS NULL
+      AND p.approval_status = 'approved'
+      AND p.publish_at IS NULL
+)
+SELECT
+    user_id,
+    SUM(is_post)::bigint     AS post_count,
+    SUM(1 - is_post)::bigint AS comment_count
+FROM activity
+GROUP BY user_id
+ORDER BY post_count DESC, comment_count DESC, user_id ASC
+LIMIT $3
+`
+
+type GetGroupActivePostersParams struct {
+	GroupID int64
+	Since   pgtype.Timestamptz
+	Limit   int32
+}
+
+type GetGroupActivePostersRow struct {
+	UserID       int64
+	PostCount    int64
+	CommentCount int64
+}
+
+// members with the most posts (then comments) in a group since the given time
+func (q *Queries) GetGroupActivePosters(ctx context.Context, arg GetGroupActivePostersParams) ([]GetGroupActivePostersRow, error) {
+	rows, err := q.db.Query(ctx, getGroupActivePosters, arg.GroupID, arg.Since, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetGroupActivePostersRow{}
+	for rows.Next() {
+		var i GetGroupActivePostersRow
+		if err := rows.Scan(&i.UserID, &i.PostCount, &i.CommentCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGroupEventAttendance = `-- name: GetGroupEventAttendance :many
+SELECT
+    id,
+    event_title,
+    event_date,
+    going_count,
+    not_going_count
+FROM events
+WHERE group_id = $1
+  AND event_date >= $2::date
+  AND deleted_at IS NULL
+ORDER BY event_date ASC, id ASC
+`
+
+type GetGroupEventAttendanceParams struct {
+	GroupID int64
+	Since   pgtype.Date
+}
+
+type GetGroupEventAttendanceRow struct {
+	ID            int64
+	EventTitle    string
+	EventDate     pgtype.Date
+	GoingCount    int32
+	NotGoingCount int32
+}
+
+// events of a group taking place on or after the given date, with their response counts
+func (q *Queries) GetGroupEventAttendance(ctx context.Context, arg GetGroupEventAttendanceParams) ([]GetGroupEventAttendanceRow, error) {
+	rows, err := q.db.Query(ctx, getGroupEventAttendance, arg.GroupID, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetGroupEventAttendanceRow{}
+	for rows.Next() {
+		var i GetGroupEventAttendanceRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventTitle,
+			&i.EventDate,
+			&i.GoingCount,
+			&i.NotGoingCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
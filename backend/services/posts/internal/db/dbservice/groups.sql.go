@@ -0,0 +1,168 @@
+package dbservice
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const insertArchivedGroup = `-- name: InsertArchivedGroup :exec
+INSERT INTO archived_groups (group_id)
+VALUES ($1)
+ON CONFLICT (group_id) DO NOTHING
+`
+
+func (q *Queries) InsertArchivedGroup(ctx context.Context, groupID int64) error {
+	_, err := q.db.Exec(ctx, insertArchivedGroup, groupID)
+	return err
+}
+
+const deleteArchivedGroup = `-- name: DeleteArchivedGroup :exec
+DELETE FROM archived_groups
+WHERE group_id = $1
+`
+
+func (q *Queries) DeleteArchivedGroup(ctx context.Context, groupID int64) error {
+	_, err := q.db.Exec(ctx, deleteArchivedGroup, groupID)
+	return err
+}
+
+const isGroupArchived = `-- name: IsGroupArchived :one
+SELECT EXISTS (
+    SELECT 1 FROM archived_groups
+    WHERE group_id = $1
+)
+`
+
+func (q *Queries) IsGroupArchived(ctx context.Context, groupID int64) (bool, error) {
+	row := q.db.QueryRow(ctx, isGroupArchived, groupID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const insertPublicGroup = `-- name: InsertPublicGroup :exec
+INSERT INTO public_groups (group_id)
+VALUES ($1)
+ON CONFLICT (group_id) DO NOTHING
+`
+
+func (q *Queries) InsertPublicGroup(ctx context.Context, groupID int64) error {
+	_, err := q.db.Exec(ctx, insertPublicGroup, groupID)
+	return err
+}
+
+const deletePublicGroup = `-- name: DeletePublicGroup :exec
+DELETE FROM public_groups
+WHERE group_id = $1
+`
+
+func (q *Queries) DeletePublicGroup(ctx context.Context, groupID int64) error {
+	_, err := q.db.Exec(ctx, deletePublicGroup, groupID)
+	return err
+}
+
+const isGroupPublic = `-- name: IsGroupPublic :one
+SELECT EXISTS (
+    SELECT 1 FROM public_groups
+    WHERE group_id = $1
+)
+`
+
+func (q *Queries) IsGroupPublic(ctx context.Context, groupID int64) (bool, error) {
+	row := q.db.QueryRow(ctx, isGroupPublic, groupID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const insertModeratedGroup = `-- name: InsertModeratedGroup :exec
+INSERT INTO moderated_groups (group_id)
+VALUES ($1)
+ON CONFLICT (group_id) DO NOTHING
+`
+
+func (q *Queries) InsertModeratedGroup(ctx context.Context, groupID int64) error {
+	_, err := q.db.Exec(ctx, insertModeratedGroup, groupID)
+	return err
+}
+
+const deleteModeratedGroup = `-- name: DeleteModeratedGroup :exec
+DELETE FROM moderated_groups
+WHERE group_id = $1
+`
+
+func (q *Queries) DeleteModeratedGroup(ctx context.Context, groupID int64) error {
+	_, err := q.db.Exec(ctx, deleteModeratedGroup, groupID)
+	return err
+}
+
+const isGroupModerated = `-- name: IsGroupModerated :one
+SELECT EXISTS (
+    SELECT 1 FROM moderated_groups
+    WHERE group_id = $1
+)
+`
+
+func (q *Queries) IsGroupModerated(ctx context.Context, groupID int64) (bool, error) {
+	row := q.db.QueryRow(ctx, isGroupModerated, groupID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const insertDeletedGroup = `-- name: InsertDeletedGroup :exec
+INSERT INTO deleted_groups (group_id)
+VALUES ($1)
+ON CONFLICT (group_id) DO NOTHING
+`
+
+// hides every post and event of the group
+func (q *Queries) InsertDeletedGroup(ctx context.Context, groupID int64) error {
+	_, err := q.db.Exec(ctx, insertDeletedGroup, groupID)
+	return err
+}
+
+const countRecentGroupPosts = `-- name: CountRecentGroupPosts :many
+SELECT
+    group_id::bigint AS group_id,
+    COUNT(*) AS post_count
+FROM posts
+WHERE group_id = ANY($1::bigint[])
+  AND created_at >= $2
+  AND deleted_at IS NULL
+  AND approval_status = 'approved'
+  AND publish_at IS NULL
+GROUP BY group_id
+`
+
+type CountRecentGroupPostsParams struct {
+	GroupIds []int64
+	Since    pgtype.Timestamptz
+}
+
+type CountRecentGroupPostsRow struct {
+	GroupID   int64
+	PostCount int64
+}
+
+// number of posts created since the given time, per group
+func (q *Queries) CountRecentGroupPosts(ctx context.Context, arg CountRecentGroupPostsParams) ([]CountRecentGroupPostsRow, error) {
+	rows, err := q.db.Query(ctx, countRecentGroupPosts, arg.GroupIds, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CountRecentGroupPostsRow{}
+	for rows.Next() {
+		var i CountRecentGroupPostsRow
+		if err := rows.Scan(&i.GroupID, &i.PostCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,231 @@
+package dbservice
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getHashtagFeed = `-- name: GetHashtagFeed :many
+SELECT
+    p.id,
+    p.post_body,
+    p.creator_id,
+    COALESCE(p.group_id, 0)::bigint AS group_id,
+    p.audience,
+    p.comments_count,
+    p.reactions_count,
+    p.reaction_counts,
+    p.last_commented_at,
+    p.created_at,
+    p.updated_at,
+    p.edited_at IS NOT NULL AS edited,
+
+    EXISTS (
+        SELECT 1 FROM reactions r
+        WHERE r.content_id = p.id
+          AND r.user_id = $1
+          AND r.deleted_at IS NULL
+    ) AS liked_by_user,
+
+    COALESCE((   -- and with which reaction
+        SELECT r.reaction_type::text
+        FROM reactions r
+        WHERE r.content_id = p.id
+          AND r.user_id = $1
+          AND r.deleted_at IS NULL
+    ), '') AS user_reaction,
+
+COALESCE(
+    (SELECT array_agg(i.id ORDER BY i.sort_order ASC, i.id ASC)
+     FROM images i
+     WHERE i.parent_id = p.id AND i.deleted_at IS NULL
+    ), ARRAY[]::bigint[]
+)::bigint[] AS images
+
+FROM post_hashtags h
+JOIN posts p ON p.id = h.post_id
+
+WHERE h.tag = $2
+  AND p.deleted_at IS NULL
+  AND p.publish_at IS NULL                -- scheduled posts stay hidden until published
+  AND p.approval_status = 'approved'      -- so do pending and rejected group posts
+  AND NOT EXISTS (SELECT 1 FROM deactivated_users du WHERE du.user_id = p.creator_id) -- hide deactivated creators
+  AND NOT EXISTS (SELECT 1 FROM deleted_groups dg WHERE dg.group_id = p.group_id) -- hide deleted groups
+  AND (
+       p.creator_id = $1
+
+       -- group posts → only from public groups
+       OR (p.group_id IS NOT NULL AND EXISTS (
+           SELECT 1 FROM public_groups pg
+           WHERE pg.group_id = p.group_id
+       ))
+
+       OR (p.group_id IS NULL AND (
+           p.audience = 'everyone'
+
+           -- SELECTED audience → only manually approved viewers
+           OR (p.audience = 'selected' AND EXISTS (
+               SELECT 1 FROM post_audience pa
+               WHERE pa.post_id = p.id AND pa.allowed_user_id = $1
+           ))
+
+           -- FOLLOWERS → allowed if creator ∈ list passed in
+           OR (p.audience = 'followers' AND p.creator_id = ANY($3::bigint[]))
+       ))
+  )
+ORDER BY p.created_at DESC, p.id DESC
+OFFSET $4 LIMIT $5
+`
+
+type GetHashtagFeedParams struct {
+	UserID       int64
+	Tag          string
+	FollowingIds []int64
+	Offset       int32
+	Limit        int32
+}
+
+type GetHashtagFeedRow struct {
+	ID              int64
+	PostBody        string
+	CreatorID       int64
+	GroupID         int64
+	Audience        IntendedAudience
+	CommentsCount   int32
+	ReactionsCount  int32
+	ReactionCounts  map[string]int32
+	LastCommentedAt pgtype.Timestamptz
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+	Edited          bool
+	LikedByUser     bool
+	UserReaction    string
+	Images          []int64
+}
+
+// posts with the given tag the user can see, newest first
+// group posts are only included from public groups
+func (q *Queries) GetHashtagFeed(ctx context.Context, arg GetHashtagFeedParams) ([]GetHashtagFeedRow, error) {
+	rows, err := q.db.Query(ctx, getHashtagFeed,
+		arg.UserID,
+		arg.Tag,
+		arg.FollowingIds,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetHashtagFeedRow{}
+	for rows.Next() {
+		var i GetHashtagFeedRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PostBody,
+			&i.CreatorID,
+			&i.GroupID,
+			&i.Audience,
+			&i.CommentsCount,
+			&i.ReactionsCount,
+			&i.ReactionCounts,
+			&i.LastCommentedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Edited,
+			&i.LikedByUser,
+			&i.UserReaction,
+			&i.Images,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTrendingHashtags = `-- name: GetTrendingHashtags :many
+SELECT
+    h.tag,
+    COUNT(*)::int AS posts_count,
+    COUNT(DISTINCT p.creator_id)::int AS authors_count
+FROM post_hashtags h
+JOIN posts p ON p.id = h.post_id
+WHERE p.created_at >= $1
+  AND p.deleted_at IS NULL
+  AND p.publish_at IS NULL
+  AND p.approval_status = 'approved'
+  AND NOT EXISTS (SELECT 1 FROM deactivated_users du WHERE du.user_id = p.creator_id)
+  AND NOT EXISTS (SELECT 1 FROM deleted_groups dg WHERE dg.group_id = p.group_id)
+  AND (
+       -- public content only: posts for everyone and posts of public groups
+       (p.group_id IS NULL AND p.audience = 'everyone')
+       OR (p.group_id IS NOT NULL AND EXISTS (
+           SELECT 1 FROM public_groups pg
+           WHERE pg.group_id = p.group_id
+       ))
+  )
+GROUP BY h.tag
+ORDER BY authors_count DESC, posts_count DESC, MAX(p.created_at) DESC, h.tag
+LIMIT $2
+`
+
+type GetTrendingHashtagsParams struct {
+	Since pgtype.Timestamptz
+	Limit int32
+}
+
+type GetTrendingHashtagsRow struct {
+	Tag          string
+	PostsCount   int32
+	AuthorsCount int32
+}
+
+// most used tags in public posts created since the given time
+// ranked by distinct authors first, so a single user can't push a tag up alone
+func (q *Queries) GetTrendingHashtags(ctx context.Context, arg GetTrendingHashtagsParams) ([]GetTrendingHashtagsRow, error) {
+	rows, err := q.db.Query(ctx, getTrendingHashtags, arg.Since, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTrendingHashtagsRow{}
+	for rows.Next() {
+		var i GetTrendingHashtagsRow
+		if err := rows.Scan(&i.Tag, &i.PostsCount, &i.AuthorsCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setPostHashtags = `-- name: SetPostHashtags :exec
+WITH removed AS (
+    DELETE FROM post_hashtags
+    WHERE post_id = $1
+      AND NOT (tag = ANY($2::text[]))
+)
+INSERT INTO post_hashtags (post_id, tag)
+SELECT $1, t.tag
+FROM unnest($2::text[]) AS t(tag)
+ON CONFLICT (post_id, tag) DO NOTHING
+`
+
+type SetPostHashtagsParams struct {
+	PostID int64
+	Tags   []string
+}
+
+// replaces the tags of the given post, an empty list clears them
+func (q *Queries) SetPostHashtags(ctx context.Context, arg SetPostHashtagsParams) error {
+	_, err := q.db.Exec(ctx, setPostHashtags, arg.PostID, arg.Tags)
+	return err
+}
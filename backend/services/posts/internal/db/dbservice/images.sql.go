@@ -10,9 +10,9 @@ SET deleted_at = CURRENT_TIMESTAMP
 WHERE parent_id = $1 AND deleted_at IS NULL
 `
 
-// soft-deletes image with given parent_id as long as it wasn't already marked as deleted
+// soft-deletes all images with given parent_id as long as they weren't already marked as deleted
 // returns rows affected
-// 0 rows affected could mean no row was found or the image was already deleted
+// 0 rows affected could mean no row was found or the images were already deleted
 func (q *Queries) DeleteImage(ctx context.Context, id int64) (int64, error) {
 	result, err := q.db.Exec(ctx, deleteImage, id)
 	if err != nil {
@@ -21,6 +21,67 @@ func (q *Queries) DeleteImage(ctx context.Context, id int64) (int64, error) {
 	return result.RowsAffected(), nil
 }
 
+const getForeignImageIds = `-- name: GetForeignImageIds :many
+SELECT DISTINCT id
+FROM images
+WHERE id = ANY($2::bigint[])
+  AND parent_id <> $1
+`
+
+type GetForeignImageIdsParams struct {
+	ParentID int64
+	Ids      []int64
+}
+
+// the given images that are, or were, attached to a parent other than the given one
+func (q *Queries) GetForeignImageIds(ctx context.Context, arg GetForeignImageIdsParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, getForeignImageIds, arg.ParentID, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUnreferencedImageIds = `-- name: GetUnreferencedImageIds :many
+SELECT DISTINCT u.id
+FROM unnest($1::bigint[]) AS u(id)
+WHERE NOT EXISTS (SELECT 1 FROM images i WHERE i.id = u.id)
+  AND NOT EXISTS (SELECT 1 FROM drafts d WHERE d.image_ids @> ARRAY[u.id])
+`
+
+// the given images that no post, comment, event or draft holds, deleted ones included
+func (q *Queries) GetUnreferencedImageIds(ctx context.Context, ids []int64) ([]int64, error) {
+	rows, err := q.db.Query(ctx, getUnreferencedImageIds, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getImages = `-- name: GetImages :one
 SELECT id
 FROM images
@@ -39,16 +100,23 @@ func (q *Queries) GetImages(ctx context.Context, parentID int64) (int64, error)
 	return id, err
 }
 
-// hardcoded sort order =1 for now that we only have one image per entity id
+// hardcoded sort order =1, comments and events carry a single image
 const upsertImage = `-- name: UpsertImage :exec
+WITH replaced AS (
+    UPDATE images
+    SET deleted_at = CURRENT_TIMESTAMP
+    WHERE parent_id = $2
+      AND id <> $1
+      AND deleted_at IS NULL
+)
 INSERT INTO images (id, parent_id, sort_order)
 VALUES ($1, $2, 1)
-ON CONFLICT (parent_id)
+ON CONFLICT (parent_id, id)
 DO UPDATE
 SET
-    id   = EXCLUDED.id,
+    sort_order = 1,
     updated_at = CURRENT_TIMESTAMP,
-    deleted_at = NULL;
+    deleted_at = NULL
 `
 
 type UpsertImageParams struct {
@@ -56,12 +124,43 @@ type UpsertImageParams struct {
 	ParentID int64
 }
 
-// inserts a new image or updates the image associated with the parent_id if it exists
+// sets the single image of the given parent, soft-deleting any other image it had
 func (q *Queries) UpsertImage(ctx context.Context, arg UpsertImageParams) error {
 	_, err := q.db.Exec(ctx, upsertImage, arg.ID, arg.ParentID)
 	return err
 }
 
+const setPostImages = `-- name: SetPostImages :exec
+WITH removed AS (
+    UPDATE images
+    SET deleted_at = CURRENT_TIMESTAMP
+    WHERE parent_id = $1
+      AND deleted_at IS NULL
+      AND NOT (id = ANY($2::bigint[]))
+)
+INSERT INTO images (id, parent_id, sort_order)
+SELECT img.id, $1, img.ord::int
+FROM unnest($2::bigint[]) WITH ORDINALITY AS img(id, ord)
+ON CONFLICT (parent_id, id)
+DO UPDATE
+SET
+    sort_order = EXCLUDED.sort_order,
+    updated_at = CURRENT_TIMESTAMP,
+    deleted_at = NULL
+`
+
+type SetPostImagesParams struct {
+	ParentID int64
+	Ids      []int64
+}
+
+// replaces the gallery of the given parent with the given image ids, in the given order
+// images left out of the list are soft-deleted, images already in the gallery are reordered
+func (q *Queries) SetPostImages(ctx context.Context, arg SetPostImagesParams) error {
+	_, err := q.db.Exec(ctx, setPostImages, arg.ParentID, arg.Ids)
+	return err
+}
+
 const removeImages = `-- name: RemoveImages :exec
 UPDATE images
 SET deleted_at = CURRENT_TIMESTAMP
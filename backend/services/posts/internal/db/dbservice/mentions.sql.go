@@ -0,0 +1,99 @@
+package dbservice
+
+import (
+	"context"
+)
+
+const clearMentions = `-- name: ClearMentions :many
+DELETE FROM mentions
+WHERE content_id = $1
+RETURNING mentioned_user_id
+`
+
+// removes all mentions of the given post or comment, returns who was mentioned
+func (q *Queries) ClearMentions(ctx context.Context, contentID int64) ([]int64, error) {
+	rows, err := q.db.Query(ctx, clearMentions, contentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var mentioned_user_id int64
+		if err := rows.Scan(&mentioned_user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, mentioned_user_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMentions = `-- name: GetMentions :many
+SELECT content_id, mentioned_user_id, start_offset, length
+FROM mentions
+WHERE content_id = ANY($1::bigint[])
+ORDER BY content_id, start_offset
+`
+
+type GetMentionsRow struct {
+	ContentID       int64
+	MentionedUserID int64
+	StartOffset     int32
+	Length          int32
+}
+
+// mentions of the given posts and comments, in body order
+func (q *Queries) GetMentions(ctx context.Context, contentIds []int64) ([]GetMentionsRow, error) {
+	rows, err := q.db.Query(ctx, getMentions, contentIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetMentionsRow{}
+	for rows.Next() {
+		var i GetMentionsRow
+		if err := rows.Scan(
+			&i.ContentID,
+			&i.MentionedUserID,
+			&i.StartOffset,
+			&i.Length,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertMentions = `-- name: InsertMentions :execrows
+INSERT INTO mentions (content_id, mentioned_user_id, start_offset, length)
+SELECT $1::bigint, m.user_id, m.start_offset, m.length
+FROM unnest($2::bigint[], $3::int[], $4::int[]) AS m(user_id, start_offset, length)
+`
+
+type InsertMentionsParams struct {
+	ContentID    int64
+	UserIds      []int64
+	StartOffsets []int32
+	Lengths      []int32
+}
+
+// the three arrays are zipped, one mention per position
+func (q *Queries) InsertMentions(ctx context.Context, arg InsertMentionsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, insertMentions,
+		arg.ContentID,
+		arg.UserIds,
+		arg.StartOffsets,
+		arg.Lengths,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -13,6 +13,8 @@ const (
 	ContentTypePost    ContentType = "post"
 	ContentTypeComment ContentType = "comment"
 	ContentTypeEvent   ContentType = "event"
+	ContentTypeRepost  ContentType = "repost"
+	ContentTypeQuote   ContentType = "quote"
 )
 
 func (e *ContentType) Scan(src interface{}) error {
@@ -54,7 +56,60 @@ func (e ContentType) Valid() bool {
 	switch e {
 	case ContentTypePost,
 		ContentTypeComment,
-		ContentTypeEvent:
+		ContentTypeEvent,
+		ContentTypeRepost,
+		ContentTypeQuote:
+		return true
+	}
+	return false
+}
+
+type DraftKind string
+
+const (
+	DraftKindPost    DraftKind = "post"
+	DraftKindComment DraftKind = "comment"
+)
+
+func (e *DraftKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = DraftKind(s)
+	case string:
+		*e = DraftKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for DraftKind: %T", src)
+	}
+	return nil
+}
+
+type NullDraftKind struct {
+	DraftKind DraftKind
+	Valid     bool // Valid is true if DraftKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullDraftKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.DraftKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.DraftKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullDraftKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.DraftKind), nil
+}
+
+func (e DraftKind) Valid() bool {
+	switch e {
+	case DraftKindPost,
+		DraftKindComment:
 		return true
 	}
 	return false
@@ -115,15 +170,207 @@ func (e IntendedAudience) Valid() bool {
 	return false
 }
 
+type PostApprovalStatus string
+
+const (
+	PostApprovalStatusApproved PostApprovalStatus = "approved"
+	PostApprovalStatusPending  PostApprovalStatus = "pending"
+	PostApprovalStatusRejected PostApprovalStatus = "rejected"
+)
+
+func (e *PostApprovalStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PostApprovalStatus(s)
+	case string:
+		*e = PostApprovalStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PostApprovalStatus: %T", src)
+	}
+	return nil
+}
+
+type NullPostApprovalStatus struct {
+	PostApprovalStatus PostApprovalStatus
+	Valid              bool // Valid is true if PostApprovalStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPostApprovalStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.PostApprovalStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PostApprovalStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPostApprovalStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PostApprovalStatus), nil
+}
+
+func (e PostApprovalStatus) Valid() bool {
+	switch e {
+	case PostApprovalStatusApproved,
+		PostApprovalStatusPending,
+		PostApprovalStatusRejected:
+		return true
+	}
+	return false
+}
+
+type ReactionType string
+
+const (
+	ReactionTypeLike  ReactionType = "like"
+	ReactionTypeLove  ReactionType = "love"
+	ReactionTypeLaugh ReactionType = "laugh"
+	ReactionTypeWow   ReactionType = "wow"
+	ReactionTypeSad   ReactionType = "sad"
+	ReactionTypeAngry ReactionType = "angry"
+)
+
+func (e *ReactionType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ReactionType(s)
+	case string:
+		*e = ReactionType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ReactionType: %T", src)
+	}
+	return nil
+}
+
+type NullReactionType struct {
+	ReactionType ReactionType
+	Valid        bool // Valid is true if ReactionType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullReactionType) Scan(value interface{}) error {
+	if value == nil {
+		ns.ReactionType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ReactionType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullReactionType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ReactionType), nil
+}
+
+func (e ReactionType) Valid() bool {
+	switch e {
+	case ReactionTypeLike,
+		ReactionTypeLove,
+		ReactionTypeLaugh,
+		ReactionTypeWow,
+		ReactionTypeSad,
+		ReactionTypeAngry:
+		return true
+	}
+	return false
+}
+
+type ShareKind string
+
+const (
+	ShareKindRepost ShareKind = "repost"
+	ShareKindQuote  ShareKind = "quote"
+)
+
+func (e *ShareKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ShareKind(s)
+	case string:
+		*e = ShareKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ShareKind: %T", src)
+	}
+	return nil
+}
+
+type NullShareKind struct {
+	ShareKind ShareKind
+	Valid     bool // Valid is true if ShareKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullShareKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.ShareKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ShareKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullShareKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ShareKind), nil
+}
+
+func (e ShareKind) Valid() bool {
+	switch e {
+	case ShareKindRepost,
+		ShareKindQuote:
+		return true
+	}
+	return false
+}
+
 type Comment struct {
-	ID               int64
-	CommentCreatorID int64
-	ParentID         int64
-	CommentBody      string
-	ReactionsCount   int32
-	CreatedAt        pgtype.Timestamptz
-	UpdatedAt        pgtype.Timestamptz
-	DeletedAt        pgtype.Timestamptz
+	ID                int64
+	CommentCreatorID  int64
+	ParentID          int64
+	CommentBody       string
+	ReactionsCount    int32
+	CreatedAt         pgtype.Timestamptz
+	UpdatedAt         pgtype.Timestamptz
+	DeletedAt         pgtype.Timestamptz
+	EditedAt          pgtype.Timestamptz
+	ReplyToID         pgtype.Int8
+	Depth             int16
+	RepliesCount      int32
+	ReactionCounts    []byte
+	SearchVector      interface{}
+	ShownRepliesCount int32
+}
+
+type ContentRevision struct {
+	ID        int64
+	ContentID int64
+	Body      string
+	CreatedAt pgtype.Timestamptz
+}
+
+type Draft struct {
+	ID          int64
+	CreatorID   int64
+	Kind        DraftKind
+	Body        string
+	ParentID    pgtype.Int8
+	GroupID     pgtype.Int8
+	Audience    NullIntendedAudience
+	AudienceIds []int64
+	ImageIds    []int64
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+	ExpiresAt   pgtype.Timestamptz
 }
 
 type Event struct {
@@ -138,6 +385,7 @@ type Event struct {
 	CreatedAt      pgtype.Timestamptz
 	UpdatedAt      pgtype.Timestamptz
 	DeletedAt      pgtype.Timestamptz
+	SearchVector   interface{}
 }
 
 type EventResponse struct {
@@ -167,6 +415,14 @@ type MasterIndex struct {
 	DeletedAt   pgtype.Timestamptz
 }
 
+type Mention struct {
+	ContentID       int64
+	MentionedUserID int64
+	StartOffset     int32
+	Length          int32
+	CreatedAt       pgtype.Timestamptz
+}
+
 type Post struct {
 	ID              int64
 	PostBody        string
@@ -179,6 +435,21 @@ type Post struct {
 	CreatedAt       pgtype.Timestamptz
 	UpdatedAt       pgtype.Timestamptz
 	DeletedAt       pgtype.Timestamptz
+	PinnedAt        pgtype.Timestamptz
+	PinnedBy        pgtype.Int8
+	IsAnnouncement  bool
+	ApprovalStatus  PostApprovalStatus
+	ReviewedBy      pgtype.Int8
+	ReviewedAt      pgtype.Timestamptz
+	RejectionReason pgtype.Text
+	PublishAt       pgtype.Timestamptz
+	EditedAt        pgtype.Timestamptz
+	ReactionCounts  []byte
+	SearchVector    interface{}
+	SharedPostID    pgtype.Int8
+	RepostsCount    int32
+	QuotesCount     int32
+	ShareKind       NullShareKind
 }
 
 type PostAudience struct {
@@ -186,11 +457,17 @@ type PostAudience struct {
 	AllowedUserID int64
 }
 
+type PostHashtag struct {
+	PostID int64
+	Tag    string
+}
+
 type Reaction struct {
-	ID        int64
-	ContentID int64
-	UserID    int64
-	CreatedAt pgtype.Timestamptz
-	UpdatedAt pgtype.Timestamptz
-	DeletedAt pgtype.Timestamptz
+	ID           int64
+	ContentID    int64
+	UserID       int64
+	CreatedAt    pgtype.Timestamptz
+	UpdatedAt    pgtype.Timestamptz
+	DeletedAt    pgtype.Timestamptz
+	ReactionType ReactionType
 }
@@ -0,0 +1,138 @@
+package dbservice
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getPostGroupFlags = `-- name: GetPostGroupFlags :one
+SELECT
+    id,
+    creator_id,
+    COALESCE(group_id, 0)::bigint AS group_id,
+    post_body,
+    pinned_at,
+    is_announcement,
+    approval_status,
+    publish_at IS NOT NULL AS scheduled
+FROM posts
+WHERE id = $1
+  AND deleted_at IS NULL
+`
+
+type GetPostGroupFlagsRow struct {
+	ID             int64
+	CreatorID      int64
+	GroupID        int64
+	PostBody       string
+	PinnedAt       pgtype.Timestamptz
+	IsAnnouncement bool
+	ApprovalStatus PostApprovalStatus
+	Scheduled      bool
+}
+
+// no rows if the post doesn't exist or is deleted
+func (q *Queries) GetPostGroupFlags(ctx context.Context, id int64) (GetPostGroupFlagsRow, error) {
+	row := q.db.QueryRow(ctx, getPostGroupFlags, id)
+	var i GetPostGroupFlagsRow
+	err := row.Scan(
+		&i.ID,
+		&i.CreatorID,
+		&i.GroupID,
+		&i.PostBody,
+		&i.PinnedAt,
+		&i.IsAnnouncement,
+		&i.ApprovalStatus,
+		&i.Scheduled,
+	)
+	return i, err
+}
+
+const countPinnedGroupPosts = `-- name: CountPinnedGroupPosts :one
+SELECT COUNT(*)
+FROM posts
+WHERE group_id = $1
+  AND pinned_at IS NOT NULL
+  AND deleted_at IS NULL
+`
+
+func (q *Queries) CountPinnedGroupPosts(ctx context.Context, groupID pgtype.Int8) (int64, error) {
+	row := q.db.QueryRow(ctx, countPinnedGroupPosts, groupID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const lockGroupPins = `-- name: LockGroupPins :exec
+SELECT pg_advisory_xact_lock($1)
+`
+
+// serializes pinning in a group until the end of the transaction
+// the key is the group id, nothing else takes advisory locks in this db
+func (q *Queries) LockGroupPins(ctx context.Context, groupID int64) error {
+	_, err := q.db.Exec(ctx, lockGroupPins, groupID)
+	return err
+}
+
+const pinPost = `-- name: PinPost :execrows
+UPDATE posts
+SET pinned_at = CURRENT_TIMESTAMP,
+    pinned_by = $2
+WHERE id = $1
+  AND pinned_at IS NULL
+  AND deleted_at IS NULL
+`
+
+type PinPostParams struct {
+	ID       int64
+	PinnedBy pgtype.Int8
+}
+
+// returns 0 rows if the post is already pinned
+func (q *Queries) PinPost(ctx context.Context, arg PinPostParams) (int64, error) {
+	result, err := q.db.Exec(ctx, pinPost, arg.ID, arg.PinnedBy)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const unpinPost = `-- name: UnpinPost :execrows
+UPDATE posts
+SET pinned_at = NULL,
+    pinned_by = NULL
+WHERE id = $1
+  AND pinned_at IS NOT NULL
+`
+
+// returns 0 rows if the post isn't pinned
+func (q *Queries) UnpinPost(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, unpinPost, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setPostAnnouncement = `-- name: SetPostAnnouncement :execrows
+UPDATE posts
+SET is_announcement = $2
+WHERE id = $1
+  AND is_announcement <> $2
+  AND deleted_at IS NULL
+`
+
+type SetPostAnnouncementParams struct {
+	ID             int64
+	IsAnnouncement bool
+}
+
+// returns 0 rows if the post already had the given value
+func (q *Queries) SetPostAnnouncement(ctx context.Context, arg SetPostAnnouncementParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setPostAnnouncement, arg.ID, arg.IsAnnouncement)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
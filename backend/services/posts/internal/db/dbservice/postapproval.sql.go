@@ -0,0 +1,115 @@
+package dbservice
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getPendingGroupPosts = `-- name: GetPendingGroupPosts :many
+SELECT
+    p.id,
+    p.post_body,
+    p.creator_id,
+    p.audience,
+    p.created_at,
+    p.updated_at,
+    p.edited_at IS NOT NULL AS edited,
+
+COALESCE(
+    (SELECT array_agg(i.id ORDER BY i.sort_order ASC, i.id ASC)
+     FROM images i
+     WHERE i.parent_id = p.id AND i.deleted_at IS NULL
+    ), ARRAY[]::bigint[]
+)::bigint[] AS images
+
+FROM posts p
+WHERE p.group_id = $1
+  AND p.approval_status = 'pending'
+  AND p.deleted_at IS NULL
+  AND p.publish_at IS NULL -- scheduled posts enter the queue once published
+  AND NOT EXISTS (SELECT 1 FROM deactivated_users du WHERE du.user_id = p.creator_id) -- hide deactivated creators
+  AND NOT EXISTS (SELECT 1 FROM deleted_groups dg WHERE dg.group_id = p.group_id) -- hide deleted groups
+ORDER BY p.created_at ASC, p.id ASC
+LIMIT $2 OFFSET $3
+`
+
+type GetPendingGroupPostsParams struct {
+	GroupID pgtype.Int8
+	Limit   int32
+	Offset  int32
+}
+
+type GetPendingGroupPostsRow struct {
+	ID        int64
+	PostBody  string
+	CreatorID int64
+	Audience  IntendedAudience
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+	Edited    bool
+	Images    []int64
+}
+
+// posts of a group awaiting approval, oldest first
+func (q *Queries) GetPendingGroupPosts(ctx context.Context, arg GetPendingGroupPostsParams) ([]GetPendingGroupPostsRow, error) {
+	rows, err := q.db.Query(ctx, getPendingGroupPosts, arg.GroupID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetPendingGroupPostsRow{}
+	for rows.Next() {
+		var i GetPendingGroupPostsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PostBody,
+			&i.CreatorID,
+			&i.Audience,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Edited,
+			&i.Images,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reviewPost = `-- name: ReviewPost :execrows
+UPDATE posts
+SET approval_status  = $2,
+    reviewed_by      = $3,
+    reviewed_at      = CURRENT_TIMESTAMP,
+    rejection_reason = $4
+WHERE id = $1
+  AND approval_status = 'pending'
+  AND deleted_at IS NULL
+  AND publish_at IS NULL
+`
+
+type ReviewPostParams struct {
+	ID              int64
+	ApprovalStatus  PostApprovalStatus
+	ReviewedBy      pgtype.Int8
+	RejectionReason pgtype.Text
+}
+
+// approves or rejects a pending post, returns 0 rows if the post isn't pending
+func (q *Queries) ReviewPost(ctx context.Context, arg ReviewPostParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reviewPost,
+		arg.ID,
+		arg.ApprovalStatus,
+		arg.ReviewedBy,
+		arg.RejectionReason,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -17,16 +17,18 @@ func (q *Queries) ClearPostAudience(ctx context.Context, postID int64) error {
 }
 
 const createPost = `-- name: CreatePost :one
-INSERT INTO posts (post_body, creator_id, group_id, audience)
-VALUES ($1, $2, $3, $4)
+INSERT INTO posts (post_body, creator_id, group_id, audience, approval_status, publish_at)
+VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING id
 `
 
 type CreatePostParams struct {
-	PostBody  string
-	CreatorID int64
-	GroupID   pgtype.Int8
-	Audience  IntendedAudience
+	PostBody       string
+	CreatorID      int64
+	GroupID        pgtype.Int8
+	Audience       IntendedAudience
+	ApprovalStatus PostApprovalStatus
+	PublishAt      pgtype.Timestamptz
 }
 
 func (q *Queries) CreatePost(ctx context.Context, arg CreatePostParams) (int64, error) {
@@ -35,6 +37,8 @@ func (q *Queries) CreatePost(ctx context.Context, arg CreatePostParams) (int64,
 		arg.CreatorID,
 		arg.GroupID,
 		arg.Audience,
+		arg.ApprovalStatus,
+		arg.PublishAt,
 	)
 	var id int64
 	err := row.Scan(&id)
@@ -62,7 +66,11 @@ func (q *Queries) DeletePost(ctx context.Context, arg DeletePostParams) (int64,
 
 const editPostContent = `-- name: EditPostContent :execrows
 UPDATE posts
-SET post_body  = $1
+SET post_body  = $1,
+    edited_at = CASE
+        WHEN post_body <> $1 AND publish_at IS NULL THEN CURRENT_TIMESTAMP
+        ELSE edited_at
+    END
 WHERE id = $2 AND creator_id = $3 AND deleted_at IS NULL
 `
 
@@ -72,6 +80,7 @@ type EditPostContentParams struct {
 	CreatorID int64
 }
 
+// marks the post edited if the body changed after it was published
 func (q *Queries) EditPostContent(ctx context.Context, arg EditPostContentParams) (int64, error) {
 	result, err := q.db.Exec(ctx, editPostContent, arg.PostBody, arg.ID, arg.CreatorID)
 	if err != nil {
@@ -89,21 +98,18 @@ SELECT
     p.audience,
     p.comments_count,
     p.reactions_count,
+    p.reaction_counts,
     p.last_commented_at,
     p.created_at,
     p.updated_at,
+    p.edited_at IS NOT NULL AS edited,
 
     COALESCE(
-        (
-            SELECT i.id
-            FROM images i
-            WHERE i.parent_id = p.id
-              AND i.deleted_at IS NULL
-            ORDER BY i.sort_order ASC
-            LIMIT 1
-        ),
-        0
-    )::bigint AS image,
+    (SELECT array_agg(i.id ORDER BY i.sort_order ASC, i.id ASC)
+     FROM images i
+     WHERE i.parent_id = p.id AND i.deleted_at IS NULL
+    ), ARRAY[]::bigint[]
+)::bigint[] AS images,
 
 
     (p.reactions_count + p.comments_count) AS popularity_score     -- popularity metric (likes + comments)
@@ -111,6 +117,10 @@ SELECT
 FROM posts p
 WHERE p.group_id = $1
   AND p.deleted_at IS NULL
+  AND p.approval_status = 'approved'
+  AND p.publish_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM deactivated_users du WHERE du.user_id = p.creator_id) -- hide deactivated creators
+  AND NOT EXISTS (SELECT 1 FROM deleted_groups dg WHERE dg.group_id = p.group_id) -- hide deleted groups
 
 ORDER BY popularity_score DESC, p.created_at DESC
 LIMIT 1
@@ -124,10 +134,12 @@ type GetMostPopularPostInGroupRow struct {
 	Audience        IntendedAudience
 	CommentsCount   int32
 	ReactionsCount  int32
+	ReactionCounts  map[string]int32
 	LastCommentedAt pgtype.Timestamptz
 	CreatedAt       pgtype.Timestamptz
 	UpdatedAt       pgtype.Timestamptz
-	Image           int64
+	Edited          bool
+	Images          []int64
 	PopularityScore int32
 }
 
@@ -142,10 +154,12 @@ func (q *Queries) GetMostPopularPostInGroup(ctx context.Context, groupID pgtype.
 		&i.Audience,
 		&i.CommentsCount,
 		&i.ReactionsCount,
+		&i.ReactionCounts,
 		&i.LastCommentedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
-		&i.Image,
+		&i.Edited,
+		&i.Images,
 		&i.PopularityScore,
 	)
 	return i, err
@@ -187,9 +201,16 @@ SELECT
     p.audience,
     p.comments_count,
     p.reactions_count,
+    p.reaction_counts,
     p.last_commented_at,
     p.created_at,
     p.updated_at,
+    p.edited_at IS NOT NULL AS edited,
+    p.pinned_at IS NOT NULL AS pinned,
+    p.is_announcement,
+    p.approval_status,
+    COALESCE(p.rejection_reason, '')::text AS rejection_reason,
+    p.publish_at,
 
     EXISTS (
         SELECT 1 FROM reactions r
@@ -198,14 +219,20 @@ SELECT
           AND r.deleted_at IS NULL
     ) AS liked_by_user,
 
+    COALESCE((   -- and with which reaction
+        SELECT r.reaction_type::text
+        FROM reactions r
+        WHERE r.content_id = p.id
+          AND r.user_id = $1
+          AND r.deleted_at IS NULL
+    ), '') AS user_reaction,
+
 COALESCE(
-    (SELECT i.id
+    (SELECT array_agg(i.id ORDER BY i.sort_order ASC, i.id ASC)
      FROM images i
      WHERE i.parent_id = p.id AND i.deleted_at IS NULL
-     ORDER BY i.sort_order ASC
-     LIMIT 1
-    ), 0
-)::bigint AS image,
+    ), ARRAY[]::bigint[]
+)::bigint[] AS images,
 
  COALESCE(
         (
@@ -221,6 +248,15 @@ COALESCE(
 FROM posts p
 WHERE p.id=$2
   AND p.deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM deleted_groups dg WHERE dg.group_id = p.group_id) -- hidden from creators too
+  AND (
+        p.creator_id = $1
+        OR (
+            NOT EXISTS (SELECT 1 FROM deactivated_users du WHERE du.user_id = p.creator_id)
+            AND p.approval_status = 'approved' -- pending and rejected posts only shown to their creator
+            AND p.publish_at IS NULL -- so are scheduled posts
+        )
+      )
 `
 
 type GetPostByIDParams struct {
@@ -236,11 +272,19 @@ type GetPostByIDRow struct {
 	Audience         IntendedAudience
 	CommentsCount    int32
 	ReactionsCount   int32
+	ReactionCounts   map[string]int32
 	LastCommentedAt  pgtype.Timestamptz
 	CreatedAt        pgtype.Timestamptz
 	UpdatedAt        pgtype.Timestamptz
+	Edited           bool
+	Pinned           bool
+	IsAnnouncement   bool
+	ApprovalStatus   PostApprovalStatus
+	RejectionReason  string
+	PublishAt        pgtype.Timestamptz
 	LikedByUser      bool
-	Image            int64
+	UserReaction     string
+	Images           []int64
 	SelectedAudience []int64
 }
 
@@ -255,11 +299,19 @@ func (q *Queries) GetPostByID(ctx context.Context, arg GetPostByIDParams) (GetPo
 		&i.Audience,
 		&i.CommentsCount,
 		&i.ReactionsCount,
+		&i.ReactionCounts,
 		&i.LastCommentedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Edited,
+		&i.Pinned,
+		&i.IsAnnouncement,
+		&i.ApprovalStatus,
+		&i.RejectionReason,
+		&i.PublishAt,
 		&i.LikedByUser,
-		&i.Image,
+		&i.UserReaction,
+		&i.Images,
 		&i.SelectedAudience,
 	)
 	return i, err
@@ -8,44 +8,178 @@ import (
 
 type Querier interface {
 	CanUserSeeEntity(ctx context.Context, arg CanUserSeeEntityParams) (bool, error)
+	// deletes a post that hasn't been published yet
+	// returns 0 rows if the post doesn't exist, isn't owned by the creator or was already published
+	CancelScheduledPost(ctx context.Context, arg CancelScheduledPostParams) (int64, error)
+	// removes all mentions of the given post or comment, returns who was mentioned
+	ClearMentions(ctx context.Context, contentID int64) ([]int64, error)
 	ClearPostAudience(ctx context.Context, postID int64) error
+	CountPinnedGroupPosts(ctx context.Context, groupID pgtype.Int8) (int64, error)
+	// number of posts created since the given time, per group
+	CountRecentGroupPosts(ctx context.Context, arg CountRecentGroupPostsParams) ([]CountRecentGroupPostsRow, error)
 	CreateComment(ctx context.Context, arg CreateCommentParams) (int64, error)
+	// inserts a draft unless the creator already has max_drafts of them
+	// returns no rows if the limit is reached
+	CreateDraft(ctx context.Context, arg CreateDraftParams) (int64, error)
 	CreateEvent(ctx context.Context, arg CreateEventParams) (int64, error)
 	CreatePost(ctx context.Context, arg CreatePostParams) (int64, error)
+	// an empty body makes a repost, anything else a quote
+	// returns no rows if the creator already reposted the post
+	CreateSharedPost(ctx context.Context, arg CreateSharedPostParams) (int64, error)
+	// puts a post just claimed by PublishDuePosts back in the schedule, to retry it later
+	DeferDuePost(ctx context.Context, arg DeferDuePostParams) error
 	DeleteComment(ctx context.Context, arg DeleteCommentParams) (int64, error)
+	DeleteArchivedGroup(ctx context.Context, groupID int64) error
+	DeleteDeactivatedUser(ctx context.Context, userID int64) error
+	// returns the images the draft held
+	// returns no rows if the draft doesn't exist or isn't owned by the creator
+	DeleteDraft(ctx context.Context, arg DeleteDraftParams) ([]int64, error)
 	DeleteEvent(ctx context.Context, arg DeleteEventParams) (int64, error)
 	DeleteEventResponse(ctx context.Context, arg DeleteEventResponseParams) (int64, error)
+	// drops drafts that weren't saved again before expiring
+	// returns the images they held, the images themselves are left to the caller
+	DeleteExpiredDrafts(ctx context.Context) ([]DeleteExpiredDraftsRow, error)
 	DeleteImage(ctx context.Context, id int64) (int64, error)
+	DeleteModeratedGroup(ctx context.Context, groupID int64) error
 	DeletePost(ctx context.Context, arg DeletePostParams) (int64, error)
+	DeletePublicGroup(ctx context.Context, groupID int64) error
 	EditComment(ctx context.Context, arg EditCommentParams) (int64, error)
 	EditEvent(ctx context.Context, arg EditEventParams) (int64, error)
+	// marks the post edited if the body changed after it was published
 	EditPostContent(ctx context.Context, arg EditPostContentParams) (int64, error)
 	GetBasicPostByID(ctx context.Context, postId int64) (GetBasicPostByIDRow, error)
+	// the comment a new reply would go under
+	// returns no rows if the comment doesn't exist or is deleted
+	GetCommentReplyTarget(ctx context.Context, id int64) (GetCommentReplyTargetRow, error)
+	// returns paginated top level comments of post with given id, in descending created order,
+	// or the direct replies of comment with given id, in ascending created order
 	GetCommentsByPostId(ctx context.Context, arg GetCommentsByPostIdParams) ([]GetCommentsByPostIdRow, error)
+	// returns no rows if the draft doesn't exist, isn't owned by the creator or has expired
+	GetDraft(ctx context.Context, arg GetDraftParams) (Draft, error)
+	// drafts of the creator that haven't expired, most recently saved first
+	GetDrafts(ctx context.Context, arg GetDraftsParams) ([]Draft, error)
+	// group posts whose publish time has come, without locking them
+	// lets the caller check their creators before PublishDuePosts claims them
+	GetDueGroupPosts(ctx context.Context, limit int32) ([]GetDueGroupPostsRow, error)
 	GetEntityCreatorAndGroup(ctx context.Context, id int64) (GetEntityCreatorAndGroupRow, error)
 	GetEventsByGroupId(ctx context.Context, arg GetEventsByGroupIdParams) ([]GetEventsByGroupIdRow, error)
+	// the given images that are, or were, attached to a parent other than the given one
+	GetForeignImageIds(ctx context.Context, arg GetForeignImageIdsParams) ([]int64, error)
+	// members with the most posts (then comments) in a group since the given time
+	GetGroupActivePosters(ctx context.Context, arg GetGroupActivePostersParams) ([]GetGroupActivePostersRow, error)
+	// comments on a group's posts per UTC day, days without comments are missing
+	GetGroupDailyCommentCounts(ctx context.Context, arg GetGroupDailyCommentCountsParams) ([]GetGroupDailyCommentCountsRow, error)
+	// posts created in a group per UTC day, days without posts are missing
+	GetGroupDailyPostCounts(ctx context.Context, arg GetGroupDailyPostCountsParams) ([]GetGroupDailyPostCountsRow, error)
+	// events of a group taking place on or after the given date, with their response counts
+	GetGroupEventAttendance(ctx context.Context, arg GetGroupEventAttendanceParams) ([]GetGroupEventAttendanceRow, error)
 	GetGroupPostsPaginated(ctx context.Context, arg GetGroupPostsPaginatedParams) ([]GetGroupPostsPaginatedRow, error)
+	// posts with the given tag the user can see, newest first
+	// group posts are only included from public groups
+	GetHashtagFeed(ctx context.Context, arg GetHashtagFeedParams) ([]GetHashtagFeedRow, error)
 	GetImages(ctx context.Context, parentID int64) (int64, error)
 	GetLatestCommentforPostId(ctx context.Context, arg GetLatestCommentforPostIdParams) (GetLatestCommentforPostIdRow, error)
+	// mentions of the given posts and comments, in body order
+	GetMentions(ctx context.Context, contentIds []int64) ([]GetMentionsRow, error)
 	GetMostPopularPostInGroup(ctx context.Context, groupID pgtype.Int8) (GetMostPopularPostInGroupRow, error)
 	GetPostAudienceForComment(ctx context.Context, postID int64) (string, error)
+	// posts of a group awaiting approval, oldest first
+	GetPendingGroupPosts(ctx context.Context, arg GetPendingGroupPostsParams) ([]GetPendingGroupPostsRow, error)
 	GetPersonalizedFeed(ctx context.Context, arg GetPersonalizedFeedParams) ([]GetPersonalizedFeedRow, error)
 	GetPostAudience(ctx context.Context, postID int64) ([]int64, error)
 	GetPostByID(ctx context.Context, arg GetPostByIDParams) (GetPostByIDRow, error)
+	// no rows if the post doesn't exist or is deleted
+	GetPostGroupFlags(ctx context.Context, id int64) (GetPostGroupFlagsRow, error)
+	// share counters of the given posts and the post each of them shares, if any
+	GetPostShares(ctx context.Context, arg GetPostSharesParams) ([]GetPostSharesRow, error)
+	// those of the given posts the user can see
+	// FollowingIds are the users they follow, GroupIds the groups they are a member of
+	GetPostsUserCanSee(ctx context.Context, arg GetPostsUserCanSeeParams) ([]int64, error)
 	GetPublicFeed(ctx context.Context, arg GetPublicFeedParams) ([]GetPublicFeedRow, error)
+	// previous versions of a post or comment that isn't deleted, most recent first
+	GetRevisions(ctx context.Context, arg GetRevisionsParams) ([]ContentRevision, error)
+	// posts of the creator waiting to be published, next to go out first
+	GetScheduledPosts(ctx context.Context, arg GetScheduledPostsParams) ([]GetScheduledPostsRow, error)
+	// what decides who a post can be shared with
+	// is_public: anyone can see it, so any audience can see a share of it
+	GetShareTarget(ctx context.Context, id int64) (GetShareTargetRow, error)
+	// the posts shared by reposts and quotes, to embed in them
+	// no access check here, the caller checks the posts first
+	GetSharedPosts(ctx context.Context, arg GetSharedPostsParams) ([]GetSharedPostsRow, error)
+	// most used tags in public posts created since the given time
+	// ranked by distinct authors first, so a single user can't push a tag up alone
+	GetTrendingHashtags(ctx context.Context, arg GetTrendingHashtagsParams) ([]GetTrendingHashtagsRow, error)
+	// the given images that no post, comment, event or draft holds, deleted ones included
+	GetUnreferencedImageIds(ctx context.Context, ids []int64) ([]int64, error)
 	// pagination
 	GetUserPostsPaginated(ctx context.Context, arg GetUserPostsPaginatedParams) ([]GetUserPostsPaginatedRow, error)
-	GetWhoLikedEntityId(ctx context.Context, contentID int64) ([]int64, error)
+	// those of the given users who can see the post
+	// FollowerIds are the followers of its creator, MemberIds the members of its group
+	GetUsersWhoCanSeePost(ctx context.Context, arg GetUsersWhoCanSeePostParams) ([]int64, error)
+	// users with a live reaction on the entity, only those of the given type unless it's empty
+	GetWhoLikedEntityId(ctx context.Context, arg GetWhoLikedEntityIdParams) ([]int64, error)
+	InsertArchivedGroup(ctx context.Context, groupID int64) error
+	// keeps the current body of a comment as a revision, if it's about to be replaced by a different one
+	// must run before EditComment
+	InsertCommentRevision(ctx context.Context, arg InsertCommentRevisionParams) (int64, error)
+	InsertDeactivatedUser(ctx context.Context, userID int64) error
+	// hides every post and event of the group
+	InsertDeletedGroup(ctx context.Context, groupID int64) error
+	// the three arrays are zipped, one mention per position
+	InsertMentions(ctx context.Context, arg InsertMentionsParams) (int64, error)
+	InsertModeratedGroup(ctx context.Context, groupID int64) error
 	InsertPostAudience(ctx context.Context, arg InsertPostAudienceParams) (int64, error)
+	// keeps the current body of a published post as a revision, if it's about to be replaced by a different one
+	// must run before EditPostContent
+	InsertPostRevision(ctx context.Context, arg InsertPostRevisionParams) (int64, error)
+	InsertPublicGroup(ctx context.Context, groupID int64) error
+	IsGroupArchived(ctx context.Context, groupID int64) (bool, error)
+	IsGroupModerated(ctx context.Context, groupID int64) (bool, error)
+	IsGroupPublic(ctx context.Context, groupID int64) (bool, error)
+	// serializes pinning in a group until the end of the transaction
+	// the key is the group id, nothing else takes advisory locks in this db
+	LockGroupPins(ctx context.Context, groupID int64) error
+	// returns 0 rows if the post is already pinned
+	PinPost(ctx context.Context, arg PinPostParams) (int64, error)
+	// publishes up to limit posts whose publish time has come and returns them
+	// each post is returned once, even with several workers running concurrently
+	PublishDuePosts(ctx context.Context, limit int32) ([]PublishDuePostsRow, error)
+	RemoveImages(ctx context.Context, arg []int64) error
+	// moves the publish time of a post that hasn't been published yet
+	// returns 0 rows if the post doesn't exist, isn't owned by the creator or was already published
+	ReschedulePost(ctx context.Context, arg ReschedulePostParams) (int64, error)
+	// approves or rejects a pending post, returns 0 rows if the post isn't pending
+	ReviewPost(ctx context.Context, arg ReviewPostParams) (int64, error)
+	// posts, comments and events matching a web search style query that the user can see, best match first
+	// post_id is the post of a comment (the post itself for posts, 0 for events)
+	SearchContent(ctx context.Context, arg SearchContentParams) ([]SearchContentRow, error)
+	// returns 0 rows if the post already had the given value
+	SetPostAnnouncement(ctx context.Context, arg SetPostAnnouncementParams) (int64, error)
+	// replaces the tags of the given post, an empty list clears them
+	SetPostHashtags(ctx context.Context, arg SetPostHashtagsParams) error
+	// replaces the gallery of the given parent with the given image ids, in the given order
+	// images left out of the list are soft-deleted, images already in the gallery are reordered
+	SetPostImages(ctx context.Context, arg SetPostImagesParams) error
 	// U1: Users who liked one or more of *your public posts*
 	// U2: Users who commented on your public posts
 	// U3: Users who liked the same posts as you
 	// U4: Users who commented on the same posts as you
 	// Combine scores
 	SuggestUsersByPostActivity(ctx context.Context, creatorID int64) ([]int64, error)
+	// reacting again with the same type removes the reaction, a different type replaces it
+	// only a first reaction on the entity should notify
 	ToggleOrInsertReaction(ctx context.Context, arg ToggleOrInsertReactionParams) (ToggleOrInsertReactionResult, error)
+	// returns 0 rows if the post isn't pinned
+	UnpinPost(ctx context.Context, id int64) (int64, error)
+	// deletes the creator's repost of the given post, returns 0 rows if there's none
+	UndoRepost(ctx context.Context, arg UndoRepostParams) (int64, error)
+	// replaces the content of a draft and pushes back its expiry
+	// returns the images the draft held before the update
+	// returns no rows if the draft doesn't exist, isn't owned by the creator or has expired
+	UpdateDraft(ctx context.Context, arg UpdateDraftParams) ([]int64, error)
 	UpdatePostAudience(ctx context.Context, arg UpdatePostAudienceParams) (int64, error)
 	UpsertEventResponse(ctx context.Context, arg UpsertEventResponseParams) (int64, error)
+	// sets the single image of the given parent, soft-deleting any other image it had
 	UpsertImage(ctx context.Context, arg UpsertImageParams) error
 }
 
@@ -8,10 +8,17 @@ const getWhoLikedEntityId = `-- name: GetWhoLikedEntityId :many
 SELECT user_id
 FROM reactions
 WHERE content_id = $1 AND deleted_at IS NULL
+  AND ($2::text = '' OR reaction_type::text = $2::text)
 `
 
-func (q *Queries) GetWhoLikedEntityId(ctx context.Context, contentID int64) ([]int64, error) {
-	rows, err := q.db.Query(ctx, getWhoLikedEntityId, contentID)
+type GetWhoLikedEntityIdParams struct {
+	ContentID    int64
+	ReactionType string
+}
+
+// users with a live reaction on the entity, only those of the given type unless it's empty
+func (q *Queries) GetWhoLikedEntityId(ctx context.Context, arg GetWhoLikedEntityIdParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, getWhoLikedEntityId, arg.ContentID, arg.ReactionType)
 	if err != nil {
 		return nil, err
 	}
@@ -38,11 +45,23 @@ WITH toggled_off AS (
     WHERE content_id = $1
       AND user_id = $2
       AND deleted_at IS NULL
+      AND reaction_type = $3::reaction_type
+    RETURNING 1
+),
+switched AS (
+    UPDATE reactions
+    SET reaction_type = $3::reaction_type,
+        updated_at = NOW()
+    WHERE content_id = $1
+      AND user_id = $2
+      AND deleted_at IS NULL
+      AND reaction_type <> $3::reaction_type
     RETURNING 1
 ),
 restored AS (
     UPDATE reactions
     SET deleted_at = NULL,
+        reaction_type = $3::reaction_type,
         updated_at = NOW()
     WHERE content_id = $1
       AND user_id = $2
@@ -51,9 +70,10 @@ restored AS (
     RETURNING 1
 ),
 inserted AS (
-    INSERT INTO reactions (content_id, user_id, created_at, updated_at, deleted_at)
-    SELECT $1, $2, NOW(), NOW(), NULL
+    INSERT INTO reactions (content_id, user_id, reaction_type, created_at, updated_at, deleted_at)
+    SELECT $1, $2, $3::reaction_type, NOW(), NOW(), NULL
     WHERE NOT EXISTS (SELECT 1 FROM toggled_off)
+      AND NOT EXISTS (SELECT 1 FROM switched)
       AND NOT EXISTS (SELECT 1 FROM restored)
       AND NOT EXISTS (
         SELECT 1 FROM reactions WHERE content_id = $1 AND user_id = $2
@@ -63,16 +83,18 @@ inserted AS (
 SELECT
     CASE
         WHEN EXISTS (SELECT 1 FROM toggled_off) THEN 'removed'
-        WHEN EXISTS (SELECT 1 FROM restored)   THEN 'restored'
-        WHEN EXISTS (SELECT 1 FROM inserted)   THEN 'added'
+        WHEN EXISTS (SELECT 1 FROM switched)    THEN 'switched'
+        WHEN EXISTS (SELECT 1 FROM restored)    THEN 'restored'
+        WHEN EXISTS (SELECT 1 FROM inserted)    THEN 'added'
         ELSE 'noop'
     END AS action,
     EXISTS (SELECT 1 FROM inserted) AS should_notify;
 `
 
 type ToggleOrInsertReactionParams struct {
-	ContentID int64
-	UserID    int64
+	ContentID    int64
+	UserID       int64
+	ReactionType ReactionType
 }
 
 type ToggleOrInsertReactionResult struct {
@@ -80,9 +102,11 @@ type ToggleOrInsertReactionResult struct {
 	ShouldNotify bool
 }
 
+// reacting again with the same type removes the reaction, a different type replaces it
+// only a first reaction on the entity should notify
 func (q *Queries) ToggleOrInsertReaction(ctx context.Context, arg ToggleOrInsertReactionParams) (ToggleOrInsertReactionResult, error) {
 	var res ToggleOrInsertReactionResult
-	err := q.db.QueryRow(ctx, toggleOrInsertReaction, arg.ContentID, arg.UserID).
+	err := q.db.QueryRow(ctx, toggleOrInsertReaction, arg.ContentID, arg.UserID, arg.ReactionType).
 		Scan(&res.Action, &res.ShouldNotify)
 	if err != nil {
 		return ToggleOrInsertReactionResult{}, err
@@ -0,0 +1,102 @@
+package dbservice
+
+import (
+	"context"
+)
+
+const getRevisions = `-- name: GetRevisions :many
+SELECT r.id, r.content_id, r.body, r.created_at
+FROM content_revisions r
+WHERE r.content_id = $1
+  AND (
+        EXISTS (SELECT 1 FROM posts p WHERE p.id = r.content_id AND p.deleted_at IS NULL)
+        OR EXISTS (SELECT 1 FROM comments c WHERE c.id = r.content_id AND c.deleted_at IS NULL)
+      )
+ORDER BY r.created_at DESC, r.id DESC
+LIMIT $2 OFFSET $3
+`
+
+type GetRevisionsParams struct {
+	ContentID int64
+	Limit     int32
+	Offset    int32
+}
+
+// previous versions of a post or comment that isn't deleted, most recent first
+func (q *Queries) GetRevisions(ctx context.Context, arg GetRevisionsParams) ([]ContentRevision, error) {
+	rows, err := q.db.Query(ctx, getRevisions, arg.ContentID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ContentRevision{}
+	for rows.Next() {
+		var i ContentRevision
+		if err := rows.Scan(
+			&i.ID,
+			&i.ContentID,
+			&i.Body,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertCommentRevision = `-- name: InsertCommentRevision :execrows
+INSERT INTO content_revisions (content_id, body, created_at)
+SELECT c.id, c.comment_body, COALESCE(c.edited_at, c.created_at)
+FROM comments c
+WHERE c.id = $1
+  AND c.comment_creator_id = $2
+  AND c.deleted_at IS NULL
+  AND c.comment_body <> $3::text
+`
+
+type InsertCommentRevisionParams struct {
+	ID               int64
+	CommentCreatorID int64
+	NewBody          string
+}
+
+// keeps the current body of a comment as a revision, if it's about to be replaced by a different one
+// must run before EditComment
+func (q *Queries) InsertCommentRevision(ctx context.Context, arg InsertCommentRevisionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, insertCommentRevision, arg.ID, arg.CommentCreatorID, arg.NewBody)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const insertPostRevision = `-- name: InsertPostRevision :execrows
+INSERT INTO content_revisions (content_id, body, created_at)
+SELECT p.id, p.post_body, COALESCE(p.edited_at, p.created_at)
+FROM posts p
+WHERE p.id = $1
+  AND p.creator_id = $2
+  AND p.deleted_at IS NULL
+  AND p.publish_at IS NULL -- changes before a scheduled post goes out aren't history
+  AND p.post_body <> $3::text
+`
+
+type InsertPostRevisionParams struct {
+	ID        int64
+	CreatorID int64
+	NewBody   string
+}
+
+// keeps the current body of a published post as a revision, if it's about to be replaced by a different one
+// must run before EditPostContent
+func (q *Queries) InsertPostRevision(ctx context.Context, arg InsertPostRevisionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, insertPostRevision, arg.ID, arg.CreatorID, arg.NewBody)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,251 @@
+package dbservice
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const cancelScheduledPost = `-- name: CancelScheduledPost :execrows
+UPDATE posts
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE id = $1
+  AND creator_id = $2
+  AND publish_at IS NOT NULL
+  AND deleted_at IS NULL
+`
+
+type CancelScheduledPostParams struct {
+	ID        int64
+	CreatorID int64
+}
+
+// deletes a post that hasn't been published yet
+// returns 0 rows if the post doesn't exist, isn't owned by the creator or was already published
+func (q *Queries) CancelScheduledPost(ctx context.Context, arg CancelScheduledPostParams) (int64, error) {
+	result, err := q.db.Exec(ctx, cancelScheduledPost, arg.ID, arg.CreatorID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getDueGroupPosts = `-- name: GetDueGroupPosts :many
+SELECT
+    id,
+    creator_id,
+    group_id::bigint AS group_id
+FROM posts
+WHERE publish_at <= CURRENT_TIMESTAMP
+  AND deleted_at IS NULL
+  AND group_id IS NOT NULL
+ORDER BY publish_at ASC
+LIMIT $1
+`
+
+type GetDueGroupPostsRow struct {
+	ID        int64
+	CreatorID int64
+	GroupID   int64
+}
+
+// group posts whose publish time has come, without locking them
+// lets the caller check their creators before PublishDuePosts claims them
+func (q *Queries) GetDueGroupPosts(ctx context.Context, limit int32) ([]GetDueGroupPostsRow, error) {
+	rows, err := q.db.Query(ctx, getDueGroupPosts, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetDueGroupPostsRow{}
+	for rows.Next() {
+		var i GetDueGroupPostsRow
+		if err := rows.Scan(&i.ID, &i.CreatorID, &i.GroupID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getScheduledPosts = `-- name: GetScheduledPosts :many
+SELECT
+    p.id,
+    p.post_body,
+    COALESCE(p.group_id, 0)::bigint AS group_id,
+    p.audience,
+    p.approval_status,
+    p.publish_at,
+    p.created_at,
+    p.updated_at,
+    p.edited_at IS NOT NULL AS edited,
+
+COALESCE(
+    (SELECT array_agg(i.id ORDER BY i.sort_order ASC, i.id ASC)
+     FROM images i
+     WHERE i.parent_id = p.id AND i.deleted_at IS NULL
+    ), ARRAY[]::bigint[]
+)::bigint[] AS images
+
+FROM posts p
+WHERE p.creator_id = $1
+  AND p.publish_at IS NOT NULL
+  AND p.deleted_at IS NULL
+ORDER BY p.publish_at ASC, p.id ASC
+LIMIT $2 OFFSET $3
+`
+
+type GetScheduledPostsParams struct {
+	CreatorID int64
+	Limit     int32
+	Offset    int32
+}
+
+type GetScheduledPostsRow struct {
+	ID             int64
+	PostBody       string
+	GroupID        int64
+	Audience       IntendedAudience
+	ApprovalStatus PostApprovalStatus
+	PublishAt      pgtype.Timestamptz
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+	Edited         bool
+	Images         []int64
+}
+
+// posts of the creator waiting to be published, next to go out first
+func (q *Queries) GetScheduledPosts(ctx context.Context, arg GetScheduledPostsParams) ([]GetScheduledPostsRow, error) {
+	rows, err := q.db.Query(ctx, getScheduledPosts, arg.CreatorID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetScheduledPostsRow{}
+	for rows.Next() {
+		var i GetScheduledPostsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PostBody,
+			&i.GroupID,
+			&i.Audience,
+			&i.ApprovalStatus,
+			&i.PublishAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Edited,
+			&i.Images,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const publishDuePosts = `-- name: PublishDuePosts :many
+UPDATE posts p
+SET publish_at = NULL,
+    created_at = CURRENT_TIMESTAMP -- published posts show up in feeds as new
+WHERE p.id IN (
+    SELECT d.id
+    FROM posts d
+    WHERE d.publish_at <= CURRENT_TIMESTAMP
+      AND d.deleted_at IS NULL
+    ORDER BY d.publish_at ASC
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED -- rows claimed by another replica are left to it
+)
+RETURNING
+    p.id,
+    p.creator_id,
+    COALESCE(p.group_id, 0)::bigint AS group_id,
+    p.approval_status,
+    p.post_body
+`
+
+type PublishDuePostsRow struct {
+	ID             int64
+	CreatorID      int64
+	GroupID        int64
+	ApprovalStatus PostApprovalStatus
+	PostBody       string
+}
+
+// publishes up to limit posts whose publish time has come and returns them
+// each post is returned once, even with several workers running concurrently
+func (q *Queries) PublishDuePosts(ctx context.Context, limit int32) ([]PublishDuePostsRow, error) {
+	rows, err := q.db.Query(ctx, publishDuePosts, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PublishDuePostsRow{}
+	for rows.Next() {
+		var i PublishDuePostsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatorID,
+			&i.GroupID,
+			&i.ApprovalStatus,
+			&i.PostBody,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deferDuePost = `-- name: DeferDuePost :exec
+UPDATE posts
+SET publish_at = $2
+WHERE id = $1
+  AND publish_at IS NULL
+  AND deleted_at IS NULL
+`
+
+type DeferDuePostParams struct {
+	ID        int64
+	PublishAt pgtype.Timestamptz
+}
+
+// puts a post just claimed by PublishDuePosts back in the schedule, to retry it later
+func (q *Queries) DeferDuePost(ctx context.Context, arg DeferDuePostParams) error {
+	_, err := q.db.Exec(ctx, deferDuePost, arg.ID, arg.PublishAt)
+	return err
+}
+
+const reschedulePost = `-- name: ReschedulePost :execrows
+UPDATE posts
+SET publish_at = $3
+WHERE id = $1
+  AND creator_id = $2
+  AND publish_at IS NOT NULL
+  AND deleted_at IS NULL
+`
+
+type ReschedulePostParams struct {
+	ID        int64
+	CreatorID int64
+	PublishAt pgtype.Timestamptz
+}
+
+// moves the publish time of a post that hasn't been published yet
+// returns 0 rows if the post doesn't exist, isn't owned by the creator or was already published
+func (q *Queries) ReschedulePost(ctx context.Context, arg ReschedulePostParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reschedulePost, arg.ID, arg.CreatorID, arg.PublishAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
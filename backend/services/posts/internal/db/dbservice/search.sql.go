@@ -0,0 +1,175 @@
+package dbservice
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const searchContent = `-- name: SearchContent :many
+WITH search AS (
+    SELECT websearch_to_tsquery('english', $2::text) AS query
+),
+-- same rules as CanUserSeeEntity, with follows and memberships passed in
+visible_posts AS NOT MATERIALIZED (
+    SELECT p.id, p.group_id
+    FROM posts p
+    WHERE p.deleted_at IS NULL
+      AND NOT EXISTS (SELECT 1 FROM deleted_groups dg WHERE dg.group_id = p.group_id)
+      AND (
+           p.creator_id = $1
+           OR (
+               NOT EXISTS (SELECT 1 FROM deactivated_users du WHERE du.user_id = p.creator_id)
+               AND p.approval_status = 'approved'
+               AND p.publish_at IS NULL
+               AND (
+                   (p.group_id IS NOT NULL AND (
+                       p.group_id = ANY($4::bigint[])
+                       OR EXISTS (SELECT 1 FROM public_groups pg WHERE pg.group_id = p.group_id)
+                   ))
+                   OR (p.group_id IS NULL AND (
+                       p.audience = 'everyone'
+                       OR (p.audience = 'followers' AND p.creator_id = ANY($3::bigint[]))
+                       OR (p.audience = 'selected' AND EXISTS (
+                           SELECT 1 FROM post_audience pa
+                           WHERE pa.post_id = p.id AND pa.allowed_user_id = $1
+                       ))
+                   ))
+               )
+           )
+      )
+)
+SELECT
+    m.entity_type,
+    m.id,
+    m.post_id,
+    m.group_id,
+    m.creator_id,
+    m.title,
+    m.body,
+    m.created_at
+FROM (
+    SELECT
+        'post'::text AS entity_type,
+        p.id,
+        p.id AS post_id,
+        COALESCE(p.group_id, 0)::bigint AS group_id,
+        p.creator_id,
+        ''::text AS title,
+        p.post_body AS body,
+        ts_rank_cd(p.search_vector, s.query) AS rank,
+        p.created_at
+    FROM posts p
+    JOIN visible_posts vp ON vp.id = p.id
+    CROSS JOIN search s
+    WHERE p.search_vector @@ s.query
+
+    UNION ALL
+
+    -- comments are visible with their post
+    SELECT
+        'comment'::text,
+        c.id,
+        c.parent_id,
+        COALESCE(vp.group_id, 0)::bigint,
+        c.comment_creator_id,
+        ''::text,
+        c.comment_body,
+        ts_rank_cd(c.search_vector, s.query),
+        c.created_at
+    FROM comments c
+    JOIN visible_posts vp ON vp.id = c.parent_id
+    CROSS JOIN search s
+    WHERE c.search_vector @@ s.query
+      AND c.deleted_at IS NULL
+      AND NOT EXISTS (SELECT 1 FROM deactivated_users du WHERE du.user_id = c.comment_creator_id)
+
+    UNION ALL
+
+    SELECT
+        'event'::text,
+        e.id,
+        0::bigint,
+        e.group_id,
+        e.event_creator_id,
+        e.event_title,
+        e.event_body,
+        ts_rank_cd(e.search_vector, s.query),
+        e.created_at
+    FROM events e
+    CROSS JOIN search s
+    WHERE e.search_vector @@ s.query
+      AND e.deleted_at IS NULL
+      AND NOT EXISTS (SELECT 1 FROM deleted_groups dg WHERE dg.group_id = e.group_id)
+      AND (
+           e.event_creator_id = $1
+           OR (
+               NOT EXISTS (SELECT 1 FROM deactivated_users du WHERE du.user_id = e.event_creator_id)
+               AND (
+                   e.group_id = ANY($4::bigint[])
+                   OR EXISTS (SELECT 1 FROM public_groups pg WHERE pg.group_id = e.group_id)
+               )
+           )
+      )
+) m
+ORDER BY m.rank DESC, m.created_at DESC, m.id DESC
+OFFSET $5 LIMIT $6
+`
+
+type SearchContentParams struct {
+	UserID         int64
+	Query          string
+	FollowingIds   []int64
+	MemberGroupIds []int64
+	Offset         int32
+	Limit          int32
+}
+
+type SearchContentRow struct {
+	EntityType string
+	ID         int64
+	PostID     int64
+	GroupID    int64
+	CreatorID  int64
+	Title      string
+	Body       string
+	CreatedAt  pgtype.Timestamptz
+}
+
+// posts, comments and events matching a web search style query that the user can see, best match first
+// post_id is the post of a comment (the post itself for posts, 0 for events)
+func (q *Queries) SearchContent(ctx context.Context, arg SearchContentParams) ([]SearchContentRow, error) {
+	rows, err := q.db.Query(ctx, searchContent,
+		arg.UserID,
+		arg.Query,
+		arg.FollowingIds,
+		arg.MemberGroupIds,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchContentRow{}
+	for rows.Next() {
+		var i SearchContentRow
+		if err := rows.Scan(
+			&i.EntityType,
+			&i.ID,
+			&i.PostID,
+			&i.GroupID,
+			&i.CreatorID,
+			&i.Title,
+			&i.Body,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,271 @@
+package dbservice
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createSharedPost = `-- name: CreateSharedPost :one
+INSERT INTO posts (post_body, creator_id, audience, shared_post_id, share_kind)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (creator_id, shared_post_id)
+    WHERE share_kind = 'repost' AND deleted_at IS NULL
+DO NOTHING
+RETURNING id
+`
+
+type CreateSharedPostParams struct {
+	PostBody     string
+	CreatorID    int64
+	Audience     IntendedAudience
+	SharedPostID int64
+	ShareKind    ShareKind
+}
+
+// returns no rows if the creator already reposted the post
+func (q *Queries) CreateSharedPost(ctx context.Context, arg CreateSharedPostParams) (int64, error) {
+	row := q.db.QueryRow(ctx, createSharedPost,
+		arg.PostBody,
+		arg.CreatorID,
+		arg.Audience,
+		arg.SharedPostID,
+		arg.ShareKind,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const getPostShares = `-- name: GetPostShares :many
+SELECT
+    p.id,
+    COALESCE(p.shared_post_id, 0)::bigint AS shared_post_id,
+    p.reposts_count,
+    p.quotes_count,
+    EXISTS (
+        SELECT 1 FROM posts r
+        WHERE r.shared_post_id = p.id
+          AND r.creator_id = $1
+          AND r.share_kind = 'repost'
+          AND r.deleted_at IS NULL
+    ) AS reposted_by_user
+FROM posts p
+WHERE p.id = ANY($2::bigint[])
+`
+
+type GetPostSharesParams struct {
+	UserID int64
+	Ids    []int64
+}
+
+type GetPostSharesRow struct {
+	ID             int64
+	SharedPostID   int64
+	RepostsCount   int32
+	QuotesCount    int32
+	RepostedByUser bool
+}
+
+// share counters of the given posts and the post each of them shares, if any
+func (q *Queries) GetPostShares(ctx context.Context, arg GetPostSharesParams) ([]GetPostSharesRow, error) {
+	rows, err := q.db.Query(ctx, getPostShares, arg.UserID, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetPostSharesRow{}
+	for rows.Next() {
+		var i GetPostSharesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SharedPostID,
+			&i.RepostsCount,
+			&i.QuotesCount,
+			&i.RepostedByUser,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getShareTarget = `-- name: GetShareTarget :one
+SELECT
+    p.id,
+    p.creator_id,
+    COALESCE(p.group_id, 0)::bigint AS group_id,
+    p.audience,
+    COALESCE(p.shared_post_id, 0)::bigint AS shared_post_id,
+    (p.share_kind IS NOT DISTINCT FROM 'repost') AS is_repost,
+    (p.approval_status = 'approved' AND p.publish_at IS NULL) AS published,
+    (
+        (p.group_id IS NULL AND p.audience = 'everyone')
+        OR EXISTS (
+            SELECT 1 FROM public_groups pg
+            WHERE pg.group_id = p.group_id
+        )
+    ) AS is_public
+FROM posts p
+WHERE p.id = $1
+  AND p.deleted_at IS NULL
+`
+
+type GetShareTargetRow struct {
+	ID           int64
+	CreatorID    int64
+	GroupID      int64
+	Audience     IntendedAudience
+	SharedPostID int64
+	IsRepost     bool
+	Published    bool
+	IsPublic     bool
+}
+
+// what decides who a post can be shared with
+// is_public: anyone can see it, so any audience can see a share of it
+func (q *Queries) GetShareTarget(ctx context.Context, id int64) (GetShareTargetRow, error) {
+	row := q.db.QueryRow(ctx, getShareTarget, id)
+	var i GetShareTargetRow
+	err := row.Scan(
+		&i.ID,
+		&i.CreatorID,
+		&i.GroupID,
+		&i.Audience,
+		&i.SharedPostID,
+		&i.IsRepost,
+		&i.Published,
+		&i.IsPublic,
+	)
+	return i, err
+}
+
+const getSharedPosts = `-- name: GetSharedPosts :many
+SELECT
+    p.id,
+    p.post_body,
+    p.creator_id,
+    COALESCE(p.group_id, 0)::bigint AS group_id,
+    p.audience,
+    p.comments_count,
+    p.reactions_count,
+    p.reaction_counts,
+    p.last_commented_at,
+    p.created_at,
+    p.updated_at,
+    p.edited_at IS NOT NULL AS edited,
+
+    EXISTS (
+        SELECT 1 FROM reactions r
+        WHERE r.content_id = p.id
+          AND r.user_id = $1
+          AND r.deleted_at IS NULL
+    ) AS liked_by_user,
+
+    COALESCE((   -- and with which reaction
+        SELECT r.reaction_type::text
+        FROM reactions r
+        WHERE r.content_id = p.id
+          AND r.user_id = $1
+          AND r.deleted_at IS NULL
+    ), '') AS user_reaction,
+
+COALESCE(
+    (SELECT array_agg(i.id ORDER BY i.sort_order ASC, i.id ASC)
+     FROM images i
+     WHERE i.parent_id = p.id AND i.deleted_at IS NULL
+    ), ARRAY[]::bigint[]
+)::bigint[] AS images
+
+FROM posts p
+WHERE p.id = ANY($2::bigint[])
+  AND p.deleted_at IS NULL
+`
+
+type GetSharedPostsParams struct {
+	UserID int64
+	Ids    []int64
+}
+
+type GetSharedPostsRow struct {
+	ID              int64
+	PostBody        string
+	CreatorID       int64
+	GroupID         int64
+	Audience        IntendedAudience
+	CommentsCount   int32
+	ReactionsCount  int32
+	ReactionCounts  map[string]int32
+	LastCommentedAt pgtype.Timestamptz
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+	Edited          bool
+	LikedByUser     bool
+	UserReaction    string
+	Images          []int64
+}
+
+// the posts shared by reposts and quotes, to embed in them
+// no access check here, the caller checks the posts first
+func (q *Queries) GetSharedPosts(ctx context.Context, arg GetSharedPostsParams) ([]GetSharedPostsRow, error) {
+	rows, err := q.db.Query(ctx, getSharedPosts, arg.UserID, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetSharedPostsRow{}
+	for rows.Next() {
+		var i GetSharedPostsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PostBody,
+			&i.CreatorID,
+			&i.GroupID,
+			&i.Audience,
+			&i.CommentsCount,
+			&i.ReactionsCount,
+			&i.ReactionCounts,
+			&i.LastCommentedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Edited,
+			&i.LikedByUser,
+			&i.UserReaction,
+			&i.Images,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const undoRepost = `-- name: UndoRepost :execrows
+UPDATE posts
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE shared_post_id = $1
+  AND creator_id = $2
+  AND share_kind = 'repost'
+  AND deleted_at IS NULL
+`
+
+type UndoRepostParams struct {
+	SharedPostID int64
+	CreatorID    int64
+}
+
+// deletes the creator's repost of the given post, returns 0 rows if there's none
+func (q *Queries) UndoRepost(ctx context.Context, arg UndoRepostParams) (int64, error) {
+	result, err := q.db.Exec(ctx, undoRepost, arg.SharedPostID, arg.CreatorID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -11,10 +11,14 @@ WITH ent AS (
         id,
         creator_id,
         audience,
-        group_id
+        group_id,
+        approval_status,
+        publish_at
     FROM posts
     WHERE id = $4::bigint
       AND deleted_at IS NULL
+      -- content of deleted groups is hidden from everyone, creators included
+      AND NOT EXISTS (SELECT 1 FROM deleted_groups dg WHERE dg.group_id = posts.group_id)
 
     UNION ALL
 
@@ -23,10 +27,13 @@ WITH ent AS (
         id,
         event_creator_id,
         NULL AS audience,
-        group_id
+        group_id,
+        'approved'::post_approval_status AS approval_status,
+        NULL::timestamptz AS publish_at
     FROM events
     WHERE id = $4::bigint
       AND deleted_at IS NULL
+      AND NOT EXISTS (SELECT 1 FROM deleted_groups dg WHERE dg.group_id = events.group_id)
 )
 SELECT EXISTS (
     SELECT 1
@@ -38,23 +45,42 @@ SELECT EXISTS (
         )
         OR
         (
-            -- CASE 1: group entity
-            e.group_id IS NOT NULL
-            AND $1::bool = TRUE
-        )
-        OR
-        (
-            -- CASE 2: post (no group)
-            e.group_id IS NULL
+            -- content of deactivated users is hidden from everyone else
+            NOT EXISTS (
+                SELECT 1 FROM deactivated_users du
+                WHERE du.user_id = e.creator_id
+            )
+            -- so are posts awaiting approval or rejected
+            AND e.approval_status = 'approved'
+            -- and scheduled posts
+            AND e.publish_at IS NULL
             AND (
-                e.audience = 'everyone'
-                OR (e.audience = 'followers' AND $2::bool = TRUE)
-                OR (
-                    e.audience = 'selected'
-                    AND EXISTS (
-                        SELECT 1 FROM post_audience pa
-                        WHERE pa.post_id = e.id
-                          AND pa.allowed_user_id = $3::bigint
+                (
+                    -- CASE 1: group entity, members or anyone if the group is public
+                    e.group_id IS NOT NULL
+                    AND (
+                        $1::bool = TRUE
+                        OR EXISTS (
+                            SELECT 1 FROM public_groups pg
+                            WHERE pg.group_id = e.group_id
+                        )
+                    )
+                )
+                OR
+                (
+                    -- CASE 2: post (no group)
+                    e.group_id IS NULL
+                    AND (
+                        e.audience = 'everyone'
+                        OR (e.audience = 'followers' AND $2::bool = TRUE)
+                        OR (
+                            e.audience = 'selected'
+                            AND EXISTS (
+                                SELECT 1 FROM post_audience pa
+                                WHERE pa.post_id = e.id
+                                  AND pa.allowed_user_id = $3::bigint
+                            )
+                        )
                     )
                 )
             )
@@ -88,7 +114,7 @@ SELECT
      -- Who created THIS content (comment author, post author, event creator)
     (
         CASE
-            WHEN mi.content_type = 'post'
+            WHEN mi.content_type IN ('post', 'repost', 'quote')
                 THEN p.creator_id
             WHEN mi.content_type = 'event'
                 THEN e.event_creator_id
@@ -109,7 +135,7 @@ SELECT
     -- group_id: post.group_id, event.group_id, or parent post group for comments
 COALESCE(
     CASE
-        WHEN mi.content_type = 'post'    THEN p.group_id
+        WHEN mi.content_type IN ('post', 'repost', 'quote') THEN p.group_id
         WHEN mi.content_type = 'event'   THEN e.group_id
         WHEN mi.content_type = 'comment' THEN p2.group_id
     END,
@@ -145,3 +171,166 @@ func (q *Queries) GetEntityCreatorAndGroup(ctx context.Context, id int64) (GetEn
 	err := row.Scan(&i.ContentType, &i.CreatorID, &i.ParentCreatorID, &i.GroupID, &i.ParentID)
 	return i, err
 }
+
+const getPostsUserCanSee = `-- name: GetPostsUserCanSee :many
+-- same rules as CanUserSeeEntity, for many posts at once
+SELECT p.id
+FROM posts p
+WHERE p.id = ANY($1::bigint[])
+  AND p.deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM deleted_groups dg WHERE dg.group_id = p.group_id)
+  AND (
+        p.creator_id = $2
+     OR (
+            NOT EXISTS (
+                SELECT 1 FROM deactivated_users du
+                WHERE du.user_id = p.creator_id
+            )
+            AND p.approval_status = 'approved'
+            AND p.publish_at IS NULL
+            AND (
+                (
+                    p.group_id IS NOT NULL
+                    AND (
+                        p.group_id = ANY($4::bigint[])
+                        OR EXISTS (
+                            SELECT 1 FROM public_groups pg
+                            WHERE pg.group_id = p.group_id
+                        )
+                    )
+                )
+                OR
+                (
+                    p.group_id IS NULL
+                    AND (
+                        p.audience = 'everyone'
+                        OR (p.audience = 'followers' AND p.creator_id = ANY($3::bigint[]))
+                        OR (
+                            p.audience = 'selected'
+                            AND EXISTS (
+                                SELECT 1 FROM post_audience pa
+                                WHERE pa.post_id = p.id
+                                  AND pa.allowed_user_id = $2
+                            )
+                        )
+                    )
+                )
+            )
+        )
+  )
+ORDER BY p.id
+`
+
+type GetPostsUserCanSeeParams struct {
+	Ids          []int64
+	UserID       int64
+	FollowingIds []int64
+	GroupIds     []int64
+}
+
+// those of the given posts the user can see
+// FollowingIds are the users they follow, GroupIds the groups they are a member of
+func (q *Queries) GetPostsUserCanSee(ctx context.Context, arg GetPostsUserCanSeeParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, getPostsUserCanSee,
+		arg.Ids,
+		arg.UserID,
+		arg.FollowingIds,
+		arg.GroupIds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUsersWhoCanSeePost = `-- name: GetUsersWhoCanSeePost :many
+-- same rules as CanUserSeeEntity for a post, for many users at once
+SELECT u.id
+FROM unnest($2::bigint[]) AS u(id)
+JOIN posts p ON p.id = $1
+            AND p.deleted_at IS NULL
+            AND NOT EXISTS (SELECT 1 FROM deleted_groups dg WHERE dg.group_id = p.group_id)
+WHERE u.id = p.creator_id
+   OR (
+        NOT EXISTS (
+            SELECT 1 FROM deactivated_users du
+            WHERE du.user_id = p.creator_id
+        )
+        AND p.approval_status = 'approved'
+        AND p.publish_at IS NULL
+        AND (
+            (
+                p.group_id IS NOT NULL
+                AND (
+                    u.id = ANY($4::bigint[])
+                    OR EXISTS (
+                        SELECT 1 FROM public_groups pg
+                        WHERE pg.group_id = p.group_id
+                    )
+                )
+            )
+            OR
+            (
+                p.group_id IS NULL
+                AND (
+                    p.audience = 'everyone'
+                    OR (p.audience = 'followers' AND u.id = ANY($3::bigint[]))
+                    OR (
+                        p.audience = 'selected'
+                        AND EXISTS (
+                            SELECT 1 FROM post_audience pa
+                            WHERE pa.post_id = p.id
+                              AND pa.allowed_user_id = u.id
+                        )
+                    )
+                )
+            )
+        )
+   )
+`
+
+type GetUsersWhoCanSeePostParams struct {
+	PostID      int64
+	UserIds     []int64
+	FollowerIds []int64
+	MemberIds   []int64
+}
+
+// those of the given users who can see the post
+// FollowerIds are the followers of its creator, MemberIds the members of its group
+func (q *Queries) GetUsersWhoCanSeePost(ctx context.Context, arg GetUsersWhoCanSeePostParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, getUsersWhoCanSeePost,
+		arg.PostID,
+		arg.UserIds,
+		arg.FollowerIds,
+		arg.MemberIds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
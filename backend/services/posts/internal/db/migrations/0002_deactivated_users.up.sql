@@ -0,0 +1,9 @@
+------------------------------------------
+-- Deactivated users
+------------------------------------------
+-- Mirrors users temporarily deactivated in user service.
+-- Content created by these users is hidden until they log in again.
+CREATE TABLE IF NOT EXISTS deactivated_users (
+    user_id BIGINT PRIMARY KEY, -- in user service
+    deactivated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
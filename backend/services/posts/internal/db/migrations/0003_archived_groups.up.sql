@@ -0,0 +1,21 @@
+------------------------------------------
+-- Archived groups
+------------------------------------------
+-- Mirrors groups archived in user service.
+-- Posts and events of these groups are read-only until the group is unarchived.
+CREATE TABLE IF NOT EXISTS archived_groups (
+    group_id BIGINT PRIMARY KEY, -- in user service
+    archived_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+
+------------------------------------------
+-- Deleted groups
+------------------------------------------
+-- Mirrors groups deleted in user service, filled from the GroupDeleted event.
+-- Posts and events of these groups are hidden from everyone, their creators included,
+-- but kept as they were so the group can be restored.
+CREATE TABLE IF NOT EXISTS deleted_groups (
+    group_id BIGINT PRIMARY KEY, -- in user service
+    deleted_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,9 @@
+------------------------------------------
+-- Public groups
+------------------------------------------
+-- Mirrors groups with public visibility in user service.
+-- Posts and events of these groups are readable by anyone, not only members.
+CREATE TABLE IF NOT EXISTS public_groups (
+    group_id BIGINT PRIMARY KEY, -- in user service
+    made_public_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
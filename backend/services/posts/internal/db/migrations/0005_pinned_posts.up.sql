@@ -0,0 +1,13 @@
+------------------------------------------
+-- Pinned posts and announcements
+------------------------------------------
+-- Group staff can pin a limited number of posts to the top of the group feed
+-- and mark posts as announcements, which notifies all members.
+ALTER TABLE posts
+    ADD COLUMN IF NOT EXISTS pinned_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS pinned_by BIGINT, -- in user service
+    ADD COLUMN IF NOT EXISTS is_announcement BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS idx_posts_group_pinned
+ON posts(group_id, pinned_at DESC)
+WHERE pinned_at IS NOT NULL AND deleted_at IS NULL;
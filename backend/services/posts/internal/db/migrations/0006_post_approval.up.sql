@@ -0,0 +1,22 @@
+------------------------------------------
+-- Post approval
+------------------------------------------
+-- Mirrors groups in user service whose new posts need approval by group staff.
+CREATE TABLE IF NOT EXISTS moderated_groups (
+    group_id BIGINT PRIMARY KEY, -- in user service
+    enabled_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Posts created in a moderated group start as pending and are only visible
+-- to their author and group staff until approved.
+CREATE TYPE post_approval_status AS ENUM ('approved', 'pending', 'rejected');
+
+ALTER TABLE posts
+    ADD COLUMN IF NOT EXISTS approval_status post_approval_status NOT NULL DEFAULT 'approved',
+    ADD COLUMN IF NOT EXISTS reviewed_by BIGINT, -- in user service
+    ADD COLUMN IF NOT EXISTS reviewed_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS rejection_reason TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_posts_group_pending
+ON posts(group_id, created_at)
+WHERE approval_status = 'pending' AND deleted_at IS NULL;
@@ -0,0 +1,17 @@
+------------------------------------------
+-- Post image galleries
+------------------------------------------
+-- A parent (post, comment, event) can now hold several images.
+-- Order is set explicitly by the application through sort_order,
+-- so the set_next_sort_order trigger stays unused.
+ALTER TABLE images DROP CONSTRAINT IF EXISTS images_pkey;
+ALTER TABLE images ADD PRIMARY KEY (parent_id, id);
+
+DROP INDEX IF EXISTS idx_images_active;
+CREATE INDEX IF NOT EXISTS idx_images_active
+ON images(parent_id, sort_order)
+WHERE deleted_at IS NULL;
+
+-- The primary key leads with parent_id, so finding which parent holds an image
+-- (to reject images of other posts in a gallery) needs its own index.
+CREATE INDEX IF NOT EXISTS idx_images_id ON images(id);
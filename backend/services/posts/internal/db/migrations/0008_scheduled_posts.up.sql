@@ -0,0 +1,17 @@
+------------------------------------------
+-- Scheduled posts
+------------------------------------------
+-- A post with publish_at set is scheduled: only its creator can see it until
+-- the publishing worker clears publish_at, which happens once per post.
+ALTER TABLE posts
+    ADD COLUMN IF NOT EXISTS publish_at TIMESTAMPTZ;
+
+-- due posts for the publishing worker
+CREATE INDEX IF NOT EXISTS idx_posts_publish_at
+ON posts(publish_at)
+WHERE publish_at IS NOT NULL AND deleted_at IS NULL;
+
+-- scheduled posts of a user
+CREATE INDEX IF NOT EXISTS idx_posts_creator_scheduled
+ON posts(creator_id, publish_at)
+WHERE publish_at IS NOT NULL AND deleted_at IS NULL;
@@ -0,0 +1,34 @@
+------------------------------------------
+-- Drafts
+------------------------------------------
+-- Posts and comments saved before publishing. Drafts stay out of master_index:
+-- nobody but their creator sees them and they get a content id once published.
+-- A draft expires once it hasn't been saved for a while. Its images are deleted in media
+-- service by the application, unless a post or another draft holds them.
+CREATE TYPE draft_kind AS ENUM ('post','comment');
+
+CREATE TABLE IF NOT EXISTS drafts (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    creator_id BIGINT NOT NULL, -- in user service
+    kind draft_kind NOT NULL,
+    body TEXT NOT NULL DEFAULT '',
+    parent_id BIGINT REFERENCES posts(id) ON DELETE CASCADE, -- post a comment draft replies to
+    group_id BIGINT, -- in user service, post drafts only
+    audience intended_audience, -- post drafts only
+    audience_ids BIGINT[] NOT NULL DEFAULT '{}', -- for 'selected' audience
+    image_ids BIGINT[] NOT NULL DEFAULT '{}', -- in display order
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMPTZ NOT NULL,
+    CHECK ((kind = 'comment') = (parent_id IS NOT NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_drafts_creator_updated ON drafts(creator_id, updated_at DESC);
+CREATE INDEX IF NOT EXISTS idx_drafts_expires_at ON drafts(expires_at);
+-- images dropped from a draft are deleted unless another draft still holds them
+CREATE INDEX IF NOT EXISTS idx_drafts_image_ids ON drafts USING GIN (image_ids);
+
+CREATE TRIGGER trg_update_drafts_modtime
+BEFORE UPDATE ON drafts
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
@@ -0,0 +1,19 @@
+------------------------------------------
+-- Edit history
+------------------------------------------
+-- Every edit of a post or comment body keeps the version it replaces.
+-- edited_at is set on the first edit and moved on every following one.
+ALTER TABLE posts
+    ADD COLUMN IF NOT EXISTS edited_at TIMESTAMPTZ;
+
+ALTER TABLE comments
+    ADD COLUMN IF NOT EXISTS edited_at TIMESTAMPTZ;
+
+CREATE TABLE IF NOT EXISTS content_revisions (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    content_id BIGINT NOT NULL REFERENCES master_index(id) ON DELETE CASCADE, -- post or comment
+    body TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL -- when this version was written, not when it was replaced
+);
+
+CREATE INDEX IF NOT EXISTS idx_content_revisions_content_created ON content_revisions(content_id, created_at DESC);
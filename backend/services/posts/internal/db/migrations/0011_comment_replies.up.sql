@@ -0,0 +1,106 @@
+------------------------------------------
+-- Comment replies
+------------------------------------------
+-- parent_id stays the post for replies too, so post counters and access checks see the whole thread.
+-- reply_to_id is the comment being replied to, null for top level comments.
+-- A deleted comment stays in its thread while any reply under it, at any depth, is still live.
+-- replies_count only counts live direct replies, so in A -> B -> C deleting B then A would hide A
+-- and leave C unreachable. shown_replies_count counts direct replies that are still shown:
+-- live ones, and deleted ones that are themselves holding replies.
+ALTER TABLE comments
+    ADD COLUMN IF NOT EXISTS reply_to_id BIGINT REFERENCES comments(id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS depth SMALLINT NOT NULL DEFAULT 0, -- 0 for top level comments
+    ADD COLUMN IF NOT EXISTS replies_count INT NOT NULL DEFAULT 0, -- live direct replies
+    ADD COLUMN IF NOT EXISTS shown_replies_count INT NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_comments_reply_to_created ON comments(reply_to_id, created_at) WHERE reply_to_id IS NOT NULL;
+
+------------------------------------------
+-- Trigger to maintain replies_count
+------------------------------------------
+CREATE OR REPLACE FUNCTION update_comment_replies_count()
+RETURNS TRIGGER AS $$
+BEGIN
+    -- New reply
+    IF TG_OP = 'INSERT' AND NEW.reply_to_id IS NOT NULL THEN
+        UPDATE comments
+        SET replies_count = replies_count + 1
+        WHERE id = NEW.reply_to_id;
+
+    -- Soft delete
+    ELSIF TG_OP = 'UPDATE'
+      AND OLD.reply_to_id IS NOT NULL
+      AND OLD.deleted_at IS NULL
+      AND NEW.deleted_at IS NOT NULL THEN
+
+        UPDATE comments
+        SET replies_count = GREATEST(replies_count - 1, 0)
+        WHERE id = OLD.reply_to_id;
+
+    -- Restore reply
+    ELSIF TG_OP = 'UPDATE'
+      AND NEW.reply_to_id IS NOT NULL
+      AND OLD.deleted_at IS NOT NULL
+      AND NEW.deleted_at IS NULL THEN
+
+        UPDATE comments
+        SET replies_count = replies_count + 1
+        WHERE id = NEW.reply_to_id;
+
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_comment_replies_insert
+AFTER INSERT ON comments
+FOR EACH ROW
+EXECUTE FUNCTION update_comment_replies_count();
+
+CREATE TRIGGER trg_comment_replies_update
+AFTER UPDATE OF deleted_at ON comments
+FOR EACH ROW
+EXECUTE FUNCTION update_comment_replies_count();
+
+------------------------------------------
+-- Trigger to maintain shown_replies_count
+------------------------------------------
+-- Updating the parent's count fires this again on the parent, so changes walk up the thread
+-- and stop at the first comment whose shown state doesn't change.
+CREATE OR REPLACE FUNCTION update_comment_shown_replies_count()
+RETURNS TRIGGER AS $$
+DECLARE
+    was_shown BOOLEAN := FALSE;
+    is_shown BOOLEAN;
+BEGIN
+    IF NEW.reply_to_id IS NULL THEN
+        RETURN NULL;
+    END IF;
+
+    is_shown := NEW.deleted_at IS NULL OR NEW.shown_replies_count > 0;
+    IF TG_OP = 'UPDATE' THEN
+        was_shown := OLD.deleted_at IS NULL OR OLD.shown_replies_count > 0;
+    END IF;
+
+    IF is_shown = was_shown THEN
+        RETURN NULL;
+    END IF;
+
+    UPDATE comments
+    SET shown_replies_count = GREATEST(shown_replies_count + CASE WHEN is_shown THEN 1 ELSE -1 END, 0)
+    WHERE id = NEW.reply_to_id;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_comment_shown_replies_insert
+AFTER INSERT ON comments
+FOR EACH ROW
+EXECUTE FUNCTION update_comment_shown_replies_count();
+
+CREATE TRIGGER trg_comment_shown_replies_update
+AFTER UPDATE OF deleted_at, shown_replies_count ON comments
+FOR EACH ROW
+EXECUTE FUNCTION update_comment_shown_replies_count();
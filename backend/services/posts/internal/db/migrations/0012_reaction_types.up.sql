@@ -0,0 +1,119 @@
+------------------------------------------
+-- Reaction types
+------------------------------------------
+-- A user keeps a single reaction per post or comment and can switch its type.
+CREATE TYPE reaction_type AS ENUM ('like','love','laugh','wow','sad','angry');
+
+ALTER TABLE reactions
+    ADD COLUMN IF NOT EXISTS reaction_type reaction_type NOT NULL DEFAULT 'like'; -- every reaction so far was a like
+
+CREATE INDEX IF NOT EXISTS idx_reactions_content_type ON reactions(content_id, reaction_type) WHERE deleted_at IS NULL;
+
+-- live reactions per type, e.g. {"like": 3, "wow": 1}, a missing type counts as 0
+ALTER TABLE posts
+    ADD COLUMN IF NOT EXISTS reaction_counts JSONB NOT NULL DEFAULT '{}';
+
+ALTER TABLE comments
+    ADD COLUMN IF NOT EXISTS reaction_counts JSONB NOT NULL DEFAULT '{}';
+
+------------------------------------------
+-- Trigger to maintain reactions_count and reaction_counts
+------------------------------------------
+-- Reactions are toggled off and back on with deleted_at, so soft deletes,
+-- restores and type switches move the counters too, not only inserts and deletes.
+CREATE OR REPLACE FUNCTION adjust_reaction_counts(cid BIGINT, rtype reaction_type, delta INT)
+RETURNS VOID AS $$
+DECLARE
+    ctype content_type;
+BEGIN
+    SELECT content_type
+    INTO ctype
+    FROM master_index
+    WHERE id = cid;
+
+    IF ctype = 'post' THEN
+        UPDATE posts
+        SET reactions_count = GREATEST(reactions_count + delta, 0),
+            reaction_counts = reaction_counts || jsonb_build_object(
+                rtype::text,
+                GREATEST(COALESCE((reaction_counts ->> rtype::text)::INT, 0) + delta, 0)
+            )
+        WHERE id = cid;
+
+    ELSIF ctype = 'comment' THEN
+        UPDATE comments
+        SET reactions_count = GREATEST(reactions_count + delta, 0),
+            reaction_counts = reaction_counts || jsonb_build_object(
+                rtype::text,
+                GREATEST(COALESCE((reaction_counts ->> rtype::text)::INT, 0) + delta, 0)
+            )
+        WHERE id = cid;
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION update_reactions_count()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        IF NEW.deleted_at IS NULL THEN
+            PERFORM adjust_reaction_counts(NEW.content_id, NEW.reaction_type, 1);
+        END IF;
+
+    ELSIF TG_OP = 'DELETE' THEN
+        IF OLD.deleted_at IS NULL THEN
+            PERFORM adjust_reaction_counts(OLD.content_id, OLD.reaction_type, -1);
+        END IF;
+
+    -- Soft delete, restore or type switch
+    ELSIF (OLD.deleted_at IS NULL) <> (NEW.deleted_at IS NULL)
+       OR OLD.reaction_type <> NEW.reaction_type THEN
+        IF OLD.deleted_at IS NULL THEN
+            PERFORM adjust_reaction_counts(OLD.content_id, OLD.reaction_type, -1);
+        END IF;
+        IF NEW.deleted_at IS NULL THEN
+            PERFORM adjust_reaction_counts(NEW.content_id, NEW.reaction_type, 1);
+        END IF;
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_reactions_update
+AFTER UPDATE OF deleted_at, reaction_type ON reactions
+FOR EACH ROW
+EXECUTE FUNCTION update_reactions_count();
+
+-- Recount from the live reactions, reactions toggled off used to stay counted
+UPDATE posts p
+SET reactions_count = COALESCE(c.total, 0),
+    reaction_counts = COALESCE(c.per_type, '{}')
+FROM posts p2
+LEFT JOIN (
+    SELECT content_id, SUM(n)::INT AS total, jsonb_object_agg(reaction_type, n) AS per_type
+    FROM (
+        SELECT content_id, reaction_type, COUNT(*) AS n
+        FROM reactions
+        WHERE deleted_at IS NULL
+        GROUP BY content_id, reaction_type
+    ) t
+    GROUP BY content_id
+) c ON c.content_id = p2.id
+WHERE p.id = p2.id;
+
+UPDATE comments cm
+SET reactions_count = COALESCE(c.total, 0),
+    reaction_counts = COALESCE(c.per_type, '{}')
+FROM comments cm2
+LEFT JOIN (
+    SELECT content_id, SUM(n)::INT AS total, jsonb_object_agg(reaction_type, n) AS per_type
+    FROM (
+        SELECT content_id, reaction_type, COUNT(*) AS n
+        FROM reactions
+        WHERE deleted_at IS NULL
+        GROUP BY content_id, reaction_type
+    ) t
+    GROUP BY content_id
+) c ON c.content_id = cm2.id
+WHERE cm.id = cm2.id;
@@ -0,0 +1,15 @@
+------------------------------------------
+-- Mentions
+------------------------------------------
+-- Resolved @username tokens of a post or comment body, replaced whenever the body is edited.
+-- Offsets count characters (not bytes) and the span covers the whole token, @ included.
+CREATE TABLE IF NOT EXISTS mentions (
+    content_id BIGINT NOT NULL REFERENCES master_index(id) ON DELETE CASCADE, -- post or comment
+    mentioned_user_id BIGINT NOT NULL, -- in user service
+    start_offset INT NOT NULL CHECK (start_offset >= 0),
+    length INT NOT NULL CHECK (length > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (content_id, start_offset)
+);
+
+CREATE INDEX IF NOT EXISTS idx_mentions_user ON mentions(mentioned_user_id);
@@ -0,0 +1,11 @@
+------------------------------------------
+-- Hashtags
+------------------------------------------
+-- #tags of a post body, lowercase and without the #, replaced whenever the body is edited.
+CREATE TABLE IF NOT EXISTS post_hashtags (
+    post_id BIGINT NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    tag TEXT NOT NULL,
+    PRIMARY KEY (post_id, tag)
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_hashtags_tag ON post_hashtags(tag, post_id);
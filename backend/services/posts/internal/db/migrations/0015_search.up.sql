@@ -0,0 +1,21 @@
+------------------------------------------
+-- Full-text search
+------------------------------------------
+-- Stored search vectors of post, comment and event text, kept in sync by postgres on every edit.
+-- Built with the 'english' config, queries must use the same one to match stems.
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS search_vector TSVECTOR
+    GENERATED ALWAYS AS (to_tsvector('english', post_body)) STORED;
+
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS search_vector TSVECTOR
+    GENERATED ALWAYS AS (to_tsvector('english', comment_body)) STORED;
+
+-- event titles weigh more than their description
+ALTER TABLE events ADD COLUMN IF NOT EXISTS search_vector TSVECTOR
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', event_title), 'A') ||
+        setweight(to_tsvector('english', event_body), 'D')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_posts_search ON posts USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_comments_search ON comments USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_events_search ON events USING GIN (search_vector);
@@ -0,0 +1,129 @@
+------------------------------------------
+-- Reposts and quote posts
+------------------------------------------
+-- A repost shares another post as is, a quote shares it with a body of its own.
+-- Both are rows of posts pointing at the shared post, so audiences, feeds, comments and
+-- reactions work as for any post. They're personal: never in a group, never scheduled.
+-- The kind is stored, set once at creation, so edits or empty quotes can't turn one into the other.
+ALTER TYPE content_type ADD VALUE IF NOT EXISTS 'repost';
+ALTER TYPE content_type ADD VALUE IF NOT EXISTS 'quote';
+
+CREATE TYPE share_kind AS ENUM ('repost','quote');
+
+ALTER TABLE posts
+    ADD COLUMN IF NOT EXISTS shared_post_id BIGINT REFERENCES posts(id) ON DELETE CASCADE, -- null for regular posts
+    ADD COLUMN IF NOT EXISTS share_kind share_kind, -- null for regular posts
+    ADD COLUMN IF NOT EXISTS reposts_count INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS quotes_count INT NOT NULL DEFAULT 0,
+    ADD CONSTRAINT posts_share_kind_check CHECK ((shared_post_id IS NULL) = (share_kind IS NULL));
+
+CREATE INDEX IF NOT EXISTS idx_posts_shared ON posts(shared_post_id) WHERE shared_post_id IS NOT NULL;
+
+-- a user reposts a post once at a time, quotes are unlimited
+CREATE UNIQUE INDEX IF NOT EXISTS uniq_posts_repost ON posts(creator_id, shared_post_id)
+WHERE share_kind = 'repost' AND deleted_at IS NULL;
+
+------------------------------------------
+-- Trigger to register posts in master_index with their kind
+------------------------------------------
+CREATE OR REPLACE FUNCTION add_post_to_master_index()
+RETURNS TRIGGER AS $$
+DECLARE
+    new_id BIGINT;
+BEGIN
+    INSERT INTO master_index (content_type)
+    VALUES (COALESCE(NEW.share_kind::text, 'post')::content_type)
+    RETURNING id INTO new_id;
+    NEW.id := new_id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_before_insert_post ON posts;
+
+CREATE TRIGGER trg_before_insert_post
+BEFORE INSERT ON posts
+FOR EACH ROW
+EXECUTE FUNCTION add_post_to_master_index();
+
+------------------------------------------
+-- Trigger to maintain reposts_count and quotes_count
+------------------------------------------
+CREATE OR REPLACE FUNCTION update_post_shares_count()
+RETURNS TRIGGER AS $$
+DECLARE
+    delta INT := 0;
+BEGIN
+    IF NEW.share_kind IS NULL THEN
+        RETURN NULL;
+    END IF;
+
+    IF TG_OP = 'INSERT' AND NEW.deleted_at IS NULL THEN
+        delta := 1;
+    ELSIF TG_OP = 'UPDATE' AND OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+        delta := -1;
+    ELSIF TG_OP = 'UPDATE' AND OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL THEN
+        delta := 1;
+    END IF;
+
+    IF delta = 0 THEN
+        RETURN NULL;
+    END IF;
+
+    IF NEW.share_kind = 'repost' THEN
+        UPDATE posts
+        SET reposts_count = GREATEST(reposts_count + delta, 0)
+        WHERE id = NEW.shared_post_id;
+    ELSE
+        UPDATE posts
+        SET quotes_count = GREATEST(quotes_count + delta, 0)
+        WHERE id = NEW.shared_post_id;
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_posts_shares_insert
+AFTER INSERT ON posts
+FOR EACH ROW
+EXECUTE FUNCTION update_post_shares_count();
+
+CREATE TRIGGER trg_posts_shares_update
+AFTER UPDATE OF deleted_at ON posts
+FOR EACH ROW
+EXECUTE FUNCTION update_post_shares_count();
+
+------------------------------------------
+-- Reactions on reposts and quotes count like on posts
+------------------------------------------
+CREATE OR REPLACE FUNCTION adjust_reaction_counts(cid BIGINT, rtype reaction_type, delta INT)
+RETURNS VOID AS $$
+DECLARE
+    ctype content_type;
+BEGIN
+    SELECT content_type
+    INTO ctype
+    FROM master_index
+    WHERE id = cid;
+
+    IF ctype IN ('post', 'repost', 'quote') THEN
+        UPDATE posts
+        SET reactions_count = GREATEST(reactions_count + delta, 0),
+            reaction_counts = reaction_counts || jsonb_build_object(
+                rtype::text,
+                GREATEST(COALESCE((reaction_counts ->> rtype::text)::INT, 0) + delta, 0)
+            )
+        WHERE id = cid;
+
+    ELSIF ctype = 'comment' THEN
+        UPDATE comments
+        SET reactions_count = GREATEST(reactions_count + delta, 0),
+            reaction_counts = reaction_counts || jsonb_build_object(
+                rtype::text,
+                GREATEST(COALESCE((reaction_counts ->> rtype::text)::INT, 0) + delta, 0)
+            )
+        WHERE id = cid;
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
@@ -23,8 +23,10 @@ import (
 	"social-network/shared/go/gorpc"
 	postgresql "social-network/shared/go/postgre"
 	"syscall"
+	"time"
 
 	"github.com/dgraph-io/ristretto/v2"
+	"google.golang.org/protobuf/proto"
 )
 
 func Run() error {
@@ -132,6 +134,16 @@ func Run() error {
 	if err != nil {
 		return fmt.Errorf("failed to create posts application: %v", err)
 	}
+	app.StartScheduledPostsWorker(ctx, time.Duration(cfgs.ScheduledPostsIntervalSeconds)*time.Second)
+	app.StartDraftsExpiryWorker(ctx, time.Duration(cfgs.DraftsExpiryIntervalMinutes)*time.Minute)
+
+	//
+	//
+	//
+	// KAFKA CONSUMER
+	if err := startKafkaConsumer(ctx, app, cfgs); err != nil {
+		return fmt.Errorf("failed to start kafka consumer: %w", err)
+	}
 
 	service := handler.NewPostsHandler(app)
 	tele.Info(ctx, "Running gRpc service...")
@@ -175,6 +187,81 @@ func Run() error {
 
 }
 
+// startKafkaConsumer listens to the notification topic for the events posts
+// has to act on. Everything else on the topic is committed and skipped.
+func startKafkaConsumer(ctx context.Context, app *application.Application, cfgs configs) error {
+	kafkaConsumer, err := kafgo.NewKafkaConsumer(
+		[]string{cfgs.KafkaBrokers},
+		"posts", // Consumer group name for posts
+		ct.NotificationTopic,
+	)
+	if err != nil {
+		tele.Error(ctx, "failed to create kafka consumer: @1", "error", err.Error())
+		return fmt.Errorf("failed to create kafka consumer: %w", err)
+	}
+	kafkaConsumer = kafkaConsumer.WithCommitBuffer(100)
+
+	eventChannel, closeConsumer, err := kafkaConsumer.StartConsuming(ctx)
+	if err != nil {
+		tele.Error(ctx, "failed to start kafka consumer: @1", "error", err.Error())
+		return fmt.Errorf("failed to start kafka consumer: %w", err)
+	}
+
+	go func() {
+		defer closeConsumer()
+		for {
+			select {
+			case <-ctx.Done():
+				tele.Info(ctx, "kafka listener context done")
+				return
+			case record, ok := <-eventChannel:
+				if !ok {
+					tele.Info(ctx, "kafka event channel closed")
+					return
+				}
+
+				if err := processEvent(ctx, record, app); err != nil {
+					tele.Error(ctx, "failed to process kafka event", "error", err.Error())
+					// Don't commit the record if processing failed
+					continue
+				}
+
+				if err := record.Commit(ctx); err != nil {
+					tele.Error(ctx, "failed to commit kafka record", "error", err)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// processEvent handles a single event from the notification topic
+func processEvent(ctx context.Context, record *kafgo.Record, app *application.Application) error {
+	var event notifications.NotificationEvent
+	if err := proto.Unmarshal(record.Data(ctx), &event); err != nil {
+		return fmt.Errorf("failed to unmarshal protobuf notification event: %w", err)
+	}
+
+	switch event.EventType {
+	case notifications.EventType_GROUP_DELETED:
+		return app.HideGroupContent(ctx, ct.Id(event.GetGroupDeleted().GetGroupId()))
+	case notifications.EventType_USER_DEACTIVATION_CHANGED:
+		payload := event.GetUserDeactivationChanged()
+		return app.SetUserDeactivated(ctx, ct.Id(payload.GetUserId()), payload.GetDeactivated())
+	case notifications.EventType_GROUP_ARCHIVE_CHANGED:
+		payload := event.GetGroupArchiveChanged()
+		return app.SetGroupArchived(ctx, ct.Id(payload.GetGroupId()), payload.GetArchived())
+	case notifications.EventType_GROUP_VISIBILITY_CHANGED:
+		payload := event.GetGroupVisibilityChanged()
+		return app.SetGroupVisibility(ctx, ct.Id(payload.GetGroupId()), ct.GroupVisibility(payload.GetVisibility()))
+	case notifications.EventType_GROUP_POST_APPROVAL_CHANGED:
+		payload := event.GetGroupPostApprovalChanged()
+		return app.SetGroupPostApproval(ctx, ct.Id(payload.GetGroupId()), payload.GetRequired())
+	}
+	return nil
+}
+
 type configs struct {
 	RedisAddr       string   `env:"REDIS_ADDR"`
 	SentinelAddrs   []string `env:"SENTINEL_ADDRS"`
@@ -194,6 +281,9 @@ type configs struct {
 	HTTPAddr        string `env:"HTTP_ADDR"`
 	ShutdownTimeout int    `env:"SHUTDOWN_TIMEOUT_SECONDS"`
 
+	ScheduledPostsIntervalSeconds int `env:"SCHEDULED_POSTS_INTERVAL_SECONDS"`
+	DraftsExpiryIntervalMinutes   int `env:"DRAFTS_EXPIRY_INTERVAL_MINUTES"`
+
 	EnableDebugLogs bool `env:"ENABLE_DEBUG_LOGS"`
 	SimplePrint     bool `env:"ENABLE_SIMPLE_PRINT"`
 
@@ -221,6 +311,9 @@ func getConfigs() configs { // sensible defaults
 		ShutdownTimeout: 5,
 		GrpcServerPort:  ":50051",
 
+		ScheduledPostsIntervalSeconds: 30,
+		DraftsExpiryIntervalMinutes:   60,
+
 		KafkaBrokers: "kafka:9092",
 
 		EnableDebugLogs:           true,
@@ -59,15 +59,31 @@ func (s *PostsHandler) GetPostById(ctx context.Context, req *pb.GenericReq) (*pb
 		Audience:        post.Audience.String(),
 		CommentsCount:   int32(post.CommentsCount),
 		ReactionsCount:  int32(post.ReactionsCount),
+		ReactionCounts:  reactionCountsToPb(post.ReactionCounts),
 		LastCommentedAt: post.LastCommentedAt.ToProto(),
 		CreatedAt:       post.CreatedAt.ToProto(),
 		UpdatedAt:       post.UpdatedAt.ToProto(),
+		Edited:          post.Edited,
 		LikedByUser:     post.LikedByUser,
+		UserReaction:    post.UserReaction,
+		Mentions:        mentionsToPb(post.Mentions),
+		SharedPostId:    post.SharedPostId.Int64(),
+		SharedPost:      sharedPostToPb(post.SharedPost),
+		RepostsCount:    int32(post.RepostsCount),
+		QuotesCount:     int32(post.QuotesCount),
+		RepostedByUser:  post.RepostedByUser,
 		ImageId:         int64(post.ImageId),
 		ImageUrl:        post.ImageUrl,
+		ImageIds:        post.ImageIds.Int64(),
+		ImageUrls:       post.ImageUrls,
 		SelectedAudienceUsers: &cm.ListUsers{
 			Users: selectedUsers,
 		},
+		Pinned:          post.Pinned,
+		Announcement:    post.Announcement,
+		ApprovalStatus:  post.ApprovalStatus,
+		RejectionReason: post.RejectionReason,
+		PublishAt:       post.PublishAt.ToProto(),
 	}, nil
 }
 
@@ -83,6 +99,8 @@ func (s *PostsHandler) CreatePost(ctx context.Context, req *pb.CreatePostReq) (*
 		Audience:    ct.Audience(req.Audience),
 		AudienceIds: ct.FromInt64s(req.AudienceIds.Values),
 		ImageId:     ct.Id(req.ImageId),
+		ImageIds:    ct.FromInt64s(req.ImageIds),
+		PublishAt:   ct.GenDateTime(req.PublishAt.AsTime()),
 	})
 	if err != nil {
 		tele.Error(ctx, "Error in CreatePost. @1 @2", "request", req.String(), "error", err.Error())
@@ -120,6 +138,7 @@ func (s *PostsHandler) EditPost(ctx context.Context, req *pb.EditPostReq) (*empt
 		Audience:    ct.Audience(req.Audience),
 		AudienceIds: ct.FromInt64s(req.AudienceIds.Values),
 		DeleteImage: req.GetDeleteImage(),
+		ImageIds:    ct.FromInt64s(req.ImageIds),
 	})
 	if err != nil {
 		tele.Error(ctx, "Error in EditPost. @1 @2", "request", req.String(), "error", err.Error())
@@ -128,6 +147,41 @@ func (s *PostsHandler) EditPost(ctx context.Context, req *pb.EditPostReq) (*empt
 	return &emptypb.Empty{}, nil
 }
 
+func (s *PostsHandler) SharePost(ctx context.Context, req *pb.SharePostReq) (*pb.IdResp, error) {
+	tele.Info(ctx, "SharePost gRPC method called. @1", "request", req.String())
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	shareId, err := s.Application.SharePost(ctx, models.SharePostReq{
+		RequesterId: ct.Id(req.RequesterId),
+		PostId:      ct.Id(req.PostId),
+		Body:        ct.PostBody(req.Body),
+		Audience:    ct.Audience(req.Audience),
+		AudienceIds: ct.FromInt64s(req.GetAudienceIds().GetValues()),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in SharePost. @1 @2", "request", req.String(), "error", err.Error())
+		return nil, ce.EncodeProto(err)
+	}
+	return &pb.IdResp{Id: shareId}, nil
+}
+
+func (s *PostsHandler) UndoRepost(ctx context.Context, req *pb.GenericReq) (*emptypb.Empty, error) {
+	tele.Info(ctx, "UndoRepost gRPC method called. @1", "request", req.String())
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	err := s.Application.UndoRepost(ctx, models.GenericReq{
+		RequesterId: ct.Id(req.RequesterId),
+		EntityId:    ct.Id(req.EntityId),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in UndoRepost. @1 @2", "request", req.String(), "error", err.Error())
+		return nil, ce.EncodeProto(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (s *PostsHandler) GetMostPopularPostInGroup(ctx context.Context, req *pb.SimpleIdReq) (*pb.Post, error) {
 	tele.Info(ctx, "GetMostPopularPostInGroup gRPC method called. @1", "request", req.String())
 	if req == nil {
@@ -155,12 +209,18 @@ func (s *PostsHandler) GetMostPopularPostInGroup(ctx context.Context, req *pb.Si
 		Audience:        post.Audience.String(),
 		CommentsCount:   int32(post.CommentsCount),
 		ReactionsCount:  int32(post.ReactionsCount),
+		ReactionCounts:  reactionCountsToPb(post.ReactionCounts),
 		LastCommentedAt: post.LastCommentedAt.ToProto(),
 		CreatedAt:       post.CreatedAt.ToProto(),
 		UpdatedAt:       post.UpdatedAt.ToProto(),
+		Edited:          post.Edited,
 		LikedByUser:     post.LikedByUser,
+		UserReaction:    post.UserReaction,
+		Mentions:        mentionsToPb(post.Mentions),
 		ImageId:         int64(post.ImageId),
 		ImageUrl:        post.ImageUrl,
+		ImageIds:        post.ImageIds.Int64(),
+		ImageUrls:       post.ImageUrls,
 	}, nil
 }
 
@@ -193,12 +253,23 @@ func (s *PostsHandler) GetPersonalizedFeed(ctx context.Context, req *pb.GetPerso
 			Audience:        p.Audience.String(),
 			CommentsCount:   int32(p.CommentsCount),
 			ReactionsCount:  int32(p.ReactionsCount),
+			ReactionCounts:  reactionCountsToPb(p.ReactionCounts),
 			LastCommentedAt: p.LastCommentedAt.ToProto(),
 			CreatedAt:       p.CreatedAt.ToProto(),
 			UpdatedAt:       p.UpdatedAt.ToProto(),
+			Edited:          p.Edited,
 			LikedByUser:     p.LikedByUser,
+			UserReaction:    p.UserReaction,
+			Mentions:        mentionsToPb(p.Mentions),
+			SharedPostId:    p.SharedPostId.Int64(),
+			SharedPost:      sharedPostToPb(p.SharedPost),
+			RepostsCount:    int32(p.RepostsCount),
+			QuotesCount:     int32(p.QuotesCount),
+			RepostedByUser:  p.RepostedByUser,
 			ImageId:         int64(p.ImageId),
 			ImageUrl:        p.ImageUrl,
+			ImageIds:        p.ImageIds.Int64(),
+			ImageUrls:       p.ImageUrls,
 		})
 	}
 	return &pb.ListPosts{Posts: pbPosts}, nil
@@ -233,17 +304,140 @@ func (s *PostsHandler) GetPublicFeed(ctx context.Context, req *pb.GenericPaginat
 			Audience:        p.Audience.String(),
 			CommentsCount:   int32(p.CommentsCount),
 			ReactionsCount:  int32(p.ReactionsCount),
+			ReactionCounts:  reactionCountsToPb(p.ReactionCounts),
+			LastCommentedAt: p.LastCommentedAt.ToProto(),
+			CreatedAt:       p.CreatedAt.ToProto(),
+			UpdatedAt:       p.UpdatedAt.ToProto(),
+			Edited:          p.Edited,
+			LikedByUser:     p.LikedByUser,
+			UserReaction:    p.UserReaction,
+			Mentions:        mentionsToPb(p.Mentions),
+			SharedPostId:    p.SharedPostId.Int64(),
+			SharedPost:      sharedPostToPb(p.SharedPost),
+			RepostsCount:    int32(p.RepostsCount),
+			QuotesCount:     int32(p.QuotesCount),
+			RepostedByUser:  p.RepostedByUser,
+			ImageId:         int64(p.ImageId),
+			ImageUrl:        p.ImageUrl,
+			ImageIds:        p.ImageIds.Int64(),
+			ImageUrls:       p.ImageUrls,
+		})
+	}
+	return &pb.ListPosts{Posts: pbPosts}, nil
+}
+
+func (s *PostsHandler) GetHashtagFeed(ctx context.Context, req *pb.GetHashtagFeedReq) (*pb.ListPosts, error) {
+	tele.Info(ctx, "GetHashtagFeed gRPC method called. @1", "request", req.String())
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	posts, err := s.Application.GetHashtagFeed(ctx, models.GetHashtagFeedReq{
+		RequesterId: ct.Id(req.RequesterId),
+		Tag:         ct.NormalizeHashtag(req.Tag),
+		Limit:       ct.Limit(req.Limit),
+		Offset:      ct.Offset(req.Offset),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in GetHashtagFeed @1 @2", "request", req.String(), "error", err.Error())
+		return nil, ce.EncodeProto(err)
+	}
+	pbPosts := make([]*pb.Post, 0, len(posts))
+	for _, p := range posts {
+		pbPosts = append(pbPosts, &pb.Post{
+			PostId:   int64(p.PostId),
+			PostBody: string(p.Body),
+			User: &cm.User{
+				UserId:    p.User.UserId.Int64(),
+				Username:  p.User.Username.String(),
+				Avatar:    p.User.AvatarId.Int64(),
+				AvatarUrl: p.User.AvatarURL,
+			},
+			GroupId:         int64(p.GroupId),
+			Audience:        p.Audience.String(),
+			CommentsCount:   int32(p.CommentsCount),
+			ReactionsCount:  int32(p.ReactionsCount),
+			ReactionCounts:  reactionCountsToPb(p.ReactionCounts),
 			LastCommentedAt: p.LastCommentedAt.ToProto(),
 			CreatedAt:       p.CreatedAt.ToProto(),
 			UpdatedAt:       p.UpdatedAt.ToProto(),
+			Edited:          p.Edited,
 			LikedByUser:     p.LikedByUser,
+			UserReaction:    p.UserReaction,
+			Mentions:        mentionsToPb(p.Mentions),
+			SharedPostId:    p.SharedPostId.Int64(),
+			SharedPost:      sharedPostToPb(p.SharedPost),
+			RepostsCount:    int32(p.RepostsCount),
+			QuotesCount:     int32(p.QuotesCount),
+			RepostedByUser:  p.RepostedByUser,
 			ImageId:         int64(p.ImageId),
 			ImageUrl:        p.ImageUrl,
+			ImageIds:        p.ImageIds.Int64(),
+			ImageUrls:       p.ImageUrls,
 		})
 	}
 	return &pb.ListPosts{Posts: pbPosts}, nil
 }
 
+func (s *PostsHandler) GetTrendingHashtags(ctx context.Context, req *pb.GetTrendingHashtagsReq) (*pb.ListTrendingHashtags, error) {
+	tele.Info(ctx, "GetTrendingHashtags gRPC method called. @1", "request", req.String())
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	tags, err := s.Application.GetTrendingHashtags(ctx, models.GetTrendingHashtagsReq{
+		WindowHours: int(req.WindowHours),
+		Limit:       ct.Limit(req.Limit),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in GetTrendingHashtags @1 @2", "request", req.String(), "error", err.Error())
+		return nil, ce.EncodeProto(err)
+	}
+	pbTags := make([]*pb.TrendingHashtag, 0, len(tags))
+	for _, t := range tags {
+		pbTags = append(pbTags, &pb.TrendingHashtag{
+			Tag:          t.Tag.String(),
+			PostsCount:   int32(t.PostsCount),
+			AuthorsCount: int32(t.AuthorsCount),
+		})
+	}
+	return &pb.ListTrendingHashtags{Hashtags: pbTags}, nil
+}
+
+func (s *PostsHandler) SearchContent(ctx context.Context, req *pb.SearchContentReq) (*pb.ListSearchResults, error) {
+	tele.Info(ctx, "SearchContent gRPC method called. @1", "request", req.String())
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	results, err := s.Application.SearchContent(ctx, models.SearchContentReq{
+		RequesterId: ct.Id(req.RequesterId),
+		SearchTerm:  ct.SearchTerm(req.SearchTerm),
+		Limit:       ct.Limit(req.Limit),
+		Offset:      ct.Offset(req.Offset),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in SearchContent @1 @2", "request", req.String(), "error", err.Error())
+		return nil, ce.EncodeProto(err)
+	}
+	pbResults := make([]*pb.SearchResult, 0, len(results))
+	for _, r := range results {
+		pbResults = append(pbResults, &pb.SearchResult{
+			EntityType: r.EntityType,
+			EntityId:   r.EntityId.Int64(),
+			PostId:     r.PostId.Int64(),
+			GroupId:    r.GroupId.Int64(),
+			User: &cm.User{
+				UserId:    r.User.UserId.Int64(),
+				Username:  r.User.Username.String(),
+				Avatar:    r.User.AvatarId.Int64(),
+				AvatarUrl: r.User.AvatarURL,
+			},
+			Title:     r.Title,
+			Body:      r.Body,
+			CreatedAt: r.CreatedAt.ToProto(),
+		})
+	}
+	return &pb.ListSearchResults{Results: pbResults}, nil
+}
+
 func (s *PostsHandler) GetUserPostsPaginated(ctx context.Context, req *pb.GetUserPostsReq) (*pb.ListPosts, error) {
 	tele.Info(ctx, "GetUserPostsPaginated gRPC method called. @1", "request", req.String())
 	if req == nil {
@@ -274,12 +468,23 @@ func (s *PostsHandler) GetUserPostsPaginated(ctx context.Context, req *pb.GetUse
 			Audience:        p.Audience.String(),
 			CommentsCount:   int32(p.CommentsCount),
 			ReactionsCount:  int32(p.ReactionsCount),
+			ReactionCounts:  reactionCountsToPb(p.ReactionCounts),
 			LastCommentedAt: p.LastCommentedAt.ToProto(),
 			CreatedAt:       p.CreatedAt.ToProto(),
 			UpdatedAt:       p.UpdatedAt.ToProto(),
+			Edited:          p.Edited,
 			LikedByUser:     p.LikedByUser,
+			UserReaction:    p.UserReaction,
+			Mentions:        mentionsToPb(p.Mentions),
+			SharedPostId:    p.SharedPostId.Int64(),
+			SharedPost:      sharedPostToPb(p.SharedPost),
+			RepostsCount:    int32(p.RepostsCount),
+			QuotesCount:     int32(p.QuotesCount),
+			RepostedByUser:  p.RepostedByUser,
 			ImageId:         int64(p.ImageId),
 			ImageUrl:        p.ImageUrl,
+			ImageIds:        p.ImageIds.Int64(),
+			ImageUrls:       p.ImageUrls,
 		})
 	}
 	return &pb.ListPosts{Posts: pbPosts}, nil
@@ -315,17 +520,215 @@ func (s *PostsHandler) GetGroupPostsPaginated(ctx context.Context, req *pb.GetGr
 			Audience:        p.Audience.String(),
 			CommentsCount:   int32(p.CommentsCount),
 			ReactionsCount:  int32(p.ReactionsCount),
+			ReactionCounts:  reactionCountsToPb(p.ReactionCounts),
 			LastCommentedAt: p.LastCommentedAt.ToProto(),
 			CreatedAt:       p.CreatedAt.ToProto(),
 			UpdatedAt:       p.UpdatedAt.ToProto(),
+			Edited:          p.Edited,
 			LikedByUser:     p.LikedByUser,
+			UserReaction:    p.UserReaction,
+			Mentions:        mentionsToPb(p.Mentions),
+			SharedPostId:    p.SharedPostId.Int64(),
+			SharedPost:      sharedPostToPb(p.SharedPost),
+			RepostsCount:    int32(p.RepostsCount),
+			QuotesCount:     int32(p.QuotesCount),
+			RepostedByUser:  p.RepostedByUser,
 			ImageId:         int64(p.ImageId),
 			ImageUrl:        p.ImageUrl,
+			ImageIds:        p.ImageIds.Int64(),
+			ImageUrls:       p.ImageUrls,
+			Pinned:          p.Pinned,
+			Announcement:    p.Announcement,
+			ApprovalStatus:  p.ApprovalStatus,
+		})
+	}
+	return &pb.ListPosts{Posts: pbPosts}, nil
+}
+
+func (s *PostsHandler) SetPostPinned(ctx context.Context, req *pb.SetPostFlagReq) (*emptypb.Empty, error) {
+	tele.Info(ctx, "SetPostPinned gRPC method called. @1", "request", req.String())
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	err := s.Application.SetPostPinned(ctx, models.SetPostFlagReq{
+		RequesterId: ct.Id(req.RequesterId),
+		PostId:      ct.Id(req.PostId),
+		Value:       req.Value,
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in SetPostPinned. @1 @2", "request", req.String(), "error", err.Error())
+		return nil, ce.EncodeProto(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *PostsHandler) SetPostAnnouncement(ctx context.Context, req *pb.SetPostFlagReq) (*emptypb.Empty, error) {
+	tele.Info(ctx, "SetPostAnnouncement gRPC method called. @1", "request", req.String())
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	err := s.Application.SetPostAnnouncement(ctx, models.SetPostFlagReq{
+		RequesterId: ct.Id(req.RequesterId),
+		PostId:      ct.Id(req.PostId),
+		Value:       req.Value,
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in SetPostAnnouncement. @1 @2", "request", req.String(), "error", err.Error())
+		return nil, ce.EncodeProto(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *PostsHandler) GetPendingGroupPosts(ctx context.Context, req *pb.GetGroupPostsReq) (*pb.ListPosts, error) {
+	tele.Info(ctx, "GetPendingGroupPosts gRPC method called. @1", "request", req.String())
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	posts, err := s.Application.GetPendingGroupPosts(ctx, models.GetGroupPostsReq{
+		GroupId:     ct.Id(req.GroupId),
+		RequesterId: ct.Id(req.RequesterId),
+		Limit:       ct.Limit(req.Limit),
+		Offset:      ct.Offset(req.Offset),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in GetPendingGroupPosts @1 @2", "request", req.String(), "error", err.Error())
+		return nil, ce.EncodeProto(err)
+	}
+	pbPosts := make([]*pb.Post, 0, len(posts))
+	for _, p := range posts {
+		pbPosts = append(pbPosts, &pb.Post{
+			PostId:   int64(p.PostId),
+			PostBody: string(p.Body),
+			User: &cm.User{
+				UserId:    p.User.UserId.Int64(),
+				Username:  p.User.Username.String(),
+				Avatar:    p.User.AvatarId.Int64(),
+				AvatarUrl: p.User.AvatarURL,
+			},
+			GroupId:        int64(p.GroupId),
+			Audience:       p.Audience.String(),
+			CreatedAt:      p.CreatedAt.ToProto(),
+			UpdatedAt:      p.UpdatedAt.ToProto(),
+			Edited:         p.Edited,
+			ImageId:        int64(p.ImageId),
+			ImageUrl:       p.ImageUrl,
+			ImageIds:       p.ImageIds.Int64(),
+			ImageUrls:      p.ImageUrls,
+			ApprovalStatus: p.ApprovalStatus,
+			Mentions:       mentionsToPb(p.Mentions),
+		})
+	}
+	return &pb.ListPosts{Posts: pbPosts}, nil
+}
+
+func (s *PostsHandler) ApprovePost(ctx context.Context, req *pb.ReviewPostReq) (*emptypb.Empty, error) {
+	tele.Info(ctx, "ApprovePost gRPC method called. @1", "request", req.String())
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	err := s.Application.ApprovePost(ctx, models.ReviewPostReq{
+		RequesterId: ct.Id(req.RequesterId),
+		PostId:      ct.Id(req.PostId),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in ApprovePost. @1 @2", "request", req.String(), "error", err.Error())
+		return nil, ce.EncodeProto(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *PostsHandler) RejectPost(ctx context.Context, req *pb.ReviewPostReq) (*emptypb.Empty, error) {
+	tele.Info(ctx, "RejectPost gRPC method called. @1", "request", req.String())
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	err := s.Application.RejectPost(ctx, models.ReviewPostReq{
+		RequesterId: ct.Id(req.RequesterId),
+		PostId:      ct.Id(req.PostId),
+		Reason:      req.Reason,
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in RejectPost. @1 @2", "request", req.String(), "error", err.Error())
+		return nil, ce.EncodeProto(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *PostsHandler) GetScheduledPosts(ctx context.Context, req *pb.GenericPaginatedReq) (*pb.ListPosts, error) {
+	tele.Info(ctx, "GetScheduledPosts gRPC method called. @1", "request", req.String())
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	posts, err := s.Application.GetScheduledPosts(ctx, models.GenericPaginatedReq{
+		RequesterId: ct.Id(req.RequesterId),
+		Limit:       ct.Limit(req.Limit),
+		Offset:      ct.Offset(req.Offset),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in GetScheduledPosts @1 @2", "request", req.String(), "error", err.Error())
+		return nil, ce.EncodeProto(err)
+	}
+	pbPosts := make([]*pb.Post, 0, len(posts))
+	for _, p := range posts {
+		pbPosts = append(pbPosts, &pb.Post{
+			PostId:   int64(p.PostId),
+			PostBody: string(p.Body),
+			User: &cm.User{
+				UserId:    p.User.UserId.Int64(),
+				Username:  p.User.Username.String(),
+				Avatar:    p.User.AvatarId.Int64(),
+				AvatarUrl: p.User.AvatarURL,
+			},
+			GroupId:        int64(p.GroupId),
+			Audience:       p.Audience.String(),
+			CreatedAt:      p.CreatedAt.ToProto(),
+			UpdatedAt:      p.UpdatedAt.ToProto(),
+			Edited:         p.Edited,
+			ImageId:        int64(p.ImageId),
+			ImageUrl:       p.ImageUrl,
+			ImageIds:       p.ImageIds.Int64(),
+			ImageUrls:      p.ImageUrls,
+			ApprovalStatus: p.ApprovalStatus,
+			PublishAt:      p.PublishAt.ToProto(),
+			Mentions:       mentionsToPb(p.Mentions),
 		})
 	}
 	return &pb.ListPosts{Posts: pbPosts}, nil
 }
 
+func (s *PostsHandler) ReschedulePost(ctx context.Context, req *pb.ReschedulePostReq) (*emptypb.Empty, error) {
+	tele.Info(ctx, "ReschedulePost gRPC method called. @1", "request", req.String())
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	err := s.Application.ReschedulePost(ctx, models.ReschedulePostReq{
+		RequesterId: ct.Id(req.RequesterId),
+		PostId:      ct.Id(req.PostId),
+		PublishAt:   ct.GenDateTime(req.PublishAt.AsTime()),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in ReschedulePost. @1 @2", "request", req.String(), "error", err.Error())
+		return nil, ce.EncodeProto(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *PostsHandler) CancelScheduledPost(ctx context.Context, req *pb.GenericReq) (*emptypb.Empty, error) {
+	tele.Info(ctx, "CancelScheduledPost gRPC method called. @1", "request", req.String())
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	err := s.Application.CancelScheduledPost(ctx, models.GenericReq{
+		RequesterId: ct.Id(req.RequesterId),
+		EntityId:    ct.Id(req.EntityId),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in CancelScheduledPost. @1 @2", "request", req.String(), "error", err.Error())
+		return nil, ce.EncodeProto(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (s *PostsHandler) CreateComment(ctx context.Context, req *pb.CreateCommentReq) (*pb.IdResp, error) {
 	tele.Info(ctx, "CreateComment gRPC method called. @1", "request", req.String())
 	if req == nil {
@@ -336,6 +739,7 @@ func (s *PostsHandler) CreateComment(ctx context.Context, req *pb.CreateCommentR
 		ParentId:  ct.Id(req.ParentId),
 		Body:      ct.CommentBody(req.Body),
 		ImageId:   ct.Id(req.ImageId),
+		ReplyToId: ct.Id(req.ReplyToId),
 	})
 	if err != nil {
 		tele.Error(ctx, "Error in CreateComment @1 @2", "request", req.String(), "error", err.Error())
@@ -407,16 +811,134 @@ func (s *PostsHandler) GetCommentsByParentId(ctx context.Context, req *pb.Entity
 				AvatarUrl: c.User.AvatarURL,
 			},
 			ReactionsCount: int32(c.ReactionsCount),
+			ReactionCounts: reactionCountsToPb(c.ReactionCounts),
 			CreatedAt:      c.CreatedAt.ToProto(),
 			UpdatedAt:      c.UpdatedAt.ToProto(),
+			Edited:         c.Edited,
 			LikedByUser:    c.LikedByUser,
+			UserReaction:   c.UserReaction,
 			ImageId:        int64(c.ImageId),
 			ImageUrl:       c.ImageUrl,
+			ReplyToId:      c.ReplyToId.Int64(),
+			Depth:          int32(c.Depth),
+			RepliesCount:   int32(c.RepliesCount),
+			Deleted:        c.Deleted,
+			Mentions:       mentionsToPb(c.Mentions),
 		})
 	}
 	return &pb.ListComments{Comments: pbComments}, nil
 }
 
+func (s *PostsHandler) CreateDraft(ctx context.Context, req *pb.CreateDraftReq) (*pb.IdResp, error) {
+	tele.Info(ctx, "CreateDraft gRPC method called. @1", "request", req.String())
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	draftId, err := s.Application.CreateDraft(ctx, models.CreateDraftReq{
+		CreatorId:   ct.Id(req.CreatorId),
+		Kind:        ct.DraftKind(req.Kind),
+		Body:        ct.PostBody(req.Body),
+		ParentId:    ct.Id(req.ParentId),
+		GroupId:     ct.Id(req.GroupId),
+		Audience:    ct.Audience(req.Audience),
+		AudienceIds: ct.FromInt64s(req.AudienceIds),
+		ImageIds:    ct.FromInt64s(req.ImageIds),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in CreateDraft. @1 @2", "request", req.String(), "error", err.Error())
+		return nil, ce.EncodeProto(err)
+	}
+	return &pb.IdResp{Id: draftId}, nil
+}
+
+func (s *PostsHandler) UpdateDraft(ctx context.Context, req *pb.UpdateDraftReq) (*emptypb.Empty, error) {
+	tele.Info(ctx, "UpdateDraft gRPC method called. @1", "request", req.String())
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	err := s.Application.UpdateDraft(ctx, models.UpdateDraftReq{
+		RequesterId: ct.Id(req.RequesterId),
+		DraftId:     ct.Id(req.DraftId),
+		Body:        ct.PostBody(req.Body),
+		GroupId:     ct.Id(req.GroupId),
+		Audience:    ct.Audience(req.Audience),
+		AudienceIds: ct.FromInt64s(req.AudienceIds),
+		ImageIds:    ct.FromInt64s(req.ImageIds),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in UpdateDraft. @1 @2", "request", req.String(), "error", err.Error())
+		return nil, ce.EncodeProto(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *PostsHandler) GetDrafts(ctx context.Context, req *pb.GenericPaginatedReq) (*pb.ListDrafts, error) {
+	tele.Info(ctx, "GetDrafts gRPC method called. @1", "request", req.String())
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	drafts, err := s.Application.GetDrafts(ctx, models.GenericPaginatedReq{
+		RequesterId: ct.Id(req.RequesterId),
+		Limit:       ct.Limit(req.Limit),
+		Offset:      ct.Offset(req.Offset),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in GetDrafts @1 @2", "request", req.String(), "error", err.Error())
+		return nil, ce.EncodeProto(err)
+	}
+	pbDrafts := make([]*pb.Draft, 0, len(drafts))
+	for _, d := range drafts {
+		pbDrafts = append(pbDrafts, &pb.Draft{
+			DraftId:     d.DraftId.Int64(),
+			Kind:        d.Kind.String(),
+			Body:        d.Body.String(),
+			ParentId:    d.ParentId.Int64(),
+			GroupId:     d.GroupId.Int64(),
+			Audience:    d.Audience.String(),
+			AudienceIds: d.AudienceIds.Int64(),
+			ImageIds:    d.ImageIds.Int64(),
+			ImageUrls:   d.ImageUrls,
+			CreatedAt:   d.CreatedAt.ToProto(),
+			UpdatedAt:   d.UpdatedAt.ToProto(),
+			ExpiresAt:   d.ExpiresAt.ToProto(),
+		})
+	}
+	return &pb.ListDrafts{Drafts: pbDrafts}, nil
+}
+
+func (s *PostsHandler) DeleteDraft(ctx context.Context, req *pb.GenericReq) (*emptypb.Empty, error) {
+	tele.Info(ctx, "DeleteDraft gRPC method called. @1", "request", req.String())
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	err := s.Application.DeleteDraft(ctx, models.GenericReq{
+		RequesterId: ct.Id(req.RequesterId),
+		EntityId:    ct.Id(req.EntityId),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in DeleteDraft. @1 @2", "request", req.String(), "error", err.Error())
+		return nil, ce.EncodeProto(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *PostsHandler) PublishDraft(ctx context.Context, req *pb.PublishDraftReq) (*pb.IdResp, error) {
+	tele.Info(ctx, "PublishDraft gRPC method called. @1", "request", req.String())
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	id, err := s.Application.PublishDraft(ctx, models.PublishDraftReq{
+		RequesterId: ct.Id(req.RequesterId),
+		DraftId:     ct.Id(req.DraftId),
+		PublishAt:   ct.GenDateTime(req.PublishAt.AsTime()),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in PublishDraft. @1 @2", "request", req.String(), "error", err.Error())
+		return nil, ce.EncodeProto(err)
+	}
+	return &pb.IdResp{Id: id}, nil
+}
+
 func (s *PostsHandler) CreateEvent(ctx context.Context, req *pb.CreateEventReq) (*pb.IdResp, error) {
 	tele.Info(ctx, "CreateEvent gRPC method called. @1", "request", req.String())
 	if req == nil {
@@ -579,14 +1101,15 @@ func (s *PostsHandler) SuggestUsersByPostActivity(ctx context.Context, req *pb.S
 	return &cm.ListUsers{Users: pbUsers}, nil
 }
 
-func (s *PostsHandler) ToggleOrInsertReaction(ctx context.Context, req *pb.GenericReq) (*emptypb.Empty, error) {
+func (s *PostsHandler) ToggleOrInsertReaction(ctx context.Context, req *pb.ReactionReq) (*emptypb.Empty, error) {
 	tele.Info(ctx, "ToggleOrInsertReaction gRPC method called. @1", "request", req.String())
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is nil")
 	}
-	err := s.Application.ToggleOrInsertReaction(ctx, models.GenericReq{
-		RequesterId: ct.Id(req.RequesterId),
-		EntityId:    ct.Id(req.EntityId),
+	err := s.Application.ToggleOrInsertReaction(ctx, models.ReactionReq{
+		RequesterId:  ct.Id(req.RequesterId),
+		EntityId:     ct.Id(req.EntityId),
+		ReactionType: ct.ReactionType(req.ReactionType),
 	})
 	if err != nil {
 		tele.Error(ctx, "Error in ToggleOrInsertReaction @1 @2", "request", req.String(), "error", err.Error())
@@ -595,14 +1118,15 @@ func (s *PostsHandler) ToggleOrInsertReaction(ctx context.Context, req *pb.Gener
 	return &emptypb.Empty{}, nil
 }
 
-func (s *PostsHandler) GetWhoLikedEntityId(ctx context.Context, req *pb.GenericReq) (*cm.ListUsers, error) {
+func (s *PostsHandler) GetWhoLikedEntityId(ctx context.Context, req *pb.ReactionReq) (*cm.ListUsers, error) {
 	tele.Info(ctx, "GetWhoLikedEntityId gRPC method called. @1", "request", req.String())
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is nil")
 	}
-	users, err := s.Application.GetWhoLikedEntityId(ctx, models.GenericReq{
-		RequesterId: ct.Id(req.RequesterId),
-		EntityId:    ct.Id(req.EntityId),
+	users, err := s.Application.GetWhoLikedEntityId(ctx, models.GetReactionsReq{
+		RequesterId:  ct.Id(req.RequesterId),
+		EntityId:     ct.Id(req.EntityId),
+		ReactionType: ct.ReactionType(req.ReactionType),
 	})
 	if err != nil {
 		tele.Error(ctx, "Error in GetWhoLikedEntityId @1 @2", "request", req.String(), "error", err.Error())
@@ -620,6 +1144,63 @@ func (s *PostsHandler) GetWhoLikedEntityId(ctx context.Context, req *pb.GenericR
 	return &cm.ListUsers{Users: pbUsers}, nil
 }
 
+func (s *PostsHandler) GetGroupsPostActivity(ctx context.Context, req *pb.GroupsActivityReq) (*pb.GroupsActivityResp, error) {
+	tele.Info(ctx, "GetGroupsPostActivity gRPC method called. @1", "request", req.String())
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	counts, err := s.Application.GetGroupsPostActivity(ctx, ct.FromInt64s(req.GroupIds), req.Since.AsTime())
+	if err != nil {
+		tele.Error(ctx, "Error in GetGroupsPostActivity @1 @2", "request", req.String(), "error", err.Error())
+		return nil, ce.EncodeProto(err)
+	}
+	return &pb.GroupsActivityResp{PostCounts: counts}, nil
+}
+
+func (s *PostsHandler) GetGroupContentInsights(ctx context.Context, req *pb.GroupInsightsReq) (*pb.GroupContentInsights, error) {
+	tele.Info(ctx, "GetGroupContentInsights gRPC method called. @1", "request", req.String())
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	insights, err := s.Application.GetGroupContentInsights(ctx, ct.Id(req.GroupId), req.Since.AsTime())
+	if err != nil {
+		tele.Error(ctx, "Error in GetGroupContentInsights @1 @2", "request", req.String(), "error", err.Error())
+		return nil, ce.EncodeProto(err)
+	}
+
+	resp := &pb.GroupContentInsights{
+		PostsPerDay:    dailyCountsToPb(insights.PostsPerDay),
+		CommentsPerDay: dailyCountsToPb(insights.CommentsPerDay),
+		ActivePosters:  make([]*pb.PosterActivity, 0, len(insights.ActivePosters)),
+		Events:         make([]*pb.EventAttendance, 0, len(insights.Events)),
+	}
+	for _, p := range insights.ActivePosters {
+		resp.ActivePosters = append(resp.ActivePosters, &pb.PosterActivity{
+			UserId:       p.User.UserId.Int64(),
+			PostCount:    p.PostCount,
+			CommentCount: p.CommentCount,
+		})
+	}
+	for _, e := range insights.Events {
+		resp.Events = append(resp.Events, &pb.EventAttendance{
+			EventId:       e.EventId.Int64(),
+			Title:         e.Title.String(),
+			EventDate:     e.EventDate.ToProto(),
+			GoingCount:    e.GoingCount,
+			NotGoingCount: e.NotGoingCount,
+		})
+	}
+	return resp, nil
+}
+
+func dailyCountsToPb(counts []models.DailyCount) []*pb.DailyCount {
+	out := make([]*pb.DailyCount, 0, len(counts))
+	for _, c := range counts {
+		out = append(out, &pb.DailyCount{Day: c.Day.ToProto(), Count: c.Count})
+	}
+	return out
+}
+
 func (s *PostsHandler) GetPostAudienceForComment(ctx context.Context, req *pb.SimpleIdReq) (*pb.AudienceResp, error) {
 	tele.Info(ctx, "GetPostAudienceForComment gRPC method called. @1", "request", req.String())
 	if req == nil {
@@ -634,3 +1215,86 @@ func (s *PostsHandler) GetPostAudienceForComment(ctx context.Context, req *pb.Si
 		Audience: audience,
 	}, nil
 }
+
+func (s *PostsHandler) GetRevisions(ctx context.Context, req *pb.EntityIdPaginatedReq) (*pb.ListRevisions, error) {
+	tele.Info(ctx, "GetRevisions gRPC method called. @1", "request", req.String())
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	revisions, err := s.Application.GetRevisions(ctx, models.EntityIdPaginatedReq{
+		RequesterId: ct.Id(req.RequesterId),
+		EntityId:    ct.Id(req.EntityId),
+		Limit:       ct.Limit(req.Limit),
+		Offset:      ct.Offset(req.Offset),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in GetRevisions @1 @2", "request", req.String(), "error", err.Error())
+		return nil, ce.EncodeProto(err)
+	}
+	pbRevisions := make([]*pb.Revision, 0, len(revisions))
+	for _, r := range revisions {
+		pbRevisions = append(pbRevisions, &pb.Revision{
+			RevisionId: r.RevisionId.Int64(),
+			Body:       r.Body,
+			CreatedAt:  r.CreatedAt.ToProto(),
+		})
+	}
+	return &pb.ListRevisions{Revisions: pbRevisions}, nil
+}
+
+func reactionCountsToPb(counts map[string]int) map[string]int32 {
+	res := make(map[string]int32, len(counts))
+	for t, n := range counts {
+		res[t] = int32(n)
+	}
+	return res
+}
+
+func mentionsToPb(mentions []models.Mention) []*pb.Mention {
+	res := make([]*pb.Mention, 0, len(mentions))
+	for _, m := range mentions {
+		res = append(res, &pb.Mention{
+			UserId: m.UserId.Int64(),
+			Start:  int32(m.Start),
+			Length: int32(m.Length),
+		})
+	}
+	return res
+}
+
+// the post embedded in a repost or quote, nil if there's none
+func sharedPostToPb(p *models.Post) *pb.Post {
+	if p == nil {
+		return nil
+	}
+	return &pb.Post{
+		PostId:   int64(p.PostId),
+		PostBody: string(p.Body),
+		User: &cm.User{
+			UserId:    p.User.UserId.Int64(),
+			Username:  p.User.Username.String(),
+			Avatar:    p.User.AvatarId.Int64(),
+			AvatarUrl: p.User.AvatarURL,
+		},
+		GroupId:         int64(p.GroupId),
+		Audience:        p.Audience.String(),
+		CommentsCount:   int32(p.CommentsCount),
+		ReactionsCount:  int32(p.ReactionsCount),
+		ReactionCounts:  reactionCountsToPb(p.ReactionCounts),
+		LastCommentedAt: p.LastCommentedAt.ToProto(),
+		CreatedAt:       p.CreatedAt.ToProto(),
+		UpdatedAt:       p.UpdatedAt.ToProto(),
+		Edited:          p.Edited,
+		LikedByUser:     p.LikedByUser,
+		UserReaction:    p.UserReaction,
+		Mentions:        mentionsToPb(p.Mentions),
+		ImageId:         int64(p.ImageId),
+		ImageUrl:        p.ImageUrl,
+		ImageIds:        p.ImageIds.Int64(),
+		ImageUrls:       p.ImageUrls,
+		SharedPostId:    p.SharedPostId.Int64(),
+		RepostsCount:    int32(p.RepostsCount),
+		QuotesCount:     int32(p.QuotesCount),
+		RepostedByUser:  p.RepostedByUser,
+	}
+}
@@ -24,10 +24,17 @@ type Application struct {
 	clients        ClientsInterface
 	mediaRetriever *retrievemedia.MediaRetriever
 	eventProducer  *notifevents.EventCreator
+	// wakes the outbox worker up after a commit that queued events
+	outboxKick chan struct{}
+
+	// signs group invite link tokens
+	inviteLinkSecret []byte
+	// pending group invites and join requests expire after this long, 0 disables expiry
+	groupRequestTTL time.Duration
 }
 
 // NewApplication constructs a new UserService
-func NewApplication(db ds.Querier, txRunner TxRunner, pool *pgxpool.Pool, clients *client.Clients, eventProducer *kafgo.KafkaProducer) *Application {
+func NewApplication(db ds.Querier, txRunner TxRunner, pool *pgxpool.Pool, clients *client.Clients, eventProducer *kafgo.KafkaProducer, inviteLinkSecret string, groupRequestTTL time.Duration) *Application {
 	mediaRetriever := retrievemedia.NewMediaRetriever(clients.MediaClient, clients.RedisClient, 3*time.Minute)
 	return &Application{
 		db:             db,
@@ -35,6 +42,10 @@ func NewApplication(db ds.Querier, txRunner TxRunner, pool *pgxpool.Pool, client
 		clients:        clients,
 		mediaRetriever: mediaRetriever,
 		eventProducer:  notifevents.NewEventProducer(eventProducer),
+		outboxKick:     make(chan struct{}, 1),
+
+		inviteLinkSecret: []byte(inviteLinkSecret),
+		groupRequestTTL:  groupRequestTTL,
 	}
 }
 
@@ -43,6 +54,8 @@ type ClientsInterface interface {
 	GetObj(ctx context.Context, key string, dest any) error
 	SetObj(ctx context.Context, key string, value any, exp time.Duration) error
 	Del(ctx context.Context, key string) error
+	GetGroupsPostActivity(ctx context.Context, groupIds []int64, since time.Time) (map[int64]int64, error)
+	GetGroupContentInsights(ctx context.Context, groupId int64, since time.Time) (models.GroupContentInsights, error)
 	CreateNotification(ctx context.Context, req models.CreateNotificationRequest) error
 	CreateFollowRequestNotification(ctx context.Context, targetUserID, requesterUserID int64, requesterUsername string) error
 	CreateNewFollower(ctx context.Context, targetUserID, followerUserID int64, followerUsername string) error
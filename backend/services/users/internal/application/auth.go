@@ -40,6 +40,16 @@ func (s *Application) RegisterUser(ctx context.Context, req models.RegisterUserR
 	var newId ct.Id
 
 	err := s.txRunner.RunTx(ctx, func(q *ds.Queries) error {
+		// former handles of other users can't be claimed while reserved, derived ones included
+		reserved, err := q.IsUsernameReserved(ctx, ds.IsUsernameReservedParams{
+			Username: username.String(),
+		})
+		if err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		if reserved {
+			return ce.New(ce.ErrAlreadyExists, fmt.Errorf("username %v is reserved", username), input).WithPublic("username is not available")
+		}
 
 		// Insert user
 		userId, err := q.InsertNewUser(ctx, ds.InsertNewUserParams{
@@ -57,11 +67,15 @@ func (s *Application) RegisterUser(ctx context.Context, req models.RegisterUserR
 		newId = ct.Id(userId)
 
 		// Insert auth
-		return q.InsertNewUserAuth(ctx, ds.InsertNewUserAuthParams{
+		err = q.InsertNewUserAuth(ctx, ds.InsertNewUserAuthParams{
 			UserID:       newId.Int64(),
 			Email:        req.Email.String(),
 			PasswordHash: req.Password.String(),
 		})
+		if err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		return nil
 	})
 
 	if err != nil {
@@ -71,7 +85,7 @@ func (s *Application) RegisterUser(ctx context.Context, req models.RegisterUserR
 				return models.RegisterUserResponse{}, ce.New(ce.ErrAlreadyExists, err, input).WithPublic("email already exists")
 			}
 		}
-		return models.RegisterUserResponse{}, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		return models.RegisterUserResponse{}, ce.Wrap(nil, err)
 	}
 
 	return models.RegisterUserResponse{
@@ -85,6 +99,7 @@ func (s *Application) LoginUser(ctx context.Context, req models.LoginRequest) (m
 	input := fmt.Sprintf("%#v", req)
 
 	var u models.User
+	var reactivated bool
 
 	if err := ct.ValidateStruct(req); err != nil {
 		return models.User{}, ce.Wrap(ce.ErrInvalidArgument, err, input).WithPublic("invalid data received")
@@ -108,6 +123,14 @@ func (s *Application) LoginUser(ctx context.Context, req models.LoginRequest) (m
 			AvatarId: ct.Id(row.AvatarID),
 		}
 
+		// logging in ends a temporary deactivation
+		if row.CurrentStatus == ds.UserStatusDeactivated {
+			if err := s.reactivateAccount(ctx, q, u.UserId); err != nil {
+				return ce.Wrap(nil, err)
+			}
+			reactivated = true
+		}
+
 		if u.AvatarId > 0 {
 			imageUrl, err := s.mediaRetriever.GetImage(ctx, u.AvatarId.Int64(), media.FileVariant_THUMBNAIL)
 			if err != nil {
@@ -125,6 +148,11 @@ func (s *Application) LoginUser(ctx context.Context, req models.LoginRequest) (m
 		return models.User{}, ce.Wrap(nil, err)
 	}
 
+	if reactivated {
+		s.kickOutbox()
+		s.invalidateBasicUserInfo(ctx, u.UserId)
+	}
+
 	return u, nil
 }
 
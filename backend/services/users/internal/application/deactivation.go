@@ -0,0 +1,83 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	ds "social-network/services/users/internal/db/dbservice"
+	notifpb "social-network/shared/gen-go/notifications"
+	ce "social-network/shared/go/commonerrors"
+	ct "social-network/shared/go/ct"
+	tele "social-network/shared/go/telemetry"
+)
+
+// Temporarily deactivates the account until the next login.
+// Posts service hides the user's content once it receives the queued event.
+func (s *Application) DeactivateAccount(ctx context.Context, userId ct.Id) error {
+	input := fmt.Sprintf("%#v", userId)
+
+	if err := userId.Validate(); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	err := s.txRunner.RunTx(ctx, func(q *ds.Queries) error {
+		rows, err := q.DeactivateUser(ctx, userId.Int64())
+		if err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		if rows == 0 {
+			return ce.New(ce.ErrNotFound, fmt.Errorf("no active user with id %v", userId), input).WithPublic("user not found")
+		}
+
+		if err := enqueueEvent(ctx, q, userDeactivationEvent(userId, true)); err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		return nil
+	})
+	if err != nil {
+		return ce.Wrap(nil, err)
+	}
+
+	s.kickOutbox()
+	s.invalidateBasicUserInfo(ctx, userId)
+	return nil
+}
+
+// Restores a deactivated account. Must run inside the login transaction,
+// which has to kick the outbox once committed.
+func (s *Application) reactivateAccount(ctx context.Context, q *ds.Queries, userId ct.Id) error {
+	input := fmt.Sprintf("%#v", userId)
+
+	if err := q.ReactivateUser(ctx, userId.Int64()); err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+
+	if err := enqueueEvent(ctx, q, userDeactivationEvent(userId, false)); err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	return nil
+}
+
+// builds the event telling posts service to hide or restore the user's content
+func userDeactivationEvent(userId ct.Id, deactivated bool) *notifpb.NotificationEvent {
+	return &notifpb.NotificationEvent{
+		EventType: notifpb.EventType_USER_DEACTIVATION_CHANGED,
+		Payload: &notifpb.NotificationEvent_UserDeactivationChanged{
+			UserDeactivationChanged: &notifpb.UserDeactivationChanged{
+				UserId:      userId.Int64(),
+				Deactivated: deactivated,
+			},
+		},
+	}
+}
+
+// deletes cached basic user info so that other services pick up the new status
+func (s *Application) invalidateBasicUserInfo(ctx context.Context, userId ct.Id) {
+	key, err := ct.BasicUserInfoKey{Id: userId}.GenKey()
+	if err != nil {
+		tele.Warn(ctx, "could not construct basic user info key for user @1", "userId", userId)
+		return
+	}
+	if err := s.clients.Del(ctx, key); err != nil {
+		tele.Warn(ctx, "could not delete basic user info for user @1 using key @2", "userId", userId, "key", key)
+	}
+}
@@ -22,6 +22,10 @@ func (s *Application) GetFollowersPaginated(ctx context.Context, req models.Pagi
 	if err := ct.ValidateStruct(req); err != nil {
 		return []models.User{}, ce.Wrap(ce.ErrInvalidArgument, err, input).WithPublic("invalid data received")
 	}
+
+	if err := s.checkFollowListsVisible(ctx, req.RequesterId, req.UserId); err != nil {
+		return []models.User{}, err
+	}
 	//paginated, sorted by newest first
 	rows, err := s.db.GetFollowers(ctx, ds.GetFollowersParams{
 		FollowingID: req.UserId.Int64(),
@@ -67,6 +71,10 @@ func (s *Application) GetFollowingPaginated(ctx context.Context, req models.Pagi
 		return []models.User{}, ce.Wrap(ce.ErrInvalidArgument, err, input).WithPublic("invalid data received")
 	}
 
+	if err := s.checkFollowListsVisible(ctx, req.RequesterId, req.UserId); err != nil {
+		return []models.User{}, err
+	}
+
 	//paginated, sorted by newest first
 	rows, err := s.db.GetFollowing(ctx, ds.GetFollowingParams{
 		FollowerID: req.UserId.Int64(),
@@ -327,6 +335,20 @@ func (s *Application) GetFollowingIds(ctx context.Context, userId ct.Id) ([]int6
 	return ids, nil
 }
 
+// returns ids of the followers of a user for posts service, to check who can see their followers-only posts
+func (s *Application) GetFollowerIds(ctx context.Context, userId ct.Id) ([]int64, error) {
+	input := fmt.Sprintf("%#v", userId)
+
+	if err := userId.Validate(); err != nil {
+		return []int64{}, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+	ids, err := s.db.GetFollowerIds(ctx, userId.Int64())
+	if err != nil {
+		return nil, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	return ids, nil
+}
+
 // returns five random users that people you follow follow, or are in your groups
 func (s *Application) GetFollowSuggestions(ctx context.Context, userId ct.Id) ([]models.User, error) {
 	input := fmt.Sprintf("%#v", userId)
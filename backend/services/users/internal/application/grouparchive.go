@@ -0,0 +1,155 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	ds "social-network/services/users/internal/db/dbservice"
+	notifpb "social-network/shared/gen-go/notifications"
+	ce "social-network/shared/go/commonerrors"
+	ct "social-network/shared/go/ct"
+	"social-network/shared/go/models"
+	tele "social-network/shared/go/telemetry"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Makes the group read-only. Posts service follows once it receives the queued event.
+func (s *Application) ArchiveGroup(ctx context.Context, req models.GeneralGroupReq) error {
+	return s.setGroupArchived(ctx, req, true)
+}
+
+// Makes an archived group writable again.
+func (s *Application) UnarchiveGroup(ctx context.Context, req models.GeneralGroupReq) error {
+	return s.setGroupArchived(ctx, req, false)
+}
+
+func (s *Application) setGroupArchived(ctx context.Context, req models.GeneralGroupReq, archived bool) error {
+	input := fmt.Sprintf("%#v, archived: %v", req, archived)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+	if err := s.checkGroupOwner(ctx, req, input); err != nil {
+		return ce.Wrap(nil, err)
+	}
+
+	err := s.txRunner.RunTx(ctx, func(q *ds.Queries) error {
+		var rows int64
+		var err error
+		if archived {
+			rows, err = q.ArchiveGroup(ctx, req.GroupId.Int64())
+		} else {
+			rows, err = q.UnarchiveGroup(ctx, req.GroupId.Int64())
+		}
+		if err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		if rows == 0 {
+			if archived {
+				return ce.New(ce.ErrFailedPrecondition, fmt.Errorf("group %v is already archived", req.GroupId), input).WithPublic("group is already archived")
+			}
+			return ce.New(ce.ErrFailedPrecondition, fmt.Errorf("group %v is not archived", req.GroupId), input).WithPublic("group is not archived")
+		}
+
+		event := &notifpb.NotificationEvent{
+			EventType: notifpb.EventType_GROUP_ARCHIVE_CHANGED,
+			Payload: &notifpb.NotificationEvent_GroupArchiveChanged{
+				GroupArchiveChanged: &notifpb.GroupArchiveChanged{
+					GroupId:  req.GroupId.Int64(),
+					Archived: archived,
+				},
+			},
+		}
+		if err := enqueueEvent(ctx, q, event); err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		return nil
+	})
+	if err != nil {
+		return ce.Wrap(nil, err)
+	}
+
+	s.kickOutbox()
+	return nil
+}
+
+// Soft deletes the group. Memberships, pending invites and join requests are revoked by the database.
+// The GroupDeleted event is queued with the deletion and sent once it is committed: posts service
+// hides the group's posts and events, chat service closes the group conversation and notifications
+// service clears pending invite and join request notifications.
+func (s *Application) DeleteGroup(ctx context.Context, req models.GeneralGroupReq) error {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+	if err := s.checkGroupOwner(ctx, req, input); err != nil {
+		return ce.Wrap(nil, err)
+	}
+
+	err := s.txRunner.RunTx(ctx, func(q *ds.Queries) error {
+		rows, err := q.SoftDeleteGroup(ctx, req.GroupId.Int64())
+		if err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		if rows == 0 {
+			return ce.New(ce.ErrNotFound, fmt.Errorf("group %v not found", req.GroupId), input).WithPublic("group not found")
+		}
+
+		if err := enqueueEvent(ctx, q, groupDeletedEvent(req.GroupId)); err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		return nil
+	})
+	if err != nil {
+		return ce.Wrap(nil, err)
+	}
+
+	s.kickOutbox()
+	tele.Info(ctx, "group deleted event queued")
+	return nil
+}
+
+// builds the event telling other services to drop the group's content
+func groupDeletedEvent(groupId ct.Id) *notifpb.NotificationEvent {
+	return &notifpb.NotificationEvent{
+		EventType: notifpb.EventType_GROUP_DELETED,
+		Payload: &notifpb.NotificationEvent_GroupDeleted{
+			GroupDeleted: &notifpb.GroupDeleted{
+				GroupId: groupId.Int64(),
+			},
+		},
+	}
+}
+
+// NOT GRPC
+// returns permission denied if user is not the owner of the group
+func (s *Application) checkGroupOwner(ctx context.Context, req models.GeneralGroupReq, input string) error {
+	isOwner, err := s.isGroupOwner(ctx, req)
+	if err != nil {
+		return ce.Wrap(nil, err)
+	}
+	if !isOwner {
+		return ce.New(ce.ErrPermissionDenied, fmt.Errorf("user %v is not the owner of group %v", req.UserId, req.GroupId), input).WithPublic("permission denied")
+	}
+	return nil
+}
+
+// NOT GRPC
+// returns not found if the group doesn't exist and failed precondition if it is archived
+func (s *Application) checkGroupWritable(ctx context.Context, q ds.Querier, groupId ct.Id) error {
+	input := fmt.Sprintf("group id: %v", groupId)
+
+	archived, err := q.IsGroupArchived(ctx, groupId.Int64())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ce.New(ce.ErrNotFound, err, input).WithPublic("group not found")
+		}
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if archived {
+		return ce.New(ce.ErrFailedPrecondition, fmt.Errorf("group %v is archived", groupId), input).WithPublic("this group is archived")
+	}
+	return nil
+}
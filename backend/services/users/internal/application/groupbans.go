@@ -0,0 +1,193 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	ds "social-network/services/users/internal/db/dbservice"
+	"social-network/shared/gen-go/media"
+	ce "social-network/shared/go/commonerrors"
+	ct "social-network/shared/go/ct"
+	"social-network/shared/go/models"
+	tele "social-network/shared/go/telemetry"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const maxBanReasonChars = 500
+
+// Bans a user from the group. Members are removed, pending invites and join
+// requests are dropped, and the user can't request, be invited or join by link
+// until the ban expires or is lifted. Posting and chatting require membership,
+// so those stop with the removal.
+func (s *Application) BanFromGroup(ctx context.Context, req models.BanFromGroupReq) error {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+	reason := strings.TrimSpace(req.Reason)
+	if utf8.RuneCountInString(reason) > maxBanReasonChars {
+		return ce.New(ce.ErrInvalidArgument, fmt.Errorf("ban reason of %v characters", utf8.RuneCountInString(reason)), input).WithPublic(fmt.Sprintf("reason can't be longer than %d characters", maxBanReasonChars))
+	}
+	if !req.ExpiresAt.Time().IsZero() && req.ExpiresAt.Time().Before(time.Now()) {
+		return ce.New(ce.ErrInvalidArgument, fmt.Errorf("expiry %v is in the past", req.ExpiresAt), input).WithPublic("expiry must be in the future")
+	}
+	if req.RequesterId == req.UserId {
+		return ce.New(ce.ErrPermissionDenied, fmt.Errorf("user %v tried to ban themselves", req.RequesterId), input).WithPublic("you can't ban yourself")
+	}
+
+	//check requester can remove members and outranks the user if they are a member
+	requesterRole, err := s.checkGroupPermission(ctx, req.GroupId, req.RequesterId, ct.PermRemoveMembers)
+	if err != nil {
+		return ce.Wrap(nil, err)
+	}
+	memberRole, err := s.getGroupRole(ctx, req.GroupId, req.UserId)
+	if err != nil {
+		return ce.Wrap(nil, err)
+	}
+	if memberRole != "" && requesterRole.Rank() <= memberRole.Rank() {
+		return ce.New(ce.ErrPermissionDenied, fmt.Errorf("%v cannot ban %v from group %v", requesterRole, memberRole, req.GroupId), input).WithPublic("permission denied")
+	}
+	if err := s.checkGroupWritable(ctx, s.db, req.GroupId); err != nil {
+		return ce.Wrap(nil, err)
+	}
+
+	return s.txRunner.RunTx(ctx, func(q *ds.Queries) error {
+		err := q.UpsertGroupBan(ctx, ds.UpsertGroupBanParams{
+			GroupID:   req.GroupId.Int64(),
+			UserID:    req.UserId.Int64(),
+			BannedBy:  req.RequesterId.Int64(),
+			Reason:    reason,
+			ExpiresAt: pgtype.Timestamptz{Time: req.ExpiresAt.Time(), Valid: !req.ExpiresAt.Time().IsZero()},
+		})
+		if err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+
+		if memberRole != "" {
+			err = q.LeaveGroup(ctx, ds.LeaveGroupParams{
+				GroupID: req.GroupId.Int64(),
+				UserID:  req.UserId.Int64(),
+			})
+			if err != nil {
+				return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+			}
+		}
+
+		err = q.RejectPendingGroupJoinRequest(ctx, ds.RejectPendingGroupJoinRequestParams{
+			GroupID: req.GroupId.Int64(),
+			UserID:  req.UserId.Int64(),
+		})
+		if err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		err = q.DeclinePendingGroupInvites(ctx, ds.DeclinePendingGroupInvitesParams{
+			GroupID:    req.GroupId.Int64(),
+			ReceiverID: req.UserId.Int64(),
+		})
+		if err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		return nil
+	})
+}
+
+// Lifts an active ban. The user is not re-added, they can request or be invited again.
+func (s *Application) UnbanFromGroup(ctx context.Context, req models.UnbanFromGroupReq) error {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	if _, err := s.checkGroupPermission(ctx, req.GroupId, req.RequesterId, ct.PermRemoveMembers); err != nil {
+		return ce.Wrap(nil, err)
+	}
+
+	rows, err := s.db.DeleteGroupBan(ctx, ds.DeleteGroupBanParams{
+		GroupID: req.GroupId.Int64(),
+		UserID:  req.UserId.Int64(),
+	})
+	if err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if rows == 0 {
+		return ce.New(ce.ErrNotFound, fmt.Errorf("user %v is not banned from group %v", req.UserId, req.GroupId), input).WithPublic("user is not banned from this group")
+	}
+	return nil
+}
+
+// Lists the active bans of the group, newest first.
+func (s *Application) GetGroupBans(ctx context.Context, req models.GroupMembersReq) ([]models.GroupBan, error) {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return nil, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	if _, err := s.checkGroupPermission(ctx, req.GroupId, req.UserId, ct.PermRemoveMembers); err != nil {
+		return nil, ce.Wrap(nil, err)
+	}
+
+	rows, err := s.db.ListGroupBans(ctx, ds.ListGroupBansParams{
+		GroupID: req.GroupId.Int64(),
+		Limit:   req.Limit.Int32(),
+		Offset:  req.Offset.Int32(),
+	})
+	if err != nil {
+		return nil, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+
+	bans := make([]models.GroupBan, 0, len(rows))
+	var imageIds ct.Ids
+	for _, r := range rows {
+		bans = append(bans, models.GroupBan{
+			User: models.User{
+				UserId:   ct.Id(r.UserID),
+				Username: ct.Username(r.Username),
+				AvatarId: ct.Id(r.AvatarID),
+			},
+			BannedBy:  ct.Id(r.BannedBy.Int64),
+			Reason:    r.Reason,
+			ExpiresAt: ct.GenDateTime(r.ExpiresAt.Time),
+			BannedAt:  ct.GenDateTime(r.CreatedAt.Time),
+		})
+		if r.AvatarID > 0 {
+			imageIds = append(imageIds, ct.Id(r.AvatarID))
+		}
+	}
+	//get avatar urls
+	if len(imageIds) > 0 {
+		avatarMap, failedImageIds, err := s.mediaRetriever.GetImages(ctx, imageIds, media.FileVariant_THUMBNAIL)
+		if err != nil {
+			tele.Error(ctx, "media retriever failed for @1", "request", imageIds, "error", err.Error()) //log error instead of returning
+		} else {
+			for i := range bans {
+				bans[i].AvatarURL = avatarMap[bans[i].AvatarId.Int64()]
+			}
+			s.removeFailedImagesAsync(ctx, failedImageIds)
+		}
+	}
+	return bans, nil
+}
+
+// NOT GRPC
+// returns permission denied if user has an active ban from the group
+func (s *Application) checkNotBanned(ctx context.Context, q ds.Querier, groupId, userId ct.Id) error {
+	input := fmt.Sprintf("group id: %v, user id: %v", groupId, userId)
+
+	banned, err := q.IsUserBannedFromGroup(ctx, ds.IsUserBannedFromGroupParams{
+		GroupID: groupId.Int64(),
+		UserID:  userId.Int64(),
+	})
+	if err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if banned {
+		return ce.New(ce.ErrPermissionDenied, fmt.Errorf("user %v is banned from group %v", userId, groupId), input).WithPublic("you are banned from this group")
+	}
+	return nil
+}
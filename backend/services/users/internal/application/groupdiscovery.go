@@ -0,0 +1,143 @@
+package application
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	ds "social-network/services/users/internal/db/dbservice"
+	"social-network/shared/gen-go/media"
+	ce "social-network/shared/go/commonerrors"
+	ct "social-network/shared/go/ct"
+	"social-network/shared/go/models"
+	tele "social-network/shared/go/telemetry"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// growth and activity are counted over this window
+	discoveryWindow = 7 * 24 * time.Hour
+	// how many candidates are ranked, pages past it are empty
+	discoveryPoolSize = 200
+
+	discoveryFollowedWeight  = 3
+	discoveryNewMemberWeight = 2
+	discoveryPostWeight      = 1
+)
+
+// Lists groups the user hasn't joined, optionally in one category.
+// Candidates come from the db ranked by members the user follows and recent growth,
+// then recent post activity from posts service is added and the pool is re-ranked.
+// If posts service is unavailable groups are ranked without activity.
+func (s *Application) DiscoverGroups(ctx context.Context, req models.DiscoverGroupsReq) ([]models.Group, error) {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return nil, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	since := time.Now().Add(-discoveryWindow)
+	rows, err := s.db.GetDiscoverableGroups(ctx, ds.GetDiscoverableGroupsParams{
+		UserID: req.UserId.Int64(),
+		Category: ds.NullGroupCategory{
+			GroupCategory: ds.GroupCategory(req.Category),
+			Valid:         req.Category != "",
+		},
+		Since: pgtype.Timestamptz{Time: since, Valid: true},
+		Limit: discoveryPoolSize,
+	})
+	if err != nil {
+		return nil, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if len(rows) == 0 {
+		return []models.Group{}, nil
+	}
+
+	groupIds := make([]int64, 0, len(rows))
+	for _, r := range rows {
+		groupIds = append(groupIds, r.ID)
+	}
+	postCounts, err := s.clients.GetGroupsPostActivity(ctx, groupIds, since)
+	if err != nil {
+		tele.Error(ctx, "could not get post activity for discovery @1", "error", err.Error()) //rank without activity instead of returning
+	}
+
+	score := func(r ds.GetDiscoverableGroupsRow) int64 {
+		return int64(r.FollowedMembers)*discoveryFollowedWeight +
+			int64(r.NewMembers)*discoveryNewMemberWeight +
+			postCounts[r.ID]*discoveryPostWeight
+	}
+	slices.SortStableFunc(rows, func(a, b ds.GetDiscoverableGroupsRow) int {
+		return cmp.Or(
+			cmp.Compare(score(b), score(a)),
+			cmp.Compare(b.MembersCount, a.MembersCount),
+			cmp.Compare(b.ID, a.ID),
+		)
+	})
+
+	//paginate the ranked pool
+	start := min(int(req.Offset.Int32()), len(rows))
+	end := min(start+int(req.Limit.Int32()), len(rows))
+	rows = rows[start:end]
+	if len(rows) == 0 {
+		return []models.Group{}, nil
+	}
+
+	pageIds := make([]int64, 0, len(rows))
+	for _, r := range rows {
+		pageIds = append(pageIds, r.ID)
+	}
+	tagRows, err := s.db.GetTagsForGroups(ctx, pageIds)
+	if err != nil {
+		return nil, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	tagsByGroup := make(map[int64]ct.GroupTags, len(rows))
+	for _, t := range tagRows {
+		tagsByGroup[t.GroupID] = append(tagsByGroup[t.GroupID], ct.GroupTag(t.Tag))
+	}
+
+	groups := make([]models.Group, 0, len(rows))
+	var imageIds ct.Ids
+	for _, r := range rows {
+		pendingInfo, err := s.isGroupMembershipPending(ctx, models.GeneralGroupReq{
+			GroupId: ct.Id(r.ID),
+			UserId:  req.UserId,
+		})
+		if err != nil {
+			return nil, ce.Wrap(nil, err)
+		}
+		groups = append(groups, models.Group{
+			GroupId:          ct.Id(r.ID),
+			GroupOwnerId:     ct.Id(r.GroupOwner),
+			GroupTitle:       ct.Title(r.GroupTitle),
+			GroupDescription: ct.About(r.GroupDescription),
+			GroupImage:       ct.Id(r.GroupImageID),
+			MembersCount:     r.MembersCount,
+			PendingRequest:   pendingInfo.pendingRequest,
+			PendingInvite:    pendingInfo.pendingInvite,
+			Visibility:       ct.GroupVisibility(r.Visibility),
+			Category:         ct.GroupCategory(r.Category),
+			Tags:             tagsByGroup[r.ID],
+		})
+		if r.GroupImageID > 0 {
+			imageIds = append(imageIds, ct.Id(r.GroupImageID))
+		}
+	}
+
+	//get image urls
+	if len(imageIds) > 0 {
+		imageMap, failedImageIds, err := s.mediaRetriever.GetImages(ctx, imageIds, media.FileVariant_SMALL)
+		if err != nil {
+			tele.Error(ctx, "media retriever failed for @1", "request", imageIds, "error", err.Error()) //log error instead of returning
+		} else {
+			for i := range groups {
+				groups[i].GroupImageURL = imageMap[groups[i].GroupImage.Int64()]
+			}
+			s.removeFailedImagesAsync(ctx, failedImageIds)
+		}
+	}
+
+	return groups, nil
+}
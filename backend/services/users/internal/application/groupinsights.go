@@ -0,0 +1,164 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	ds "social-network/services/users/internal/db/dbservice"
+	"social-network/shared/gen-go/media"
+	ce "social-network/shared/go/commonerrors"
+	ct "social-network/shared/go/ct"
+	"social-network/shared/go/models"
+	tele "social-network/shared/go/telemetry"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// number of UTC days covered by group insights, today included
+const groupInsightsDays = 30
+
+// Returns how a group did over the last 30 days. Needs the view_insights permission.
+// Insights are computed once per UTC day and cached until the day ends,
+// avatar urls are not cached with them and are fetched on every call.
+func (s *Application) GetGroupInsights(ctx context.Context, req models.GeneralGroupReq) (models.GroupInsights, error) {
+	input := fmt.Sprintf("%#v", req)
+
+	var insights models.GroupInsights
+	if err := ct.ValidateStruct(req); err != nil {
+		return insights, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	if _, err := s.checkGroupPermission(ctx, req.GroupId, req.UserId, ct.PermViewInsights); err != nil {
+		return insights, ce.Wrap(nil, err)
+	}
+
+	now := time.Now().UTC()
+	day := now.Truncate(24 * time.Hour)
+	key := ct.GroupInsightsKey{GroupId: req.GroupId, Day: day}.String()
+
+	if err := s.clients.GetObj(ctx, key, &insights); err != nil {
+		insights, err = s.computeGroupInsights(ctx, req.GroupId, day.AddDate(0, 0, -(groupInsightsDays-1)), now)
+		if err != nil {
+			return insights, ce.Wrap(nil, err)
+		}
+		if err := s.clients.SetObj(ctx, key, insights, day.AddDate(0, 0, 1).Sub(now)); err != nil {
+			tele.Error(ctx, "could not cache group insights for @1: @2", "groupId", req.GroupId, "error", err.Error()) //log error instead of returning
+		}
+	}
+
+	//get avatar urls
+	var imageIds ct.Ids
+	for _, p := range insights.ActivePosters {
+		if p.User.AvatarId > 0 {
+			imageIds = append(imageIds, p.User.AvatarId)
+		}
+	}
+	if len(imageIds) > 0 {
+		avatarMap, failedImageIds, err := s.mediaRetriever.GetImages(ctx, imageIds, media.FileVariant_THUMBNAIL)
+		if err != nil {
+			tele.Error(ctx, "media retriever failed for @1", "request", imageIds, "error", err.Error()) //log error instead of returning
+		} else {
+			for i := range insights.ActivePosters {
+				insights.ActivePosters[i].User.AvatarURL = avatarMap[insights.ActivePosters[i].User.AvatarId.Int64()]
+			}
+			s.removeFailedImagesAsync(ctx, failedImageIds)
+		}
+	}
+
+	return insights, nil
+}
+
+// NOT GRPC
+// builds insights from users db and posts service, since is the start of the first day
+func (s *Application) computeGroupInsights(ctx context.Context, groupId ct.Id, since, now time.Time) (models.GroupInsights, error) {
+	input := fmt.Sprintf("group id: %v, since: %v", groupId, since)
+
+	insights := models.GroupInsights{
+		GroupId:    groupId,
+		Since:      ct.GenDateTime(since),
+		ComputedAt: ct.GenDateTime(now),
+	}
+	sinceTs := pgtype.Timestamptz{Time: since, Valid: true}
+
+	group, err := s.db.GetGroupInfo(ctx, groupId.Int64())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return insights, ce.New(ce.ErrNotFound, err, input).WithPublic("not found")
+		}
+		return insights, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	insights.MembersCount = group.MembersCount
+
+	growthRows, err := s.db.GetGroupMemberGrowth(ctx, ds.GetGroupMemberGrowthParams{
+		GroupID: groupId.Int64(),
+		Since:   sinceTs,
+	})
+	if err != nil {
+		return insights, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	insights.MemberGrowth = make([]models.GroupMemberGrowth, 0, len(growthRows))
+	for _, r := range growthRows {
+		insights.MemberGrowth = append(insights.MemberGrowth, models.GroupMemberGrowth{
+			Day:    ct.GenDateTime(r.Day.Time),
+			Joined: r.Joined,
+			Total:  r.Total,
+		})
+	}
+
+	stats, err := s.db.GetGroupJoinRequestStats(ctx, ds.GetGroupJoinRequestStatsParams{
+		GroupID: groupId.Int64(),
+		Since:   sinceTs,
+	})
+	if err != nil {
+		return insights, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	insights.JoinRequests = models.GroupJoinRequestStats{
+		Accepted:              stats.Accepted,
+		Rejected:              stats.Rejected,
+		Pending:               stats.Pending,
+		AvgApprovalSeconds:    stats.AvgApprovalSeconds,
+		MedianApprovalSeconds: stats.MedianApprovalSeconds,
+	}
+
+	content, err := s.clients.GetGroupContentInsights(ctx, groupId.Int64(), since)
+	if err != nil {
+		return insights, ce.DecodeProto(err, input)
+	}
+	insights.GroupContentInsights = content
+
+	//fill in poster info
+	if len(insights.ActivePosters) > 0 {
+		posterIds := make(ct.Ids, 0, len(insights.ActivePosters))
+		for _, p := range insights.ActivePosters {
+			posterIds = append(posterIds, p.User.UserId)
+		}
+		users, err := s.GetBatchBasicUserInfo(ctx, posterIds)
+		if err != nil {
+			return insights, ce.Wrap(nil, err)
+		}
+		usersById := make(map[ct.Id]models.User, len(users))
+		for _, u := range users {
+			usersById[u.UserId] = u
+		}
+		for i, p := range insights.ActivePosters {
+			if u, ok := usersById[p.User.UserId]; ok {
+				insights.ActivePosters[i].User = u
+			}
+		}
+	}
+
+	//attendance is measured against current members
+	if len(insights.Events) > 0 && insights.MembersCount > 0 {
+		var total float64
+		for i, e := range insights.Events {
+			rate := float64(e.GoingCount) / float64(insights.MembersCount)
+			insights.Events[i].AttendanceRate = rate
+			total += rate
+		}
+		insights.AttendanceRate = total / float64(len(insights.Events))
+	}
+
+	return insights, nil
+}
@@ -0,0 +1,162 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	ds "social-network/services/users/internal/db/dbservice"
+	ce "social-network/shared/go/commonerrors"
+	ct "social-network/shared/go/ct"
+	"social-network/shared/go/models"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// What each group role is allowed to do. Members have no extra permissions.
+// Actions towards other members (removing, changing roles) additionally
+// require outranking them.
+var groupRolePermissions = map[ct.GroupRole][]ct.GroupPermission{
+	ct.GroupRoleOwner: {
+		ct.PermApproveJoins, ct.PermRemoveMembers, ct.PermDeletePosts, ct.PermPinPosts,
+		ct.PermEditInfo, ct.PermManageEvents, ct.PermManageRoles, ct.PermManageInvites,
+		ct.PermAnnounce, ct.PermViewInsights, ct.PermApprovePosts,
+	},
+	ct.GroupRoleAdmin: {
+		ct.PermApproveJoins, ct.PermRemoveMembers, ct.PermDeletePosts, ct.PermPinPosts,
+		ct.PermEditInfo, ct.PermManageEvents, ct.PermManageRoles, ct.PermManageInvites,
+		ct.PermAnnounce, ct.PermViewInsights, ct.PermApprovePosts,
+	},
+	ct.GroupRoleModerator: {
+		ct.PermApproveJoins, ct.PermRemoveMembers, ct.PermDeletePosts, ct.PermPinPosts,
+		ct.PermApprovePosts,
+	},
+	ct.GroupRoleMember: {},
+}
+
+func roleAllows(role ct.GroupRole, perm ct.GroupPermission) bool {
+	return slices.Contains(groupRolePermissions[role], perm)
+}
+
+// the roles granting the given permission, sorted
+func rolesWith(perm ct.GroupPermission) []string {
+	roles := []string{}
+	for role := range groupRolePermissions {
+		if roleAllows(role, perm) {
+			roles = append(roles, string(role))
+		}
+	}
+	slices.Sort(roles)
+	return roles
+}
+
+// Returns whether user's role in the group grants the given permission.
+// Non members have no permissions.
+func (s *Application) HasGroupPermission(ctx context.Context, req models.GroupPermissionReq) (bool, error) {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return false, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	role, err := s.getGroupRole(ctx, req.GroupId, req.UserId)
+	if err != nil {
+		return false, ce.Wrap(nil, err)
+	}
+	return roleAllows(role, req.Permission), nil
+}
+
+// Raises a member to a higher role. Requester must outrank both the member's current and new role.
+func (s *Application) PromoteGroupMember(ctx context.Context, req models.ChangeGroupRoleReq) error {
+	return s.changeGroupRole(ctx, req, true)
+}
+
+// Lowers a member to a lower role. Requester must outrank the member's current role.
+func (s *Application) DemoteGroupMember(ctx context.Context, req models.ChangeGroupRoleReq) error {
+	return s.changeGroupRole(ctx, req, false)
+}
+
+// NOT GRPC
+func (s *Application) changeGroupRole(ctx context.Context, req models.ChangeGroupRoleReq, promote bool) error {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+	if req.Role == ct.GroupRoleOwner {
+		return ce.New(ce.ErrInvalidArgument, fmt.Errorf("owner role requested for user %v", req.MemberId), input).WithPublic("ownership can only be transferred")
+	}
+	if req.RequesterId == req.MemberId {
+		return ce.New(ce.ErrPermissionDenied, fmt.Errorf("user %v tried to change own role", req.RequesterId), input).WithPublic("you can't change your own role")
+	}
+
+	requesterRole, err := s.checkGroupPermission(ctx, req.GroupId, req.RequesterId, ct.PermManageRoles)
+	if err != nil {
+		return ce.Wrap(nil, err)
+	}
+
+	memberRole, err := s.getGroupRole(ctx, req.GroupId, req.MemberId)
+	if err != nil {
+		return ce.Wrap(nil, err)
+	}
+	if memberRole == "" {
+		return ce.New(ce.ErrNotFound, fmt.Errorf("user %v is not a member of group %v", req.MemberId, req.GroupId), input).WithPublic("user is not a member of this group")
+	}
+
+	if promote && req.Role.Rank() <= memberRole.Rank() {
+		return ce.New(ce.ErrFailedPrecondition, fmt.Errorf("cannot promote %v to %v", memberRole, req.Role), input).WithPublic("new role must be higher than the current one")
+	}
+	if !promote && req.Role.Rank() >= memberRole.Rank() {
+		return ce.New(ce.ErrFailedPrecondition, fmt.Errorf("cannot demote %v to %v", memberRole, req.Role), input).WithPublic("new role must be lower than the current one")
+	}
+	if requesterRole.Rank() <= memberRole.Rank() || requesterRole.Rank() <= req.Role.Rank() {
+		return ce.New(ce.ErrPermissionDenied, fmt.Errorf("%v cannot change role of %v to %v", requesterRole, memberRole, req.Role), input).WithPublic("permission denied")
+	}
+
+	rows, err := s.db.UpdateGroupMemberRole(ctx, ds.UpdateGroupMemberRoleParams{
+		GroupID: req.GroupId.Int64(),
+		UserID:  req.MemberId.Int64(),
+		Role:    ds.GroupRole(req.Role),
+	})
+	if err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if rows == 0 {
+		return ce.New(ce.ErrNotFound, fmt.Errorf("user %v is not a non owner member of group %v", req.MemberId, req.GroupId), input).WithPublic("user is not a member of this group")
+	}
+	return nil
+}
+
+// NOT GRPC
+// returns the role of user in group, empty if not a member
+func (s *Application) getGroupRole(ctx context.Context, groupId, userId ct.Id) (ct.GroupRole, error) {
+	input := fmt.Sprintf("group id: %v, user id: %v", groupId, userId)
+
+	row, err := s.db.GetUserGroupRole(ctx, ds.GetUserGroupRoleParams{
+		GroupID: groupId.Int64(),
+		UserID:  userId.Int64(),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return "", ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if !row.Valid {
+		return ct.GroupRoleMember, nil
+	}
+	return ct.GroupRole(row.GroupRole), nil
+}
+
+// NOT GRPC
+// returns permission denied unless user's role in group grants perm, otherwise the role
+func (s *Application) checkGroupPermission(ctx context.Context, groupId, userId ct.Id, perm ct.GroupPermission) (ct.GroupRole, error) {
+	role, err := s.getGroupRole(ctx, groupId, userId)
+	if err != nil {
+		return "", ce.Wrap(nil, err)
+	}
+	if !roleAllows(role, perm) {
+		return "", ce.New(ce.ErrPermissionDenied, fmt.Errorf("user %v with role %q in group %v lacks permission %v", userId, role, groupId, perm), fmt.Sprintf("%v %v %v", groupId, userId, perm)).WithPublic("permission denied")
+	}
+	return role, nil
+}
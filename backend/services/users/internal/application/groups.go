@@ -2,6 +2,7 @@ package application
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	ds "social-network/services/users/internal/db/dbservice"
 	"social-network/shared/gen-go/media"
@@ -11,6 +12,7 @@ import (
 	"social-network/shared/go/models"
 	tele "social-network/shared/go/telemetry"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 )
 
@@ -21,9 +23,11 @@ func (s *Application) GetAllGroupsPaginated(ctx context.Context, req models.Pagi
 		return []models.Group{}, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
 	}
 	//paginated (sorting by most members first)
+	//secret groups only listed to members
 	rows, err := s.db.GetAllGroups(ctx, ds.GetAllGroupsParams{
 		Offset: req.Offset.Int32(),
 		Limit:  req.Limit.Int32(),
+		UserID: req.UserId.Int64(),
 	})
 	if err != nil {
 		return nil, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
@@ -52,6 +56,7 @@ func (s *Application) GetAllGroupsPaginated(ctx context.Context, req models.Pagi
 			IsOwner:          userInfo.isOwner,
 			PendingRequest:   userInfo.pendingRequest,
 			PendingInvite:    userInfo.pendingInvite,
+			Visibility:       ct.GroupVisibility(r.Visibility),
 		})
 		if r.GroupImageID > 0 {
 			imageIds = append(imageIds, ct.Id(r.GroupImageID))
@@ -113,6 +118,7 @@ func (s *Application) GetUserGroupsPaginated(ctx context.Context, req models.Pag
 			IsOwner:          r.IsOwner,
 			PendingRequest:   pendingInfo.pendingRequest,
 			PendingInvite:    pendingInfo.pendingInvite,
+			Visibility:       ct.GroupVisibility(r.Visibility),
 		})
 		if r.GroupImageID > 0 {
 			imageIds = append(imageIds, ct.Id(r.GroupImageID))
@@ -146,12 +152,16 @@ func (s *Application) GetGroupInfo(ctx context.Context, req models.GeneralGroupR
 		return models.Group{}, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
 	}
 	group := models.Group{
-		GroupId:          ct.Id(row.ID),
-		GroupOwnerId:     ct.Id(row.GroupOwner),
-		GroupTitle:       ct.Title(row.GroupTitle),
-		GroupDescription: ct.About(row.GroupDescription),
-		GroupImage:       ct.Id(row.GroupImageID),
-		MembersCount:     row.MembersCount,
+		GroupId:              ct.Id(row.ID),
+		GroupOwnerId:         ct.Id(row.GroupOwner),
+		GroupTitle:           ct.Title(row.GroupTitle),
+		GroupDescription:     ct.About(row.GroupDescription),
+		GroupImage:           ct.Id(row.GroupImageID),
+		MembersCount:         row.MembersCount,
+		Archived:             row.Archived,
+		Visibility:           ct.GroupVisibility(row.Visibility),
+		Category:             ct.GroupCategory(row.Category),
+		PostsRequireApproval: row.PostsRequireApproval,
 	}
 	userInfo, err := s.userInRelationToGroup(ctx, models.GeneralGroupReq{
 		GroupId: req.GroupId,
@@ -165,6 +175,22 @@ func (s *Application) GetGroupInfo(ctx context.Context, req models.GeneralGroupR
 	group.PendingRequest = userInfo.pendingRequest
 	group.PendingInvite = userInfo.pendingInvite
 
+	group.OwnershipOffered, err = s.isOwnershipOffered(ctx, req.GroupId, req.UserId)
+	if err != nil {
+		return models.Group{}, ce.Wrap(nil, err)
+	}
+
+	//secret groups are hidden from everyone but members and invited users
+	if group.Visibility == ct.GroupVisibilitySecret && !group.IsMember && !group.PendingInvite && !group.OwnershipOffered {
+		return models.Group{}, ce.New(ce.ErrNotFound, fmt.Errorf("group %v is secret", req.GroupId), input).WithPublic("not found")
+	}
+
+	tags, err := s.db.GetGroupTags(ctx, req.GroupId.Int64())
+	if err != nil {
+		return models.Group{}, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	group.Tags = ct.GroupTagsFromStrings(tags)
+
 	if group.GroupImage > 0 {
 		imageUrl, err := s.mediaRetriever.GetImage(ctx, group.GroupImage.Int64(), media.FileVariant_SMALL)
 		if err != nil {
@@ -268,6 +294,20 @@ func (s *Application) GetAllGroupMemberIds(ctx context.Context, req models.Group
 	return members, nil
 }
 
+// returns ids of the groups a user is a member of for posts service, so that search can filter on them
+func (s *Application) GetUserGroupIds(ctx context.Context, userId ct.Id) ([]int64, error) {
+	input := fmt.Sprintf("%#v", userId)
+
+	if err := userId.Validate(); err != nil {
+		return []int64{}, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+	ids, err := s.db.GetUserGroupIds(ctx, userId.Int64())
+	if err != nil {
+		return nil, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	return ids, nil
+}
+
 func (s *Application) SearchGroups(ctx context.Context, req models.GroupSearchReq) ([]models.Group, error) {
 	input := fmt.Sprintf("%#v", req)
 
@@ -276,6 +316,7 @@ func (s *Application) SearchGroups(ctx context.Context, req models.GroupSearchRe
 	}
 	//weighted (title more important than description)
 	//paginated (most members first)
+	//secret groups only found by members
 	rows, err := s.db.SearchGroups(ctx, ds.SearchGroupsParams{
 		Query:  req.SearchTerm.String(),
 		UserID: req.UserId.Int64(),
@@ -312,6 +353,8 @@ func (s *Application) SearchGroups(ctx context.Context, req models.GroupSearchRe
 			IsOwner:          r.IsOwner,
 			PendingRequest:   pendingInfo.pendingRequest,
 			PendingInvite:    pendingInfo.pendingInvite,
+			Visibility:       ct.GroupVisibility(r.Visibility),
+			Category:         ct.GroupCategory(r.Category),
 		})
 		if r.GroupImageID > 0 {
 			imageIds = append(imageIds, ct.Id(r.GroupImageID))
@@ -352,11 +395,45 @@ func (s *Application) InviteToGroup(ctx context.Context, req models.InviteToGrou
 	if !isMember {
 		return ce.New(ce.ErrPermissionDenied, fmt.Errorf("user %v is not a member of group %v", req.InviterId, req.GroupId), input).WithPublic("permission denied")
 	}
+	if err := s.checkGroupWritable(ctx, s.db, req.GroupId); err != nil {
+		return ce.Wrap(nil, err)
+	}
+
+	//skip invitees whose privacy settings don't allow the inviter
+	invitedIds, err := s.db.FilterInvitableUsers(ctx, ds.FilterInvitableUsersParams{
+		InviterID:   req.InviterId.Int64(),
+		ReceiverIDs: req.InvitedIds.Int64(),
+	})
+	if err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if len(invitedIds) == 0 {
+		return ce.New(ce.ErrPermissionDenied, fmt.Errorf("none of users %v allow group invites from user %v", req.InvitedIds, req.InviterId), input).WithPublic("these users don't accept group invites from you")
+	}
+	if len(invitedIds) < len(req.InvitedIds) {
+		tele.Info(ctx, "skipping @1 invitees of group @2 due to privacy settings", "skipped", len(req.InvitedIds)-len(invitedIds), "groupId", req.GroupId)
+	}
+
+	//skip invitees banned from the group
+	allowedIds, err := s.db.FilterNotBannedFromGroup(ctx, ds.FilterNotBannedFromGroupParams{
+		GroupID: req.GroupId.Int64(),
+		UserIds: invitedIds,
+	})
+	if err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if len(allowedIds) == 0 {
+		return ce.New(ce.ErrPermissionDenied, fmt.Errorf("all of users %v are banned from group %v", invitedIds, req.GroupId), input).WithPublic("these users are banned from this group")
+	}
+	if len(allowedIds) < len(invitedIds) {
+		tele.Info(ctx, "skipping @1 banned invitees of group @2", "skipped", len(invitedIds)-len(allowedIds), "groupId", req.GroupId)
+	}
+	invitedIds = allowedIds
 
 	err = s.db.SendGroupInvites(ctx, ds.SendGroupInvitesParams{
 		GroupID:     req.GroupId.Int64(),
 		SenderID:    req.InviterId.Int64(),
-		ReceiverIDs: req.InvitedIds.Int64(),
+		ReceiverIDs: invitedIds,
 	})
 	if err != nil {
 		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
@@ -377,7 +454,7 @@ func (s *Application) InviteToGroup(ctx context.Context, req models.InviteToGrou
 		EventType: notifpb.EventType_GROUP_INVITE_CREATED,
 		Payload: &notifpb.NotificationEvent_GroupInviteCreated{
 			GroupInviteCreated: &notifpb.GroupInviteCreated{
-				InvitedUserId:   req.InvitedIds.Int64(),
+				InvitedUserId:   invitedIds,
 				InviterUserId:   req.InviterId.Int64(),
 				GroupId:         req.GroupId.Int64(),
 				GroupName:       group.GroupTitle,
@@ -417,22 +494,84 @@ func (s *Application) RequestJoinGroup(ctx context.Context, req models.GroupJoin
 	if err := ct.ValidateStruct(req); err != nil {
 		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
 	}
-	err := s.db.SendGroupJoinRequest(ctx, ds.SendGroupJoinRequestParams{
+	if err := s.checkGroupWritable(ctx, s.db, req.GroupId); err != nil {
+		return ce.Wrap(nil, err)
+	}
+	if err := s.checkNotBanned(ctx, s.db, req.GroupId, req.RequesterId); err != nil {
+		return ce.Wrap(nil, err)
+	}
+
+	visibility, err := s.db.GetGroupVisibility(ctx, req.GroupId.Int64())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ce.New(ce.ErrNotFound, err, input).WithPublic("not found")
+		}
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if visibility == ds.GroupVisibilitySecret {
+		return ce.New(ce.ErrPermissionDenied, fmt.Errorf("group %v is secret", req.GroupId), input).WithPublic("this group can only be joined by invite")
+	}
+
+	answers, err := s.checkJoinAnswers(ctx, s.db, req, input)
+	if err != nil {
+		return ce.Wrap(nil, err)
+	}
+
+	err = s.txRunner.RunTx(ctx, func(q *ds.Queries) error {
+		return s.saveJoinRequest(ctx, q, req, answers, input)
+	})
+	if err != nil {
+		return ce.Wrap(nil, err)
+	}
+	s.sendJoinRequestNotification(ctx, req.GroupId, req.RequesterId)
+	return nil
+}
+
+// NOT GRPC
+// creates or renews the pending join request with answers checked by checkJoinAnswers
+func (s *Application) saveJoinRequest(ctx context.Context, q ds.Querier, req models.GroupJoinRequest, answers []ds.InsertGroupJoinAnswerParams, input string) error {
+	err := q.SendGroupJoinRequest(ctx, ds.SendGroupJoinRequestParams{
 		GroupID: req.GroupId.Int64(),
 		UserID:  req.RequesterId.Int64(),
 	})
+	if err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	err = q.SetJoinRequestRulesAccepted(ctx, ds.SetJoinRequestRulesAcceptedParams{
+		GroupID:  req.GroupId.Int64(),
+		UserID:   req.RequesterId.Int64(),
+		Accepted: req.AcceptRules,
+	})
+	if err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
 
+	// a repeated request replaces the previous answers
+	err = q.DeleteGroupJoinAnswers(ctx, ds.DeleteGroupJoinAnswersParams{
+		GroupID: req.GroupId.Int64(),
+		UserID:  req.RequesterId.Int64(),
+	})
 	if err != nil {
 		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
 	}
-	//create notification
-	requester, err := s.GetBasicUserInfo(ctx, req.RequesterId)
+	for _, a := range answers {
+		if err := q.InsertGroupJoinAnswer(ctx, a); err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+	}
+	return nil
+}
+
+// NOT GRPC
+// notifies the group owner of a new join request, failures are only logged
+func (s *Application) sendJoinRequestNotification(ctx context.Context, groupId, requesterId ct.Id) {
+	requester, err := s.GetBasicUserInfo(ctx, requesterId)
 	if err != nil {
-		tele.Error(ctx, "Could not get basic user info for id @1 for request join group notif: @2", "userId", req.RequesterId, "error", err.Error())
+		tele.Error(ctx, "Could not get basic user info for id @1 for request join group notif: @2", "userId", requesterId, "error", err.Error())
 	}
-	group, err := s.db.GetGroupBasicInfo(ctx, req.GroupId.Int64())
+	group, err := s.db.GetGroupBasicInfo(ctx, groupId.Int64())
 	if err != nil {
-		tele.Error(ctx, "Could not get basic group info for id @1 for request join group notif: @2", "userId", req.GroupId, "error", err.Error())
+		tele.Error(ctx, "Could not get basic group info for id @1 for request join group notif: @2", "userId", groupId, "error", err.Error())
 	}
 
 	event := &notifpb.NotificationEvent{
@@ -440,8 +579,8 @@ func (s *Application) RequestJoinGroup(ctx context.Context, req models.GroupJoin
 		Payload: &notifpb.NotificationEvent_GroupJoinRequestCreated{
 			GroupJoinRequestCreated: &notifpb.GroupJoinRequestCreated{
 				GroupOwnerId:      group.GroupOwner,
-				RequesterUserId:   req.RequesterId.Int64(),
-				GroupId:           req.GroupId.Int64(),
+				RequesterUserId:   requesterId.Int64(),
+				GroupId:           groupId.Int64(),
 				GroupName:         group.GroupTitle,
 				RequesterUsername: requester.Username.String(),
 			},
@@ -452,8 +591,6 @@ func (s *Application) RequestJoinGroup(ctx context.Context, req models.GroupJoin
 		tele.Error(ctx, "failed to send request join group notification: @1", "error", err.Error())
 	}
 	tele.Info(ctx, "request join group notification event created")
-
-	return nil
 }
 
 func (s *Application) CancelJoinGroupRequest(ctx context.Context, req models.GroupJoinRequest) error {
@@ -506,10 +643,19 @@ func (s *Application) RespondToGroupInvite(ctx context.Context, req models.Handl
 		ReceiverID: req.InvitedId.Int64(),
 	})
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ce.New(ce.ErrNotFound, err, input).WithPublic("no pending invite to this group")
+		}
 		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
 	}
 
 	if req.Accepted {
+		if err := s.checkGroupWritable(ctx, s.db, req.GroupId); err != nil {
+			return ce.Wrap(nil, err)
+		}
+		if err := s.checkNotBanned(ctx, s.db, req.GroupId, req.InvitedId); err != nil {
+			return ce.Wrap(nil, err)
+		}
 
 		err := s.db.AcceptGroupInvite(ctx, ds.AcceptGroupInviteParams{
 			GroupID:    req.GroupId.Int64(),
@@ -592,19 +738,19 @@ func (s *Application) HandleGroupJoinRequest(ctx context.Context, req models.Han
 		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
 	}
 
-	isOwner, err := s.isGroupOwner(ctx, models.GeneralGroupReq{
-		GroupId: req.GroupId,
-		UserId:  req.OwnerId,
-	})
-	if err != nil {
+	if _, err := s.checkGroupPermission(ctx, req.GroupId, req.OwnerId, ct.PermApproveJoins); err != nil {
 		return ce.Wrap(nil, err)
 	}
-	if !isOwner {
-		return ce.New(ce.ErrPermissionDenied, fmt.Errorf("user %v is not the owner of group %v", req.OwnerId, req.GroupId), input).WithPublic("permission denied")
-	}
 
 	if req.Accepted {
-		err = s.db.AcceptGroupJoinRequest(ctx, ds.AcceptGroupJoinRequestParams{
+		if err := s.checkGroupWritable(ctx, s.db, req.GroupId); err != nil {
+			return ce.Wrap(nil, err)
+		}
+		if err := s.checkNotBanned(ctx, s.db, req.GroupId, req.RequesterId); err != nil {
+			return ce.Wrap(nil, err)
+		}
+
+		err := s.db.AcceptGroupJoinRequest(ctx, ds.AcceptGroupJoinRequestParams{
 			GroupID: req.GroupId.Int64(),
 			UserID:  req.RequesterId.Int64(),
 		})
@@ -636,7 +782,7 @@ func (s *Application) HandleGroupJoinRequest(ctx context.Context, req models.Han
 		tele.Info(ctx, "group join request accepted notification event created")
 
 	} else {
-		err = s.db.RejectGroupJoinRequest(ctx, ds.RejectGroupJoinRequestParams{
+		err := s.db.RejectGroupJoinRequest(ctx, ds.RejectGroupJoinRequestParams{
 			GroupID: req.GroupId.Int64(),
 			UserID:  req.RequesterId.Int64(),
 		})
@@ -704,16 +850,17 @@ func (s *Application) RemoveFromGroup(ctx context.Context, req models.RemoveFrom
 	if err := ct.ValidateStruct(req); err != nil {
 		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
 	}
-	//check owner has indeed the owner role
-	isOwner, err := s.isGroupOwner(ctx, models.GeneralGroupReq{
-		GroupId: req.GroupId,
-		UserId:  req.OwnerId,
-	})
+	//check requester can remove members and outranks the member
+	requesterRole, err := s.checkGroupPermission(ctx, req.GroupId, req.OwnerId, ct.PermRemoveMembers)
 	if err != nil {
 		return ce.Wrap(nil, err)
 	}
-	if !isOwner {
-		return ce.New(ce.ErrPermissionDenied, fmt.Errorf("user %v is not the owner of group %v", req.OwnerId, req.GroupId), input).WithPublic("permission denied")
+	memberRole, err := s.getGroupRole(ctx, req.GroupId, req.MemberId)
+	if err != nil {
+		return ce.Wrap(nil, err)
+	}
+	if memberRole != "" && requesterRole.Rank() <= memberRole.Rank() {
+		return ce.New(ce.ErrPermissionDenied, fmt.Errorf("%v cannot remove %v from group %v", requesterRole, memberRole, req.GroupId), input).WithPublic("permission denied")
 	}
 
 	err = s.LeaveGroup(ctx, models.GeneralGroupReq{
@@ -733,21 +880,58 @@ func (s *Application) CreateGroup(ctx context.Context, req *models.CreateGroupRe
 		return 0, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
 	}
 
-	groupId, err := s.db.CreateGroup(ctx, ds.CreateGroupParams{
-		GroupOwner:       req.OwnerId.Int64(),
-		GroupTitle:       req.GroupTitle.String(),
-		GroupDescription: req.GroupDescription.String(),
-		GroupImageID:     req.GroupImage.Int64(),
-	})
-	if err != nil {
-		if pgErr, ok := err.(*pgconn.PgError); ok {
-			if pgErr.Code == "23505" { // unique_violation
-				return 0, ce.New(ce.ErrAlreadyExists, err, input).WithPublic("group already exists")
+	visibility := req.Visibility
+	if visibility == "" {
+		visibility = ct.GroupVisibilityPrivate
+	}
+	category := req.Category
+	if category == "" {
+		category = ct.GroupCategoryOther
+	}
+
+	var groupId int64
+	err := s.txRunner.RunTx(ctx, func(q *ds.Queries) error {
+		var err error
+		groupId, err = q.CreateGroup(ctx, ds.CreateGroupParams{
+			GroupOwner:       req.OwnerId.Int64(),
+			GroupTitle:       req.GroupTitle.String(),
+			GroupDescription: req.GroupDescription.String(),
+			GroupImageID:     req.GroupImage.Int64(),
+			Visibility:       ds.GroupVisibility(visibility),
+			Category:         ds.GroupCategory(category),
+		})
+		if err != nil {
+			if pgErr, ok := err.(*pgconn.PgError); ok {
+				if pgErr.Code == "23505" { // unique_violation
+					return ce.New(ce.ErrAlreadyExists, err, input).WithPublic("group already exists")
+				}
 			}
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
 		}
-		return 0, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+
+		if len(req.Tags) > 0 {
+			err = q.InsertGroupTags(ctx, ds.InsertGroupTagsParams{
+				GroupID: groupId,
+				Tags:    req.Tags.Strings(),
+			})
+			if err != nil {
+				return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+			}
+		}
+
+		//posts service only keeps track of public groups
+		if visibility == ct.GroupVisibilityPublic {
+			if err := enqueueEvent(ctx, q, groupVisibilityEvent(ct.Id(groupId), visibility)); err != nil {
+				return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, ce.Wrap(nil, err)
 	}
 
+	s.kickOutbox()
 	return models.GroupId(groupId), nil
 }
 
@@ -758,16 +942,12 @@ func (s *Application) UpdateGroup(ctx context.Context, req *models.UpdateGroupRe
 		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
 	}
 
-	//check requester is owner
-	isOwner, err := s.isGroupOwner(ctx, models.GeneralGroupReq{
-		GroupId: req.GroupId,
-		UserId:  req.RequesterId,
-	})
-	if err != nil {
+	//check requester can edit group info
+	if _, err := s.checkGroupPermission(ctx, req.GroupId, req.RequesterId, ct.PermEditInfo); err != nil {
 		return ce.Wrap(nil, err)
 	}
-	if !isOwner {
-		return ce.New(ce.ErrPermissionDenied, fmt.Errorf("user %v is not the owner of group %v", req.RequesterId, req.GroupId), input).WithPublic("permission denied")
+	if err := s.checkGroupWritable(ctx, s.db, req.GroupId); err != nil {
+		return ce.Wrap(nil, err)
 	}
 
 	groupImageId := req.GroupImage.Int64()
@@ -775,23 +955,122 @@ func (s *Application) UpdateGroup(ctx context.Context, req *models.UpdateGroupRe
 		groupImageId = 0
 	}
 
-	rowsAffected, err := s.db.UpdateGroup(ctx, ds.UpdateGroupParams{
-		ID:               req.GroupId.Int64(),
-		GroupTitle:       req.GroupTitle.String(),
-		GroupDescription: req.GroupDescription.String(),
-		GroupImageID:     groupImageId,
-	})
+	err := s.txRunner.RunTx(ctx, func(q *ds.Queries) error {
+		rowsAffected, err := q.UpdateGroup(ctx, ds.UpdateGroupParams{
+			ID:               req.GroupId.Int64(),
+			GroupTitle:       req.GroupTitle.String(),
+			GroupDescription: req.GroupDescription.String(),
+			GroupImageID:     groupImageId,
+			Visibility: ds.NullGroupVisibility{
+				GroupVisibility: ds.GroupVisibility(req.Visibility),
+				Valid:           req.Visibility != "",
+			},
+			Category: ds.NullGroupCategory{
+				GroupCategory: ds.GroupCategory(req.Category),
+				Valid:         req.Category != "",
+			},
+		})
+
+		if err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
 
+		if rowsAffected != 1 {
+			return ce.New(ce.ErrNotFound, fmt.Errorf("group %v was not found or has been deleted", req.GroupId), input).WithPublic("not found")
+		}
+
+		//nil tags keep the current ones
+		if req.Tags != nil {
+			if err := q.DeleteGroupTags(ctx, req.GroupId.Int64()); err != nil {
+				return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+			}
+			if len(req.Tags) > 0 {
+				err = q.InsertGroupTags(ctx, ds.InsertGroupTagsParams{
+					GroupID: req.GroupId.Int64(),
+					Tags:    req.Tags.Strings(),
+				})
+				if err != nil {
+					return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+				}
+			}
+		}
+
+		if req.Visibility != "" {
+			if err := enqueueEvent(ctx, q, groupVisibilityEvent(req.GroupId, req.Visibility)); err != nil {
+				return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		return ce.Wrap(nil, err)
 	}
 
-	if rowsAffected != 1 {
-		return ce.New(ce.ErrNotFound, fmt.Errorf("group %v was not found or has been deleted", req.GroupId), input).WithPublic("not found")
+	s.kickOutbox()
+	return nil
+}
+
+// builds the event telling posts service who may read the group's posts and events
+func groupVisibilityEvent(groupId ct.Id, visibility ct.GroupVisibility) *notifpb.NotificationEvent {
+	return &notifpb.NotificationEvent{
+		EventType: notifpb.EventType_GROUP_VISIBILITY_CHANGED,
+		Payload: &notifpb.NotificationEvent_GroupVisibilityChanged{
+			GroupVisibilityChanged: &notifpb.GroupVisibilityChanged{
+				GroupId:    groupId.Int64(),
+				Visibility: visibility.String(),
+			},
+		},
 	}
+}
 
-	return nil
+// Turns approval of new posts by group staff on or off.
+// Posts already waiting stay in the queue when approval is turned off.
+func (s *Application) SetGroupPostApproval(ctx context.Context, req models.SetGroupPostApprovalReq) error {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
 
+	if _, err := s.checkGroupPermission(ctx, req.GroupId, req.RequesterId, ct.PermEditInfo); err != nil {
+		return ce.Wrap(nil, err)
+	}
+	if err := s.checkGroupWritable(ctx, s.db, req.GroupId); err != nil {
+		return ce.Wrap(nil, err)
+	}
+
+	err := s.txRunner.RunTx(ctx, func(q *ds.Queries) error {
+		rowsAffected, err := q.SetGroupPostsRequireApproval(ctx, ds.SetGroupPostsRequireApprovalParams{
+			ID:                   req.GroupId.Int64(),
+			PostsRequireApproval: req.Required,
+		})
+		if err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		if rowsAffected != 1 {
+			return ce.New(ce.ErrNotFound, fmt.Errorf("group %v was not found or has been deleted", req.GroupId), input).WithPublic("not found")
+		}
+
+		event := &notifpb.NotificationEvent{
+			EventType: notifpb.EventType_GROUP_POST_APPROVAL_CHANGED,
+			Payload: &notifpb.NotificationEvent_GroupPostApprovalChanged{
+				GroupPostApprovalChanged: &notifpb.GroupPostApprovalChanged{
+					GroupId:  req.GroupId.Int64(),
+					Required: req.Required,
+				},
+			},
+		}
+		if err := enqueueEvent(ctx, q, event); err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		return nil
+	})
+	if err != nil {
+		return ce.Wrap(nil, err)
+	}
+
+	s.kickOutbox()
+	return nil
 }
 
 // NOT GRPC
@@ -940,22 +1219,15 @@ func (s *Application) GetFollowersNotInvitedToGroup(ctx context.Context, req mod
 
 }
 
-func (s *Application) GetPendingGroupJoinRequests(ctx context.Context, req models.GroupMembersReq) ([]models.User, error) {
+func (s *Application) GetPendingGroupJoinRequests(ctx context.Context, req models.GroupMembersReq) ([]models.PendingJoinRequest, error) {
 	input := fmt.Sprintf("%#v", req)
 
 	if err := ct.ValidateStruct(req); err != nil {
-		return []models.User{}, ce.Wrap(ce.ErrInvalidArgument, err, input).WithPublic("invalid data received")
+		return []models.PendingJoinRequest{}, ce.Wrap(ce.ErrInvalidArgument, err, input).WithPublic("invalid data received")
 	}
 
-	isOwner, err := s.isGroupOwner(ctx, models.GeneralGroupReq{
-		GroupId: req.GroupId,
-		UserId:  req.UserId,
-	})
-	if err != nil {
-		return []models.User{}, ce.Wrap(nil, err)
-	}
-	if !isOwner {
-		return []models.User{}, ce.New(ce.ErrPermissionDenied, fmt.Errorf("user %v is not the owner of group %v", req.UserId, req.GroupId), input).WithPublic("permission denied")
+	if _, err := s.checkGroupPermission(ctx, req.GroupId, req.UserId, ct.PermApproveJoins); err != nil {
+		return []models.PendingJoinRequest{}, ce.Wrap(nil, err)
 	}
 
 	//paginated, sorted by newest first
@@ -965,15 +1237,30 @@ func (s *Application) GetPendingGroupJoinRequests(ctx context.Context, req model
 		Offset:  int(req.Offset),
 	})
 	if err != nil {
-		return []models.User{}, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		return []models.PendingJoinRequest{}, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
 	}
-	users := make([]models.User, 0, len(rows))
+	userIds := make([]int64, 0, len(rows))
+	for _, r := range rows {
+		userIds = append(userIds, r.Id)
+	}
+	answers, err := s.joinAnswersByUser(ctx, req.GroupId, userIds)
+	if err != nil {
+		return []models.PendingJoinRequest{}, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+
+	users := make([]models.PendingJoinRequest, 0, len(rows))
 	var imageIds ct.Ids
 	for _, r := range rows {
-		users = append(users, models.User{
-			UserId:   ct.Id(r.Id),
-			Username: ct.Username(r.Username),
-			AvatarId: ct.Id(r.AvatarId),
+		users = append(users, models.PendingJoinRequest{
+			User: models.User{
+				UserId:   ct.Id(r.Id),
+				Username: ct.Username(r.Username),
+				AvatarId: ct.Id(r.AvatarId),
+			},
+			RequestedAt:   ct.GenDateTime(r.RequestedAt.Time),
+			ExpiresAt:     s.groupRequestExpiry(r.RequestedAt.Time),
+			RulesAccepted: r.RulesAcceptedAt.Valid,
+			Answers:       answers[r.Id],
 		})
 		if r.AvatarId > 0 {
 			imageIds = append(imageIds, ct.Id(r.AvatarId))
@@ -1003,16 +1290,9 @@ func (s *Application) GetPendingGroupJoinRequestsCount(ctx context.Context, req
 		return 0, ce.Wrap(ce.ErrInvalidArgument, err, input).WithPublic("invalid data received")
 	}
 
-	isOwner, err := s.isGroupOwner(ctx, models.GeneralGroupReq{
-		GroupId: req.GroupId,
-		UserId:  req.RequesterId,
-	})
-	if err != nil {
+	if _, err := s.checkGroupPermission(ctx, req.GroupId, req.RequesterId, ct.PermApproveJoins); err != nil {
 		return 0, ce.Wrap(nil, err)
 	}
-	if !isOwner {
-		return 0, ce.New(ce.ErrPermissionDenied, fmt.Errorf("user %v is not the owner of group %v", req.RequesterId, req.GroupId), input).WithPublic("permission denied")
-	}
 
 	//paginated, sorted by newest first
 	count, err := s.db.GetPendingGroupJoinRequestsCount(ctx, ds.GetPendingGroupJoinRequestsCountParams{
@@ -1046,23 +1326,3 @@ func (s *Application) GetGroupBasicInfo(ctx context.Context, req models.GroupId)
 	}
 	return group, nil
 }
-
-// ---------------------------------------------------------------------
-// low priority
-// ---------------------------------------------------------------------
-func DeleteGroup() {}
-
-//called with group_id, owner_id
-//returns success or error
-//request needs to come from owner
-//---------------------------------------------------------------------
-
-//initiated by ownder
-//SoftDeleteGroup
-
-func TranferGroupOwnerShip() {}
-
-//called with group_id,previous_owner_id, new_owner_id
-//returns success or error
-//request needs to come from previous owner (or admin - not implemented)
-//---------------------------------------------------------------------
@@ -0,0 +1,291 @@
+package application
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	ds "social-network/services/users/internal/db/dbservice"
+	ce "social-network/shared/go/commonerrors"
+	ct "social-network/shared/go/ct"
+	"social-network/shared/go/models"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Creates a shareable invite link for the group.
+// The returned token is signed and only valid while the link isn't revoked, expired or used up.
+func (s *Application) CreateGroupInviteLink(ctx context.Context, req models.CreateGroupInviteLinkReq) (models.GroupInviteLink, error) {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return models.GroupInviteLink{}, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+	if req.MaxUses < 0 {
+		return models.GroupInviteLink{}, ce.New(ce.ErrInvalidArgument, fmt.Errorf("negative max uses %v", req.MaxUses), input).WithPublic("max uses can't be negative")
+	}
+	if !req.ExpiresAt.Time().IsZero() && req.ExpiresAt.Time().Before(time.Now()) {
+		return models.GroupInviteLink{}, ce.New(ce.ErrInvalidArgument, fmt.Errorf("expiry %v is in the past", req.ExpiresAt), input).WithPublic("expiry must be in the future")
+	}
+
+	if _, err := s.checkGroupPermission(ctx, req.GroupId, req.RequesterId, ct.PermManageInvites); err != nil {
+		return models.GroupInviteLink{}, ce.Wrap(nil, err)
+	}
+	if err := s.checkGroupWritable(ctx, s.db, req.GroupId); err != nil {
+		return models.GroupInviteLink{}, ce.Wrap(nil, err)
+	}
+
+	nonce, err := newInviteLinkNonce()
+	if err != nil {
+		return models.GroupInviteLink{}, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+
+	link, err := s.db.InsertGroupInviteLink(ctx, ds.InsertGroupInviteLinkParams{
+		GroupID:     req.GroupId.Int64(),
+		CreatedBy:   req.RequesterId.Int64(),
+		Nonce:       nonce,
+		ExpiresAt:   pgtype.Timestamptz{Time: req.ExpiresAt.Time(), Valid: !req.ExpiresAt.Time().IsZero()},
+		MaxUses:     pgtype.Int4{Int32: req.MaxUses, Valid: req.MaxUses > 0},
+		AutoApprove: req.AutoApprove,
+	})
+	if err != nil {
+		return models.GroupInviteLink{}, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+
+	return models.GroupInviteLink{
+		LinkId:      ct.Id(link.ID),
+		GroupId:     ct.Id(link.GroupID),
+		Token:       s.inviteLinkToken(link.ID, link.Nonce),
+		CreatedBy:   ct.Id(link.CreatedBy),
+		CreatedAt:   ct.GenDateTime(link.CreatedAt.Time),
+		ExpiresAt:   ct.GenDateTime(link.ExpiresAt.Time),
+		MaxUses:     link.MaxUses.Int32,
+		AutoApprove: link.AutoApprove,
+	}, nil
+}
+
+// Lists all invite links of the group, newest first, with their usage stats.
+func (s *Application) GetGroupInviteLinks(ctx context.Context, req models.GeneralGroupReq) ([]models.GroupInviteLink, error) {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return nil, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	if _, err := s.checkGroupPermission(ctx, req.GroupId, req.UserId, ct.PermManageInvites); err != nil {
+		return nil, ce.Wrap(nil, err)
+	}
+
+	rows, err := s.db.ListGroupInviteLinks(ctx, req.GroupId.Int64())
+	if err != nil {
+		return nil, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+
+	links := make([]models.GroupInviteLink, 0, len(rows))
+	for _, r := range rows {
+		links = append(links, models.GroupInviteLink{
+			LinkId:         ct.Id(r.ID),
+			GroupId:        ct.Id(r.GroupID),
+			Token:          s.inviteLinkToken(r.ID, r.Nonce),
+			CreatedBy:      ct.Id(r.CreatedBy),
+			CreatedAt:      ct.GenDateTime(r.CreatedAt.Time),
+			ExpiresAt:      ct.GenDateTime(r.ExpiresAt.Time),
+			MaxUses:        r.MaxUses.Int32,
+			AutoApprove:    r.AutoApprove,
+			Revoked:        r.RevokedAt.Valid,
+			Uses:           r.Uses,
+			JoinedCount:    r.JoinedCount,
+			RequestedCount: r.RequestedCount,
+			LastUsedAt:     ct.GenDateTime(r.LastUsedAt.Time),
+		})
+	}
+	return links, nil
+}
+
+// Revokes an invite link, its token stops working immediately.
+func (s *Application) RevokeGroupInviteLink(ctx context.Context, req models.RevokeGroupInviteLinkReq) error {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	if _, err := s.checkGroupPermission(ctx, req.GroupId, req.RequesterId, ct.PermManageInvites); err != nil {
+		return ce.Wrap(nil, err)
+	}
+
+	rows, err := s.db.RevokeGroupInviteLink(ctx, ds.RevokeGroupInviteLinkParams{
+		ID:      req.LinkId.Int64(),
+		GroupID: req.GroupId.Int64(),
+	})
+	if err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if rows == 0 {
+		return ce.New(ce.ErrNotFound, fmt.Errorf("no active link %v in group %v", req.LinkId, req.GroupId), input).WithPublic("invite link not found")
+	}
+	return nil
+}
+
+// Joins the group of an invite link. Links without auto approve
+// create a join request instead, which group staff still have to accept,
+// so the rules and join questions are checked like in RequestJoinGroup.
+func (s *Application) JoinGroupByLink(ctx context.Context, req models.JoinGroupByLinkReq) (models.JoinGroupByLinkResp, error) {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return models.JoinGroupByLinkResp{}, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	linkId, nonce, err := s.parseInviteLinkToken(req.Token)
+	if err != nil {
+		return models.JoinGroupByLinkResp{}, ce.New(ce.ErrInvalidArgument, err, input).WithPublic("invalid invite link")
+	}
+
+	var resp models.JoinGroupByLinkResp
+	err = s.txRunner.RunTx(ctx, func(q *ds.Queries) error {
+		link, err := q.GetGroupInviteLinkForUpdate(ctx, linkId)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ce.New(ce.ErrNotFound, err, input).WithPublic("invite link not found")
+			}
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		if link.Nonce != nonce {
+			return ce.New(ce.ErrNotFound, fmt.Errorf("nonce mismatch for link %v", linkId), input).WithPublic("invite link not found")
+		}
+		if link.RevokedAt.Valid {
+			return ce.New(ce.ErrFailedPrecondition, fmt.Errorf("link %v is revoked", linkId), input).WithPublic("this invite link has been revoked")
+		}
+		if link.ExpiresAt.Valid && !link.ExpiresAt.Time.After(time.Now()) {
+			return ce.New(ce.ErrFailedPrecondition, fmt.Errorf("link %v expired at %v", linkId, link.ExpiresAt.Time), input).WithPublic("this invite link has expired")
+		}
+		if link.MaxUses.Valid && link.Uses >= link.MaxUses.Int32 {
+			return ce.New(ce.ErrFailedPrecondition, fmt.Errorf("link %v used %v of %v times", linkId, link.Uses, link.MaxUses.Int32), input).WithPublic("this invite link has reached its maximum uses")
+		}
+
+		groupId := ct.Id(link.GroupID)
+		if err := s.checkGroupWritable(ctx, q, groupId); err != nil {
+			return ce.Wrap(nil, err)
+		}
+		if err := s.checkNotBanned(ctx, q, groupId, req.UserId); err != nil {
+			return ce.Wrap(nil, err)
+		}
+
+		isMember, err := q.IsUserGroupMember(ctx, ds.IsUserGroupMemberParams{
+			GroupID: groupId.Int64(),
+			UserID:  req.UserId.Int64(),
+		})
+		if err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		if isMember {
+			return ce.New(ce.ErrAlreadyExists, fmt.Errorf("user %v is already a member of group %v", req.UserId, groupId), input).WithPublic("you are already a member of this group")
+		}
+
+		joinReq := models.GroupJoinRequest{
+			GroupId:     groupId,
+			RequesterId: req.UserId,
+			AcceptRules: req.AcceptRules,
+			Answers:     req.Answers,
+		}
+		if link.AutoApprove {
+			// auto approved joins go through the join request so membership history stays the same
+			err = q.SendGroupJoinRequest(ctx, ds.SendGroupJoinRequestParams{
+				GroupID: groupId.Int64(),
+				UserID:  req.UserId.Int64(),
+			})
+			if err != nil {
+				return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+			}
+			err = q.AcceptGroupJoinRequest(ctx, ds.AcceptGroupJoinRequestParams{
+				GroupID: groupId.Int64(),
+				UserID:  req.UserId.Int64(),
+			})
+			if err != nil {
+				return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+			}
+		} else {
+			answers, err := s.checkJoinAnswers(ctx, q, joinReq, input)
+			if err != nil {
+				return ce.Wrap(nil, err)
+			}
+			if err := s.saveJoinRequest(ctx, q, joinReq, answers, input); err != nil {
+				return ce.Wrap(nil, err)
+			}
+		}
+
+		if err := q.IncrementGroupInviteLinkUses(ctx, link.ID); err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		err = q.UpsertGroupInviteLinkUse(ctx, ds.UpsertGroupInviteLinkUseParams{
+			LinkID: link.ID,
+			UserID: req.UserId.Int64(),
+			Joined: link.AutoApprove,
+		})
+		if err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+
+		resp = models.JoinGroupByLinkResp{
+			GroupId: groupId,
+			Joined:  link.AutoApprove,
+		}
+		return nil
+	})
+	if err != nil {
+		return models.JoinGroupByLinkResp{}, ce.Wrap(nil, err)
+	}
+
+	if !resp.Joined {
+		s.sendJoinRequestNotification(ctx, resp.GroupId, req.UserId)
+	}
+	return resp, nil
+}
+
+// NOT GRPC
+// token format: <link id>.<nonce>.<signature>
+func (s *Application) inviteLinkToken(linkId int64, nonce string) string {
+	unsigned := strconv.FormatInt(linkId, 10) + "." + nonce
+	return unsigned + "." + s.signInviteLink(unsigned)
+}
+
+// NOT GRPC
+func (s *Application) signInviteLink(unsigned string) string {
+	h := hmac.New(sha256.New, s.inviteLinkSecret)
+	h.Write([]byte(unsigned))
+	return base64.RawURLEncoding.EncodeToString(h.Sum(nil))
+}
+
+// NOT GRPC
+// verifies the signature and returns the link id and nonce
+func (s *Application) parseInviteLinkToken(token string) (int64, string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return 0, "", errors.New("invalid invite token format")
+	}
+	unsigned := parts[0] + "." + parts[1]
+	if !hmac.Equal([]byte(parts[2]), []byte(s.signInviteLink(unsigned))) {
+		return 0, "", errors.New("invalid invite token signature")
+	}
+	linkId, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || linkId <= 0 {
+		return 0, "", fmt.Errorf("invalid invite link id %q", parts[0])
+	}
+	return linkId, parts[1], nil
+}
+
+func newInviteLinkNonce() (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
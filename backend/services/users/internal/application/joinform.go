@@ -0,0 +1,225 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	ds "social-network/services/users/internal/db/dbservice"
+	ce "social-network/shared/go/commonerrors"
+	ct "social-network/shared/go/ct"
+	"social-network/shared/go/models"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	maxGroupRules    = 20
+	maxRuleChars     = 500
+	maxJoinQuestions = 10
+	maxQuestionChars = 300
+	maxAnswerChars   = 1000
+)
+
+// Returns the rules and join questions of the group.
+// The form of a secret group is only shown to its members.
+func (s *Application) GetGroupJoinForm(ctx context.Context, req models.GeneralGroupReq) (models.GroupJoinForm, error) {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return models.GroupJoinForm{}, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	visibility, err := s.db.GetGroupVisibility(ctx, req.GroupId.Int64())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.GroupJoinForm{}, ce.New(ce.ErrNotFound, err, input).WithPublic("not found")
+		}
+		return models.GroupJoinForm{}, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if visibility == ds.GroupVisibilitySecret {
+		isMember, err := s.IsGroupMember(ctx, req)
+		if err != nil {
+			return models.GroupJoinForm{}, ce.Wrap(nil, err)
+		}
+		if !isMember {
+			return models.GroupJoinForm{}, ce.New(ce.ErrNotFound, fmt.Errorf("group %v is secret", req.GroupId), input).WithPublic("not found")
+		}
+	}
+
+	rules, err := s.db.ListGroupRules(ctx, req.GroupId.Int64())
+	if err != nil {
+		return models.GroupJoinForm{}, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	questions, err := s.db.ListGroupJoinQuestions(ctx, req.GroupId.Int64())
+	if err != nil {
+		return models.GroupJoinForm{}, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+
+	form := models.GroupJoinForm{
+		GroupId:   req.GroupId,
+		Rules:     make([]string, 0, len(rules)),
+		Questions: make([]models.GroupJoinQuestion, 0, len(questions)),
+	}
+	for _, r := range rules {
+		form.Rules = append(form.Rules, r.RuleText)
+	}
+	for _, q := range questions {
+		form.Questions = append(form.Questions, models.GroupJoinQuestion{
+			QuestionId: ct.Id(q.ID),
+			Question:   q.Question,
+			Required:   q.Required,
+		})
+	}
+	return form, nil
+}
+
+// Replaces the rules and join questions of the group.
+// Answers of pending requests keep the questions as they were asked.
+func (s *Application) SetGroupJoinForm(ctx context.Context, req models.SetGroupJoinFormReq) error {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+	if err := validateJoinForm(req); err != nil {
+		return ce.New(ce.ErrInvalidArgument, err, input).WithPublic(err.Error())
+	}
+
+	if _, err := s.checkGroupPermission(ctx, req.GroupId, req.RequesterId, ct.PermEditInfo); err != nil {
+		return ce.Wrap(nil, err)
+	}
+	if err := s.checkGroupWritable(ctx, s.db, req.GroupId); err != nil {
+		return ce.Wrap(nil, err)
+	}
+
+	return s.txRunner.RunTx(ctx, func(q *ds.Queries) error {
+		if err := q.DeleteGroupRules(ctx, req.GroupId.Int64()); err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		for i, rule := range req.Rules {
+			err := q.InsertGroupRule(ctx, ds.InsertGroupRuleParams{
+				GroupID:  req.GroupId.Int64(),
+				Position: int32(i),
+				RuleText: strings.TrimSpace(rule),
+			})
+			if err != nil {
+				return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+			}
+		}
+
+		if err := q.DeleteGroupJoinQuestions(ctx, req.GroupId.Int64()); err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		for i, question := range req.Questions {
+			err := q.InsertGroupJoinQuestion(ctx, ds.InsertGroupJoinQuestionParams{
+				GroupID:  req.GroupId.Int64(),
+				Position: int32(i),
+				Question: strings.TrimSpace(question.Question),
+				Required: question.Required,
+			})
+			if err != nil {
+				return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+			}
+		}
+		return nil
+	})
+}
+
+// NOT GRPC
+// checks the applicant accepted the rules and answered every required question of the join form,
+// returns the answers to store in question order
+func (s *Application) checkJoinAnswers(ctx context.Context, q ds.Querier, req models.GroupJoinRequest, input string) ([]ds.InsertGroupJoinAnswerParams, error) {
+	rules, err := q.ListGroupRules(ctx, req.GroupId.Int64())
+	if err != nil {
+		return nil, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if len(rules) > 0 && !req.AcceptRules {
+		return nil, ce.New(ce.ErrFailedPrecondition, fmt.Errorf("rules of group %v not accepted", req.GroupId), input).WithPublic("you must agree to the group rules")
+	}
+
+	questions, err := q.ListGroupJoinQuestions(ctx, req.GroupId.Int64())
+	if err != nil {
+		return nil, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+
+	given := make(map[int64]string, len(req.Answers))
+	for _, a := range req.Answers {
+		if _, ok := given[a.QuestionId.Int64()]; ok {
+			return nil, ce.New(ce.ErrInvalidArgument, fmt.Errorf("question %v answered twice", a.QuestionId), input).WithPublic("each question can only be answered once")
+		}
+		answer := strings.TrimSpace(a.Answer)
+		if utf8.RuneCountInString(answer) > maxAnswerChars {
+			return nil, ce.New(ce.ErrInvalidArgument, fmt.Errorf("answer to question %v too long", a.QuestionId), input).WithPublic(fmt.Sprintf("answers can't be longer than %d characters", maxAnswerChars))
+		}
+		given[a.QuestionId.Int64()] = answer
+	}
+
+	answers := make([]ds.InsertGroupJoinAnswerParams, 0, len(questions))
+	for _, question := range questions {
+		answer := given[question.ID]
+		delete(given, question.ID)
+		if answer == "" {
+			if question.Required {
+				return nil, ce.New(ce.ErrInvalidArgument, fmt.Errorf("required question %v not answered", question.ID), input).WithPublic("please answer all required questions")
+			}
+			continue
+		}
+		answers = append(answers, ds.InsertGroupJoinAnswerParams{
+			GroupID:    req.GroupId.Int64(),
+			UserID:     req.RequesterId.Int64(),
+			QuestionID: question.ID,
+			Position:   question.Position,
+			Question:   question.Question,
+			Answer:     answer,
+		})
+	}
+	if len(given) > 0 {
+		return nil, ce.New(ce.ErrInvalidArgument, fmt.Errorf("answers to unknown questions %v", given), input).WithPublic("the join questions have changed, please reload and try again")
+	}
+	return answers, nil
+}
+
+// NOT GRPC
+// groups the stored answers of the given applicants by user id
+func (s *Application) joinAnswersByUser(ctx context.Context, groupId ct.Id, userIds []int64) (map[int64][]models.GroupJoinAnswer, error) {
+	rows, err := s.db.GetGroupJoinAnswers(ctx, ds.GetGroupJoinAnswersParams{
+		GroupID: groupId.Int64(),
+		UserIds: userIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+	answers := make(map[int64][]models.GroupJoinAnswer, len(userIds))
+	for _, r := range rows {
+		answers[r.UserID] = append(answers[r.UserID], models.GroupJoinAnswer{
+			QuestionId: ct.Id(r.QuestionID),
+			Question:   r.Question,
+			Answer:     r.Answer,
+		})
+	}
+	return answers, nil
+}
+
+func validateJoinForm(req models.SetGroupJoinFormReq) error {
+	if len(req.Rules) > maxGroupRules {
+		return fmt.Errorf("a group can have at most %d rules", maxGroupRules)
+	}
+	for _, rule := range req.Rules {
+		n := utf8.RuneCountInString(strings.TrimSpace(rule))
+		if n == 0 || n > maxRuleChars {
+			return fmt.Errorf("rules must be between 1 and %d characters", maxRuleChars)
+		}
+	}
+	if len(req.Questions) > maxJoinQuestions {
+		return fmt.Errorf("a group can have at most %d join questions", maxJoinQuestions)
+	}
+	for _, q := range req.Questions {
+		n := utf8.RuneCountInString(strings.TrimSpace(q.Question))
+		if n == 0 || n > maxQuestionChars {
+			return fmt.Errorf("questions must be between 1 and %d characters", maxQuestionChars)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	ds "social-network/services/users/internal/db/dbservice"
+	ce "social-network/shared/go/commonerrors"
+	"social-network/shared/go/models"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+// joinGateQuerier serves the queries behind the join checks from memory,
+// any other query panics on the nil embedded interface
+type joinGateQuerier struct {
+	ds.Querier
+	rules     []ds.GroupRule
+	questions []ds.GroupJoinQuestion
+	archived  bool
+	missing   bool
+	banned    bool
+}
+
+func (q *joinGateQuerier) ListGroupRules(ctx context.Context, groupID int64) ([]ds.GroupRule, error) {
+	return q.rules, nil
+}
+
+func (q *joinGateQuerier) ListGroupJoinQuestions(ctx context.Context, groupID int64) ([]ds.GroupJoinQuestion, error) {
+	return q.questions, nil
+}
+
+func (q *joinGateQuerier) IsGroupArchived(ctx context.Context, id int64) (bool, error) {
+	if q.missing {
+		return false, pgx.ErrNoRows
+	}
+	return q.archived, nil
+}
+
+func (q *joinGateQuerier) IsUserBannedFromGroup(ctx context.Context, arg ds.IsUserBannedFromGroupParams) (bool, error) {
+	return q.banned, nil
+}
+
+func TestCheckJoinAnswers(t *testing.T) {
+	rules := []ds.GroupRule{{ID: 1, GroupID: 10, RuleText: "be nice"}}
+	questions := []ds.GroupJoinQuestion{
+		{ID: 1, GroupID: 10, Position: 1, Question: "why?", Required: true},
+		{ID: 2, GroupID: 10, Position: 2, Question: "anything else?"},
+	}
+
+	tests := []struct {
+		name      string
+		rules     []ds.GroupRule
+		questions []ds.GroupJoinQuestion
+		req       models.GroupJoinRequest
+		wantErr   error
+		wantIds   []int64
+	}{
+		{
+			name: "no form",
+			req:  models.GroupJoinRequest{GroupId: 10, RequesterId: 5},
+		},
+		{
+			name:    "rules not accepted",
+			rules:   rules,
+			req:     models.GroupJoinRequest{GroupId: 10, RequesterId: 5},
+			wantErr: ce.ErrFailedPrecondition,
+		},
+		{
+			name:  "rules accepted",
+			rules: rules,
+			req:   models.GroupJoinRequest{GroupId: 10, RequesterId: 5, AcceptRules: true},
+		},
+		{
+			name:      "required question missing",
+			questions: questions,
+			req: models.GroupJoinRequest{GroupId: 10, RequesterId: 5, Answers: []models.GroupJoinAnswer{
+				{QuestionId: 2, Answer: "no"},
+			}},
+			wantErr: ce.ErrInvalidArgument,
+		},
+		{
+			name:      "blank answer to required question",
+			questions: questions,
+			req: models.GroupJoinRequest{GroupId: 10, RequesterId: 5, Answers: []models.GroupJoinAnswer{
+				{QuestionId: 1, Answer: "   "},
+			}},
+			wantErr: ce.ErrInvalidArgument,
+		},
+		{
+			name:      "question answered twice",
+			questions: questions,
+			req: models.GroupJoinRequest{GroupId: 10, RequesterId: 5, Answers: []models.GroupJoinAnswer{
+				{QuestionId: 1, Answer: "a"},
+				{QuestionId: 1, Answer: "b"},
+			}},
+			wantErr: ce.ErrInvalidArgument,
+		},
+		{
+			name:      "unknown question",
+			questions: questions,
+			req: models.GroupJoinRequest{GroupId: 10, RequesterId: 5, Answers: []models.GroupJoinAnswer{
+				{QuestionId: 1, Answer: "a"},
+				{QuestionId: 3, Answer: "b"},
+			}},
+			wantErr: ce.ErrInvalidArgument,
+		},
+		{
+			name:      "answer too long",
+			questions: questions,
+			req: models.GroupJoinRequest{GroupId: 10, RequesterId: 5, Answers: []models.GroupJoinAnswer{
+				{QuestionId: 1, Answer: strings.Repeat("a", maxAnswerChars+1)},
+			}},
+			wantErr: ce.ErrInvalidArgument,
+		},
+		{
+			name:      "answers returned in question order without blanks",
+			questions: questions,
+			req: models.GroupJoinRequest{GroupId: 10, RequesterId: 5, Answers: []models.GroupJoinAnswer{
+				{QuestionId: 2, Answer: " "},
+				{QuestionId: 1, Answer: " because "},
+			}},
+			wantIds: []int64{1},
+		},
+		{
+			name:      "all answered",
+			questions: questions,
+			req: models.GroupJoinRequest{GroupId: 10, RequesterId: 5, Answers: []models.GroupJoinAnswer{
+				{QuestionId: 2, Answer: "no"},
+				{QuestionId: 1, Answer: "because"},
+			}},
+			wantIds: []int64{1, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Application{}
+			q := &joinGateQuerier{rules: tt.rules, questions: tt.questions}
+
+			answers, err := s.checkJoinAnswers(context.Background(), q, tt.req, "")
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected %v, got %v", tt.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			ids := []int64{}
+			for _, a := range answers {
+				ids = append(ids, a.QuestionID)
+				assert.Equal(t, tt.req.RequesterId.Int64(), a.UserID)
+				assert.Equal(t, strings.TrimSpace(a.Answer), a.Answer)
+			}
+			if tt.wantIds == nil {
+				tt.wantIds = []int64{}
+			}
+			assert.Equal(t, tt.wantIds, ids)
+		})
+	}
+}
+
+func TestJoinGateChecks(t *testing.T) {
+	tests := []struct {
+		name         string
+		q            joinGateQuerier
+		writableErr  error
+		notBannedErr error
+	}{
+		{
+			name: "open group",
+		},
+		{
+			name:        "archived group",
+			q:           joinGateQuerier{archived: true},
+			writableErr: ce.ErrFailedPrecondition,
+		},
+		{
+			name:        "missing group",
+			q:           joinGateQuerier{missing: true},
+			writableErr: ce.ErrNotFound,
+		},
+		{
+			name:         "banned user",
+			q:            joinGateQuerier{banned: true},
+			notBannedErr: ce.ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Application{}
+			ctx := context.Background()
+
+			err := s.checkGroupWritable(ctx, &tt.q, 10)
+			if tt.writableErr != nil {
+				assert.True(t, errors.Is(err, tt.writableErr), "expected %v, got %v", tt.writableErr, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			err = s.checkNotBanned(ctx, &tt.q, 10, 5)
+			if tt.notBannedErr != nil {
+				assert.True(t, errors.Is(err, tt.notBannedErr), "expected %v, got %v", tt.notBannedErr, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
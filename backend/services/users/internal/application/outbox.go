@@ -0,0 +1,127 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	ds "social-network/services/users/internal/db/dbservice"
+	notifpb "social-network/shared/gen-go/notifications"
+	ct "social-network/shared/go/ct"
+	tele "social-network/shared/go/telemetry"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultOutboxInterval = 10 * time.Second // used when the configured interval isn't positive
+	outboxBatchSize       = 100              // events relayed per transaction
+)
+
+// StartOutboxWorker starts a background worker that relays queued events to kafka,
+// every interval and whenever a transaction that queued events commits.
+func (s *Application) StartOutboxWorker(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		tele.Warn(ctx, "Invalid outbox interval @1, using @2", "interval", interval.String(), "default", defaultOutboxInterval.String())
+		interval = defaultOutboxInterval
+	}
+	tele.Info(ctx, "Initiating outbox worker. @1", "interval", interval.String())
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+			case <-s.outboxKick:
+			case <-ctx.Done():
+				tele.Info(ctx, "Outbox worker stopped")
+				return
+			}
+			if err := s.relayOutbox(ctx); err != nil {
+				tele.Error(ctx, "Error relaying outbox events. @1", "error", err.Error())
+			}
+		}
+	}()
+}
+
+// NOT GRPC
+// queues the event in the caller's transaction, see StartOutboxWorker.
+// Call kickOutbox once the transaction commits.
+func enqueueEvent(ctx context.Context, q ds.Querier, event *notifpb.NotificationEvent) error {
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %v event: %w", event.EventType, err)
+	}
+	return q.InsertOutboxEvent(ctx, payload)
+}
+
+// NOT GRPC
+// wakes the outbox worker up, never blocks
+func (s *Application) kickOutbox() {
+	select {
+	case s.outboxKick <- struct{}{}:
+	default:
+	}
+}
+
+// NOT GRPC
+// sends queued events in order and deletes them once sent.
+// Stops at the first failed send so that later events never overtake it.
+func (s *Application) relayOutbox(ctx context.Context) error {
+	if err := s.queueCascadeDeletedGroups(ctx); err != nil {
+		return err
+	}
+	for {
+		var relayed int
+		var sendErr error
+		err := s.txRunner.RunTx(ctx, func(q *ds.Queries) error {
+			relayed = 0
+			events, err := q.GetOutboxEvents(ctx, outboxBatchSize)
+			if err != nil {
+				return err
+			}
+			for _, e := range events {
+				var event notifpb.NotificationEvent
+				if err := proto.Unmarshal(e.Payload, &event); err != nil {
+					// can never be sent, keeping it would block the outbox
+					tele.Error(ctx, "dropping unreadable outbox event @1: @2", "id", e.ID, "error", err.Error())
+				} else if err := s.eventProducer.CreateAndSendNotificationEvent(ctx, &event); err != nil {
+					sendErr = err
+					break
+				}
+				if err := q.DeleteOutboxEvent(ctx, e.ID); err != nil {
+					return err
+				}
+				relayed++
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if sendErr != nil {
+			return fmt.Errorf("relayed %d events before failing: %w", relayed, sendErr)
+		}
+		if relayed < outboxBatchSize {
+			return nil
+		}
+	}
+}
+
+// NOT GRPC
+// queues GroupDeleted for the groups that the database deleted along with their owner,
+// see soft_delete_user_cascade
+func (s *Application) queueCascadeDeletedGroups(ctx context.Context) error {
+	return s.txRunner.RunTx(ctx, func(q *ds.Queries) error {
+		groupIds, err := q.DrainCascadeDeletedGroups(ctx)
+		if err != nil {
+			return err
+		}
+		for _, groupId := range groupIds {
+			if err := enqueueEvent(ctx, q, groupDeletedEvent(ct.Id(groupId))); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
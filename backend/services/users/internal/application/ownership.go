@@ -0,0 +1,133 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	ds "social-network/services/users/internal/db/dbservice"
+	ce "social-network/shared/go/commonerrors"
+	ct "social-network/shared/go/ct"
+	"social-network/shared/go/models"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Offers ownership of a group to one of its members.
+// Ownership changes only once the member accepts.
+// A new offer replaces any pending one.
+func (s *Application) TransferGroupOwnership(ctx context.Context, req models.TransferOwnershipReq) error {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+	if req.OwnerId == req.NewOwnerId {
+		return ce.New(ce.ErrInvalidArgument, fmt.Errorf("user %v offered ownership to themself", req.OwnerId), input).WithPublic("you already own this group")
+	}
+
+	isOwner, err := s.isGroupOwner(ctx, models.GeneralGroupReq{
+		GroupId: req.GroupId,
+		UserId:  req.OwnerId,
+	})
+	if err != nil {
+		return ce.Wrap(nil, err)
+	}
+	if !isOwner {
+		return ce.New(ce.ErrPermissionDenied, fmt.Errorf("user %v is not the owner of group %v", req.OwnerId, req.GroupId), input).WithPublic("permission denied")
+	}
+
+	isMember, err := s.IsGroupMember(ctx, models.GeneralGroupReq{
+		GroupId: req.GroupId,
+		UserId:  req.NewOwnerId,
+	})
+	if err != nil {
+		return ce.Wrap(nil, err)
+	}
+	if !isMember {
+		return ce.New(ce.ErrFailedPrecondition, fmt.Errorf("user %v is not a member of group %v", req.NewOwnerId, req.GroupId), input).WithPublic("new owner must be a member of the group")
+	}
+
+	err = s.db.UpsertOwnershipTransfer(ctx, ds.UpsertOwnershipTransferParams{
+		GroupID:    req.GroupId.Int64(),
+		FromUserID: req.OwnerId.Int64(),
+		ToUserID:   req.NewOwnerId.Int64(),
+	})
+	if err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	return nil
+}
+
+// Accepts or declines a pending ownership offer addressed to the user.
+// On acceptance the user becomes owner and the previous owner becomes admin, free to leave the group.
+func (s *Application) RespondToOwnershipTransfer(ctx context.Context, req models.HandleOwnershipTransferReq) error {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	return s.txRunner.RunTx(ctx, func(q *ds.Queries) error {
+		transfer, err := q.GetOwnershipTransfer(ctx, req.GroupId.Int64())
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ce.New(ce.ErrNotFound, err, input).WithPublic("no pending ownership transfer")
+			}
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		if transfer.ToUserID != req.UserId.Int64() {
+			return ce.New(ce.ErrNotFound, fmt.Errorf("ownership of group %v was offered to user %v", req.GroupId, transfer.ToUserID), input).WithPublic("no pending ownership transfer")
+		}
+
+		if !req.Accepted {
+			if _, err := q.DeleteOwnershipTransfer(ctx, req.GroupId.Int64()); err != nil {
+				return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+			}
+			return nil
+		}
+
+		// the offer is void if the offering user no longer owns the group
+		// or the recipient is no longer a member
+		stillOwner, err := q.IsUserGroupOwner(ctx, ds.IsUserGroupOwnerParams{
+			ID:         req.GroupId.Int64(),
+			GroupOwner: transfer.FromUserID,
+		})
+		if err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		stillMember, err := q.IsUserGroupMember(ctx, ds.IsUserGroupMemberParams{
+			GroupID: req.GroupId.Int64(),
+			UserID:  req.UserId.Int64(),
+		})
+		if err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		if !stillOwner || !stillMember {
+			return ce.New(ce.ErrFailedPrecondition, fmt.Errorf("stale ownership transfer %#v", transfer), input).WithPublic("this ownership offer is no longer valid")
+		}
+
+		err = q.TransferOwnership(ctx, ds.TransferOwnershipParams{
+			GroupID:    req.GroupId.Int64(),
+			NewOwnerID: req.UserId.Int64(),
+		})
+		if err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		return nil
+	})
+}
+
+// NOT GRPC
+// returns whether user has a pending offer to become the group's owner
+func (s *Application) isOwnershipOffered(ctx context.Context, groupId, userId ct.Id) (bool, error) {
+	input := fmt.Sprintf("group id: %v, user id: %v", groupId, userId)
+
+	transfer, err := s.db.GetOwnershipTransfer(ctx, groupId.Int64())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	return transfer.ToUserID == userId.Int64(), nil
+}
@@ -0,0 +1,108 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	ds "social-network/services/users/internal/db/dbservice"
+	ce "social-network/shared/go/commonerrors"
+	ct "social-network/shared/go/ct"
+	"social-network/shared/go/models"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *Application) GetPrivacySettings(ctx context.Context, userId ct.Id) (models.PrivacySettings, error) {
+	input := fmt.Sprintf("%#v", userId)
+
+	if err := userId.Validate(); err != nil {
+		return models.PrivacySettings{}, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	row, err := s.db.GetPrivacySettings(ctx, userId.Int64())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.PrivacySettings{}, ce.New(ce.ErrNotFound, err, input).WithPublic("user not found")
+		}
+		return models.PrivacySettings{}, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+
+	return models.PrivacySettings{
+		UserId:               ct.Id(row.UserID),
+		WhoCanMessage:        ct.PrivacyAudience(row.WhoCanMessage),
+		WhoCanComment:        ct.PrivacyAudience(row.WhoCanComment),
+		WhoCanSeeFollowLists: ct.PrivacyAudience(row.WhoCanSeeFollowLists),
+		WhoCanInviteToGroups: ct.PrivacyAudience(row.WhoCanInviteToGroups),
+		Discoverable:         row.Discoverable,
+	}, nil
+}
+
+func (s *Application) UpdatePrivacySettings(ctx context.Context, req models.PrivacySettings) error {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	err := s.db.UpsertPrivacySettings(ctx, ds.UpsertPrivacySettingsParams{
+		UserID:               req.UserId.Int64(),
+		WhoCanMessage:        ds.PrivacyAudience(req.WhoCanMessage),
+		WhoCanComment:        ds.PrivacyAudience(req.WhoCanComment),
+		WhoCanSeeFollowLists: ds.PrivacyAudience(req.WhoCanSeeFollowLists),
+		WhoCanInviteToGroups: ds.PrivacyAudience(req.WhoCanInviteToGroups),
+		Discoverable:         req.Discoverable,
+	})
+	if err != nil {
+		return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	return nil
+}
+
+// Checks target's privacy settings for the given action.
+// A user can always interact with themself.
+func (s *Application) CanInteract(ctx context.Context, req models.CanInteractReq) (bool, error) {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return false, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	row, err := s.db.GetAllowedInteractions(ctx, ds.GetAllowedInteractionsParams{
+		ActorID:  req.ActorId.Int64(),
+		TargetID: req.TargetId.Int64(),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, ce.New(ce.ErrNotFound, err, input).WithPublic("user not found")
+		}
+		return false, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+
+	switch req.Action {
+	case ct.ActionMessage:
+		return row.CanMessage, nil
+	case ct.ActionComment:
+		return row.CanComment, nil
+	case ct.ActionViewFollowLists:
+		return row.CanSeeFollowLists, nil
+	case ct.ActionInviteToGroup:
+		return row.CanInviteToGroups, nil
+	}
+	return false, ce.New(ce.ErrInvalidArgument, fmt.Errorf("unknown privacy action %v", req.Action), input).WithPublic("invalid data received")
+}
+
+// returns permission denied if target hides their follow lists from requester
+func (s *Application) checkFollowListsVisible(ctx context.Context, requesterId, targetId ct.Id) error {
+	canSee, err := s.CanInteract(ctx, models.CanInteractReq{
+		ActorId:  requesterId,
+		TargetId: targetId,
+		Action:   ct.ActionViewFollowLists,
+	})
+	if err != nil {
+		return ce.Wrap(nil, err)
+	}
+	if !canSee {
+		return ce.New(ce.ErrPermissionDenied, fmt.Errorf("user %v hides follow lists from user %v", targetId, requesterId), fmt.Sprintf("%v %v", requesterId, targetId)).WithPublic("this user's follow lists are private")
+	}
+	return nil
+}
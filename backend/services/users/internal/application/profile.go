@@ -33,9 +33,10 @@ func (s *Application) GetBasicUserInfo(ctx context.Context, userId ct.Id) (resp
 		return models.User{}, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
 	}
 	u := models.User{
-		UserId:   ct.Id(userId),
-		Username: ct.Username(row.Username),
-		AvatarId: ct.Id(row.AvatarID),
+		UserId:      ct.Id(userId),
+		Username:    ct.Username(row.Username),
+		AvatarId:    ct.Id(row.AvatarID),
+		Deactivated: row.CurrentStatus == ds.UserStatusDeactivated,
 	}
 	return u, nil
 
@@ -60,9 +61,10 @@ func (s *Application) GetBatchBasicUserInfo(ctx context.Context, userIds ct.Ids)
 	users := make([]models.User, 0, len(rows))
 	for _, r := range rows {
 		users = append(users, models.User{
-			UserId:   ct.Id(r.ID),
-			Username: ct.Username(r.Username),
-			AvatarId: ct.Id(r.AvatarID),
+			UserId:      ct.Id(r.ID),
+			Username:    ct.Username(r.Username),
+			AvatarId:    ct.Id(r.AvatarID),
+			Deactivated: r.CurrentStatus == ds.UserStatusDeactivated,
 		})
 	}
 	return users, nil
@@ -84,6 +86,11 @@ func (s *Application) GetUserProfile(ctx context.Context, req models.UserProfile
 		}
 		return models.UserProfileResponse{}, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
 	}
+	// deactivated profiles are only visible to their owner
+	if row.CurrentStatus == ds.UserStatusDeactivated && req.RequesterId != req.UserId {
+		return models.UserProfileResponse{}, ce.New(ce.ErrNotFound, fmt.Errorf("user %v is deactivated", req.UserId), input).
+			WithPublic("profile not found")
+	}
 	dob := time.Time{}
 	if row.DateOfBirth.Valid {
 		dob = row.DateOfBirth.Time
@@ -236,17 +243,35 @@ func (s *Application) UpdateUserProfile(ctx context.Context, req models.UpdatePr
 		avatarId = 0
 	}
 
-	row, err := s.db.UpdateUserProfile(ctx, ds.UpdateUserProfileParams{
-		ID:          req.UserId.Int64(),
-		Username:    req.Username.String(),
-		FirstName:   req.FirstName.String(),
-		LastName:    req.LastName.String(),
-		DateOfBirth: dob,
-		AvatarID:    avatarId,
-		AboutMe:     req.About.String(),
+	var row ds.User
+	err := s.txRunner.RunTx(ctx, func(q *ds.Queries) error {
+		// username changes are subject to cooldown and reservation rules
+		if req.Username != "" {
+			_, err := s.changeUsername(ctx, q, models.ChangeUsernameRequest{
+				UserId:   req.UserId,
+				Username: req.Username,
+			}, input)
+			if err != nil {
+				return ce.Wrap(nil, err)
+			}
+		}
+
+		var err error
+		row, err = q.UpdateUserProfile(ctx, ds.UpdateUserProfileParams{
+			ID:          req.UserId.Int64(),
+			FirstName:   req.FirstName.String(),
+			LastName:    req.LastName.String(),
+			DateOfBirth: dob,
+			AvatarID:    avatarId,
+			AboutMe:     req.About.String(),
+		})
+		if err != nil {
+			return ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		}
+		return nil
 	})
 	if err != nil {
-		return models.UserProfileResponse{}, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+		return models.UserProfileResponse{}, ce.Wrap(nil, err)
 	}
 
 	//delete redis basic user info if exists
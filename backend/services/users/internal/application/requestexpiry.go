@@ -0,0 +1,121 @@
+package application
+
+import (
+	"context"
+	ds "social-network/services/users/internal/db/dbservice"
+	notifpb "social-network/shared/gen-go/notifications"
+	ct "social-network/shared/go/ct"
+	tele "social-network/shared/go/telemetry"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// used when the configured interval isn't positive
+const defaultGroupRequestExpiryInterval = time.Hour
+
+// StartGroupRequestExpiryWorker starts a background worker that periodically expires
+// group invites and join requests left pending for longer than the configured TTL.
+// Does nothing if expiry is disabled, a non positive interval falls back to an hour.
+func (s *Application) StartGroupRequestExpiryWorker(ctx context.Context, interval time.Duration) {
+	if s.groupRequestTTL <= 0 {
+		tele.Info(ctx, "Group request expiry disabled, not starting worker")
+		return
+	}
+	if interval <= 0 {
+		tele.Warn(ctx, "Invalid group request expiry interval @1, using @2", "interval", interval.String(), "default", defaultGroupRequestExpiryInterval.String())
+		interval = defaultGroupRequestExpiryInterval
+	}
+
+	tele.Info(ctx, "Initiating group request expiry worker. @1 @2", "interval", interval.String(), "ttl", s.groupRequestTTL.String())
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := s.expireGroupRequests(ctx); err != nil {
+					tele.Error(ctx, "Error expiring group requests. @1", "error", err.Error())
+				}
+			case <-ctx.Done():
+				tele.Info(ctx, "Group request expiry worker stopped")
+				return
+			}
+		}
+	}()
+}
+
+// NOT GRPC
+// expires stale invites and join requests and lets notifications mark the related notifications as acted
+func (s *Application) expireGroupRequests(ctx context.Context) error {
+	cutoff := pgtype.Timestamptz{Time: time.Now().Add(-s.groupRequestTTL), Valid: true}
+
+	invites, err := s.db.ExpireGroupInvites(ctx, cutoff)
+	if err != nil {
+		return err
+	}
+	for _, inv := range invites {
+		event := &notifpb.NotificationEvent{
+			EventType: notifpb.EventType_GROUP_INVITE_EXPIRED,
+			Payload: &notifpb.NotificationEvent_GroupInviteExpired{
+				GroupInviteExpired: &notifpb.GroupInviteExpired{
+					InvitedUserId: inv.ReceiverID,
+					GroupId:       inv.GroupID,
+				},
+			},
+		}
+		if err := s.eventProducer.CreateAndSendNotificationEvent(ctx, event); err != nil {
+			tele.Error(ctx, "failed to send group invite expired notification: @1", "error", err.Error())
+		}
+	}
+
+	requests, err := s.db.ExpireGroupJoinRequests(ctx, cutoff)
+	if err != nil {
+		return err
+	}
+	approvers := make(map[int64][]int64) // by group id
+	for _, r := range requests {
+		groupApprovers, ok := approvers[r.GroupID]
+		if !ok {
+			groupApprovers, err = s.db.GetGroupMemberIdsWithRoles(ctx, ds.GetGroupMemberIdsWithRolesParams{
+				GroupID: r.GroupID,
+				Roles:   rolesWith(ct.PermApproveJoins),
+			})
+			if err != nil {
+				tele.Error(ctx, "failed to get join approvers of group @1: @2", "groupId", r.GroupID, "error", err.Error())
+				groupApprovers = []int64{r.GroupOwner}
+			}
+			approvers[r.GroupID] = groupApprovers
+		}
+
+		event := &notifpb.NotificationEvent{
+			EventType: notifpb.EventType_GROUP_JOIN_REQUEST_EXPIRED,
+			Payload: &notifpb.NotificationEvent_GroupJoinRequestExpired{
+				GroupJoinRequestExpired: &notifpb.GroupJoinRequestExpired{
+					GroupOwnerId:    r.GroupOwner,
+					RequesterUserId: r.UserID,
+					GroupId:         r.GroupID,
+					ApproverIds:     groupApprovers,
+				},
+			},
+		}
+		if err := s.eventProducer.CreateAndSendNotificationEvent(ctx, event); err != nil {
+			tele.Error(ctx, "failed to send group join request expired notification: @1", "error", err.Error())
+		}
+	}
+
+	if len(invites) > 0 || len(requests) > 0 {
+		tele.Info(ctx, "Expired stale group requests. @1 @2", "invites", len(invites), "joinRequests", len(requests))
+	}
+	return nil
+}
+
+// NOT GRPC
+// when a request pending since the given time expires, zero if expiry is disabled
+func (s *Application) groupRequestExpiry(pendingSince time.Time) ct.GenDateTime {
+	if s.groupRequestTTL <= 0 || pendingSince.IsZero() {
+		return ct.GenDateTime{}
+	}
+	return ct.GenDateTime(pendingSince.Add(s.groupRequestTTL))
+}
@@ -0,0 +1,135 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	ds "social-network/services/users/internal/db/dbservice"
+	ce "social-network/shared/go/commonerrors"
+	ct "social-network/shared/go/ct"
+	"social-network/shared/go/models"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// minimum time between two username changes
+	usernameChangeCooldown = 30 * 24 * time.Hour
+	// how long a former username stays reserved for its previous owner
+	usernameReservation = 90 * 24 * time.Hour
+)
+
+// Changes the username of a user, keeping the old one reserved for them.
+// Setting the current username again is a no-op.
+func (s *Application) ChangeUsername(ctx context.Context, req models.ChangeUsernameRequest) error {
+	input := fmt.Sprintf("%#v", req)
+
+	if err := ct.ValidateStruct(req); err != nil {
+		return ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	changed := false
+	err := s.txRunner.RunTx(ctx, func(q *ds.Queries) error {
+		var err error
+		changed, err = s.changeUsername(ctx, q, req, input)
+		return err
+	})
+	if err != nil {
+		return ce.Wrap(nil, err)
+	}
+
+	if changed {
+		s.invalidateBasicUserInfo(ctx, req.UserId)
+	}
+	return nil
+}
+
+// NOT GRPC
+// applies the cooldown and reservation rules of ChangeUsername within the caller's transaction,
+// returns false if the username was already the current one
+func (s *Application) changeUsername(ctx context.Context, q ds.Querier, req models.ChangeUsernameRequest, input string) (bool, error) {
+	current, err := q.GetUsernameChangeInfo(ctx, req.UserId.Int64())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, ce.New(ce.ErrNotFound, err, input).WithPublic("user not found")
+		}
+		return false, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+
+	if current.Username == req.Username.String() {
+		return false, nil
+	}
+
+	if current.UsernameChangedAt.Valid {
+		nextChange := current.UsernameChangedAt.Time.Add(usernameChangeCooldown)
+		if time.Now().Before(nextChange) {
+			return false, ce.New(ce.ErrFailedPrecondition, fmt.Errorf("username changed at %v", current.UsernameChangedAt.Time), input).
+				WithPublic(fmt.Sprintf("username can be changed again after %s", nextChange.Format(time.DateOnly)))
+		}
+	}
+
+	taken, err := q.IsUsernameTaken(ctx, ds.IsUsernameTakenParams{
+		Username: req.Username.String(),
+		UserID:   req.UserId.Int64(),
+	})
+	if err != nil {
+		return false, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	reserved, err := q.IsUsernameReserved(ctx, ds.IsUsernameReservedParams{
+		Username: req.Username.String(),
+		UserID:   req.UserId.Int64(),
+	})
+	if err != nil {
+		return false, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if taken || reserved {
+		return false, ce.New(ce.ErrAlreadyExists, fmt.Errorf("username %v is taken or reserved", req.Username), input).WithPublic("username is not available")
+	}
+
+	err = q.InsertUsernameHistory(ctx, ds.InsertUsernameHistoryParams{
+		UserID:        req.UserId.Int64(),
+		OldUsername:   current.Username,
+		ReservedUntil: pgtype.Timestamptz{Time: time.Now().Add(usernameReservation), Valid: true},
+	})
+	if err != nil {
+		return false, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+
+	rows, err := q.UpdateUsername(ctx, ds.UpdateUsernameParams{
+		ID:       req.UserId.Int64(),
+		Username: req.Username.String(),
+	})
+	if err != nil {
+		return false, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+	if rows == 0 {
+		return false, ce.New(ce.ErrNotFound, fmt.Errorf("user %v not found", req.UserId), input).WithPublic("user not found")
+	}
+	return true, nil
+}
+
+// Maps a current or reserved former handle to a user id.
+// Redirected is true when the handle used to belong to the user.
+func (s *Application) ResolveHandle(ctx context.Context, handle ct.Username) (models.ResolvedHandle, error) {
+	input := fmt.Sprintf("%#v", handle)
+
+	if err := handle.Validate(); err != nil {
+		return models.ResolvedHandle{}, ce.Wrap(ce.ErrInvalidArgument, err, "request validation failed", input).WithPublic("invalid data received")
+	}
+
+	row, err := s.db.ResolveHandle(ctx, handle.String())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.ResolvedHandle{}, ce.New(ce.ErrNotFound, err, input).WithPublic("user not found")
+		}
+		return models.ResolvedHandle{}, ce.New(ce.ErrInternal, err, input).WithPublic(genericPublic)
+	}
+
+	return models.ResolvedHandle{
+		UserId:     ct.Id(row.ID),
+		Username:   ct.Username(row.Username),
+		Redirected: !row.IsCurrent,
+	}, nil
+}
@@ -6,9 +6,13 @@ import (
 	"social-network/shared/gen-go/media"
 	mediapb "social-network/shared/gen-go/media"
 	"social-network/shared/gen-go/notifications"
+	postspb "social-network/shared/gen-go/posts"
+	ct "social-network/shared/go/ct"
 	"social-network/shared/go/models"
 	rds "social-network/shared/go/redis"
 	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Holds connections to clients
@@ -16,14 +20,16 @@ type Clients struct {
 	ChatClient   chatpb.ChatServiceClient
 	NotifsClient notifications.NotificationServiceClient
 	MediaClient  mediapb.MediaServiceClient
+	PostsClient  postspb.PostsServiceClient
 	RedisClient  *rds.RedisClient
 }
 
-func NewClients(chatClient chatpb.ChatServiceClient, notifClient notifications.NotificationServiceClient, mediaClient mediapb.MediaServiceClient, redisClient *rds.RedisClient) *Clients {
+func NewClients(chatClient chatpb.ChatServiceClient, notifClient notifications.NotificationServiceClient, mediaClient mediapb.MediaServiceClient, postsClient postspb.PostsServiceClient, redisClient *rds.RedisClient) *Clients {
 	c := &Clients{
 		ChatClient:   chatClient,
 		NotifsClient: notifClient,
 		MediaClient:  mediaClient,
+		PostsClient:  postsClient,
 		RedisClient:  redisClient,
 	}
 	return c
@@ -53,6 +59,62 @@ func (c *Clients) Del(ctx context.Context, key string) error {
 	return c.RedisClient.Del(ctx, key)
 }
 
+// Number of posts created in each group since the given time, from posts service
+func (c *Clients) GetGroupsPostActivity(ctx context.Context, groupIds []int64, since time.Time) (map[int64]int64, error) {
+	resp, err := c.PostsClient.GetGroupsPostActivity(ctx, &postspb.GroupsActivityReq{
+		GroupIds: groupIds,
+		Since:    timestamppb.New(since),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.PostCounts, nil
+}
+
+// Posts, comments, active posters and events of a group since the given time, from posts service.
+// Posters only carry their user id.
+func (c *Clients) GetGroupContentInsights(ctx context.Context, groupId int64, since time.Time) (models.GroupContentInsights, error) {
+	resp, err := c.PostsClient.GetGroupContentInsights(ctx, &postspb.GroupInsightsReq{
+		GroupId: groupId,
+		Since:   timestamppb.New(since),
+	})
+	if err != nil {
+		return models.GroupContentInsights{}, err
+	}
+
+	insights := models.GroupContentInsights{
+		PostsPerDay:    dailyCountsFromPb(resp.PostsPerDay),
+		CommentsPerDay: dailyCountsFromPb(resp.CommentsPerDay),
+		ActivePosters:  make([]models.GroupActivePoster, 0, len(resp.ActivePosters)),
+		Events:         make([]models.GroupEventAttendance, 0, len(resp.Events)),
+	}
+	for _, p := range resp.ActivePosters {
+		insights.ActivePosters = append(insights.ActivePosters, models.GroupActivePoster{
+			User:         models.User{UserId: ct.Id(p.UserId)},
+			PostCount:    p.PostCount,
+			CommentCount: p.CommentCount,
+		})
+	}
+	for _, e := range resp.Events {
+		insights.Events = append(insights.Events, models.GroupEventAttendance{
+			EventId:       ct.Id(e.EventId),
+			Title:         ct.Title(e.Title),
+			EventDate:     ct.GenDateTime(e.EventDate.AsTime()),
+			GoingCount:    e.GoingCount,
+			NotGoingCount: e.NotGoingCount,
+		})
+	}
+	return insights, nil
+}
+
+func dailyCountsFromPb(counts []*postspb.DailyCount) []models.DailyCount {
+	out := make([]models.DailyCount, 0, len(counts))
+	for _, c := range counts {
+		out = append(out, models.DailyCount{Day: ct.GenDateTime(c.Day.AsTime()), Count: c.Count})
+	}
+	return out
+}
+
 func (c *Clients) CreateNotification(ctx context.Context, req models.CreateNotificationRequest) error {
 	grpcRec := &notifications.CreateNotificationRequest{
 		UserId:         req.UserId.Int64(),
@@ -225,4 +287,3 @@ func (c *Clients) CreateGroupJoinRequestRejected(ctx context.Context, requesterI
 // }
 
 //remove members from group conversation?
-//delete group conversation on group delete?
@@ -24,18 +24,37 @@ func (q *Queries) BanUser(ctx context.Context, arg BanUserParams) error {
 	return err
 }
 
+const deactivateUser = `-- name: DeactivateUser :execrows
+UPDATE users
+SET
+    current_status = 'deactivated',
+    deactivated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+  AND current_status = 'active'
+  AND deleted_at IS NULL
+`
+
+func (q *Queries) DeactivateUser(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, deactivateUser, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getUserForLogin = `-- name: GetUserForLogin :one
 SELECT
     u.id,
     u.username,
     u.avatar_id,
     u.profile_public,
-    au.password_hash
+    au.password_hash,
+    u.current_status
 FROM users u
 JOIN auth_user au ON au.user_id = u.id
 WHERE (u.username = $1 OR au.email = $1) 
   AND password_hash = $2
-  AND u.current_status = 'active'
+  AND u.current_status IN ('active', 'deactivated')
   AND u.deleted_at IS NULL
 `
 
@@ -50,6 +69,7 @@ type GetUserForLoginRow struct {
 	AvatarID      int64
 	ProfilePublic bool
 	PasswordHash  string
+	CurrentStatus UserStatus
 }
 
 func (q *Queries) GetUserForLogin(ctx context.Context, arg GetUserForLoginParams) (GetUserForLoginRow, error) {
@@ -61,6 +81,7 @@ func (q *Queries) GetUserForLogin(ctx context.Context, arg GetUserForLoginParams
 		&i.AvatarID,
 		&i.ProfilePublic,
 		&i.PasswordHash,
+		&i.CurrentStatus,
 	)
 	return i, err
 }
@@ -141,6 +162,20 @@ func (q *Queries) InsertNewUserAuth(ctx context.Context, arg InsertNewUserAuthPa
 	return err
 }
 
+const reactivateUser = `-- name: ReactivateUser :exec
+UPDATE users
+SET
+    current_status = 'active',
+    deactivated_at = NULL
+WHERE id = $1
+  AND current_status = 'deactivated'
+`
+
+func (q *Queries) ReactivateUser(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, reactivateUser, id)
+	return err
+}
+
 const softDeleteUser = `-- name: SoftDeleteUser :exec
 UPDATE users
 SET
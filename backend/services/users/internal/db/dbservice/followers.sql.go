@@ -138,6 +138,13 @@ SELECT
     c.total_score
 FROM combined c
 JOIN users u ON u.id = c.user_id
+WHERE u.deleted_at IS NULL
+  AND u.current_status <> 'deactivated'
+  AND NOT EXISTS (
+      SELECT 1 FROM user_privacy_settings p
+      WHERE p.user_id = u.id
+        AND p.discoverable = FALSE
+  )
 ORDER BY c.total_score DESC, random()
 LIMIT 5
 `
@@ -197,6 +204,7 @@ FROM follows f
 JOIN users u ON u.id = f.follower_id
 WHERE f.following_id = $1
 AND f.deleted_at IS NULL
+AND u.current_status <> 'deactivated'
 ORDER BY f.created_at DESC
 LIMIT $2 OFFSET $3
 `
@@ -247,6 +255,7 @@ FROM follows f
 JOIN users u ON u.id = f.following_id
 WHERE f.follower_id = $1
 AND f.deleted_at IS NULL
+AND u.current_status <> 'deactivated'
 ORDER BY f.created_at DESC
 LIMIT $2 OFFSET $3
 `
@@ -332,6 +341,33 @@ func (q *Queries) GetFollowingIds(ctx context.Context, followerID int64) ([]int6
 	return items, nil
 }
 
+const getFollowerIds = `-- name: GetFollowerIds :many
+SELECT follower_id
+FROM follows
+WHERE following_id = $1
+AND deleted_at IS NULL;
+`
+
+func (q *Queries) GetFollowerIds(ctx context.Context, followingID int64) ([]int64, error) {
+	rows, err := q.db.Query(ctx, getFollowerIds, followingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var follower_id int64
+		if err := rows.Scan(&follower_id); err != nil {
+			return nil, err
+		}
+		items = append(items, follower_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getMutualFollowers = `-- name: GetMutualFollowers :many
 SELECT u.id, u.username
 FROM follows f1
@@ -0,0 +1,54 @@
+package dbservice
+
+import (
+	"context"
+)
+
+const archiveGroup = `-- name: ArchiveGroup :execrows
+UPDATE groups
+SET archived_at = CURRENT_TIMESTAMP
+WHERE id = $1
+  AND archived_at IS NULL
+  AND deleted_at IS NULL
+`
+
+// makes the group read-only, no rows if already archived or deleted
+func (q *Queries) ArchiveGroup(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, archiveGroup, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const unarchiveGroup = `-- name: UnarchiveGroup :execrows
+UPDATE groups
+SET archived_at = NULL
+WHERE id = $1
+  AND archived_at IS NOT NULL
+  AND deleted_at IS NULL
+`
+
+// no rows if the group is not archived or deleted
+func (q *Queries) UnarchiveGroup(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, unarchiveGroup, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const isGroupArchived = `-- name: IsGroupArchived :one
+SELECT archived_at IS NOT NULL AS archived
+FROM groups
+WHERE id = $1
+  AND deleted_at IS NULL
+`
+
+// no rows if the group does not exist or is deleted
+func (q *Queries) IsGroupArchived(ctx context.Context, id int64) (bool, error) {
+	row := q.db.QueryRow(ctx, isGroupArchived, id)
+	var archived bool
+	err := row.Scan(&archived)
+	return archived, err
+}
@@ -0,0 +1,219 @@
+package dbservice
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const upsertGroupBan = `-- name: UpsertGroupBan :exec
+INSERT INTO group_bans (group_id, user_id, banned_by, reason, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (group_id, user_id)
+DO UPDATE SET
+    banned_by  = EXCLUDED.banned_by,
+    reason     = EXCLUDED.reason,
+    expires_at = EXCLUDED.expires_at,
+    created_at = CURRENT_TIMESTAMP
+`
+
+type UpsertGroupBanParams struct {
+	GroupID   int64
+	UserID    int64
+	BannedBy  int64
+	Reason    string
+	ExpiresAt pgtype.Timestamptz
+}
+
+// banning an already banned user replaces the previous ban
+func (q *Queries) UpsertGroupBan(ctx context.Context, arg UpsertGroupBanParams) error {
+	_, err := q.db.Exec(ctx, upsertGroupBan,
+		arg.GroupID,
+		arg.UserID,
+		arg.BannedBy,
+		arg.Reason,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const deleteGroupBan = `-- name: DeleteGroupBan :execrows
+DELETE FROM group_bans
+WHERE group_id = $1
+  AND user_id = $2
+  AND (expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP)
+`
+
+type DeleteGroupBanParams struct {
+	GroupID int64
+	UserID  int64
+}
+
+// lifts an active ban, returns 0 rows if the user isn't banned
+func (q *Queries) DeleteGroupBan(ctx context.Context, arg DeleteGroupBanParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteGroupBan, arg.GroupID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const isUserBannedFromGroup = `-- name: IsUserBannedFromGroup :one
+SELECT EXISTS (
+    SELECT 1
+    FROM group_bans
+    WHERE group_id = $1
+      AND user_id = $2
+      AND (expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP)
+) AS is_banned
+`
+
+type IsUserBannedFromGroupParams struct {
+	GroupID int64
+	UserID  int64
+}
+
+func (q *Queries) IsUserBannedFromGroup(ctx context.Context, arg IsUserBannedFromGroupParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isUserBannedFromGroup, arg.GroupID, arg.UserID)
+	var is_banned bool
+	err := row.Scan(&is_banned)
+	return is_banned, err
+}
+
+const filterNotBannedFromGroup = `-- name: FilterNotBannedFromGroup :many
+SELECT u.user_id::bigint
+FROM unnest($2::bigint[]) AS u(user_id)
+WHERE NOT EXISTS (
+    SELECT 1
+    FROM group_bans b
+    WHERE b.group_id = $1
+      AND b.user_id = u.user_id
+      AND (b.expires_at IS NULL OR b.expires_at > CURRENT_TIMESTAMP)
+)
+`
+
+type FilterNotBannedFromGroupParams struct {
+	GroupID int64
+	UserIds []int64
+}
+
+// Returns the subset of user ids without an active ban from the group.
+func (q *Queries) FilterNotBannedFromGroup(ctx context.Context, arg FilterNotBannedFromGroupParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, filterNotBannedFromGroup, arg.GroupID, arg.UserIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var user_id int64
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGroupBans = `-- name: ListGroupBans :many
+SELECT
+    b.user_id,
+    u.username,
+    u.avatar_id,
+    b.banned_by,
+    b.reason,
+    b.expires_at,
+    b.created_at
+FROM group_bans b
+JOIN users u
+    ON u.id = b.user_id
+WHERE b.group_id = $1
+  AND (b.expires_at IS NULL OR b.expires_at > CURRENT_TIMESTAMP)
+ORDER BY b.created_at DESC
+LIMIT $2 OFFSET $3
+`
+
+type ListGroupBansParams struct {
+	GroupID int64
+	Limit   int32
+	Offset  int32
+}
+
+type ListGroupBansRow struct {
+	UserID    int64
+	Username  string
+	AvatarID  int64
+	BannedBy  pgtype.Int8
+	Reason    string
+	ExpiresAt pgtype.Timestamptz
+	CreatedAt pgtype.Timestamptz
+}
+
+// active bans of the group, newest first
+func (q *Queries) ListGroupBans(ctx context.Context, arg ListGroupBansParams) ([]ListGroupBansRow, error) {
+	rows, err := q.db.Query(ctx, listGroupBans, arg.GroupID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListGroupBansRow{}
+	for rows.Next() {
+		var i ListGroupBansRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Username,
+			&i.AvatarID,
+			&i.BannedBy,
+			&i.Reason,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const rejectPendingGroupJoinRequest = `-- name: RejectPendingGroupJoinRequest :exec
+UPDATE group_join_requests
+SET status = 'rejected',
+    responded_at = CURRENT_TIMESTAMP
+WHERE group_id = $1
+  AND user_id = $2
+  AND status = 'pending'
+`
+
+type RejectPendingGroupJoinRequestParams struct {
+	GroupID int64
+	UserID  int64
+}
+
+func (q *Queries) RejectPendingGroupJoinRequest(ctx context.Context, arg RejectPendingGroupJoinRequestParams) error {
+	_, err := q.db.Exec(ctx, rejectPendingGroupJoinRequest, arg.GroupID, arg.UserID)
+	return err
+}
+
+const declinePendingGroupInvites = `-- name: DeclinePendingGroupInvites :exec
+UPDATE group_invites
+SET status = 'declined'
+WHERE group_id = $1
+  AND receiver_id = $2
+  AND status = 'pending'
+`
+
+type DeclinePendingGroupInvitesParams struct {
+	GroupID    int64
+	ReceiverID int64
+}
+
+func (q *Queries) DeclinePendingGroupInvites(ctx context.Context, arg DeclinePendingGroupInvitesParams) error {
+	_, err := q.db.Exec(ctx, declinePendingGroupInvites, arg.GroupID, arg.ReceiverID)
+	return err
+}
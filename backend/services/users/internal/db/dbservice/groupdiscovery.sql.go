@@ -0,0 +1,201 @@
+package dbservice
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getGroupTags = `-- name: GetGroupTags :many
+SELECT tag
+FROM group_tags
+WHERE group_id = $1
+ORDER BY tag ASC
+`
+
+func (q *Queries) GetGroupTags(ctx context.Context, groupID int64) ([]string, error) {
+	rows, err := q.db.Query(ctx, getGroupTags, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			return nil, err
+		}
+		items = append(items, tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTagsForGroups = `-- name: GetTagsForGroups :many
+SELECT group_id, tag
+FROM group_tags
+WHERE group_id = ANY($1::bigint[])
+ORDER BY group_id, tag ASC
+`
+
+func (q *Queries) GetTagsForGroups(ctx context.Context, groupIds []int64) ([]GroupTag, error) {
+	rows, err := q.db.Query(ctx, getTagsForGroups, groupIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GroupTag{}
+	for rows.Next() {
+		var i GroupTag
+		if err := rows.Scan(&i.GroupID, &i.Tag); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteGroupTags = `-- name: DeleteGroupTags :exec
+DELETE FROM group_tags
+WHERE group_id = $1
+`
+
+func (q *Queries) DeleteGroupTags(ctx context.Context, groupID int64) error {
+	_, err := q.db.Exec(ctx, deleteGroupTags, groupID)
+	return err
+}
+
+const insertGroupTags = `-- name: InsertGroupTags :exec
+INSERT INTO group_tags (group_id, tag)
+SELECT $1, tag
+FROM unnest($2::text[]) AS tag
+ON CONFLICT DO NOTHING
+`
+
+type InsertGroupTagsParams struct {
+	GroupID int64
+	Tags    []string
+}
+
+func (q *Queries) InsertGroupTags(ctx context.Context, arg InsertGroupTagsParams) error {
+	_, err := q.db.Exec(ctx, insertGroupTags, arg.GroupID, arg.Tags)
+	return err
+}
+
+const getDiscoverableGroups = `-- name: GetDiscoverableGroups :many
+SELECT
+    g.id,
+    g.group_owner,
+    g.group_title,
+    g.group_description,
+    g.group_image_id,
+    g.members_count,
+    g.visibility,
+    g.category,
+    (
+        SELECT COUNT(*)
+        FROM group_members gm
+        WHERE gm.group_id = g.id
+          AND gm.deleted_at IS NULL
+          AND gm.joined_at >= $3
+    )::int AS new_members,
+    (
+        SELECT COUNT(*)
+        FROM group_members gm
+        JOIN follows f
+          ON f.following_id = gm.user_id
+         AND f.follower_id = $1
+         AND f.deleted_at IS NULL
+        WHERE gm.group_id = g.id
+          AND gm.deleted_at IS NULL
+    )::int AS followed_members
+FROM groups g
+WHERE g.deleted_at IS NULL
+  AND g.archived_at IS NULL
+  AND g.visibility <> 'secret'
+  AND ($2::group_category IS NULL OR g.category = $2::group_category)
+  -- not already a member
+  AND NOT EXISTS (
+        SELECT 1 FROM group_members m
+        WHERE m.group_id = g.id
+          AND m.user_id = $1
+          AND m.deleted_at IS NULL
+      )
+  -- not banned
+  AND NOT EXISTS (
+        SELECT 1 FROM group_bans b
+        WHERE b.group_id = g.id
+          AND b.user_id = $1
+          AND (b.expires_at IS NULL OR b.expires_at > CURRENT_TIMESTAMP)
+      )
+ORDER BY
+    followed_members DESC,
+    new_members DESC,
+    g.members_count DESC,
+    g.id DESC
+LIMIT $4
+`
+
+type GetDiscoverableGroupsParams struct {
+	UserID   int64
+	Category NullGroupCategory
+	Since    pgtype.Timestamptz
+	Limit    int32
+}
+
+type GetDiscoverableGroupsRow struct {
+	ID               int64
+	GroupOwner       int64
+	GroupTitle       string
+	GroupDescription string
+	GroupImageID     int64
+	MembersCount     int32
+	Visibility       GroupVisibility
+	Category         GroupCategory
+	NewMembers       int32
+	FollowedMembers  int32
+}
+
+// Candidate groups for discovery: listed, active, optionally in one category,
+// excluding the user's own groups and bans. Members joined since $3 count as growth,
+// members the user follows drive recommendations.
+func (q *Queries) GetDiscoverableGroups(ctx context.Context, arg GetDiscoverableGroupsParams) ([]GetDiscoverableGroupsRow, error) {
+	rows, err := q.db.Query(ctx, getDiscoverableGroups,
+		arg.UserID,
+		arg.Category,
+		arg.Since,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetDiscoverableGroupsRow{}
+	for rows.Next() {
+		var i GetDiscoverableGroupsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.GroupOwner,
+			&i.GroupTitle,
+			&i.GroupDescription,
+			&i.GroupImageID,
+			&i.MembersCount,
+			&i.Visibility,
+			&i.Category,
+			&i.NewMembers,
+			&i.FollowedMembers,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,123 @@
+package dbservice
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getGroupMemberGrowth = `-- name: GetGroupMemberGrowth :many
+WITH days AS (
+    SELECT d::date AS day
+    FROM generate_series(
+        ($2::timestamptz AT TIME ZONE 'UTC')::date,
+        (CURRENT_TIMESTAMP AT TIME ZONE 'UTC')::date,
+        interval '1 day'
+    ) AS d
+)
+SELECT
+    days.day,
+    (
+        SELECT COUNT(*)
+        FROM group_members gm
+        WHERE gm.group_id = $1
+          AND (gm.joined_at AT TIME ZONE 'UTC')::date = days.day
+    ) AS joined,
+    (
+        SELECT COUNT(*)
+        FROM group_members gm
+        WHERE gm.group_id = $1
+          AND (gm.joined_at AT TIME ZONE 'UTC')::date <= days.day
+          AND (gm.deleted_at IS NULL OR (gm.deleted_at AT TIME ZONE 'UTC')::date > days.day)
+    ) AS total
+FROM days
+ORDER BY days.day ASC
+`
+
+type GetGroupMemberGrowthParams struct {
+	GroupID int64
+	Since   pgtype.Timestamptz
+}
+
+type GetGroupMemberGrowthRow struct {
+	Day    pgtype.Date
+	Joined int64
+	Total  int64
+}
+
+// One row per UTC day from $2 to today: members who joined that day and members at its end.
+// Members who left and rejoined only count from their latest join.
+func (q *Queries) GetGroupMemberGrowth(ctx context.Context, arg GetGroupMemberGrowthParams) ([]GetGroupMemberGrowthRow, error) {
+	rows, err := q.db.Query(ctx, getGroupMemberGrowth, arg.GroupID, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetGroupMemberGrowthRow{}
+	for rows.Next() {
+		var i GetGroupMemberGrowthRow
+		if err := rows.Scan(&i.Day, &i.Joined, &i.Total); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGroupJoinRequestStats = `-- name: GetGroupJoinRequestStats :one
+SELECT
+    COUNT(*) FILTER (
+        WHERE status = 'accepted' AND responded_at >= $2
+    ) AS accepted,
+    COUNT(*) FILTER (
+        WHERE status = 'rejected' AND responded_at >= $2
+    ) AS rejected,
+    COUNT(*) FILTER (
+        WHERE status = 'pending' AND deleted_at IS NULL
+    ) AS pending,
+    COALESCE(
+        AVG(EXTRACT(EPOCH FROM responded_at - requested_at)) FILTER (
+            WHERE status = 'accepted' AND responded_at >= $2 AND requested_at IS NOT NULL
+        ),
+        0
+    )::float8 AS avg_approval_seconds,
+    COALESCE(
+        percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM responded_at - requested_at)) FILTER (
+            WHERE status = 'accepted' AND responded_at >= $2 AND requested_at IS NOT NULL
+        ),
+        0
+    )::float8 AS median_approval_seconds
+FROM group_join_requests
+WHERE group_id = $1
+`
+
+type GetGroupJoinRequestStatsParams struct {
+	GroupID int64
+	Since   pgtype.Timestamptz
+}
+
+type GetGroupJoinRequestStatsRow struct {
+	Accepted              int64
+	Rejected              int64
+	Pending               int64
+	AvgApprovalSeconds    float64
+	MedianApprovalSeconds float64
+}
+
+// Join requests answered since $2 and how long accepted ones waited.
+// Pending counts every request still waiting, whenever it was sent.
+func (q *Queries) GetGroupJoinRequestStats(ctx context.Context, arg GetGroupJoinRequestStatsParams) (GetGroupJoinRequestStatsRow, error) {
+	row := q.db.QueryRow(ctx, getGroupJoinRequestStats, arg.GroupID, arg.Since)
+	var i GetGroupJoinRequestStatsRow
+	err := row.Scan(
+		&i.Accepted,
+		&i.Rejected,
+		&i.Pending,
+		&i.AvgApprovalSeconds,
+		&i.MedianApprovalSeconds,
+	)
+	return i, err
+}
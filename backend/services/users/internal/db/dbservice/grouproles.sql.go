@@ -0,0 +1,68 @@
+package dbservice
+
+import (
+	"context"
+)
+
+const getGroupMemberIdsWithRoles = `-- name: GetGroupMemberIdsWithRoles :many
+SELECT user_id
+FROM group_members
+WHERE group_id = $1
+  AND role = ANY($2::group_role[])
+  AND deleted_at IS NULL
+ORDER BY user_id
+`
+
+type GetGroupMemberIdsWithRolesParams struct {
+	GroupID int64
+	Roles   []string
+}
+
+// ids of the active members holding any of the given roles
+func (q *Queries) GetGroupMemberIdsWithRoles(ctx context.Context, arg GetGroupMemberIdsWithRolesParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, getGroupMemberIdsWithRoles, arg.GroupID, arg.Roles)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var user_id int64
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateGroupMemberRole = `-- name: UpdateGroupMemberRole :execrows
+UPDATE group_members
+SET role = $3
+WHERE group_id = $1
+  AND user_id = $2
+  AND deleted_at IS NULL
+  AND role <> 'owner'
+  AND $3 <> 'owner'::group_role
+`
+
+type UpdateGroupMemberRoleParams struct {
+	GroupID int64
+	UserID  int64
+	Role    GroupRole
+}
+
+// Sets the role of an active, non owner group member.
+// Ownership can't be granted through this query.
+//
+// returns rows affected, 0 if the user is not a member or is the owner
+func (q *Queries) UpdateGroupMemberRole(ctx context.Context, arg UpdateGroupMemberRoleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateGroupMemberRole, arg.GroupID, arg.UserID, arg.Role)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
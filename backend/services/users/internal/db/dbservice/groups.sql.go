@@ -25,7 +25,8 @@ func (q *Queries) AcceptGroupInvite(ctx context.Context, arg AcceptGroupInvitePa
 
 const acceptGroupJoinRequest = `-- name: AcceptGroupJoinRequest :exec
 UPDATE group_join_requests
-SET status = 'accepted'
+SET status = 'accepted',
+    responded_at = CURRENT_TIMESTAMP
 WHERE group_id = $1
   AND user_id = $2
 `
@@ -106,8 +107,8 @@ func (q *Queries) CancelGroupJoinRequest(ctx context.Context, arg CancelGroupJoi
 }
 
 const createGroup = `-- name: CreateGroup :one
-INSERT INTO groups (group_owner, group_title, group_description, group_image_id)
-VALUES ($1, $2, $3, $4)
+INSERT INTO groups (group_owner, group_title, group_description, group_image_id, visibility, category)
+VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING id
 `
 
@@ -116,6 +117,8 @@ type CreateGroupParams struct {
 	GroupTitle       string
 	GroupDescription string
 	GroupImageID     int64
+	Visibility       GroupVisibility
+	Category         GroupCategory
 }
 
 func (q *Queries) CreateGroup(ctx context.Context, arg CreateGroupParams) (int64, error) {
@@ -124,6 +127,8 @@ func (q *Queries) CreateGroup(ctx context.Context, arg CreateGroupParams) (int64
 		arg.GroupTitle,
 		arg.GroupDescription,
 		arg.GroupImageID,
+		arg.Visibility,
+		arg.Category,
 	)
 	var id int64
 	err := row.Scan(&id)
@@ -154,9 +159,20 @@ SELECT
   group_title,
   group_description,
   group_image_id,
-  members_count
-FROM groups
+  members_count,
+  visibility
+FROM groups g
 WHERE deleted_at IS NULL
+  -- secret groups are only listed to their members
+  AND (
+        visibility <> 'secret'
+     OR EXISTS (
+          SELECT 1 FROM group_members gm
+          WHERE gm.group_id = g.id
+            AND gm.user_id = $3
+            AND gm.deleted_at IS NULL
+        )
+      )
 ORDER BY members_count DESC, id ASC
 LIMIT $1 OFFSET $2
 `
@@ -164,6 +180,7 @@ LIMIT $1 OFFSET $2
 type GetAllGroupsParams struct {
 	Limit  int32
 	Offset int32
+	UserID int64
 }
 
 type GetAllGroupsRow struct {
@@ -173,10 +190,11 @@ type GetAllGroupsRow struct {
 	GroupDescription string
 	GroupImageID     int64
 	MembersCount     int32
+	Visibility       GroupVisibility
 }
 
 func (q *Queries) GetAllGroups(ctx context.Context, arg GetAllGroupsParams) ([]GetAllGroupsRow, error) {
-	rows, err := q.db.Query(ctx, getAllGroups, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, getAllGroups, arg.Limit, arg.Offset, arg.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -191,6 +209,7 @@ func (q *Queries) GetAllGroups(ctx context.Context, arg GetAllGroupsParams) ([]G
 			&i.GroupDescription,
 			&i.GroupImageID,
 			&i.MembersCount,
+			&i.Visibility,
 		); err != nil {
 			return nil, err
 		}
@@ -209,19 +228,27 @@ SELECT
   group_title,
   group_description,
   group_image_id,
-  members_count
+  members_count,
+  archived_at IS NOT NULL AS archived,
+  visibility,
+  category,
+  posts_require_approval
 FROM groups
 WHERE id=$1
   AND deleted_at IS NULL
 `
 
 type GetGroupInfoRow struct {
-	ID               int64
-	GroupOwner       int64
-	GroupTitle       string
-	GroupDescription string
-	GroupImageID     int64
-	MembersCount     int32
+	ID                   int64
+	GroupOwner           int64
+	GroupTitle           string
+	GroupDescription     string
+	GroupImageID         int64
+	MembersCount         int32
+	Archived             bool
+	Visibility           GroupVisibility
+	Category             GroupCategory
+	PostsRequireApproval bool
 }
 
 func (q *Queries) GetGroupInfo(ctx context.Context, id int64) (GetGroupInfoRow, error) {
@@ -234,6 +261,10 @@ func (q *Queries) GetGroupInfo(ctx context.Context, id int64) (GetGroupInfoRow,
 		&i.GroupDescription,
 		&i.GroupImageID,
 		&i.MembersCount,
+		&i.Archived,
+		&i.Visibility,
+		&i.Category,
+		&i.PostsRequireApproval,
 	)
 	return i, err
 }
@@ -271,6 +302,41 @@ func (q *Queries) GetGroupBasicInfo(ctx context.Context, id int64) (GetGroupBasi
 	return i, err
 }
 
+const getGroupVisibility = `-- name: GetGroupVisibility :one
+SELECT visibility
+FROM groups
+WHERE id = $1
+  AND deleted_at IS NULL
+`
+
+func (q *Queries) GetGroupVisibility(ctx context.Context, id int64) (GroupVisibility, error) {
+	row := q.db.QueryRow(ctx, getGroupVisibility, id)
+	var visibility GroupVisibility
+	err := row.Scan(&visibility)
+	return visibility, err
+}
+
+const setGroupPostsRequireApproval = `-- name: SetGroupPostsRequireApproval :execrows
+UPDATE groups
+SET posts_require_approval = $2
+WHERE id = $1
+  AND deleted_at IS NULL
+`
+
+type SetGroupPostsRequireApprovalParams struct {
+	ID                   int64
+	PostsRequireApproval bool
+}
+
+// no rows if the group doesn't exist or is deleted
+func (q *Queries) SetGroupPostsRequireApproval(ctx context.Context, arg SetGroupPostsRequireApprovalParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setGroupPostsRequireApproval, arg.ID, arg.PostsRequireApproval)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getGroupMembers = `-- name: GetGroupMembers :many
 SELECT
     u.id,
@@ -284,6 +350,7 @@ JOIN users u
 WHERE gm.group_id = $1
   AND gm.deleted_at IS NULL
   AND u.deleted_at IS NULL
+  AND u.current_status <> 'deactivated'
 ORDER BY gm.joined_at DESC, u.id DESC
 LIMIT $2 OFFSET $3
 `
@@ -390,6 +457,36 @@ func (q *Queries) GetUserGroupRole(ctx context.Context, arg GetUserGroupRolePara
 	return role, err
 }
 
+const getUserGroupIds = `-- name: GetUserGroupIds :many
+SELECT gm.group_id
+FROM group_members gm
+JOIN groups g
+    ON gm.group_id = g.id
+WHERE gm.user_id = $1
+  AND gm.deleted_at IS NULL
+  AND g.deleted_at IS NULL;
+`
+
+func (q *Queries) GetUserGroupIds(ctx context.Context, userID int64) ([]int64, error) {
+	rows, err := q.db.Query(ctx, getUserGroupIds, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var group_id int64
+		if err := rows.Scan(&group_id); err != nil {
+			return nil, err
+		}
+		items = append(items, group_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getUserGroups = `-- name: GetUserGroups :many
 SELECT
     group_id,
@@ -399,7 +496,8 @@ SELECT
     group_image_id,
     members_count,
     is_member,
-    is_owner
+    is_owner,
+    visibility
 FROM (
     SELECT DISTINCT
         g.id AS group_id,
@@ -410,6 +508,7 @@ FROM (
         g.members_count,
         CASE WHEN gm.user_id IS NOT NULL THEN TRUE ELSE FALSE END AS is_member,
         CASE WHEN g.group_owner = $1 THEN TRUE ELSE FALSE END AS is_owner,
+        g.visibility,
         COALESCE(gm.joined_at, g.created_at) AS sort_date
     FROM groups g
     LEFT JOIN group_members gm
@@ -438,6 +537,7 @@ type GetUserGroupsRow struct {
 	MembersCount     int32
 	IsMember         bool
 	IsOwner          bool
+	Visibility       GroupVisibility
 }
 
 func (q *Queries) GetUserGroups(ctx context.Context, arg GetUserGroupsParams) ([]GetUserGroupsRow, error) {
@@ -458,6 +558,7 @@ func (q *Queries) GetUserGroups(ctx context.Context, arg GetUserGroupsParams) ([
 			&i.MembersCount,
 			&i.IsMember,
 			&i.IsOwner,
+			&i.Visibility,
 		); err != nil {
 			return nil, err
 		}
@@ -559,7 +660,8 @@ func (q *Queries) IsUserGroupOwner(ctx context.Context, arg IsUserGroupOwnerPara
 
 const leaveGroup = `-- name: LeaveGroup :exec
 UPDATE group_members
-SET deleted_at = CURRENT_TIMESTAMP
+SET deleted_at = CURRENT_TIMESTAMP,
+    role = 'member'
 WHERE group_id = $1
   AND user_id = $2
   AND role <> 'owner'
@@ -577,7 +679,8 @@ func (q *Queries) LeaveGroup(ctx context.Context, arg LeaveGroupParams) error {
 
 const rejectGroupJoinRequest = `-- name: RejectGroupJoinRequest :exec
 UPDATE group_join_requests
-SET status = 'rejected'
+SET status = 'rejected',
+    responded_at = CURRENT_TIMESTAMP
 WHERE group_id = $1
   AND user_id = $2
 `
@@ -602,6 +705,8 @@ SELECT DISTINCT ON (g.id)
     g.members_count,
     (gm.user_id IS NOT NULL) AS is_member,
     (g.group_owner = $2) AS is_owner,
+    g.visibility,
+    g.category,
     CASE
         WHEN LENGTH($1) >= 3 THEN
             similarity(g.group_title, $1) * 2.0 +
@@ -612,17 +717,25 @@ SELECT DISTINCT ON (g.id)
                 WHEN g.group_description ILIKE '%' || $1 || '%' THEN 1
                 ELSE 0
             END
-    END AS weighted_score
+    END
+    -- exact tag match ranks like a strong title match
+    + CASE WHEN gt.tag IS NOT NULL THEN 2 ELSE 0 END AS weighted_score
 FROM groups g
 LEFT JOIN group_members gm
     ON gm.group_id = g.id
    AND gm.user_id = $2
    AND gm.deleted_at IS NULL
+LEFT JOIN group_tags gt
+    ON gt.group_id = g.id
+   AND gt.tag = LOWER(TRIM($1))
 WHERE g.deleted_at IS NULL
+  -- secret groups are only found by their members
+  AND (g.visibility <> 'secret' OR gm.user_id IS NOT NULL)
   AND (
         -- Always allow substring match for any length
         g.group_title ILIKE '%' || $1 || '%'
      OR g.group_description ILIKE '%' || $1 || '%'
+     OR gt.tag IS NOT NULL
      -- For longer queries, also use fuzzy % match
      OR (LENGTH($1) >= 3 AND (g.group_title % $1 OR g.group_description % $1))
       )
@@ -651,6 +764,8 @@ type SearchGroupsRow struct {
 	MembersCount     int32
 	IsMember         bool
 	IsOwner          bool
+	Visibility       GroupVisibility
+	Category         GroupCategory
 	WeightedScore    float64
 }
 
@@ -683,6 +798,8 @@ func (q *Queries) SearchGroups(
 			&i.MembersCount,
 			&i.IsMember,
 			&i.IsOwner,
+			&i.Visibility,
+			&i.Category,
 			&i.WeightedScore,
 		); err != nil {
 			return nil, err
@@ -751,10 +868,12 @@ func (q *Queries) GetGroupInviterId(ctx context.Context, arg GetGroupInviterIdPa
 }
 
 const sendGroupJoinRequest = `-- name: SendGroupJoinRequest :exec
-INSERT INTO group_join_requests (group_id, user_id, status)
-VALUES ($1, $2, 'pending')
+INSERT INTO group_join_requests (group_id, user_id, status, requested_at)
+VALUES ($1, $2, 'pending', CURRENT_TIMESTAMP)
 ON CONFLICT (group_id, user_id)
-DO UPDATE SET status = 'pending'
+DO UPDATE SET status = 'pending',
+    requested_at = CURRENT_TIMESTAMP,
+    responded_at = NULL
 `
 
 type SendGroupJoinRequestParams struct {
@@ -767,46 +886,37 @@ func (q *Queries) SendGroupJoinRequest(ctx context.Context, arg SendGroupJoinReq
 	return err
 }
 
-const softDeleteGroup = `-- name: SoftDeleteGroup :exec
+const softDeleteGroup = `-- name: SoftDeleteGroup :execrows
 UPDATE groups
 SET deleted_at = CURRENT_TIMESTAMP
 WHERE id = $1
+  AND deleted_at IS NULL
 `
 
-func (q *Queries) SoftDeleteGroup(ctx context.Context, id int64) error {
-	_, err := q.db.Exec(ctx, softDeleteGroup, id)
-	return err
+// soft deletes the group, trg_soft_delete_group revokes its memberships,
+// pending invites, join requests and ownership offers.
+func (q *Queries) SoftDeleteGroup(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, softDeleteGroup, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const transferOwnership = `-- name: TransferOwnership :exec
-
-
-WITH demote AS (
-    UPDATE group_members AS gm_old
-    SET role = 'member'
-    WHERE gm_old.group_id = $1
-      AND gm_old.user_id = $2
-      AND gm_old.role = 'owner'
-),
-promote AS (
-    UPDATE group_members AS gm_new
-    SET role = 'owner'
-    WHERE gm_new.group_id = $1
-      AND gm_new.user_id = $3
-      AND gm_new.role = 'member'
-)
-SELECT 1
+SELECT transfer_group_ownership($1, $2)
 `
 
 type TransferOwnershipParams struct {
-	GroupID  int64
-	UserID   int64
-	UserID_2 int64
+	GroupID    int64
+	NewOwnerID int64
 }
 
-// owners cannot leave the group (transfer ownership logic? TODO)
+// makes new owner (an active member) the group owner, previous owner becomes admin.
+// clears any pending ownership transfer of the group.
+// errors if new owner is not a member
 func (q *Queries) TransferOwnership(ctx context.Context, arg TransferOwnershipParams) error {
-	_, err := q.db.Exec(ctx, transferOwnership, arg.GroupID, arg.UserID, arg.UserID_2)
+	_, err := q.db.Exec(ctx, transferOwnership, arg.GroupID, arg.NewOwnerID)
 	return err
 }
 
@@ -815,7 +925,9 @@ UPDATE groups
 SET
     group_title      = $2,
     group_description    = $3,
-    group_image_id     = $4
+    group_image_id     = $4,
+    visibility         = COALESCE($5::group_visibility, visibility),
+    category           = COALESCE($6::group_category, category)
 WHERE id = $1 AND deleted_at IS NULL
 `
 
@@ -824,6 +936,8 @@ type UpdateGroupParams struct {
 	GroupTitle       string
 	GroupDescription string
 	GroupImageID     int64
+	Visibility       NullGroupVisibility
+	Category         NullGroupCategory
 }
 
 func (q *Queries) UpdateGroup(ctx context.Context, arg UpdateGroupParams) (int64, error) {
@@ -832,6 +946,8 @@ func (q *Queries) UpdateGroup(ctx context.Context, arg UpdateGroupParams) (int64
 		arg.GroupTitle,
 		arg.GroupDescription,
 		arg.GroupImageID,
+		arg.Visibility,
+		arg.Category,
 	)
 	if err != nil {
 		return 0, err
@@ -926,7 +1042,9 @@ const getPendingGroupJoinRequests = `-- name: GetPendingGroupJoinRequests :many
 SELECT
     u.id,
     u.username,
-    u.avatar_id
+    u.avatar_id,
+    COALESCE(gjr.updated_at, gjr.created_at) AS requested_at,
+    gjr.rules_accepted_at
 FROM group_join_requests gjr
 JOIN users u
     ON u.id = gjr.user_id
@@ -946,9 +1064,11 @@ type GetPendingGroupJoinRequestsParams struct {
 }
 
 type GetPendingGroupJoinRequestsRow struct {
-	Id       int64
-	Username string
-	AvatarId int64
+	Id              int64
+	Username        string
+	AvatarId        int64
+	RequestedAt     pgtype.Timestamptz
+	RulesAcceptedAt pgtype.Timestamptz
 }
 
 func (q *Queries) GetPendingGroupJoinRequests(ctx context.Context, arg GetPendingGroupJoinRequestsParams) ([]GetPendingGroupJoinRequestsRow, error) {
@@ -964,6 +1084,8 @@ func (q *Queries) GetPendingGroupJoinRequests(ctx context.Context, arg GetPendin
 			&i.Id,
 			&i.Username,
 			&i.AvatarId,
+			&i.RequestedAt,
+			&i.RulesAcceptedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,200 @@
+package dbservice
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const insertGroupInviteLink = `-- name: InsertGroupInviteLink :one
+INSERT INTO group_invite_links (group_id, created_by, nonce, expires_at, max_uses, auto_approve)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, group_id, created_by, nonce, expires_at, max_uses, uses, auto_approve, revoked_at, created_at
+`
+
+type InsertGroupInviteLinkParams struct {
+	GroupID     int64
+	CreatedBy   int64
+	Nonce       string
+	ExpiresAt   pgtype.Timestamptz
+	MaxUses     pgtype.Int4
+	AutoApprove bool
+}
+
+func (q *Queries) InsertGroupInviteLink(ctx context.Context, arg InsertGroupInviteLinkParams) (GroupInviteLink, error) {
+	row := q.db.QueryRow(ctx, insertGroupInviteLink,
+		arg.GroupID,
+		arg.CreatedBy,
+		arg.Nonce,
+		arg.ExpiresAt,
+		arg.MaxUses,
+		arg.AutoApprove,
+	)
+	var i GroupInviteLink
+	err := row.Scan(
+		&i.ID,
+		&i.GroupID,
+		&i.CreatedBy,
+		&i.Nonce,
+		&i.ExpiresAt,
+		&i.MaxUses,
+		&i.Uses,
+		&i.AutoApprove,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getGroupInviteLinkForUpdate = `-- name: GetGroupInviteLinkForUpdate :one
+SELECT id, group_id, created_by, nonce, expires_at, max_uses, uses, auto_approve, revoked_at, created_at
+FROM group_invite_links
+WHERE id = $1
+FOR UPDATE
+`
+
+// locks the link until the end of the transaction so concurrent uses can't exceed max uses
+func (q *Queries) GetGroupInviteLinkForUpdate(ctx context.Context, id int64) (GroupInviteLink, error) {
+	row := q.db.QueryRow(ctx, getGroupInviteLinkForUpdate, id)
+	var i GroupInviteLink
+	err := row.Scan(
+		&i.ID,
+		&i.GroupID,
+		&i.CreatedBy,
+		&i.Nonce,
+		&i.ExpiresAt,
+		&i.MaxUses,
+		&i.Uses,
+		&i.AutoApprove,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const incrementGroupInviteLinkUses = `-- name: IncrementGroupInviteLinkUses :exec
+UPDATE group_invite_links
+SET uses = uses + 1
+WHERE id = $1
+`
+
+func (q *Queries) IncrementGroupInviteLinkUses(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, incrementGroupInviteLinkUses, id)
+	return err
+}
+
+const upsertGroupInviteLinkUse = `-- name: UpsertGroupInviteLinkUse :exec
+INSERT INTO group_invite_link_uses (link_id, user_id, joined)
+VALUES ($1, $2, $3)
+ON CONFLICT (link_id, user_id)
+DO UPDATE SET
+    joined  = EXCLUDED.joined,
+    used_at = CURRENT_TIMESTAMP
+`
+
+type UpsertGroupInviteLinkUseParams struct {
+	LinkID int64
+	UserID int64
+	Joined bool
+}
+
+func (q *Queries) UpsertGroupInviteLinkUse(ctx context.Context, arg UpsertGroupInviteLinkUseParams) error {
+	_, err := q.db.Exec(ctx, upsertGroupInviteLinkUse, arg.LinkID, arg.UserID, arg.Joined)
+	return err
+}
+
+const listGroupInviteLinks = `-- name: ListGroupInviteLinks :many
+SELECT
+    l.id,
+    l.group_id,
+    l.created_by,
+    l.nonce,
+    l.expires_at,
+    l.max_uses,
+    l.uses,
+    l.auto_approve,
+    l.revoked_at,
+    l.created_at,
+    COUNT(u.user_id) FILTER (WHERE u.joined)     AS joined_count,
+    COUNT(u.user_id) FILTER (WHERE NOT u.joined) AS requested_count,
+    MAX(u.used_at)::timestamptz                  AS last_used_at
+FROM group_invite_links l
+LEFT JOIN group_invite_link_uses u
+    ON u.link_id = l.id
+WHERE l.group_id = $1
+GROUP BY l.id
+ORDER BY l.created_at DESC
+`
+
+type ListGroupInviteLinksRow struct {
+	ID             int64
+	GroupID        int64
+	CreatedBy      int64
+	Nonce          string
+	ExpiresAt      pgtype.Timestamptz
+	MaxUses        pgtype.Int4
+	Uses           int32
+	AutoApprove    bool
+	RevokedAt      pgtype.Timestamptz
+	CreatedAt      pgtype.Timestamptz
+	JoinedCount    int64
+	RequestedCount int64
+	LastUsedAt     pgtype.Timestamptz
+}
+
+// all links of the group, newest first, with usage stats
+func (q *Queries) ListGroupInviteLinks(ctx context.Context, groupID int64) ([]ListGroupInviteLinksRow, error) {
+	rows, err := q.db.Query(ctx, listGroupInviteLinks, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListGroupInviteLinksRow{}
+	for rows.Next() {
+		var i ListGroupInviteLinksRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.GroupID,
+			&i.CreatedBy,
+			&i.Nonce,
+			&i.ExpiresAt,
+			&i.MaxUses,
+			&i.Uses,
+			&i.AutoApprove,
+			&i.RevokedAt,
+			&i.CreatedAt,
+			&i.JoinedCount,
+			&i.RequestedCount,
+			&i.LastUsedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeGroupInviteLink = `-- name: RevokeGroupInviteLink :execrows
+UPDATE group_invite_links
+SET revoked_at = CURRENT_TIMESTAMP
+WHERE id = $1
+  AND group_id = $2
+  AND revoked_at IS NULL
+`
+
+type RevokeGroupInviteLinkParams struct {
+	ID      int64
+	GroupID int64
+}
+
+// no rows if the link doesn't belong to the group or is already revoked
+func (q *Queries) RevokeGroupInviteLink(ctx context.Context, arg RevokeGroupInviteLinkParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeGroupInviteLink, arg.ID, arg.GroupID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
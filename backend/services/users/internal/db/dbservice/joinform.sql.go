@@ -0,0 +1,230 @@
+package dbservice
+
+import (
+	"context"
+)
+
+const listGroupRules = `-- name: ListGroupRules :many
+SELECT id, group_id, position, rule_text, created_at
+FROM group_rules
+WHERE group_id = $1
+ORDER BY position ASC
+`
+
+func (q *Queries) ListGroupRules(ctx context.Context, groupID int64) ([]GroupRule, error) {
+	rows, err := q.db.Query(ctx, listGroupRules, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GroupRule{}
+	for rows.Next() {
+		var i GroupRule
+		if err := rows.Scan(
+			&i.ID,
+			&i.GroupID,
+			&i.Position,
+			&i.RuleText,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteGroupRules = `-- name: DeleteGroupRules :exec
+DELETE FROM group_rules
+WHERE group_id = $1
+`
+
+func (q *Queries) DeleteGroupRules(ctx context.Context, groupID int64) error {
+	_, err := q.db.Exec(ctx, deleteGroupRules, groupID)
+	return err
+}
+
+const insertGroupRule = `-- name: InsertGroupRule :exec
+INSERT INTO group_rules (group_id, position, rule_text)
+VALUES ($1, $2, $3)
+`
+
+type InsertGroupRuleParams struct {
+	GroupID  int64
+	Position int32
+	RuleText string
+}
+
+func (q *Queries) InsertGroupRule(ctx context.Context, arg InsertGroupRuleParams) error {
+	_, err := q.db.Exec(ctx, insertGroupRule, arg.GroupID, arg.Position, arg.RuleText)
+	return err
+}
+
+const listGroupJoinQuestions = `-- name: ListGroupJoinQuestions :many
+SELECT id, group_id, position, question, required, created_at
+FROM group_join_questions
+WHERE group_id = $1
+ORDER BY position ASC
+`
+
+func (q *Queries) ListGroupJoinQuestions(ctx context.Context, groupID int64) ([]GroupJoinQuestion, error) {
+	rows, err := q.db.Query(ctx, listGroupJoinQuestions, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GroupJoinQuestion{}
+	for rows.Next() {
+		var i GroupJoinQuestion
+		if err := rows.Scan(
+			&i.ID,
+			&i.GroupID,
+			&i.Position,
+			&i.Question,
+			&i.Required,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteGroupJoinQuestions = `-- name: DeleteGroupJoinQuestions :exec
+DELETE FROM group_join_questions
+WHERE group_id = $1
+`
+
+func (q *Queries) DeleteGroupJoinQuestions(ctx context.Context, groupID int64) error {
+	_, err := q.db.Exec(ctx, deleteGroupJoinQuestions, groupID)
+	return err
+}
+
+const insertGroupJoinQuestion = `-- name: InsertGroupJoinQuestion :exec
+INSERT INTO group_join_questions (group_id, position, question, required)
+VALUES ($1, $2, $3, $4)
+`
+
+type InsertGroupJoinQuestionParams struct {
+	GroupID  int64
+	Position int32
+	Question string
+	Required bool
+}
+
+func (q *Queries) InsertGroupJoinQuestion(ctx context.Context, arg InsertGroupJoinQuestionParams) error {
+	_, err := q.db.Exec(ctx, insertGroupJoinQuestion,
+		arg.GroupID,
+		arg.Position,
+		arg.Question,
+		arg.Required,
+	)
+	return err
+}
+
+const deleteGroupJoinAnswers = `-- name: DeleteGroupJoinAnswers :exec
+DELETE FROM group_join_answers
+WHERE group_id = $1 AND user_id = $2
+`
+
+type DeleteGroupJoinAnswersParams struct {
+	GroupID int64
+	UserID  int64
+}
+
+func (q *Queries) DeleteGroupJoinAnswers(ctx context.Context, arg DeleteGroupJoinAnswersParams) error {
+	_, err := q.db.Exec(ctx, deleteGroupJoinAnswers, arg.GroupID, arg.UserID)
+	return err
+}
+
+const insertGroupJoinAnswer = `-- name: InsertGroupJoinAnswer :exec
+INSERT INTO group_join_answers (group_id, user_id, question_id, position, question, answer)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type InsertGroupJoinAnswerParams struct {
+	GroupID    int64
+	UserID     int64
+	QuestionID int64
+	Position   int32
+	Question   string
+	Answer     string
+}
+
+func (q *Queries) InsertGroupJoinAnswer(ctx context.Context, arg InsertGroupJoinAnswerParams) error {
+	_, err := q.db.Exec(ctx, insertGroupJoinAnswer,
+		arg.GroupID,
+		arg.UserID,
+		arg.QuestionID,
+		arg.Position,
+		arg.Question,
+		arg.Answer,
+	)
+	return err
+}
+
+const getGroupJoinAnswers = `-- name: GetGroupJoinAnswers :many
+SELECT group_id, user_id, question_id, position, question, answer, created_at
+FROM group_join_answers
+WHERE group_id = $1
+  AND user_id = ANY($2::bigint[])
+ORDER BY user_id, position ASC
+`
+
+type GetGroupJoinAnswersParams struct {
+	GroupID int64
+	UserIds []int64
+}
+
+// answers of several applicants at once, for the pending requests page
+func (q *Queries) GetGroupJoinAnswers(ctx context.Context, arg GetGroupJoinAnswersParams) ([]GroupJoinAnswer, error) {
+	rows, err := q.db.Query(ctx, getGroupJoinAnswers, arg.GroupID, arg.UserIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GroupJoinAnswer{}
+	for rows.Next() {
+		var i GroupJoinAnswer
+		if err := rows.Scan(
+			&i.GroupID,
+			&i.UserID,
+			&i.QuestionID,
+			&i.Position,
+			&i.Question,
+			&i.Answer,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setJoinRequestRulesAccepted = `-- name: SetJoinRequestRulesAccepted :exec
+UPDATE group_join_requests
+SET rules_accepted_at = CASE WHEN $3::bool THEN CURRENT_TIMESTAMP ELSE NULL END
+WHERE group_id = $1 AND user_id = $2
+`
+
+type SetJoinRequestRulesAcceptedParams struct {
+	GroupID  int64
+	UserID   int64
+	Accepted bool
+}
+
+func (q *Queries) SetJoinRequestRulesAccepted(ctx context.Context, arg SetJoinRequestRulesAcceptedParams) error {
+	_, err := q.db.Exec(ctx, setJoinRequestRulesAccepted, arg.GroupID, arg.UserID, arg.Accepted)
+	return err
+}
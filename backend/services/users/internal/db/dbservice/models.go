@@ -60,6 +60,83 @@ func (e FollowRequestStatus) Valid() bool {
 	return false
 }
 
+type GroupCategory string
+
+const (
+	GroupCategoryOther         GroupCategory = "other"
+	GroupCategoryArts          GroupCategory = "arts"
+	GroupCategoryBusiness      GroupCategory = "business"
+	GroupCategoryEducation     GroupCategory = "education"
+	GroupCategoryEntertainment GroupCategory = "entertainment"
+	GroupCategoryGaming        GroupCategory = "gaming"
+	GroupCategoryHealth        GroupCategory = "health"
+	GroupCategoryHobbies       GroupCategory = "hobbies"
+	GroupCategoryLocal         GroupCategory = "local"
+	GroupCategoryMusic         GroupCategory = "music"
+	GroupCategoryNews          GroupCategory = "news"
+	GroupCategoryScience       GroupCategory = "science"
+	GroupCategorySports        GroupCategory = "sports"
+	GroupCategoryTechnology    GroupCategory = "technology"
+	GroupCategoryTravel        GroupCategory = "travel"
+)
+
+func (e *GroupCategory) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = GroupCategory(s)
+	case string:
+		*e = GroupCategory(s)
+	default:
+		return fmt.Errorf("unsupported scan type for GroupCategory: %T", src)
+	}
+	return nil
+}
+
+type NullGroupCategory struct {
+	GroupCategory GroupCategory
+	Valid         bool // Valid is true if GroupCategory is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullGroupCategory) Scan(value interface{}) error {
+	if value == nil {
+		ns.GroupCategory, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.GroupCategory.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullGroupCategory) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.GroupCategory), nil
+}
+
+func (e GroupCategory) Valid() bool {
+	switch e {
+	case GroupCategoryOther,
+		GroupCategoryArts,
+		GroupCategoryBusiness,
+		GroupCategoryEducation,
+		GroupCategoryEntertainment,
+		GroupCategoryGaming,
+		GroupCategoryHealth,
+		GroupCategoryHobbies,
+		GroupCategoryLocal,
+		GroupCategoryMusic,
+		GroupCategoryNews,
+		GroupCategoryScience,
+		GroupCategorySports,
+		GroupCategoryTechnology,
+		GroupCategoryTravel:
+		return true
+	}
+	return false
+}
+
 type GroupInviteStatus string
 
 const (
@@ -118,8 +195,10 @@ func (e GroupInviteStatus) Valid() bool {
 type GroupRole string
 
 const (
-	GroupRoleMember GroupRole = "member"
-	GroupRoleOwner  GroupRole = "owner"
+	GroupRoleMember    GroupRole = "member"
+	GroupRoleModerator GroupRole = "moderator"
+	GroupRoleAdmin     GroupRole = "admin"
+	GroupRoleOwner     GroupRole = "owner"
 )
 
 func (e *GroupRole) Scan(src interface{}) error {
@@ -160,18 +239,74 @@ func (ns NullGroupRole) Value() (driver.Value, error) {
 func (e GroupRole) Valid() bool {
 	switch e {
 	case GroupRoleMember,
+		GroupRoleModerator,
+		GroupRoleAdmin,
 		GroupRoleOwner:
 		return true
 	}
 	return false
 }
 
+type GroupVisibility string
+
+const (
+	GroupVisibilityPublic  GroupVisibility = "public"
+	GroupVisibilityPrivate GroupVisibility = "private"
+	GroupVisibilitySecret  GroupVisibility = "secret"
+)
+
+func (e *GroupVisibility) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = GroupVisibility(s)
+	case string:
+		*e = GroupVisibility(s)
+	default:
+		return fmt.Errorf("unsupported scan type for GroupVisibility: %T", src)
+	}
+	return nil
+}
+
+type NullGroupVisibility struct {
+	GroupVisibility GroupVisibility
+	Valid           bool // Valid is true if GroupVisibility is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullGroupVisibility) Scan(value interface{}) error {
+	if value == nil {
+		ns.GroupVisibility, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.GroupVisibility.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullGroupVisibility) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.GroupVisibility), nil
+}
+
+func (e GroupVisibility) Valid() bool {
+	switch e {
+	case GroupVisibilityPublic,
+		GroupVisibilityPrivate,
+		GroupVisibilitySecret:
+		return true
+	}
+	return false
+}
+
 type JoinRequestStatus string
 
 const (
 	JoinRequestStatusPending  JoinRequestStatus = "pending"
 	JoinRequestStatusAccepted JoinRequestStatus = "accepted"
 	JoinRequestStatusRejected JoinRequestStatus = "rejected"
+	JoinRequestStatusExpired  JoinRequestStatus = "expired"
 )
 
 func (e *JoinRequestStatus) Scan(src interface{}) error {
@@ -219,12 +354,68 @@ func (e JoinRequestStatus) Valid() bool {
 	return false
 }
 
+type PrivacyAudience string
+
+const (
+	PrivacyAudienceEveryone  PrivacyAudience = "everyone"
+	PrivacyAudienceFollowers PrivacyAudience = "followers"
+	PrivacyAudienceMutuals   PrivacyAudience = "mutuals"
+	PrivacyAudienceNobody    PrivacyAudience = "nobody"
+)
+
+func (e *PrivacyAudience) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PrivacyAudience(s)
+	case string:
+		*e = PrivacyAudience(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PrivacyAudience: %T", src)
+	}
+	return nil
+}
+
+type NullPrivacyAudience struct {
+	PrivacyAudience PrivacyAudience
+	Valid           bool // Valid is true if PrivacyAudience is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPrivacyAudience) Scan(value interface{}) error {
+	if value == nil {
+		ns.PrivacyAudience, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PrivacyAudience.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPrivacyAudience) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PrivacyAudience), nil
+}
+
+func (e PrivacyAudience) Valid() bool {
+	switch e {
+	case PrivacyAudienceEveryone,
+		PrivacyAudienceFollowers,
+		PrivacyAudienceMutuals,
+		PrivacyAudienceNobody:
+		return true
+	}
+	return false
+}
+
 type UserStatus string
 
 const (
-	UserStatusActive  UserStatus = "active"
-	UserStatusBanned  UserStatus = "banned"
-	UserStatusDeleted UserStatus = "deleted"
+	UserStatusActive      UserStatus = "active"
+	UserStatusBanned      UserStatus = "banned"
+	UserStatusDeleted     UserStatus = "deleted"
+	UserStatusDeactivated UserStatus = "deactivated"
 )
 
 func (e *UserStatus) Scan(src interface{}) error {
@@ -266,7 +457,8 @@ func (e UserStatus) Valid() bool {
 	switch e {
 	case UserStatusActive,
 		UserStatusBanned,
-		UserStatusDeleted:
+		UserStatusDeleted,
+		UserStatusDeactivated:
 		return true
 	}
 	return false
@@ -297,15 +489,28 @@ type FollowRequest struct {
 }
 
 type Group struct {
-	ID               int64
-	GroupOwner       int64
-	GroupTitle       string
-	GroupDescription string
-	GroupImageID     int64
-	MembersCount     int32
-	CreatedAt        pgtype.Timestamptz
-	UpdatedAt        pgtype.Timestamptz
-	DeletedAt        pgtype.Timestamptz
+	ID                   int64
+	GroupOwner           int64
+	GroupTitle           string
+	GroupDescription     string
+	GroupImageID         int64
+	MembersCount         int32
+	CreatedAt            pgtype.Timestamptz
+	UpdatedAt            pgtype.Timestamptz
+	DeletedAt            pgtype.Timestamptz
+	ArchivedAt           pgtype.Timestamptz
+	Visibility           GroupVisibility
+	Category             GroupCategory
+	PostsRequireApproval bool
+}
+
+type GroupBan struct {
+	GroupID   int64
+	UserID    int64
+	BannedBy  pgtype.Int8
+	Reason    string
+	ExpiresAt pgtype.Timestamptz
+	CreatedAt pgtype.Timestamptz
 }
 
 type GroupInvite struct {
@@ -318,13 +523,62 @@ type GroupInvite struct {
 	DeletedAt  pgtype.Timestamptz
 }
 
-type GroupJoinRequest struct {
+type GroupInviteLink struct {
+	ID          int64
+	GroupID     int64
+	CreatedBy   int64
+	Nonce       string
+	ExpiresAt   pgtype.Timestamptz
+	MaxUses     pgtype.Int4
+	Uses        int32
+	AutoApprove bool
+	RevokedAt   pgtype.Timestamptz
+	CreatedAt   pgtype.Timestamptz
+}
+
+type GroupInviteLinkUse struct {
+	LinkID int64
+	UserID int64
+	Joined bool
+	UsedAt pgtype.Timestamptz
+}
+
+type GroupJoinAnswer struct {
+	GroupID    int64
+	UserID     int64
+	QuestionID int64
+	Position   int32
+	Question   string
+	Answer     string
+	CreatedAt  pgtype.Timestamptz
+}
+
+type GroupJoinQuestion struct {
+	ID        int64
 	GroupID   int64
-	UserID    int64
-	Status    JoinRequestStatus
+	Position  int32
+	Question  string
+	Required  bool
 	CreatedAt pgtype.Timestamptz
-	UpdatedAt pgtype.Timestamptz
-	DeletedAt pgtype.Timestamptz
+}
+
+type GroupJoinRequest struct {
+	GroupID         int64
+	UserID          int64
+	Status          JoinRequestStatus
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+	DeletedAt       pgtype.Timestamptz
+	RulesAcceptedAt pgtype.Timestamptz
+	RequestedAt     pgtype.Timestamptz
+	RespondedAt     pgtype.Timestamptz
+}
+
+type GroupOwnershipTransfer struct {
+	GroupID    int64
+	FromUserID int64
+	ToUserID   int64
+	CreatedAt  pgtype.Timestamptz
 }
 
 type GroupMember struct {
@@ -336,18 +590,52 @@ type GroupMember struct {
 	DeletedAt pgtype.Timestamptz
 }
 
+type GroupRule struct {
+	ID        int64
+	GroupID   int64
+	Position  int32
+	RuleText  string
+	CreatedAt pgtype.Timestamptz
+}
+
+type GroupTag struct {
+	GroupID int64
+	Tag     string
+}
+
 type User struct {
+	ID                int64
+	Username          string
+	FirstName         string
+	LastName          string
+	DateOfBirth       pgtype.Date
+	AvatarID          int64
+	AboutMe           string
+	ProfilePublic     bool
+	CurrentStatus     UserStatus
+	BanEndsAt         pgtype.Timestamptz
+	CreatedAt         pgtype.Timestamptz
+	UpdatedAt         pgtype.Timestamptz
+	DeletedAt         pgtype.Timestamptz
+	DeactivatedAt     pgtype.Timestamptz
+	UsernameChangedAt pgtype.Timestamptz
+}
+
+type UsernameHistory struct {
 	ID            int64
-	Username      string
-	FirstName     string
-	LastName      string
-	DateOfBirth   pgtype.Date
-	AvatarID      int64
-	AboutMe       string
-	ProfilePublic bool
-	CurrentStatus UserStatus
-	BanEndsAt     pgtype.Timestamptz
-	CreatedAt     pgtype.Timestamptz
-	UpdatedAt     pgtype.Timestamptz
-	DeletedAt     pgtype.Timestamptz
+	UserID        int64
+	OldUsername   string
+	ChangedAt     pgtype.Timestamptz
+	ReservedUntil pgtype.Timestamptz
+}
+
+type UserPrivacySetting struct {
+	UserID               int64
+	WhoCanMessage        PrivacyAudience
+	WhoCanComment        PrivacyAudience
+	WhoCanSeeFollowLists PrivacyAudience
+	WhoCanInviteToGroups PrivacyAudience
+	Discoverable         bool
+	CreatedAt            pgtype.Timestamptz
+	UpdatedAt            pgtype.Timestamptz
 }
@@ -0,0 +1,88 @@
+package dbservice
+
+import (
+	"context"
+)
+
+const deleteOutboxEvent = `-- name: DeleteOutboxEvent :exec
+DELETE FROM event_outbox
+WHERE id = $1
+`
+
+// removes a relayed event
+func (q *Queries) DeleteOutboxEvent(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteOutboxEvent, id)
+	return err
+}
+
+const drainCascadeDeletedGroups = `-- name: DrainCascadeDeletedGroups :many
+DELETE FROM cascade_deleted_groups
+RETURNING group_id
+`
+
+// returns and forgets the groups deleted by the user cascade since the last call
+func (q *Queries) DrainCascadeDeletedGroups(ctx context.Context) ([]int64, error) {
+	rows, err := q.db.Query(ctx, drainCascadeDeletedGroups)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var group_id int64
+		if err := rows.Scan(&group_id); err != nil {
+			return nil, err
+		}
+		items = append(items, group_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOutboxEvents = `-- name: GetOutboxEvents :many
+SELECT id, payload
+FROM event_outbox
+ORDER BY id
+LIMIT $1
+FOR UPDATE SKIP LOCKED
+`
+
+type GetOutboxEventsRow struct {
+	ID      int64
+	Payload []byte
+}
+
+// oldest events first, locked until the end of the transaction
+// so that concurrent relays never send the same event
+func (q *Queries) GetOutboxEvents(ctx context.Context, limit int32) ([]GetOutboxEventsRow, error) {
+	rows, err := q.db.Query(ctx, getOutboxEvents, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetOutboxEventsRow{}
+	for rows.Next() {
+		var i GetOutboxEventsRow
+		if err := rows.Scan(&i.ID, &i.Payload); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertOutboxEvent = `-- name: InsertOutboxEvent :exec
+INSERT INTO event_outbox (payload)
+VALUES ($1)
+`
+
+// queues an event, sent once the surrounding transaction commits
+func (q *Queries) InsertOutboxEvent(ctx context.Context, payload []byte) error {
+	_, err := q.db.Exec(ctx, insertOutboxEvent, payload)
+	return err
+}
@@ -0,0 +1,63 @@
+package dbservice
+
+import (
+	"context"
+)
+
+const upsertOwnershipTransfer = `-- name: UpsertOwnershipTransfer :exec
+INSERT INTO group_ownership_transfers (group_id, from_user_id, to_user_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (group_id)
+DO UPDATE SET
+    from_user_id = EXCLUDED.from_user_id,
+    to_user_id   = EXCLUDED.to_user_id,
+    created_at   = CURRENT_TIMESTAMP
+`
+
+type UpsertOwnershipTransferParams struct {
+	GroupID    int64
+	FromUserID int64
+	ToUserID   int64
+}
+
+// Creates the pending ownership transfer of a group, replacing any previous one.
+func (q *Queries) UpsertOwnershipTransfer(ctx context.Context, arg UpsertOwnershipTransferParams) error {
+	_, err := q.db.Exec(ctx, upsertOwnershipTransfer, arg.GroupID, arg.FromUserID, arg.ToUserID)
+	return err
+}
+
+const getOwnershipTransfer = `-- name: GetOwnershipTransfer :one
+SELECT
+    group_id,
+    from_user_id,
+    to_user_id,
+    created_at
+FROM group_ownership_transfers
+WHERE group_id = $1
+`
+
+func (q *Queries) GetOwnershipTransfer(ctx context.Context, groupID int64) (GroupOwnershipTransfer, error) {
+	row := q.db.QueryRow(ctx, getOwnershipTransfer, groupID)
+	var i GroupOwnershipTransfer
+	err := row.Scan(
+		&i.GroupID,
+		&i.FromUserID,
+		&i.ToUserID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteOwnershipTransfer = `-- name: DeleteOwnershipTransfer :execrows
+DELETE FROM group_ownership_transfers
+WHERE group_id = $1
+`
+
+// returns rows affected, 0 if the group had no pending transfer
+func (q *Queries) DeleteOwnershipTransfer(ctx context.Context, groupID int64) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteOwnershipTransfer, groupID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
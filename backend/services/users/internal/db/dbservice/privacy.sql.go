@@ -0,0 +1,151 @@
+package dbservice
+
+import (
+	"context"
+)
+
+const getPrivacySettings = `-- name: GetPrivacySettings :one
+SELECT
+    p.user_id,
+    p.who_can_message,
+    p.who_can_comment,
+    p.who_can_see_follow_lists,
+    p.who_can_invite_to_groups,
+    p.discoverable,
+    p.created_at,
+    p.updated_at
+FROM user_privacy_settings p
+JOIN users u ON u.id = p.user_id
+WHERE p.user_id = $1
+  AND u.deleted_at IS NULL
+`
+
+func (q *Queries) GetPrivacySettings(ctx context.Context, userID int64) (UserPrivacySetting, error) {
+	row := q.db.QueryRow(ctx, getPrivacySettings, userID)
+	var i UserPrivacySetting
+	err := row.Scan(
+		&i.UserID,
+		&i.WhoCanMessage,
+		&i.WhoCanComment,
+		&i.WhoCanSeeFollowLists,
+		&i.WhoCanInviteToGroups,
+		&i.Discoverable,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertPrivacySettings = `-- name: UpsertPrivacySettings :exec
+INSERT INTO user_privacy_settings (
+    user_id,
+    who_can_message,
+    who_can_comment,
+    who_can_see_follow_lists,
+    who_can_invite_to_groups,
+    discoverable
+)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (user_id)
+DO UPDATE SET
+    who_can_message          = EXCLUDED.who_can_message,
+    who_can_comment          = EXCLUDED.who_can_comment,
+    who_can_see_follow_lists = EXCLUDED.who_can_see_follow_lists,
+    who_can_invite_to_groups = EXCLUDED.who_can_invite_to_groups,
+    discoverable             = EXCLUDED.discoverable
+`
+
+type UpsertPrivacySettingsParams struct {
+	UserID               int64
+	WhoCanMessage        PrivacyAudience
+	WhoCanComment        PrivacyAudience
+	WhoCanSeeFollowLists PrivacyAudience
+	WhoCanInviteToGroups PrivacyAudience
+	Discoverable         bool
+}
+
+func (q *Queries) UpsertPrivacySettings(ctx context.Context, arg UpsertPrivacySettingsParams) error {
+	_, err := q.db.Exec(ctx, upsertPrivacySettings,
+		arg.UserID,
+		arg.WhoCanMessage,
+		arg.WhoCanComment,
+		arg.WhoCanSeeFollowLists,
+		arg.WhoCanInviteToGroups,
+		arg.Discoverable,
+	)
+	return err
+}
+
+const getAllowedInteractions = `-- name: GetAllowedInteractions :one
+SELECT
+    u.current_status = 'active' AND privacy_allows($1, u.id, COALESCE(p.who_can_message, 'everyone'))          AS can_message,
+    u.current_status = 'active' AND privacy_allows($1, u.id, COALESCE(p.who_can_comment, 'everyone'))          AS can_comment,
+    u.current_status = 'active' AND privacy_allows($1, u.id, COALESCE(p.who_can_see_follow_lists, 'everyone')) AS can_see_follow_lists,
+    u.current_status = 'active' AND privacy_allows($1, u.id, COALESCE(p.who_can_invite_to_groups, 'everyone')) AS can_invite_to_groups
+FROM users u
+LEFT JOIN user_privacy_settings p ON p.user_id = u.id
+WHERE u.id = $2
+  AND u.deleted_at IS NULL
+`
+
+type GetAllowedInteractionsParams struct {
+	ActorID  int64
+	TargetID int64
+}
+
+type GetAllowedInteractionsRow struct {
+	CanMessage        bool
+	CanComment        bool
+	CanSeeFollowLists bool
+	CanInviteToGroups bool
+}
+
+// Returns which interactions actor is allowed to have with target,
+// according to target's privacy settings. Inactive targets allow none.
+func (q *Queries) GetAllowedInteractions(ctx context.Context, arg GetAllowedInteractionsParams) (GetAllowedInteractionsRow, error) {
+	row := q.db.QueryRow(ctx, getAllowedInteractions, arg.ActorID, arg.TargetID)
+	var i GetAllowedInteractionsRow
+	err := row.Scan(
+		&i.CanMessage,
+		&i.CanComment,
+		&i.CanSeeFollowLists,
+		&i.CanInviteToGroups,
+	)
+	return i, err
+}
+
+const filterInvitableUsers = `-- name: FilterInvitableUsers :many
+SELECT u.id
+FROM users u
+LEFT JOIN user_privacy_settings p ON p.user_id = u.id
+WHERE u.id = ANY($2::bigint[])
+  AND u.deleted_at IS NULL
+  AND u.current_status = 'active'
+  AND privacy_allows($1, u.id, COALESCE(p.who_can_invite_to_groups, 'everyone'))
+`
+
+type FilterInvitableUsersParams struct {
+	InviterID   int64
+	ReceiverIDs []int64
+}
+
+// Returns the subset of receiver ids that allow inviter to invite them to groups.
+func (q *Queries) FilterInvitableUsers(ctx context.Context, arg FilterInvitableUsersParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, filterInvitableUsers, arg.InviterID, arg.ReceiverIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -10,15 +10,17 @@ const getBatchUsersBasic = `-- name: GetBatchUsersBasic :many
 SELECT
   id,
   username,
-  avatar_id
+  avatar_id,
+  current_status
 FROM users
 WHERE id = ANY($1::bigint[])
 `
 
 type GetBatchUsersBasicRow struct {
-	ID       int64
-	Username string
-	AvatarID int64
+	ID            int64
+	Username      string
+	AvatarID      int64
+	CurrentStatus UserStatus
 }
 
 func (q *Queries) GetBatchUsersBasic(ctx context.Context, dollar_1 []int64) ([]GetBatchUsersBasicRow, error) {
@@ -30,7 +32,12 @@ func (q *Queries) GetBatchUsersBasic(ctx context.Context, dollar_1 []int64) ([]G
 	items := []GetBatchUsersBasicRow{}
 	for rows.Next() {
 		var i GetBatchUsersBasicRow
-		if err := rows.Scan(&i.ID, &i.Username, &i.AvatarID); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.AvatarID,
+			&i.CurrentStatus,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -45,21 +52,28 @@ const getUserBasic = `-- name: GetUserBasic :one
 SELECT
     id,
     username,
-    avatar_id
+    avatar_id,
+    current_status
 FROM users
 WHERE id = $1
 `
 
 type GetUserBasicRow struct {
-	ID       int64
-	Username string
-	AvatarID int64
+	ID            int64
+	Username      string
+	AvatarID      int64
+	CurrentStatus UserStatus
 }
 
 func (q *Queries) GetUserBasic(ctx context.Context, id int64) (GetUserBasicRow, error) {
 	row := q.db.QueryRow(ctx, getUserBasic, id)
 	var i GetUserBasicRow
-	err := row.Scan(&i.ID, &i.Username, &i.AvatarID)
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.AvatarID,
+		&i.CurrentStatus,
+	)
 	return i, err
 }
 
@@ -74,7 +88,8 @@ SELECT
     u.about_me,
     u.profile_public,
     u.created_at,
-    a.email
+    a.email,
+    u.current_status
 FROM users u
 INNER JOIN auth_user a
     ON a.user_id = u.id
@@ -93,6 +108,7 @@ type GetUserProfileRow struct {
 	ProfilePublic bool
 	CreatedAt     pgtype.Timestamptz
 	Email         string
+	CurrentStatus UserStatus
 }
 
 func (q *Queries) GetUserProfile(ctx context.Context, id int64) (GetUserProfileRow, error) {
@@ -109,6 +125,7 @@ func (q *Queries) GetUserProfile(ctx context.Context, id int64) (GetUserProfileR
 		&i.ProfilePublic,
 		&i.CreatedAt,
 		&i.Email,
+		&i.CurrentStatus,
 	)
 	return i, err
 }
@@ -121,6 +138,12 @@ SELECT
     profile_public
 FROM users
 WHERE deleted_at IS NULL
+  AND current_status <> 'deactivated'
+  AND NOT EXISTS (
+        SELECT 1 FROM user_privacy_settings p
+        WHERE p.user_id = users.id
+          AND p.discoverable = FALSE
+      )
   AND (
         CASE
             -- 3+ characters: fuzzy search (pg_trgm)
@@ -251,12 +274,11 @@ func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPassword
 const updateUserProfile = `-- name: UpdateUserProfile :one
 UPDATE users
 SET
-    username      = $2,
-    first_name    = $3,
-    last_name     = $4,
-    date_of_birth = $5,
-    avatar_id        = $6,
-    about_me      = $7,
+    first_name    = $2,
+    last_name     = $3,
+    date_of_birth = $4,
+    avatar_id        = $5,
+    about_me      = $6,
     updated_at    = CURRENT_TIMESTAMP
 WHERE id = $1 AND deleted_at IS NULL
 RETURNING id, username, first_name, last_name, date_of_birth, avatar_id, about_me, profile_public, current_status, ban_ends_at, created_at, updated_at, deleted_at
@@ -264,7 +286,6 @@ RETURNING id, username, first_name, last_name, date_of_birth, avatar_id, about_m
 
 type UpdateUserProfileParams struct {
 	ID          int64
-	Username    string
 	FirstName   string
 	LastName    string
 	DateOfBirth pgtype.Date
@@ -275,7 +296,6 @@ type UpdateUserProfileParams struct {
 func (q *Queries) UpdateUserProfile(ctx context.Context, arg UpdateUserProfileParams) (User, error) {
 	row := q.db.QueryRow(ctx, updateUserProfile,
 		arg.ID,
-		arg.Username,
 		arg.FirstName,
 		arg.LastName,
 		arg.DateOfBirth,
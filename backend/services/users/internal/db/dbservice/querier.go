@@ -2,6 +2,8 @@ package dbservice
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
@@ -10,21 +12,53 @@ type Querier interface {
 	AcceptGroupJoinRequest(ctx context.Context, arg AcceptGroupJoinRequestParams) error
 	AddGroupOwnerAsMember(ctx context.Context, arg AddGroupOwnerAsMemberParams) error
 	AddUserToGroup(ctx context.Context, arg AddUserToGroupParams) error
+	// makes the group read-only, no rows if already archived or deleted
+	ArchiveGroup(ctx context.Context, id int64) (int64, error)
 	AreFollowingEachOther(ctx context.Context, arg AreFollowingEachOtherParams) (AreFollowingEachOtherRow, error)
 	BanUser(ctx context.Context, arg BanUserParams) error
 	CancelGroupInvite(ctx context.Context, arg CancelGroupInviteParams) error
 	CancelGroupJoinRequest(ctx context.Context, arg CancelGroupJoinRequestParams) error
 	CreateGroup(ctx context.Context, arg CreateGroupParams) (int64, error)
+	DeactivateUser(ctx context.Context, id int64) (int64, error)
 	DeclineGroupInvite(ctx context.Context, arg DeclineGroupInviteParams) error
+	DeclinePendingGroupInvites(ctx context.Context, arg DeclinePendingGroupInvitesParams) error
+	// lifts an active ban, returns 0 rows if the user isn't banned
+	DeleteGroupBan(ctx context.Context, arg DeleteGroupBanParams) (int64, error)
+	DeleteGroupJoinAnswers(ctx context.Context, arg DeleteGroupJoinAnswersParams) error
+	DeleteGroupJoinQuestions(ctx context.Context, groupID int64) error
+	DeleteGroupRules(ctx context.Context, groupID int64) error
+	DeleteGroupTags(ctx context.Context, groupID int64) error
+	// removes a relayed event
+	DeleteOutboxEvent(ctx context.Context, id int64) error
+	// returns rows affected, 0 if the group had no pending transfer
+	DeleteOwnershipTransfer(ctx context.Context, groupID int64) (int64, error)
+	// returns and forgets the groups deleted by the user cascade since the last call
+	DrainCascadeDeletedGroups(ctx context.Context) ([]int64, error)
+	// expires invites pending since before the cutoff
+	ExpireGroupInvites(ctx context.Context, cutoff pgtype.Timestamptz) ([]ExpireGroupInvitesRow, error)
+	// expires join requests pending since before the cutoff
+	ExpireGroupJoinRequests(ctx context.Context, cutoff pgtype.Timestamptz) ([]ExpireGroupJoinRequestsRow, error)
+	// Returns the subset of receiver ids that allow inviter to invite them to groups.
+	FilterInvitableUsers(ctx context.Context, arg FilterInvitableUsersParams) ([]int64, error)
+	// Returns the subset of user ids without an active ban from the group.
+	FilterNotBannedFromGroup(ctx context.Context, arg FilterNotBannedFromGroupParams) ([]int64, error)
 	FollowUser(ctx context.Context, arg FollowUserParams) (string, error)
 	GetAllGroups(ctx context.Context, arg GetAllGroupsParams) ([]GetAllGroupsRow, error)
 	GetAllGroupMemberIds(ctx context.Context, arg GetAllGroupMemberIdsParams) ([]GetAllGroupMemberIdsRow, error)
+	// Returns which interactions actor is allowed to have with target,
+	// according to target's privacy settings. Inactive targets allow none.
+	GetAllowedInteractions(ctx context.Context, arg GetAllowedInteractionsParams) (GetAllowedInteractionsRow, error)
 	GetBatchUsersBasic(ctx context.Context, dollar_1 []int64) ([]GetBatchUsersBasicRow, error)
+	// Candidate groups for discovery: listed, active, optionally in one category,
+	// excluding the user's own groups and bans. Members joined since $3 count as growth,
+	// members the user follows drive recommendations.
+	GetDiscoverableGroups(ctx context.Context, arg GetDiscoverableGroupsParams) ([]GetDiscoverableGroupsRow, error)
 	// S1: second-degree follows
 	// S2: shared groups
 	// Combine & score
 	GetFollowSuggestions(ctx context.Context, followerID int64) ([]GetFollowSuggestionsRow, error)
 	GetFollowerCount(ctx context.Context, followingID int64) (int64, error)
+	GetFollowerIds(ctx context.Context, followingID int64) ([]int64, error)
 	GetFollowers(ctx context.Context, arg GetFollowersParams) ([]GetFollowersRow, error)
 	GetFollowersNotInvitedToGroup(ctx context.Context, arg GetFollowersNotInvitedToGroupParams) ([]GetFollowersNotInvitedToGroupRow, error)
 	GetFollowing(ctx context.Context, arg GetFollowingParams) ([]GetFollowingRow, error)
@@ -33,45 +67,117 @@ type Querier interface {
 	GetGroupInfo(ctx context.Context, id int64) (GetGroupInfoRow, error)
 	GetGroupInviterId(ctx context.Context, arg GetGroupInviterIdParams) (int64, error)
 	GetGroupBasicInfo(ctx context.Context, id int64) (GetGroupBasicInfoRow, error)
+	// locks the link until the end of the transaction so concurrent uses can't exceed max uses
+	GetGroupInviteLinkForUpdate(ctx context.Context, id int64) (GroupInviteLink, error)
+	GetGroupVisibility(ctx context.Context, id int64) (GroupVisibility, error)
+	// answers of several applicants at once, for the pending requests page
+	GetGroupJoinAnswers(ctx context.Context, arg GetGroupJoinAnswersParams) ([]GroupJoinAnswer, error)
+	// Join requests answered since $2 and how long accepted ones waited.
+	// Pending counts every request still waiting, whenever it was sent.
+	GetGroupJoinRequestStats(ctx context.Context, arg GetGroupJoinRequestStatsParams) (GetGroupJoinRequestStatsRow, error)
+	// One row per UTC day from $2 to today: members who joined that day and members at its end.
+	// Members who left and rejoined only count from their latest join.
+	GetGroupMemberGrowth(ctx context.Context, arg GetGroupMemberGrowthParams) ([]GetGroupMemberGrowthRow, error)
+	// ids of the active members holding any of the given roles
+	GetGroupMemberIdsWithRoles(ctx context.Context, arg GetGroupMemberIdsWithRolesParams) ([]int64, error)
 	GetGroupMembers(ctx context.Context, arg GetGroupMembersParams) ([]GetGroupMembersRow, error)
+	GetGroupTags(ctx context.Context, groupID int64) ([]string, error)
 	GetMutualFollowers(ctx context.Context, arg GetMutualFollowersParams) ([]GetMutualFollowersRow, error)
+	// oldest events first, locked until the end of the transaction
+	// so that concurrent relays never send the same event
+	GetOutboxEvents(ctx context.Context, limit int32) ([]GetOutboxEventsRow, error)
+	GetOwnershipTransfer(ctx context.Context, groupID int64) (GroupOwnershipTransfer, error)
 	GetPendingGroupJoinRequests(ctx context.Context, arg GetPendingGroupJoinRequestsParams) ([]GetPendingGroupJoinRequestsRow, error)
 	GetPendingGroupJoinRequestsCount(ctx context.Context, arg GetPendingGroupJoinRequestsCountParams) (int64, error)
+	GetPrivacySettings(ctx context.Context, userID int64) (UserPrivacySetting, error)
+	GetTagsForGroups(ctx context.Context, groupIds []int64) ([]GroupTag, error)
 	GetUserBasic(ctx context.Context, id int64) (GetUserBasicRow, error)
 	GetUserForLogin(ctx context.Context, arg GetUserForLoginParams) (GetUserForLoginRow, error)
+	GetUserGroupIds(ctx context.Context, userID int64) ([]int64, error)
 	GetUserGroupRole(ctx context.Context, arg GetUserGroupRoleParams) (NullGroupRole, error)
 	GetUserGroups(ctx context.Context, arg GetUserGroupsParams) ([]GetUserGroupsRow, error)
 	GetUserPassword(ctx context.Context, userID int64) (string, error)
 	GetUserProfile(ctx context.Context, id int64) (GetUserProfileRow, error)
+	GetUsernameChangeInfo(ctx context.Context, id int64) (GetUsernameChangeInfoRow, error)
+	InsertGroupInviteLink(ctx context.Context, arg InsertGroupInviteLinkParams) (GroupInviteLink, error)
+	InsertGroupJoinAnswer(ctx context.Context, arg InsertGroupJoinAnswerParams) error
+	InsertGroupJoinQuestion(ctx context.Context, arg InsertGroupJoinQuestionParams) error
+	InsertGroupRule(ctx context.Context, arg InsertGroupRuleParams) error
+	InsertGroupTags(ctx context.Context, arg InsertGroupTagsParams) error
 	InsertNewUser(ctx context.Context, arg InsertNewUserParams) (int64, error)
 	InsertNewUserAuth(ctx context.Context, arg InsertNewUserAuthParams) error
+	// queues an event, sent once the surrounding transaction commits
+	InsertOutboxEvent(ctx context.Context, payload []byte) error
+	InsertUsernameHistory(ctx context.Context, arg InsertUsernameHistoryParams) error
+	IncrementGroupInviteLinkUses(ctx context.Context, id int64) error
 	IsFollowRequestPending(ctx context.Context, arg IsFollowRequestPendingParams) (bool, error)
 	IsFollowing(ctx context.Context, arg IsFollowingParams) (bool, error)
+	// no rows if the group does not exist or is deleted
+	IsGroupArchived(ctx context.Context, id int64) (bool, error)
 	IsGroupMembershipPending(ctx context.Context, arg IsGroupMembershipPendingParams) (IsGroupMembershipPendingRow, error)
+	IsUserBannedFromGroup(ctx context.Context, arg IsUserBannedFromGroupParams) (bool, error)
 	IsUserGroupMember(ctx context.Context, arg IsUserGroupMemberParams) (bool, error)
 	IsUserGroupOwner(ctx context.Context, arg IsUserGroupOwnerParams) (bool, error)
+	// Returns whether the username is a former handle of another user that is still reserved.
+	IsUsernameReserved(ctx context.Context, arg IsUsernameReservedParams) (bool, error)
+	// Returns whether another existing user currently uses the username.
+	IsUsernameTaken(ctx context.Context, arg IsUsernameTakenParams) (bool, error)
 	LeaveGroup(ctx context.Context, arg LeaveGroupParams) error
+	// active bans of the group, newest first
+	ListGroupBans(ctx context.Context, arg ListGroupBansParams) ([]ListGroupBansRow, error)
+	// all links of the group, newest first, with usage stats
+	ListGroupInviteLinks(ctx context.Context, groupID int64) ([]ListGroupInviteLinksRow, error)
+	ListGroupJoinQuestions(ctx context.Context, groupID int64) ([]GroupJoinQuestion, error)
+	ListGroupRules(ctx context.Context, groupID int64) ([]GroupRule, error)
+	ReactivateUser(ctx context.Context, id int64) error
 	RejectFollowRequest(ctx context.Context, arg RejectFollowRequestParams) error
 	RejectGroupJoinRequest(ctx context.Context, arg RejectGroupJoinRequestParams) error
+	RejectPendingGroupJoinRequest(ctx context.Context, arg RejectPendingGroupJoinRequestParams) error
 	RemoveImages(ctx context.Context, arg []int64) error
+	// Maps a current or reserved former handle to its user.
+	// Current handles take precedence over former ones.
+	ResolveHandle(ctx context.Context, handle string) (ResolveHandleRow, error)
+	// no rows if the link doesn't belong to the group or is already revoked
+	RevokeGroupInviteLink(ctx context.Context, arg RevokeGroupInviteLinkParams) (int64, error)
 	SearchGroups(ctx context.Context, arg SearchGroupsParams) ([]SearchGroupsRow, error)
 	SearchUsers(ctx context.Context, arg SearchUsersParams) ([]SearchUsersRow, error)
 	SendGroupInvites(ctx context.Context, arg SendGroupInvitesParams) error
 	SendGroupJoinRequest(ctx context.Context, arg SendGroupJoinRequestParams) error
-	SoftDeleteGroup(ctx context.Context, id int64) error
+	// no rows if the group doesn't exist or is deleted
+	SetGroupPostsRequireApproval(ctx context.Context, arg SetGroupPostsRequireApprovalParams) (int64, error)
+	SetJoinRequestRulesAccepted(ctx context.Context, arg SetJoinRequestRulesAcceptedParams) error
+	// soft deletes the group, trg_soft_delete_group revokes its memberships,
+	// pending invites, join requests and ownership offers.
+	SoftDeleteGroup(ctx context.Context, id int64) (int64, error)
 	SoftDeleteUser(ctx context.Context, id int64) error
-	// owners cannot leave the group (transfer ownership logic? TODO)
+	// makes new owner (an active member) the group owner, previous owner becomes admin.
+	// clears any pending ownership transfer of the group.
+	// errors if new owner is not a member
 	TransferOwnership(ctx context.Context, arg TransferOwnershipParams) error
 	UnbanUser(ctx context.Context, id int64) error
 	//1: follower_id
 	//2: following_id
 	// returns followed or requested depending on target's privacy settings
 	UnfollowUser(ctx context.Context, arg UnfollowUserParams) (string, error)
+	// no rows if the group is not archived or deleted
+	UnarchiveGroup(ctx context.Context, id int64) (int64, error)
 	UpdateGroup(ctx context.Context, arg UpdateGroupParams) (int64, error)
+	// Sets the role of an active, non owner group member.
+	// Ownership can't be granted through this query.
+	//
+	// returns rows affected, 0 if the user is not a member or is the owner
+	UpdateGroupMemberRole(ctx context.Context, arg UpdateGroupMemberRoleParams) (int64, error)
 	UpdateProfilePrivacy(ctx context.Context, arg UpdateProfilePrivacyParams) error
 	UpdateUserEmail(ctx context.Context, arg UpdateUserEmailParams) error
 	UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error
 	UpdateUserProfile(ctx context.Context, arg UpdateUserProfileParams) (User, error)
+	UpdateUsername(ctx context.Context, arg UpdateUsernameParams) (int64, error)
+	// banning an already banned user replaces the previous ban
+	UpsertGroupBan(ctx context.Context, arg UpsertGroupBanParams) error
+	UpsertGroupInviteLinkUse(ctx context.Context, arg UpsertGroupInviteLinkUseParams) error
+	// Creates the pending ownership transfer of a group, replacing any previous one.
+	UpsertOwnershipTransfer(ctx context.Context, arg UpsertOwnershipTransferParams) error
+	UpsertPrivacySettings(ctx context.Context, arg UpsertPrivacySettingsParams) error
 	UserGroupCountsPerRole(ctx context.Context, groupOwner int64) (UserGroupCountsPerRoleRow, error)
 }
 
@@ -0,0 +1,80 @@
+package dbservice
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const expireGroupInvites = `-- name: ExpireGroupInvites :many
+UPDATE group_invites
+SET status = 'expired'
+WHERE status = 'pending'
+  AND deleted_at IS NULL
+  AND COALESCE(updated_at, created_at) < $1
+RETURNING group_id, receiver_id
+`
+
+type ExpireGroupInvitesRow struct {
+	GroupID    int64
+	ReceiverID int64
+}
+
+// expires invites pending since before the cutoff
+func (q *Queries) ExpireGroupInvites(ctx context.Context, cutoff pgtype.Timestamptz) ([]ExpireGroupInvitesRow, error) {
+	rows, err := q.db.Query(ctx, expireGroupInvites, cutoff)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ExpireGroupInvitesRow{}
+	for rows.Next() {
+		var i ExpireGroupInvitesRow
+		if err := rows.Scan(&i.GroupID, &i.ReceiverID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const expireGroupJoinRequests = `-- name: ExpireGroupJoinRequests :many
+UPDATE group_join_requests gjr
+SET status = 'expired'
+FROM groups g
+WHERE g.id = gjr.group_id
+  AND gjr.status = 'pending'
+  AND gjr.deleted_at IS NULL
+  AND COALESCE(gjr.updated_at, gjr.created_at) < $1
+RETURNING gjr.group_id, gjr.user_id, g.group_owner
+`
+
+type ExpireGroupJoinRequestsRow struct {
+	GroupID    int64
+	UserID     int64
+	GroupOwner int64
+}
+
+// expires join requests pending since before the cutoff
+func (q *Queries) ExpireGroupJoinRequests(ctx context.Context, cutoff pgtype.Timestamptz) ([]ExpireGroupJoinRequestsRow, error) {
+	rows, err := q.db.Query(ctx, expireGroupJoinRequests, cutoff)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ExpireGroupJoinRequestsRow{}
+	for rows.Next() {
+		var i ExpireGroupJoinRequestsRow
+		if err := rows.Scan(&i.GroupID, &i.UserID, &i.GroupOwner); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
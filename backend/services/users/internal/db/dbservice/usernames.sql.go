@@ -0,0 +1,159 @@
+package dbservice
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getUsernameChangeInfo = `-- name: GetUsernameChangeInfo :one
+SELECT
+    username,
+    username_changed_at
+FROM users
+WHERE id = $1
+  AND deleted_at IS NULL
+`
+
+type GetUsernameChangeInfoRow struct {
+	Username          string
+	UsernameChangedAt pgtype.Timestamptz
+}
+
+func (q *Queries) GetUsernameChangeInfo(ctx context.Context, id int64) (GetUsernameChangeInfoRow, error) {
+	row := q.db.QueryRow(ctx, getUsernameChangeInfo, id)
+	var i GetUsernameChangeInfoRow
+	err := row.Scan(&i.Username, &i.UsernameChangedAt)
+	return i, err
+}
+
+const isUsernameTaken = `-- name: IsUsernameTaken :one
+SELECT EXISTS (
+    SELECT 1 FROM users u
+    WHERE u.username = $1
+      AND u.id <> $2
+      AND u.deleted_at IS NULL
+)
+`
+
+type IsUsernameTakenParams struct {
+	Username string
+	UserID   int64
+}
+
+// Returns whether another existing user currently uses the username.
+func (q *Queries) IsUsernameTaken(ctx context.Context, arg IsUsernameTakenParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isUsernameTaken, arg.Username, arg.UserID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const isUsernameReserved = `-- name: IsUsernameReserved :one
+SELECT EXISTS (
+    SELECT 1 FROM username_history h
+    WHERE h.old_username = $1
+      AND h.user_id <> $2
+      AND h.reserved_until > CURRENT_TIMESTAMP
+)
+`
+
+type IsUsernameReservedParams struct {
+	Username string
+	UserID   int64
+}
+
+// Returns whether the username is a former handle of another user that is still reserved.
+func (q *Queries) IsUsernameReserved(ctx context.Context, arg IsUsernameReservedParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isUsernameReserved, arg.Username, arg.UserID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const updateUsername = `-- name: UpdateUsername :execrows
+UPDATE users
+SET
+    username = $2,
+    username_changed_at = CURRENT_TIMESTAMP,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+  AND deleted_at IS NULL
+`
+
+type UpdateUsernameParams struct {
+	ID       int64
+	Username string
+}
+
+func (q *Queries) UpdateUsername(ctx context.Context, arg UpdateUsernameParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateUsername, arg.ID, arg.Username)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const insertUsernameHistory = `-- name: InsertUsernameHistory :exec
+INSERT INTO username_history (user_id, old_username, reserved_until)
+VALUES ($1, $2, $3)
+`
+
+type InsertUsernameHistoryParams struct {
+	UserID        int64
+	OldUsername   string
+	ReservedUntil pgtype.Timestamptz
+}
+
+func (q *Queries) InsertUsernameHistory(ctx context.Context, arg InsertUsernameHistoryParams) error {
+	_, err := q.db.Exec(ctx, insertUsernameHistory, arg.UserID, arg.OldUsername, arg.ReservedUntil)
+	return err
+}
+
+const resolveHandle = `-- name: ResolveHandle :one
+SELECT id, username, is_current
+FROM (
+    -- current handles
+    SELECT
+        u.id,
+        u.username,
+        TRUE AS is_current,
+        u.created_at AS ranked_at
+    FROM users u
+    WHERE u.username = $1
+      AND u.deleted_at IS NULL
+      AND u.current_status = 'active'
+
+    UNION ALL
+
+    -- reserved former handles
+    SELECT
+        u.id,
+        u.username,
+        FALSE AS is_current,
+        h.changed_at AS ranked_at
+    FROM username_history h
+    JOIN users u ON u.id = h.user_id
+    WHERE h.old_username = $1
+      AND h.reserved_until > CURRENT_TIMESTAMP
+      AND u.deleted_at IS NULL
+      AND u.current_status = 'active'
+) handles
+ORDER BY is_current DESC, ranked_at ASC
+LIMIT 1
+`
+
+type ResolveHandleRow struct {
+	ID        int64
+	Username  string
+	IsCurrent bool
+}
+
+// Maps a current or reserved former handle to its user.
+// Current handles take precedence over former ones.
+func (q *Queries) ResolveHandle(ctx context.Context, handle string) (ResolveHandleRow, error) {
+	row := q.db.QueryRow(ctx, resolveHandle, handle)
+	var i ResolveHandleRow
+	err := row.Scan(&i.ID, &i.Username, &i.IsCurrent)
+	return i, err
+}
@@ -0,0 +1,86 @@
+-----------------------------------------
+-- Granular privacy settings
+-----------------------------------------
+CREATE TYPE privacy_audience AS ENUM ('everyone','followers','mutuals','nobody');
+
+CREATE TABLE IF NOT EXISTS user_privacy_settings (
+    user_id BIGINT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    who_can_message privacy_audience NOT NULL DEFAULT 'everyone',
+    who_can_comment privacy_audience NOT NULL DEFAULT 'everyone',
+    who_can_see_follow_lists privacy_audience NOT NULL DEFAULT 'everyone',
+    who_can_invite_to_groups privacy_audience NOT NULL DEFAULT 'everyone',
+    discoverable BOOLEAN NOT NULL DEFAULT TRUE, -- appears in search and follow suggestions
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_privacy_not_discoverable
+ON user_privacy_settings(user_id)
+WHERE discoverable = FALSE;
+
+CREATE TRIGGER trg_user_privacy_settings_updated_at
+BEFORE UPDATE ON user_privacy_settings
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+-- Backfill defaults for existing users
+INSERT INTO user_privacy_settings (user_id)
+SELECT id FROM users
+ON CONFLICT (user_id) DO NOTHING;
+
+
+-----------------------------------------
+-- Create default privacy settings for every new user
+-----------------------------------------
+CREATE OR REPLACE FUNCTION add_default_privacy_settings()
+RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO user_privacy_settings (user_id)
+    VALUES (NEW.id)
+    ON CONFLICT (user_id) DO NOTHING;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_add_default_privacy_settings
+AFTER INSERT ON users
+FOR EACH ROW
+EXECUTE FUNCTION add_default_privacy_settings();
+
+
+-----------------------------------------
+-- Returns whether actor falls within the given audience of target
+--   everyone:  always
+--   followers: actor follows target
+--   mutuals:   actor and target follow each other
+--   nobody:    never (except self)
+-----------------------------------------
+CREATE OR REPLACE FUNCTION privacy_allows(
+    p_actor BIGINT,
+    p_target BIGINT,
+    p_audience privacy_audience
+)
+RETURNS BOOLEAN AS $$
+    SELECT p_actor = p_target OR CASE p_audience
+        WHEN 'everyone' THEN TRUE
+        WHEN 'nobody' THEN FALSE
+        WHEN 'followers' THEN EXISTS (
+            SELECT 1 FROM follows f
+            WHERE f.follower_id = p_actor
+              AND f.following_id = p_target
+              AND f.deleted_at IS NULL
+        )
+        WHEN 'mutuals' THEN EXISTS (
+            SELECT 1 FROM follows f
+            WHERE f.follower_id = p_actor
+              AND f.following_id = p_target
+              AND f.deleted_at IS NULL
+        ) AND EXISTS (
+            SELECT 1 FROM follows f
+            WHERE f.follower_id = p_target
+              AND f.following_id = p_actor
+              AND f.deleted_at IS NULL
+        )
+        ELSE FALSE
+    END;
+$$ LANGUAGE sql STABLE;
@@ -0,0 +1,21 @@
+-----------------------------------------
+-- Temporary account deactivation
+-----------------------------------------
+-- A deactivated user is hidden from everyone else until their next login,
+-- which sets them back to 'active'.
+ALTER TYPE user_status ADD VALUE IF NOT EXISTS 'deactivated';
+
+ALTER TABLE users
+ADD COLUMN IF NOT EXISTS deactivated_at TIMESTAMPTZ;
+
+-----------------------------------------
+-- Event outbox
+-----------------------------------------
+-- Events other services act on are written here in the transaction of the change
+-- they describe, then relayed to kafka by a worker and deleted once sent.
+-- So an event is never sent for a change that rolled back, nor lost to a kafka outage.
+CREATE TABLE IF NOT EXISTS event_outbox (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    payload BYTEA NOT NULL, -- marshaled notifications.NotificationEvent
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,21 @@
+-----------------------------------------
+-- Username changes
+-----------------------------------------
+ALTER TABLE users
+ADD COLUMN IF NOT EXISTS username_changed_at TIMESTAMPTZ;
+
+-- Previous handles stay reserved for their former owner until reserved_until,
+-- so that links using the old handle can be redirected.
+CREATE TABLE IF NOT EXISTS username_history (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    old_username CITEXT COLLATE case_insensitive_ai NOT NULL,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    reserved_until TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_username_history_old_username
+ON username_history(old_username, reserved_until DESC);
+
+CREATE INDEX IF NOT EXISTS idx_username_history_user
+ON username_history(user_id);
@@ -0,0 +1,11 @@
+-----------------------------------------
+-- Group admins and moderators
+-----------------------------------------
+-- What each role is allowed to do is defined in the users service.
+-- Roles are ranked owner > admin > moderator > member.
+ALTER TYPE group_role ADD VALUE IF NOT EXISTS 'admin' BEFORE 'owner';
+ALTER TYPE group_role ADD VALUE IF NOT EXISTS 'moderator' BEFORE 'admin';
+
+CREATE INDEX IF NOT EXISTS idx_group_members_staff
+ON group_members(group_id, role)
+WHERE role <> 'member' AND deleted_at IS NULL;
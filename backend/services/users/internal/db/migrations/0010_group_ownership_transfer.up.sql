@@ -0,0 +1,157 @@
+-----------------------------------------
+-- Pending group ownership transfers
+-----------------------------------------
+-- An owner offers ownership to a member, who must accept it.
+-- At most one pending offer per group, a new offer replaces the previous one.
+CREATE TABLE IF NOT EXISTS group_ownership_transfers (
+    group_id BIGINT PRIMARY KEY REFERENCES groups(id) ON DELETE CASCADE,
+    from_user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    to_user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_group_ownership_transfers_to
+ON group_ownership_transfers(to_user_id);
+
+
+-----------------------------------------
+-- Makes a member the owner of a group
+-- The previous owner stays in the group as admin and can then leave.
+-----------------------------------------
+CREATE OR REPLACE FUNCTION transfer_group_ownership(
+    p_group_id BIGINT,
+    p_new_owner BIGINT
+)
+RETURNS VOID AS $$
+BEGIN
+    -- demote first, only one owner is allowed per group
+    UPDATE group_members
+    SET role = 'admin'
+    WHERE group_id = p_group_id
+      AND role = 'owner'
+      AND deleted_at IS NULL;
+
+    UPDATE group_members
+    SET role = 'owner'
+    WHERE group_id = p_group_id
+      AND user_id = p_new_owner
+      AND deleted_at IS NULL;
+
+    IF NOT FOUND THEN
+        RAISE EXCEPTION 'New owner % is not a member of group %', p_new_owner, p_group_id;
+    END IF;
+
+    UPDATE groups
+    SET group_owner = p_new_owner
+    WHERE id = p_group_id;
+
+    DELETE FROM group_ownership_transfers
+    WHERE group_id = p_group_id;
+END;
+$$ LANGUAGE plpgsql;
+
+
+-----------------------------------------
+-- Owners of deleted groups no longer block leaving
+-----------------------------------------
+CREATE OR REPLACE FUNCTION prevent_owner_leave()
+RETURNS TRIGGER AS $$
+DECLARE
+    group_active BOOLEAN;
+BEGIN
+    SELECT deleted_at IS NULL INTO group_active
+    FROM groups
+    WHERE id = OLD.group_id;
+
+    -- Prevent soft-deleting the owner
+    IF TG_OP = 'UPDATE'
+       AND OLD.role = 'owner'
+       AND OLD.deleted_at IS NULL
+       AND NEW.deleted_at IS NOT NULL
+       AND group_active
+    THEN
+        RAISE EXCEPTION 'Group owner cannot leave the group. Transfer ownership first.';
+    END IF;
+
+    -- Prevent hard-deleting the owner
+    IF TG_OP = 'DELETE' AND OLD.role = 'owner' AND OLD.deleted_at IS NULL AND group_active THEN
+        RAISE EXCEPTION 'Group owner cannot be removed. Transfer ownership first.';
+    END IF;
+
+    IF TG_OP = 'DELETE' THEN
+        RETURN OLD;
+    ELSE
+        RETURN NEW;
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
+
+
+-----------------------------------------
+-- Soft delete cascade for users
+-- Owned groups pass to the longest-standing admin, or else the
+-- longest-standing member. Deactivated members are passed over.
+-- Groups without a successor are deleted.
+-----------------------------------------
+CREATE OR REPLACE FUNCTION soft_delete_user_cascade()
+RETURNS TRIGGER AS $$
+DECLARE
+    owned RECORD;
+    successor BIGINT;
+BEGIN
+    FOR owned IN
+        SELECT id FROM groups
+        WHERE group_owner = OLD.id
+          AND deleted_at IS NULL
+    LOOP
+        SELECT gm.user_id INTO successor
+        FROM group_members gm
+        JOIN users u ON u.id = gm.user_id
+        WHERE gm.group_id = owned.id
+          AND gm.user_id <> OLD.id
+          AND gm.deleted_at IS NULL
+          AND u.deleted_at IS NULL
+          AND u.current_status <> 'deactivated'
+        ORDER BY (gm.role = 'admin') DESC, gm.joined_at ASC, gm.user_id ASC
+        LIMIT 1;
+
+        IF successor IS NOT NULL THEN
+            PERFORM transfer_group_ownership(owned.id, successor);
+        ELSE
+            UPDATE groups
+            SET deleted_at = CURRENT_TIMESTAMP
+            WHERE id = owned.id;
+        END IF;
+    END LOOP;
+
+    -- Pending ownership offers from or to the user are void
+    DELETE FROM group_ownership_transfers
+    WHERE from_user_id = OLD.id OR to_user_id = OLD.id;
+
+    -- Hard-delete follows (CASCADE handles this automatically)
+    DELETE FROM follows
+    WHERE follower_id = OLD.id OR following_id = OLD.id;
+
+    -- Hard-delete follow requests (CASCADE handles this automatically)
+    DELETE FROM follow_requests
+    WHERE requester_id = OLD.id OR target_id = OLD.id;
+
+    -- Soft-delete group memberships (preserve history)
+    UPDATE group_members
+    SET deleted_at = CURRENT_TIMESTAMP
+    WHERE user_id = OLD.id AND deleted_at IS NULL;
+
+    -- Soft-delete group join requests (preserve history)
+    UPDATE group_join_requests
+    SET deleted_at = CURRENT_TIMESTAMP
+    WHERE user_id = OLD.id AND deleted_at IS NULL;
+
+    -- Soft-delete group invites (preserve history)
+    UPDATE group_invites
+    SET deleted_at = CURRENT_TIMESTAMP
+    WHERE (sender_id = OLD.id OR receiver_id = OLD.id)
+    AND deleted_at IS NULL;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,126 @@
+-----------------------------------------
+-- Archived groups
+-----------------------------------------
+-- An archived group is read-only: no new posts, events,
+-- join requests, invites or info edits until unarchived.
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS archived_at TIMESTAMPTZ;
+
+
+-----------------------------------------
+-- Soft delete cascade for groups
+-- Memberships, pending invites, join requests and ownership
+-- offers of a deleted group are revoked (history is preserved).
+-----------------------------------------
+CREATE OR REPLACE FUNCTION soft_delete_group_cascade()
+RETURNS TRIGGER AS $$
+BEGIN
+    -- prevent_owner_leave lets the owner go once the group is deleted
+    UPDATE group_members
+    SET deleted_at = CURRENT_TIMESTAMP
+    WHERE group_id = NEW.id AND deleted_at IS NULL;
+
+    UPDATE group_join_requests
+    SET deleted_at = CURRENT_TIMESTAMP
+    WHERE group_id = NEW.id AND deleted_at IS NULL;
+
+    UPDATE group_invites
+    SET deleted_at = CURRENT_TIMESTAMP
+    WHERE group_id = NEW.id AND deleted_at IS NULL;
+
+    DELETE FROM group_ownership_transfers
+    WHERE group_id = NEW.id;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_soft_delete_group
+AFTER UPDATE ON groups
+FOR EACH ROW
+WHEN (OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL)
+EXECUTE FUNCTION soft_delete_group_cascade();
+
+
+-----------------------------------------
+-- Groups deleted by the user cascade
+-----------------------------------------
+-- The trigger can't build events itself, so it records the groups it deletes
+-- and the outbox worker turns them into GroupDeleted events.
+CREATE TABLE IF NOT EXISTS cascade_deleted_groups (
+    group_id BIGINT PRIMARY KEY REFERENCES groups(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+
+-----------------------------------------
+-- Soft delete cascade for users
+-- Owned groups pass to the longest-standing admin, or else the
+-- longest-standing member. Deactivated members are passed over.
+-- Groups without a successor are deleted.
+-----------------------------------------
+CREATE OR REPLACE FUNCTION soft_delete_user_cascade()
+RETURNS TRIGGER AS $$
+DECLARE
+    owned RECORD;
+    successor BIGINT;
+BEGIN
+    FOR owned IN
+        SELECT id FROM groups
+        WHERE group_owner = OLD.id
+          AND deleted_at IS NULL
+    LOOP
+        SELECT gm.user_id INTO successor
+        FROM group_members gm
+        JOIN users u ON u.id = gm.user_id
+        WHERE gm.group_id = owned.id
+          AND gm.user_id <> OLD.id
+          AND gm.deleted_at IS NULL
+          AND u.deleted_at IS NULL
+          AND u.current_status <> 'deactivated'
+        ORDER BY (gm.role = 'admin') DESC, gm.joined_at ASC, gm.user_id ASC
+        LIMIT 1;
+
+        IF successor IS NOT NULL THEN
+            PERFORM transfer_group_ownership(owned.id, successor);
+        ELSE
+            UPDATE groups
+            SET deleted_at = CURRENT_TIMESTAMP
+            WHERE id = owned.id;
+
+            INSERT INTO cascade_deleted_groups (group_id)
+            VALUES (owned.id)
+            ON CONFLICT DO NOTHING;
+        END IF;
+    END LOOP;
+
+    -- Pending ownership offers from or to the user are void
+    DELETE FROM group_ownership_transfers
+    WHERE from_user_id = OLD.id OR to_user_id = OLD.id;
+
+    -- Hard-delete follows (CASCADE handles this automatically)
+    DELETE FROM follows
+    WHERE follower_id = OLD.id OR following_id = OLD.id;
+
+    -- Hard-delete follow requests (CASCADE handles this automatically)
+    DELETE FROM follow_requests
+    WHERE requester_id = OLD.id OR target_id = OLD.id;
+
+    -- Soft-delete group memberships (preserve history)
+    UPDATE group_members
+    SET deleted_at = CURRENT_TIMESTAMP
+    WHERE user_id = OLD.id AND deleted_at IS NULL;
+
+    -- Soft-delete group join requests (preserve history)
+    UPDATE group_join_requests
+    SET deleted_at = CURRENT_TIMESTAMP
+    WHERE user_id = OLD.id AND deleted_at IS NULL;
+
+    -- Soft-delete group invites (preserve history)
+    UPDATE group_invites
+    SET deleted_at = CURRENT_TIMESTAMP
+    WHERE (sender_id = OLD.id OR receiver_id = OLD.id)
+    AND deleted_at IS NULL;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,50 @@
+-----------------------------------------
+-- Shareable group invite links
+-----------------------------------------
+-- Links are handed out as signed tokens built from the link id and nonce.
+-- NULL expires_at or max_uses means no limit.
+CREATE TABLE IF NOT EXISTS group_invite_links (
+    id BIGSERIAL PRIMARY KEY,
+    group_id BIGINT NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    created_by BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    nonce TEXT NOT NULL,
+    expires_at TIMESTAMPTZ,
+    max_uses INTEGER CHECK (max_uses IS NULL OR max_uses > 0),
+    uses INTEGER NOT NULL DEFAULT 0,
+    auto_approve BOOLEAN NOT NULL DEFAULT FALSE,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_group_invite_links_group
+ON group_invite_links(group_id, created_at DESC);
+
+-- One row per user per link, joined is false when the use created a join request
+CREATE TABLE IF NOT EXISTS group_invite_link_uses (
+    link_id BIGINT NOT NULL REFERENCES group_invite_links(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    joined BOOLEAN NOT NULL,
+    used_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (link_id, user_id)
+);
+
+
+-----------------------------------------
+-- Links of deleted groups are revoked
+-----------------------------------------
+CREATE OR REPLACE FUNCTION revoke_group_invite_links()
+RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE group_invite_links
+    SET revoked_at = CURRENT_TIMESTAMP
+    WHERE group_id = NEW.id AND revoked_at IS NULL;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_revoke_group_invite_links
+AFTER UPDATE ON groups
+FOR EACH ROW
+WHEN (OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL)
+EXECUTE FUNCTION revoke_group_invite_links();
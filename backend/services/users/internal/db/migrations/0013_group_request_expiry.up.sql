@@ -0,0 +1,15 @@
+-----------------------------------------
+-- Expiry of stale group invites and join requests
+-----------------------------------------
+-- Pending invites and join requests older than the configured TTL are
+-- expired by a background job in the users service. The TTL counts from
+-- the last time the row became pending (updated_at, else created_at).
+ALTER TYPE join_request_status ADD VALUE IF NOT EXISTS 'expired';
+
+CREATE INDEX IF NOT EXISTS idx_group_invites_pending_since
+ON group_invites ((COALESCE(updated_at, created_at)))
+WHERE status = 'pending' AND deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_group_join_requests_pending_since
+ON group_join_requests ((COALESCE(updated_at, created_at)))
+WHERE status = 'pending' AND deleted_at IS NULL;
@@ -0,0 +1,11 @@
+-----------------------------------------
+-- Group visibility
+-----------------------------------------
+-- public:  listed and searchable, posts readable by anyone
+-- private: listed and searchable, posts readable by members only
+-- secret:  hidden from listings and search, joined by invite only
+-- Public groups are mirrored in posts service (public_groups).
+CREATE TYPE group_visibility AS ENUM ('public', 'private', 'secret');
+
+ALTER TABLE groups
+ADD COLUMN IF NOT EXISTS visibility group_visibility NOT NULL DEFAULT 'private';
@@ -0,0 +1,41 @@
+-----------------------------------------
+-- Group rules and join questionnaire
+-----------------------------------------
+-- Applicants must agree to the rules and answer the required
+-- questions of a group before their join request is created.
+CREATE TABLE IF NOT EXISTS group_rules (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    group_id BIGINT NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    rule_text TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_group_rules_group ON group_rules(group_id, position);
+
+CREATE TABLE IF NOT EXISTS group_join_questions (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    group_id BIGINT NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    question TEXT NOT NULL,
+    required BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_group_join_questions_group ON group_join_questions(group_id, position);
+
+-- Answers keep the question as it was asked, so questions can be
+-- replaced while requests are pending without losing context.
+CREATE TABLE IF NOT EXISTS group_join_answers (
+    group_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    question_id BIGINT NOT NULL,
+    position INT NOT NULL,
+    question TEXT NOT NULL,
+    answer TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (group_id, user_id, question_id),
+    FOREIGN KEY (group_id, user_id) REFERENCES group_join_requests(group_id, user_id) ON DELETE CASCADE
+);
+
+ALTER TABLE group_join_requests ADD COLUMN IF NOT EXISTS rules_accepted_at TIMESTAMPTZ;
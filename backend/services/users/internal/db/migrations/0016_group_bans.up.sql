@@ -0,0 +1,99 @@
+-----------------------------------------
+-- Group ban list
+-----------------------------------------
+-- Banning removes the membership and keeps the user from requesting,
+-- being invited or joining through a link until the ban is lifted.
+-- NULL expires_at means the ban is permanent.
+CREATE TABLE IF NOT EXISTS group_bans (
+    group_id BIGINT NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    banned_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (group_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_group_bans_group
+ON group_bans(group_id, created_at DESC);
+
+
+-----------------------------------------
+-- Soft delete cascade for users
+-- Owned groups pass to the longest-standing admin, or else the
+-- longest-standing member. Banned and deactivated members are passed over.
+-- Groups without a successor are deleted.
+-----------------------------------------
+CREATE OR REPLACE FUNCTION soft_delete_user_cascade()
+RETURNS TRIGGER AS $$
+DECLARE
+    owned RECORD;
+    successor BIGINT;
+BEGIN
+    FOR owned IN
+        SELECT id FROM groups
+        WHERE group_owner = OLD.id
+          AND deleted_at IS NULL
+    LOOP
+        SELECT gm.user_id INTO successor
+        FROM group_members gm
+        JOIN users u ON u.id = gm.user_id
+        WHERE gm.group_id = owned.id
+          AND gm.user_id <> OLD.id
+          AND gm.deleted_at IS NULL
+          AND u.deleted_at IS NULL
+          AND u.current_status <> 'deactivated'
+          AND NOT EXISTS (
+              SELECT 1
+              FROM group_bans b
+              WHERE b.group_id = gm.group_id
+                AND b.user_id = gm.user_id
+                AND (b.expires_at IS NULL OR b.expires_at > CURRENT_TIMESTAMP)
+          )
+        ORDER BY (gm.role = 'admin') DESC, gm.joined_at ASC, gm.user_id ASC
+        LIMIT 1;
+
+        IF successor IS NOT NULL THEN
+            PERFORM transfer_group_ownership(owned.id, successor);
+        ELSE
+            UPDATE groups
+            SET deleted_at = CURRENT_TIMESTAMP
+            WHERE id = owned.id;
+
+            INSERT INTO cascade_deleted_groups (group_id)
+            VALUES (owned.id)
+            ON CONFLICT DO NOTHING;
+        END IF;
+    END LOOP;
+
+    -- Pending ownership offers from or to the user are void
+    DELETE FROM group_ownership_transfers
+    WHERE from_user_id = OLD.id OR to_user_id = OLD.id;
+
+    -- Hard-delete follows (CASCADE handles this automatically)
+    DELETE FROM follows
+    WHERE follower_id = OLD.id OR following_id = OLD.id;
+
+    -- Hard-delete follow requests (CASCADE handles this automatically)
+    DELETE FROM follow_requests
+    WHERE requester_id = OLD.id OR target_id = OLD.id;
+
+    -- Soft-delete group memberships (preserve history)
+    UPDATE group_members
+    SET deleted_at = CURRENT_TIMESTAMP
+    WHERE user_id = OLD.id AND deleted_at IS NULL;
+
+    -- Soft-delete group join requests (preserve history)
+    UPDATE group_join_requests
+    SET deleted_at = CURRENT_TIMESTAMP
+    WHERE user_id = OLD.id AND deleted_at IS NULL;
+
+    -- Soft-delete group invites (preserve history)
+    UPDATE group_invites
+    SET deleted_at = CURRENT_TIMESTAMP
+    WHERE (sender_id = OLD.id OR receiver_id = OLD.id)
+    AND deleted_at IS NULL;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
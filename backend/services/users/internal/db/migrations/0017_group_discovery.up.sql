@@ -0,0 +1,30 @@
+-----------------------------------------
+-- Group categories, tags and discovery
+-----------------------------------------
+-- Every group is listed under one category (default 'other') and can carry
+-- a few free-form lowercase tags, matched exactly in search.
+CREATE TYPE group_category AS ENUM (
+    'other', 'arts', 'business', 'education', 'entertainment', 'gaming', 'health',
+    'hobbies', 'local', 'music', 'news', 'science', 'sports', 'technology', 'travel'
+);
+
+ALTER TABLE groups
+ADD COLUMN IF NOT EXISTS category group_category NOT NULL DEFAULT 'other';
+
+CREATE INDEX IF NOT EXISTS idx_groups_category
+ON groups(category)
+WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS group_tags (
+    group_id BIGINT NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    tag TEXT NOT NULL,
+    PRIMARY KEY (group_id, tag)
+);
+
+CREATE INDEX IF NOT EXISTS idx_group_tags_tag
+ON group_tags(tag);
+
+-- Recent member growth is counted from join dates
+CREATE INDEX IF NOT EXISTS idx_group_members_joined
+ON group_members(group_id, joined_at)
+WHERE deleted_at IS NULL;
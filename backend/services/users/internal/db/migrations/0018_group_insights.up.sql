@@ -0,0 +1,23 @@
+-----------------------------------------
+-- Group insights
+-----------------------------------------
+-- updated_at changes on every status change, so it can't tell how long a join
+-- request waited once answered. requested_at is set each time a request becomes
+-- pending and responded_at when it is accepted or rejected.
+-- Requests answered before this migration have no requested_at and are left
+-- out of approval times.
+ALTER TABLE group_join_requests
+ADD COLUMN IF NOT EXISTS requested_at TIMESTAMPTZ,
+ADD COLUMN IF NOT EXISTS responded_at TIMESTAMPTZ;
+
+UPDATE group_join_requests
+SET requested_at = COALESCE(updated_at, created_at)
+WHERE status = 'pending';
+
+CREATE INDEX IF NOT EXISTS idx_group_join_requests_responded
+ON group_join_requests(group_id, responded_at)
+WHERE responded_at IS NOT NULL;
+
+-- Member counts per day look at rows that left the group too
+CREATE INDEX IF NOT EXISTS idx_group_members_history
+ON group_members(group_id, joined_at, deleted_at);
@@ -0,0 +1,7 @@
+-----------------------------------------
+-- Group post approval
+-----------------------------------------
+-- New posts of groups with approval turned on wait for group staff.
+-- Mirrored in posts service (moderated_groups).
+ALTER TABLE groups
+ADD COLUMN IF NOT EXISTS posts_require_approval BOOLEAN NOT NULL DEFAULT FALSE;
@@ -12,6 +12,7 @@ import (
 	"social-network/shared/gen-go/chat"
 	"social-network/shared/gen-go/media"
 	"social-network/shared/gen-go/notifications"
+	"social-network/shared/gen-go/posts"
 	"social-network/shared/gen-go/users"
 	configutil "social-network/shared/go/configs"
 	"social-network/shared/go/ct"
@@ -22,6 +23,7 @@ import (
 	"social-network/shared/go/gorpc"
 	postgresql "social-network/shared/go/postgre"
 	"syscall"
+	"time"
 )
 
 //TODO add logs as things are getting initialized
@@ -71,6 +73,14 @@ func Run() error {
 	if err != nil {
 		tele.Fatal("failed to create chat client")
 	}
+	postsClient, err := gorpc.GetGRpcClient(
+		posts.NewPostsServiceClient,
+		cfgs.PostsGRPCAddr,
+		ct.CommonKeys(),
+	)
+	if err != nil {
+		tele.Fatal("failed to create posts client")
+	}
 
 	redisConnector := rds.NewRedisClient(cfgs.SentinelAddrs, cfgs.RedisPassword, cfgs.RedisDB, cfgs.RedisMasterName)
 
@@ -107,10 +117,21 @@ func Run() error {
 		chatClient,
 		notificationsClient,
 		mediaClient,
+		postsClient,
 		redisConnector,
 	)
 
-	app := application.NewApplication(ds.New(pool), pgxTxRunner, pool, clients, eventProducer)
+	app := application.NewApplication(
+		ds.New(pool),
+		pgxTxRunner,
+		pool,
+		clients,
+		eventProducer,
+		cfgs.InviteLinkSecret,
+		time.Duration(cfgs.GroupRequestTTLDays)*24*time.Hour,
+	)
+	app.StartGroupRequestExpiryWorker(ctx, time.Duration(cfgs.GroupRequestExpiryIntervalMinutes)*time.Minute)
+	app.StartOutboxWorker(ctx, time.Duration(cfgs.OutboxIntervalSeconds)*time.Second)
 	service := *handler.NewUsersHanlder(app)
 
 	//
@@ -164,11 +185,19 @@ type configs struct {
 	ChatGRPCAddr          string `env:"CHAT_GRPC_ADDR"`
 	MediaGRPCAddr         string `env:"MEDIA_GRPC_ADDR"`
 	NotificationsGRPCAddr string `env:"NOTIFICATIONS_GRPC_ADDR"`
+	PostsGRPCAddr         string `env:"POSTS_GRPC_ADDR"`
 	ShutdownTimeout       int    `env:"SHUTDOWN_TIMEOUT_SECONDS"`
 	GrpcServerPort        string `env:"GRPC_SERVER_PORT"`
 
 	KafkaBrokers string `env:"KAFKA_BROKERS"`
 
+	InviteLinkSecret string `env:"INVITE_LINK_SECRET"`
+
+	GroupRequestTTLDays               int `env:"GROUP_REQUEST_TTL_DAYS"` // 0 disables expiry
+	GroupRequestExpiryIntervalMinutes int `env:"GROUP_REQUEST_EXPIRY_INTERVAL_MINUTES"`
+
+	OutboxIntervalSeconds int `env:"OUTBOX_INTERVAL_SECONDS"`
+
 	OtelResourceAttributes    string `end:"OTEL_RESOURCE_ATTRIBUTES"`
 	TelemetryCollectorAddress string `env:"OTEL_EXPORTER_OTLP_ENDPOINT"`
 	EnableDebugLogs           bool   `env:"ENABLE_DEBUG_LOGS"`
@@ -186,12 +215,18 @@ func getConfigs() configs {
 		ChatGRPCAddr:              "chat:50051",
 		MediaGRPCAddr:             "media:50051",
 		NotificationsGRPCAddr:     "notifications:50051",
+		PostsGRPCAddr:             "posts:50051",
 		ShutdownTimeout:           5,
 		KafkaBrokers:              "kafka:9092",
 		OtelResourceAttributes:    "service.name=users,service.namespace=social-network,deployment.environment=dev",
 		TelemetryCollectorAddress: "alloy:4317",
 		EnableDebugLogs:           true,
 		SimplePrint:               true,
+
+		GroupRequestTTLDays:               30,
+		GroupRequestExpiryIntervalMinutes: 60,
+
+		OutboxIntervalSeconds: 10,
 	}
 
 	_, err := configutil.LoadConfigs(&cfgs)
@@ -199,5 +234,10 @@ func getConfigs() configs {
 		tele.Fatalf("failed to load env variables into config struct: %v", err)
 	}
 
+	// signs invite links, a shared default would let anyone forge them
+	if cfgs.InviteLinkSecret == "" {
+		tele.Fatalf("INVITE_LINK_SECRET is not set")
+	}
+
 	return cfgs
 }
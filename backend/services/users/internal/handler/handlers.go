@@ -140,6 +140,25 @@ func (s *UsersHandler) UpdateUserEmail(ctx context.Context, req *pb.UpdateEmailR
 	return &emptypb.Empty{}, nil
 }
 
+func (s *UsersHandler) DeactivateAccount(ctx context.Context, req *wrapperspb.Int64Value) (*emptypb.Empty, error) {
+	tele.Info(ctx, "DeactivateAccount gRPC method called with @1", "request", req.String())
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "DeactivateAccount: request is nil")
+	}
+
+	userId := req.GetValue()
+	if err := invalidId("userId", userId); err != nil {
+		return nil, err
+	}
+
+	err := s.Application.DeactivateAccount(ctx, ct.Id(userId))
+	if err != nil {
+		tele.Error(ctx, "Error in DeactivateAccount. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
 // FOLLOW
 func (s *UsersHandler) GetFollowersPaginated(ctx context.Context, req *pb.Pagination) (*cm.ListUsers, error) {
 	tele.Info(ctx, "GetFollowersPaginated gRPC method called with @1", "request", req.String())
@@ -152,6 +171,11 @@ func (s *UsersHandler) GetFollowersPaginated(ctx context.Context, req *pb.Pagina
 		return nil, err
 	}
 
+	requesterId := req.GetRequesterId()
+	if err := invalidId("requesterId", requesterId); err != nil {
+		return nil, err
+	}
+
 	limit := req.GetLimit()
 	offset := req.GetOffset()
 	if err := checkLimOff(limit, offset); err != nil {
@@ -159,9 +183,10 @@ func (s *UsersHandler) GetFollowersPaginated(ctx context.Context, req *pb.Pagina
 	}
 
 	pag := models.Pagination{
-		UserId: ct.Id(userId),
-		Limit:  ct.Limit(limit),
-		Offset: ct.Offset(offset),
+		UserId:      ct.Id(userId),
+		Limit:       ct.Limit(limit),
+		Offset:      ct.Offset(offset),
+		RequesterId: ct.Id(requesterId),
 	}
 
 	resp, err := s.Application.GetFollowersPaginated(ctx, pag)
@@ -183,6 +208,11 @@ func (s *UsersHandler) GetFollowingPaginated(ctx context.Context, req *pb.Pagina
 		return nil, err
 	}
 
+	requesterId := req.GetRequesterId()
+	if err := invalidId("requesterId", requesterId); err != nil {
+		return nil, err
+	}
+
 	limit := req.GetLimit()
 	offset := req.GetOffset()
 	if err := checkLimOff(limit, offset); err != nil {
@@ -190,9 +220,10 @@ func (s *UsersHandler) GetFollowingPaginated(ctx context.Context, req *pb.Pagina
 	}
 
 	pag := models.Pagination{
-		UserId: ct.Id(userId),
-		Limit:  ct.Limit(limit),
-		Offset: ct.Offset(offset),
+		UserId:      ct.Id(userId),
+		Limit:       ct.Limit(limit),
+		Offset:      ct.Offset(offset),
+		RequesterId: ct.Id(requesterId),
 	}
 
 	resp, err := s.Application.GetFollowingPaginated(ctx, pag)
@@ -310,6 +341,24 @@ func (s *UsersHandler) GetFollowingIds(ctx context.Context, req *wrapperspb.Int6
 	return &cm.UserIds{Values: resp}, nil
 }
 
+func (s *UsersHandler) GetFollowerIds(ctx context.Context, req *wrapperspb.Int64Value) (*cm.UserIds, error) {
+	tele.Info(ctx, "GetFollowerIds gRPC method called with @1", "request", req.String())
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "GetFollowerIds: request is nil")
+	}
+	userId := req.GetValue()
+	if err := invalidId("userId", userId); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.Application.GetFollowerIds(ctx, ct.Id(userId))
+	if err != nil {
+		tele.Error(ctx, "Error in GetFollowerIds. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+	return &cm.UserIds{Values: resp}, nil
+}
+
 func (s *UsersHandler) GetFollowSuggestions(ctx context.Context, req *wrapperspb.Int64Value) (*cm.ListUsers, error) {
 	tele.Info(ctx, "GetFollowSuggestions gRPC method called with @1", "request", req.String())
 	if req == nil {
@@ -484,17 +533,23 @@ func (s *UsersHandler) GetGroupInfo(ctx context.Context, req *pb.GeneralGroupReq
 	}
 
 	return &pb.Group{
-		GroupId:          resp.GroupId.Int64(),
-		GroupOwnerId:     resp.GroupOwnerId.Int64(),
-		GroupTitle:       resp.GroupTitle.String(),
-		GroupDescription: resp.GroupDescription.String(),
-		GroupImageId:     resp.GroupImage.Int64(),
-		GroupImageUrl:    resp.GroupImageURL,
-		MembersCount:     resp.MembersCount,
-		IsMember:         resp.IsMember,
-		IsOwner:          resp.IsOwner,
-		PendingRequest:   resp.PendingRequest,
-		PendingInvite:    resp.PendingInvite,
+		GroupId:              resp.GroupId.Int64(),
+		GroupOwnerId:         resp.GroupOwnerId.Int64(),
+		GroupTitle:           resp.GroupTitle.String(),
+		GroupDescription:     resp.GroupDescription.String(),
+		GroupImageId:         resp.GroupImage.Int64(),
+		GroupImageUrl:        resp.GroupImageURL,
+		MembersCount:         resp.MembersCount,
+		IsMember:             resp.IsMember,
+		IsOwner:              resp.IsOwner,
+		PendingRequest:       resp.PendingRequest,
+		PendingInvite:        resp.PendingInvite,
+		OwnershipOffered:     resp.OwnershipOffered,
+		Archived:             resp.Archived,
+		Visibility:           resp.Visibility.String(),
+		Category:             resp.Category.String(),
+		Tags:                 resp.Tags.Strings(),
+		PostsRequireApproval: resp.PostsRequireApproval,
 	}, nil
 }
 
@@ -514,6 +569,24 @@ func (s *UsersHandler) GetAllGroupMemberIds(ctx context.Context, req *pb.IdReq)
 	return &pb.Ids{Ids: memberIds.Int64()}, nil
 }
 
+func (s *UsersHandler) GetUserGroupIds(ctx context.Context, req *wrapperspb.Int64Value) (*pb.Ids, error) {
+	tele.Info(ctx, "GetUserGroupIds called with @1", "request", req.String())
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "GetUserGroupIds: request is nil")
+	}
+	userId := req.GetValue()
+	if err := invalidId("userId", userId); err != nil {
+		return nil, err
+	}
+
+	groupIds, err := s.Application.GetUserGroupIds(ctx, ct.Id(userId))
+	if err != nil {
+		tele.Error(ctx, "Error in GetUserGroupIds. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+	return &pb.Ids{Ids: groupIds}, nil
+}
+
 func (s *UsersHandler) GetGroupMembers(ctx context.Context, req *pb.GroupMembersRequest) (*pb.GroupUserArr, error) {
 	tele.Info(ctx, "GetGroupMembers called with @1", "request", req.String())
 
@@ -549,7 +622,7 @@ func (s *UsersHandler) GetGroupMembers(ctx context.Context, req *pb.GroupMembers
 	return groupUsersToPB(resp), nil
 }
 
-func (s *UsersHandler) GetPendingGroupJoinRequests(ctx context.Context, req *pb.GroupMembersRequest) (*cm.ListUsers, error) {
+func (s *UsersHandler) GetPendingGroupJoinRequests(ctx context.Context, req *pb.GroupMembersRequest) (*pb.PendingJoinRequestArr, error) {
 	tele.Info(ctx, "GetPendingGroupJoinRequests called with @1", "request", req.String())
 
 	if req == nil {
@@ -581,7 +654,25 @@ func (s *UsersHandler) GetPendingGroupJoinRequests(ctx context.Context, req *pb.
 		tele.Error(ctx, "Error in GetPendingGroupJoinRequests. @1", "error", err.Error(), "request", req.String())
 		return nil, ce.EncodeProto(err)
 	}
-	return usersToPB(resp), nil
+
+	out := &pb.PendingJoinRequestArr{
+		Requests: make([]*pb.PendingJoinRequest, 0, len(resp)),
+	}
+	for _, r := range resp {
+		out.Requests = append(out.Requests, &pb.PendingJoinRequest{
+			User: &cm.User{
+				UserId:    r.UserId.Int64(),
+				Username:  r.Username.String(),
+				Avatar:    r.AvatarId.Int64(),
+				AvatarUrl: r.AvatarURL,
+			},
+			RequestedAt:   r.RequestedAt.ToProto(),
+			ExpiresAt:     r.ExpiresAt.ToProto(),
+			RulesAccepted: r.RulesAccepted,
+			Answers:       joinAnswersToPB(r.Answers),
+		})
+	}
+	return out, nil
 }
 
 func (s *UsersHandler) GetPendingGroupJoinRequestsCount(ctx context.Context, req *pb.GeneralGroupRequest) (*pb.CountResp, error) {
@@ -682,6 +773,116 @@ func (s *UsersHandler) SearchGroups(ctx context.Context, req *pb.GroupSearchRequ
 	return groupsToPb(resp), nil
 }
 
+func (s *UsersHandler) DiscoverGroups(ctx context.Context, req *pb.DiscoverGroupsRequest) (*pb.GroupArr, error) {
+	tele.Info(ctx, "DiscoverGroups called with @1", "request", req.String())
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "DiscoverGroups: request is nil")
+	}
+
+	userId := req.GetUserId()
+	if err := invalidId("userId", userId); err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	offset := req.Offset
+	if err := checkLimOff(limit, offset); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.Application.DiscoverGroups(ctx, models.DiscoverGroupsReq{
+		UserId:   ct.Id(userId),
+		Category: ct.GroupCategory(req.GetCategory()),
+		Limit:    ct.Limit(limit),
+		Offset:   ct.Offset(offset),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in DiscoverGroups. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+
+	return groupsToPb(resp), nil
+}
+
+func (s *UsersHandler) GetGroupInsights(ctx context.Context, req *pb.GeneralGroupRequest) (*pb.GroupInsights, error) {
+	tele.Info(ctx, "GetGroupInsights called with @1", "request", req.String())
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "GetGroupInsights: request is nil")
+	}
+
+	userId := req.GetUserId()
+	if err := invalidId("userId", userId); err != nil {
+		return nil, err
+	}
+
+	groupId := req.GetGroupId()
+	if err := invalidId("groupId", groupId); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.Application.GetGroupInsights(ctx, models.GeneralGroupReq{
+		UserId:  ct.Id(userId),
+		GroupId: ct.Id(groupId),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in GetGroupInsights. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+
+	out := &pb.GroupInsights{
+		GroupId:        resp.GroupId.Int64(),
+		Since:          resp.Since.ToProto(),
+		ComputedAt:     resp.ComputedAt.ToProto(),
+		MembersCount:   resp.MembersCount,
+		MemberGrowth:   make([]*pb.GroupMemberGrowth, 0, len(resp.MemberGrowth)),
+		PostsPerDay:    groupDailyCountsToPb(resp.PostsPerDay),
+		CommentsPerDay: groupDailyCountsToPb(resp.CommentsPerDay),
+		ActivePosters:  make([]*pb.GroupActivePoster, 0, len(resp.ActivePosters)),
+		Events:         make([]*pb.GroupEventAttendance, 0, len(resp.Events)),
+		AttendanceRate: resp.AttendanceRate,
+		JoinRequests: &pb.GroupJoinRequestStats{
+			Accepted:              resp.JoinRequests.Accepted,
+			Rejected:              resp.JoinRequests.Rejected,
+			Pending:               resp.JoinRequests.Pending,
+			AvgApprovalSeconds:    resp.JoinRequests.AvgApprovalSeconds,
+			MedianApprovalSeconds: resp.JoinRequests.MedianApprovalSeconds,
+		},
+	}
+	for _, g := range resp.MemberGrowth {
+		out.MemberGrowth = append(out.MemberGrowth, &pb.GroupMemberGrowth{
+			Day:    g.Day.ToProto(),
+			Joined: g.Joined,
+			Total:  g.Total,
+		})
+	}
+	for _, p := range resp.ActivePosters {
+		out.ActivePosters = append(out.ActivePosters, &pb.GroupActivePoster{
+			User: &cm.User{
+				UserId:      p.User.UserId.Int64(),
+				Username:    p.User.Username.String(),
+				Avatar:      p.User.AvatarId.Int64(),
+				AvatarUrl:   p.User.AvatarURL,
+				Deactivated: p.User.Deactivated,
+			},
+			PostCount:    p.PostCount,
+			CommentCount: p.CommentCount,
+		})
+	}
+	for _, e := range resp.Events {
+		out.Events = append(out.Events, &pb.GroupEventAttendance{
+			EventId:        e.EventId.Int64(),
+			Title:          e.Title.String(),
+			EventDate:      e.EventDate.ToProto(),
+			GoingCount:     e.GoingCount,
+			NotGoingCount:  e.NotGoingCount,
+			AttendanceRate: e.AttendanceRate,
+		})
+	}
+	return out, nil
+}
+
 func (s *UsersHandler) InviteToGroup(ctx context.Context, req *pb.InviteToGroupRequest) (*emptypb.Empty, error) {
 	tele.Info(ctx, "InviteToGroup called with @1", "request", req.String())
 
@@ -754,9 +955,19 @@ func (s *UsersHandler) RequestJoinGroup(ctx context.Context, req *pb.GroupJoinRe
 		return nil, err
 	}
 
+	answers := make([]models.GroupJoinAnswer, 0, len(req.GetAnswers()))
+	for _, a := range req.GetAnswers() {
+		answers = append(answers, models.GroupJoinAnswer{
+			QuestionId: ct.Id(a.GetQuestionId()),
+			Answer:     a.GetAnswer(),
+		})
+	}
+
 	err := s.Application.RequestJoinGroup(ctx, models.GroupJoinRequest{
 		GroupId:     ct.Id(groupId),
 		RequesterId: ct.Id(requesterId),
+		AcceptRules: req.GetAcceptRules(),
+		Answers:     answers,
 	})
 	if err != nil {
 		tele.Error(ctx, "Error in RequestJoinGroup. @1", "error", err.Error(), "request", req.String())
@@ -924,6 +1135,134 @@ func (s *UsersHandler) RemoveFromGroup(ctx context.Context, req *pb.RemoveFromGr
 	return &emptypb.Empty{}, nil
 }
 
+func (s *UsersHandler) BanFromGroup(ctx context.Context, req *pb.BanFromGroupRequest) (*emptypb.Empty, error) {
+	tele.Info(ctx, "BanFromGroup called with @1", "request", req.String())
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "BanFromGroup: request is nil")
+	}
+
+	groupId := req.GetGroupId()
+	if err := invalidId("groupId", groupId); err != nil {
+		return nil, err
+	}
+
+	requesterId := req.GetRequesterId()
+	if err := invalidId("requesterId", requesterId); err != nil {
+		return nil, err
+	}
+
+	userId := req.GetUserId()
+	if err := invalidId("userId", userId); err != nil {
+		return nil, err
+	}
+
+	var expiresAt ct.GenDateTime
+	if req.GetExpiresAt() != nil {
+		expiresAt = ct.GenDateTime(req.GetExpiresAt().AsTime())
+	}
+
+	err := s.Application.BanFromGroup(ctx, models.BanFromGroupReq{
+		GroupId:     ct.Id(groupId),
+		RequesterId: ct.Id(requesterId),
+		UserId:      ct.Id(userId),
+		Reason:      req.GetReason(),
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in BanFromGroup. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UsersHandler) UnbanFromGroup(ctx context.Context, req *pb.UnbanFromGroupRequest) (*emptypb.Empty, error) {
+	tele.Info(ctx, "UnbanFromGroup called with @1", "request", req.String())
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "UnbanFromGroup: request is nil")
+	}
+
+	groupId := req.GetGroupId()
+	if err := invalidId("groupId", groupId); err != nil {
+		return nil, err
+	}
+
+	requesterId := req.GetRequesterId()
+	if err := invalidId("requesterId", requesterId); err != nil {
+		return nil, err
+	}
+
+	userId := req.GetUserId()
+	if err := invalidId("userId", userId); err != nil {
+		return nil, err
+	}
+
+	err := s.Application.UnbanFromGroup(ctx, models.UnbanFromGroupReq{
+		GroupId:     ct.Id(groupId),
+		RequesterId: ct.Id(requesterId),
+		UserId:      ct.Id(userId),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in UnbanFromGroup. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UsersHandler) GetGroupBans(ctx context.Context, req *pb.GroupMembersRequest) (*pb.GroupBanArr, error) {
+	tele.Info(ctx, "GetGroupBans called with @1", "request", req.String())
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "GetGroupBans: request is nil")
+	}
+	userId := req.GetUserId()
+	if err := invalidId("userId", userId); err != nil {
+		return nil, err
+	}
+
+	groupId := req.GetGroupId()
+	if err := invalidId("groupId", groupId); err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	offset := req.Offset
+	if err := checkLimOff(limit, offset); err != nil {
+		return nil, err
+	}
+
+	bans, err := s.Application.GetGroupBans(ctx, models.GroupMembersReq{
+		UserId:  ct.Id(userId),
+		GroupId: ct.Id(groupId),
+		Limit:   ct.Limit(limit),
+		Offset:  ct.Offset(offset),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in GetGroupBans. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+
+	out := &pb.GroupBanArr{
+		Bans: make([]*pb.GroupBan, 0, len(bans)),
+	}
+	for _, b := range bans {
+		out.Bans = append(out.Bans, &pb.GroupBan{
+			User: &cm.User{
+				UserId:    b.UserId.Int64(),
+				Username:  b.Username.String(),
+				Avatar:    b.AvatarId.Int64(),
+				AvatarUrl: b.AvatarURL,
+			},
+			BannedBy:  b.BannedBy.Int64(),
+			Reason:    b.Reason,
+			ExpiresAt: b.ExpiresAt.ToProto(),
+			BannedAt:  b.BannedAt.ToProto(),
+		})
+	}
+	return out, nil
+}
+
 func (s *UsersHandler) CreateGroup(ctx context.Context, req *pb.CreateGroupRequest) (*wrapperspb.Int64Value, error) {
 	tele.Info(ctx, "CreateGroup called @1", "request", req.String())
 
@@ -956,6 +1295,9 @@ func (s *UsersHandler) CreateGroup(ctx context.Context, req *pb.CreateGroupReque
 		GroupTitle:       ct.Title(GroupTitle),
 		GroupDescription: ct.About(GroupDescription),
 		GroupImage:       ct.Id(GroupImage),
+		Visibility:       ct.GroupVisibility(req.GetVisibility()),
+		Category:         ct.GroupCategory(req.GetCategory()),
+		Tags:             ct.GroupTagsFromStrings(req.GetTags()),
 	})
 	if err != nil {
 		tele.Error(ctx, "Error in CreateGroup. @1", "error", err.Error(), "request", req.String())
@@ -992,6 +1334,12 @@ func (s *UsersHandler) UpdateGroup(ctx context.Context, req *pb.UpdateGroupReque
 
 	groupImage := req.GroupImageId
 
+	// nil keeps the current tags, an empty wrapper clears them
+	var tags ct.GroupTags
+	if req.Tags != nil {
+		tags = ct.GroupTagsFromStrings(req.Tags.GetValues())
+	}
+
 	err := s.Application.UpdateGroup(ctx, &models.UpdateGroupRequest{
 		RequesterId:      ct.Id(requesterId),
 		GroupId:          ct.Id(groupId),
@@ -999,6 +1347,9 @@ func (s *UsersHandler) UpdateGroup(ctx context.Context, req *pb.UpdateGroupReque
 		GroupDescription: ct.About(groupDescription),
 		GroupImage:       ct.Id(groupImage),
 		DeleteImage:      req.GetDeleteImage(),
+		Visibility:       ct.GroupVisibility(req.GetVisibility()),
+		Category:         ct.GroupCategory(req.GetCategory()),
+		Tags:             tags,
 	})
 	if err != nil {
 		tele.Error(ctx, "Error in UpdateGroup. @1", "error", err.Error(), "request", req.String())
@@ -1007,82 +1358,519 @@ func (s *UsersHandler) UpdateGroup(ctx context.Context, req *pb.UpdateGroupReque
 	return &emptypb.Empty{}, nil
 }
 
-func (s *UsersHandler) GetGroupBasicInfo(ctx context.Context, req *pb.IdReq) (*pb.Group, error) {
-	tele.Info(ctx, "GetGroupBasicInfo called with @1", "request", req.String())
+func (s *UsersHandler) PromoteGroupMember(ctx context.Context, req *pb.GroupRoleRequest) (*emptypb.Empty, error) {
+	tele.Info(ctx, "PromoteGroupMember called with @1", "request", req.String())
 
 	if req == nil {
-		return nil, status.Error(codes.InvalidArgument, "request is nil")
+		return nil, status.Error(codes.InvalidArgument, "PromoteGroupMember: request is nil")
 	}
 
-	g, err := s.Application.GetGroupBasicInfo(ctx, models.GroupId(req.Id))
+	changeReq, err := groupRoleRequestFromPB(req)
 	if err != nil {
-		tele.Error(ctx, "Error in GetGroupBasicInfo. @1", "error", err.Error(), "request", req.String())
-		return nil, ce.EncodeProto(err)
+		return nil, err
 	}
 
-	return &pb.Group{
-		GroupId:          g.GroupId.Int64(),
-		GroupOwnerId:     g.GroupOwnerId.Int64(),
-		GroupTitle:       g.GroupTitle.String(),
-		GroupDescription: g.GroupDescription.String(),
-		GroupImageId:     g.GroupImage.Int64(),
-	}, nil
-
+	err = s.Application.PromoteGroupMember(ctx, changeReq)
+	if err != nil {
+		tele.Error(ctx, "Error in PromoteGroupMember. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+	return &emptypb.Empty{}, nil
 }
 
-// PROFILE
-func (s *UsersHandler) GetBasicUserInfo(ctx context.Context, req *wrapperspb.Int64Value) (*cm.User, error) {
-	tele.Info(ctx, "GetBasicUserInfo called with @1", "request", req.String())
+func (s *UsersHandler) DemoteGroupMember(ctx context.Context, req *pb.GroupRoleRequest) (*emptypb.Empty, error) {
+	tele.Info(ctx, "DemoteGroupMember called with @1", "request", req.String())
 
 	if req == nil {
-		return nil, status.Error(codes.InvalidArgument, "request is nil")
+		return nil, status.Error(codes.InvalidArgument, "DemoteGroupMember: request is nil")
 	}
 
-	userId := req.GetValue()
-	if err := invalidId("userId", userId); err != nil {
+	changeReq, err := groupRoleRequestFromPB(req)
+	if err != nil {
 		return nil, err
 	}
 
-	u, err := s.Application.GetBasicUserInfo(ctx, ct.Id(req.GetValue()))
+	err = s.Application.DemoteGroupMember(ctx, changeReq)
 	if err != nil {
-		tele.Error(ctx, "Error in GetBasicUserInfo. @1", "error", err.Error(), "request", req.String())
+		tele.Error(ctx, "Error in DemoteGroupMember. @1", "error", err.Error(), "request", req.String())
 		return nil, ce.EncodeProto(err)
 	}
-
-	return &cm.User{
-		UserId:    u.UserId.Int64(),
-		Username:  u.Username.String(),
-		Avatar:    u.AvatarId.Int64(),
-		AvatarUrl: u.AvatarURL,
-	}, nil
+	return &emptypb.Empty{}, nil
 }
 
-func (s *UsersHandler) GetBatchBasicUserInfo(ctx context.Context, req *cm.UserIds) (*cm.ListUsers, error) {
-	tele.Info(ctx, "GetBatchBasicUserInfo called with @1", "request", req.String())
+func (s *UsersHandler) HasGroupPermission(ctx context.Context, req *pb.GroupPermissionRequest) (*wrapperspb.BoolValue, error) {
+	tele.Info(ctx, "HasGroupPermission called with @1", "request", req.String())
 
 	if req == nil {
-		return nil, status.Error(codes.InvalidArgument, "request is nil")
+		return nil, status.Error(codes.InvalidArgument, "HasGroupPermission: request is nil")
 	}
 
-	userIds := req.GetValues()
-	if len(userIds) == 0 {
-		return &cm.ListUsers{Users: []*cm.User{}}, nil
+	groupId := req.GetGroupId()
+	if err := invalidId("groupId", groupId); err != nil {
+		return nil, err
 	}
 
-	ids := ct.FromInt64s(userIds)
-	users, err := s.Application.GetBatchBasicUserInfo(ctx, ids)
-	if err != nil {
-		tele.Error(ctx, "Error in GetBatchBasicUserInfo. @1", "error", err.Error(), "request", req.String())
-		return nil, ce.EncodeProto(err)
+	userId := req.GetUserId()
+	if err := invalidId("userId", userId); err != nil {
+		return nil, err
 	}
 
-	pbUsers := make([]*cm.User, 0, len(users))
+	permission := req.GetPermission()
+	if err := invalidString("permission", permission); err != nil {
+		return nil, err
+	}
+
+	allowed, err := s.Application.HasGroupPermission(ctx, models.GroupPermissionReq{
+		GroupId:    ct.Id(groupId),
+		UserId:     ct.Id(userId),
+		Permission: ct.GroupPermission(permission),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in HasGroupPermission. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+	return wrapperspb.Bool(allowed), nil
+}
+
+func (s *UsersHandler) TransferGroupOwnership(ctx context.Context, req *pb.TransferOwnershipRequest) (*emptypb.Empty, error) {
+	tele.Info(ctx, "TransferGroupOwnership called with @1", "request", req.String())
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "TransferGroupOwnership: request is nil")
+	}
+
+	groupId := req.GetGroupId()
+	if err := invalidId("groupId", groupId); err != nil {
+		return nil, err
+	}
+
+	ownerId := req.GetOwnerId()
+	if err := invalidId("ownerId", ownerId); err != nil {
+		return nil, err
+	}
+
+	newOwnerId := req.GetNewOwnerId()
+	if err := invalidId("newOwnerId", newOwnerId); err != nil {
+		return nil, err
+	}
+
+	err := s.Application.TransferGroupOwnership(ctx, models.TransferOwnershipReq{
+		GroupId:    ct.Id(groupId),
+		OwnerId:    ct.Id(ownerId),
+		NewOwnerId: ct.Id(newOwnerId),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in TransferGroupOwnership. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UsersHandler) RespondToOwnershipTransfer(ctx context.Context, req *pb.HandleOwnershipTransferRequest) (*emptypb.Empty, error) {
+	tele.Info(ctx, "RespondToOwnershipTransfer called with @1", "request", req.String())
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "RespondToOwnershipTransfer: request is nil")
+	}
+
+	groupId := req.GetGroupId()
+	if err := invalidId("groupId", groupId); err != nil {
+		return nil, err
+	}
+
+	userId := req.GetUserId()
+	if err := invalidId("userId", userId); err != nil {
+		return nil, err
+	}
+
+	err := s.Application.RespondToOwnershipTransfer(ctx, models.HandleOwnershipTransferReq{
+		GroupId:  ct.Id(groupId),
+		UserId:   ct.Id(userId),
+		Accepted: req.GetAccepted(),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in RespondToOwnershipTransfer. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UsersHandler) ArchiveGroup(ctx context.Context, req *pb.GeneralGroupRequest) (*emptypb.Empty, error) {
+	tele.Info(ctx, "ArchiveGroup called with @1", "request", req.String())
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "ArchiveGroup: request is nil")
+	}
+
+	groupReq, err := generalGroupRequestFromPB(req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Application.ArchiveGroup(ctx, groupReq)
+	if err != nil {
+		tele.Error(ctx, "Error in ArchiveGroup. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UsersHandler) UnarchiveGroup(ctx context.Context, req *pb.GeneralGroupRequest) (*emptypb.Empty, error) {
+	tele.Info(ctx, "UnarchiveGroup called with @1", "request", req.String())
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "UnarchiveGroup: request is nil")
+	}
+
+	groupReq, err := generalGroupRequestFromPB(req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Application.UnarchiveGroup(ctx, groupReq)
+	if err != nil {
+		tele.Error(ctx, "Error in UnarchiveGroup. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UsersHandler) DeleteGroup(ctx context.Context, req *pb.GeneralGroupRequest) (*emptypb.Empty, error) {
+	tele.Info(ctx, "DeleteGroup called with @1", "request", req.String())
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "DeleteGroup: request is nil")
+	}
+
+	groupReq, err := generalGroupRequestFromPB(req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Application.DeleteGroup(ctx, groupReq)
+	if err != nil {
+		tele.Error(ctx, "Error in DeleteGroup. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UsersHandler) CreateGroupInviteLink(ctx context.Context, req *pb.CreateGroupInviteLinkRequest) (*pb.GroupInviteLink, error) {
+	tele.Info(ctx, "CreateGroupInviteLink called with @1", "request", req.String())
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "CreateGroupInviteLink: request is nil")
+	}
+
+	groupId := req.GetGroupId()
+	if err := invalidId("groupId", groupId); err != nil {
+		return nil, err
+	}
+
+	requesterId := req.GetRequesterId()
+	if err := invalidId("requesterId", requesterId); err != nil {
+		return nil, err
+	}
+
+	var expiresAt ct.GenDateTime
+	if req.GetExpiresAt() != nil {
+		expiresAt = ct.GenDateTime(req.GetExpiresAt().AsTime())
+	}
+
+	link, err := s.Application.CreateGroupInviteLink(ctx, models.CreateGroupInviteLinkReq{
+		GroupId:     ct.Id(groupId),
+		RequesterId: ct.Id(requesterId),
+		ExpiresAt:   expiresAt,
+		MaxUses:     req.GetMaxUses(),
+		AutoApprove: req.GetAutoApprove(),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in CreateGroupInviteLink. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+	return inviteLinkToPB(link), nil
+}
+
+func (s *UsersHandler) GetGroupInviteLinks(ctx context.Context, req *pb.GeneralGroupRequest) (*pb.GroupInviteLinkArr, error) {
+	tele.Info(ctx, "GetGroupInviteLinks called with @1", "request", req.String())
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "GetGroupInviteLinks: request is nil")
+	}
+
+	groupReq, err := generalGroupRequestFromPB(req)
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := s.Application.GetGroupInviteLinks(ctx, groupReq)
+	if err != nil {
+		tele.Error(ctx, "Error in GetGroupInviteLinks. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+
+	out := &pb.GroupInviteLinkArr{
+		Links: make([]*pb.GroupInviteLink, 0, len(links)),
+	}
+	for _, l := range links {
+		out.Links = append(out.Links, inviteLinkToPB(l))
+	}
+	return out, nil
+}
+
+func (s *UsersHandler) RevokeGroupInviteLink(ctx context.Context, req *pb.RevokeGroupInviteLinkRequest) (*emptypb.Empty, error) {
+	tele.Info(ctx, "RevokeGroupInviteLink called with @1", "request", req.String())
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "RevokeGroupInviteLink: request is nil")
+	}
+
+	groupId := req.GetGroupId()
+	if err := invalidId("groupId", groupId); err != nil {
+		return nil, err
+	}
+
+	requesterId := req.GetRequesterId()
+	if err := invalidId("requesterId", requesterId); err != nil {
+		return nil, err
+	}
+
+	linkId := req.GetLinkId()
+	if err := invalidId("linkId", linkId); err != nil {
+		return nil, err
+	}
+
+	err := s.Application.RevokeGroupInviteLink(ctx, models.RevokeGroupInviteLinkReq{
+		GroupId:     ct.Id(groupId),
+		RequesterId: ct.Id(requesterId),
+		LinkId:      ct.Id(linkId),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in RevokeGroupInviteLink. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UsersHandler) JoinGroupByLink(ctx context.Context, req *pb.JoinGroupByLinkRequest) (*pb.JoinGroupByLinkResponse, error) {
+	tele.Info(ctx, "JoinGroupByLink called with @1", "request", req.String())
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "JoinGroupByLink: request is nil")
+	}
+
+	userId := req.GetUserId()
+	if err := invalidId("userId", userId); err != nil {
+		return nil, err
+	}
+
+	token := req.GetToken()
+	if err := invalidString("token", token); err != nil {
+		return nil, err
+	}
+
+	answers := make([]models.GroupJoinAnswer, 0, len(req.GetAnswers()))
+	for _, a := range req.GetAnswers() {
+		answers = append(answers, models.GroupJoinAnswer{
+			QuestionId: ct.Id(a.GetQuestionId()),
+			Answer:     a.GetAnswer(),
+		})
+	}
+
+	resp, err := s.Application.JoinGroupByLink(ctx, models.JoinGroupByLinkReq{
+		UserId:      ct.Id(userId),
+		Token:       token,
+		AcceptRules: req.GetAcceptRules(),
+		Answers:     answers,
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in JoinGroupByLink. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+	return &pb.JoinGroupByLinkResponse{
+		GroupId: resp.GroupId.Int64(),
+		Joined:  resp.Joined,
+	}, nil
+}
+
+func (s *UsersHandler) GetGroupJoinForm(ctx context.Context, req *pb.GeneralGroupRequest) (*pb.GroupJoinForm, error) {
+	tele.Info(ctx, "GetGroupJoinForm called with @1", "request", req.String())
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "GetGroupJoinForm: request is nil")
+	}
+
+	groupReq, err := generalGroupRequestFromPB(req)
+	if err != nil {
+		return nil, err
+	}
+
+	form, err := s.Application.GetGroupJoinForm(ctx, groupReq)
+	if err != nil {
+		tele.Error(ctx, "Error in GetGroupJoinForm. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+
+	out := &pb.GroupJoinForm{
+		GroupId:   form.GroupId.Int64(),
+		Rules:     form.Rules,
+		Questions: make([]*pb.GroupJoinQuestion, 0, len(form.Questions)),
+	}
+	for _, q := range form.Questions {
+		out.Questions = append(out.Questions, &pb.GroupJoinQuestion{
+			QuestionId: q.QuestionId.Int64(),
+			Question:   q.Question,
+			Required:   q.Required,
+		})
+	}
+	return out, nil
+}
+
+func (s *UsersHandler) SetGroupJoinForm(ctx context.Context, req *pb.SetGroupJoinFormRequest) (*emptypb.Empty, error) {
+	tele.Info(ctx, "SetGroupJoinForm called with @1", "request", req.String())
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "SetGroupJoinForm: request is nil")
+	}
+
+	groupId := req.GetGroupId()
+	if err := invalidId("groupId", groupId); err != nil {
+		return nil, err
+	}
+
+	requesterId := req.GetRequesterId()
+	if err := invalidId("requesterId", requesterId); err != nil {
+		return nil, err
+	}
+
+	questions := make([]models.GroupJoinQuestion, 0, len(req.GetQuestions()))
+	for _, q := range req.GetQuestions() {
+		questions = append(questions, models.GroupJoinQuestion{
+			Question: q.GetQuestion(),
+			Required: q.GetRequired(),
+		})
+	}
+
+	err := s.Application.SetGroupJoinForm(ctx, models.SetGroupJoinFormReq{
+		GroupId:     ct.Id(groupId),
+		RequesterId: ct.Id(requesterId),
+		Rules:       req.GetRules(),
+		Questions:   questions,
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in SetGroupJoinForm. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UsersHandler) SetGroupPostApproval(ctx context.Context, req *pb.SetGroupPostApprovalRequest) (*emptypb.Empty, error) {
+	tele.Info(ctx, "SetGroupPostApproval called with @1", "request", req.String())
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "SetGroupPostApproval: request is nil")
+	}
+
+	groupId := req.GetGroupId()
+	if err := invalidId("groupId", groupId); err != nil {
+		return nil, err
+	}
+
+	requesterId := req.GetRequesterId()
+	if err := invalidId("requesterId", requesterId); err != nil {
+		return nil, err
+	}
+
+	err := s.Application.SetGroupPostApproval(ctx, models.SetGroupPostApprovalReq{
+		GroupId:     ct.Id(groupId),
+		RequesterId: ct.Id(requesterId),
+		Required:    req.GetRequired(),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in SetGroupPostApproval. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UsersHandler) GetGroupBasicInfo(ctx context.Context, req *pb.IdReq) (*pb.Group, error) {
+	tele.Info(ctx, "GetGroupBasicInfo called with @1", "request", req.String())
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+
+	g, err := s.Application.GetGroupBasicInfo(ctx, models.GroupId(req.Id))
+	if err != nil {
+		tele.Error(ctx, "Error in GetGroupBasicInfo. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+
+	return &pb.Group{
+		GroupId:          g.GroupId.Int64(),
+		GroupOwnerId:     g.GroupOwnerId.Int64(),
+		GroupTitle:       g.GroupTitle.String(),
+		GroupDescription: g.GroupDescription.String(),
+		GroupImageId:     g.GroupImage.Int64(),
+	}, nil
+
+}
+
+// PROFILE
+func (s *UsersHandler) GetBasicUserInfo(ctx context.Context, req *wrapperspb.Int64Value) (*cm.User, error) {
+	tele.Info(ctx, "GetBasicUserInfo called with @1", "request", req.String())
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+
+	userId := req.GetValue()
+	if err := invalidId("userId", userId); err != nil {
+		return nil, err
+	}
+
+	u, err := s.Application.GetBasicUserInfo(ctx, ct.Id(req.GetValue()))
+	if err != nil {
+		tele.Error(ctx, "Error in GetBasicUserInfo. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+
+	return &cm.User{
+		UserId:      u.UserId.Int64(),
+		Username:    u.Username.String(),
+		Avatar:      u.AvatarId.Int64(),
+		AvatarUrl:   u.AvatarURL,
+		Deactivated: u.Deactivated,
+	}, nil
+}
+
+func (s *UsersHandler) GetBatchBasicUserInfo(ctx context.Context, req *cm.UserIds) (*cm.ListUsers, error) {
+	tele.Info(ctx, "GetBatchBasicUserInfo called with @1", "request", req.String())
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+
+	userIds := req.GetValues()
+	if len(userIds) == 0 {
+		return &cm.ListUsers{Users: []*cm.User{}}, nil
+	}
+
+	ids := ct.FromInt64s(userIds)
+	users, err := s.Application.GetBatchBasicUserInfo(ctx, ids)
+	if err != nil {
+		tele.Error(ctx, "Error in GetBatchBasicUserInfo. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+
+	pbUsers := make([]*cm.User, 0, len(users))
 	for _, u := range users {
 		pbUsers = append(pbUsers, &cm.User{
-			UserId:    u.UserId.Int64(),
-			Username:  u.Username.String(),
-			Avatar:    u.AvatarId.Int64(),
-			AvatarUrl: u.AvatarURL,
+			UserId:      u.UserId.Int64(),
+			Username:    u.Username.String(),
+			Avatar:      u.AvatarId.Int64(),
+			AvatarUrl:   u.AvatarURL,
+			Deactivated: u.Deactivated,
 		})
 	}
 
@@ -1238,6 +2026,59 @@ func (s *UsersHandler) UpdateProfilePrivacy(ctx context.Context, req *pb.UpdateP
 	return &emptypb.Empty{}, nil
 }
 
+func (s *UsersHandler) ChangeUsername(ctx context.Context, req *pb.ChangeUsernameRequest) (*emptypb.Empty, error) {
+	tele.Info(ctx, "ChangeUsername called with @1", "request", req.String())
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "ChangeUsername: request is nil")
+	}
+
+	userId := req.GetUserId()
+	if err := invalidId("userId", userId); err != nil {
+		return nil, err
+	}
+
+	username := req.GetUsername()
+	if err := invalidString("username", username); err != nil {
+		return nil, err
+	}
+
+	err := s.Application.ChangeUsername(ctx, models.ChangeUsernameRequest{
+		UserId:   ct.Id(userId),
+		Username: ct.Username(username),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in ChangeUsername. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UsersHandler) ResolveHandle(ctx context.Context, req *wrapperspb.StringValue) (*pb.ResolvedHandle, error) {
+	tele.Info(ctx, "ResolveHandle called with @1", "request", req.String())
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "ResolveHandle: request is nil")
+	}
+
+	handle := req.GetValue()
+	if err := invalidString("handle", handle); err != nil {
+		return nil, err
+	}
+
+	resolved, err := s.Application.ResolveHandle(ctx, ct.Username(handle))
+	if err != nil {
+		tele.Error(ctx, "Error in ResolveHandle. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+
+	return &pb.ResolvedHandle{
+		UserId:     resolved.UserId.Int64(),
+		Username:   resolved.Username.String(),
+		Redirected: resolved.Redirected,
+	}, nil
+}
+
 func (s *UsersHandler) RemoveImages(ctx context.Context, req *pb.FailedImageIds) (*emptypb.Empty, error) {
 	tele.Info(ctx, "RemoveImages called with @1", "request", req.String())
 
@@ -1253,6 +2094,94 @@ func (s *UsersHandler) RemoveImages(ctx context.Context, req *pb.FailedImageIds)
 	return &emptypb.Empty{}, nil
 }
 
+func (s *UsersHandler) GetPrivacySettings(ctx context.Context, req *wrapperspb.Int64Value) (*pb.PrivacySettings, error) {
+	tele.Info(ctx, "GetPrivacySettings called with @1", "request", req.String())
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "GetPrivacySettings: request is nil")
+	}
+
+	userId := req.GetValue()
+	if err := invalidId("userId", userId); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.Application.GetPrivacySettings(ctx, ct.Id(userId))
+	if err != nil {
+		tele.Error(ctx, "Error in GetPrivacySettings. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+	return &pb.PrivacySettings{
+		UserId:               resp.UserId.Int64(),
+		WhoCanMessage:        resp.WhoCanMessage.String(),
+		WhoCanComment:        resp.WhoCanComment.String(),
+		WhoCanSeeFollowLists: resp.WhoCanSeeFollowLists.String(),
+		WhoCanInviteToGroups: resp.WhoCanInviteToGroups.String(),
+		Discoverable:         resp.Discoverable,
+	}, nil
+}
+
+func (s *UsersHandler) UpdatePrivacySettings(ctx context.Context, req *pb.PrivacySettings) (*emptypb.Empty, error) {
+	tele.Info(ctx, "UpdatePrivacySettings called with @1", "request", req.String())
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "UpdatePrivacySettings: request is nil")
+	}
+
+	userId := req.GetUserId()
+	if err := invalidId("userId", userId); err != nil {
+		return nil, err
+	}
+
+	err := s.Application.UpdatePrivacySettings(ctx, models.PrivacySettings{
+		UserId:               ct.Id(userId),
+		WhoCanMessage:        ct.PrivacyAudience(req.GetWhoCanMessage()),
+		WhoCanComment:        ct.PrivacyAudience(req.GetWhoCanComment()),
+		WhoCanSeeFollowLists: ct.PrivacyAudience(req.GetWhoCanSeeFollowLists()),
+		WhoCanInviteToGroups: ct.PrivacyAudience(req.GetWhoCanInviteToGroups()),
+		Discoverable:         req.GetDiscoverable(),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in UpdatePrivacySettings. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UsersHandler) CanInteract(ctx context.Context, req *pb.CanInteractRequest) (*wrapperspb.BoolValue, error) {
+	tele.Info(ctx, "CanInteract called with @1", "request", req.String())
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "CanInteract: request is nil")
+	}
+
+	actorId := req.GetActorId()
+	if err := invalidId("actorId", actorId); err != nil {
+		return nil, err
+	}
+
+	targetId := req.GetTargetId()
+	if err := invalidId("targetId", targetId); err != nil {
+		return nil, err
+	}
+
+	action := req.GetAction()
+	if err := invalidString("action", action); err != nil {
+		return nil, err
+	}
+
+	allowed, err := s.Application.CanInteract(ctx, models.CanInteractReq{
+		ActorId:  ct.Id(actorId),
+		TargetId: ct.Id(targetId),
+		Action:   ct.PrivacyAction(action),
+	})
+	if err != nil {
+		tele.Error(ctx, "Error in CanInteract. @1", "error", err.Error(), "request", req.String())
+		return nil, ce.EncodeProto(err)
+	}
+	return wrapperspb.Bool(allowed), nil
+}
+
 // CONVERTORS
 func usersToPB(dbUsers []models.User) *cm.ListUsers {
 	pbUsers := make([]*cm.User, 0, len(dbUsers))
@@ -1284,6 +2213,9 @@ func groupsToPb(groups []models.Group) *pb.GroupArr {
 			IsOwner:          g.IsOwner,
 			PendingRequest:   g.PendingRequest,
 			PendingInvite:    g.PendingInvite,
+			Visibility:       g.Visibility.String(),
+			Category:         g.Category.String(),
+			Tags:             g.Tags.Strings(),
 		})
 	}
 
@@ -1292,6 +2224,14 @@ func groupsToPb(groups []models.Group) *pb.GroupArr {
 	}
 }
 
+func groupDailyCountsToPb(counts []models.DailyCount) []*pb.GroupDailyCount {
+	out := make([]*pb.GroupDailyCount, 0, len(counts))
+	for _, c := range counts {
+		out = append(out, &pb.GroupDailyCount{Day: c.Day.ToProto(), Count: c.Count})
+	}
+	return out
+}
+
 func groupUsersToPB(users []models.GroupUser) *pb.GroupUserArr {
 	out := &pb.GroupUserArr{
 		GroupUserArr: make([]*pb.GroupUser, 0, len(users)),
@@ -1309,6 +2249,82 @@ func groupUsersToPB(users []models.GroupUser) *pb.GroupUserArr {
 	return out
 }
 
+func groupRoleRequestFromPB(req *pb.GroupRoleRequest) (models.ChangeGroupRoleReq, error) {
+	groupId := req.GetGroupId()
+	if err := invalidId("groupId", groupId); err != nil {
+		return models.ChangeGroupRoleReq{}, err
+	}
+
+	requesterId := req.GetRequesterId()
+	if err := invalidId("requesterId", requesterId); err != nil {
+		return models.ChangeGroupRoleReq{}, err
+	}
+
+	memberId := req.GetMemberId()
+	if err := invalidId("memberId", memberId); err != nil {
+		return models.ChangeGroupRoleReq{}, err
+	}
+
+	role := req.GetRole()
+	if err := invalidString("role", role); err != nil {
+		return models.ChangeGroupRoleReq{}, err
+	}
+
+	return models.ChangeGroupRoleReq{
+		GroupId:     ct.Id(groupId),
+		RequesterId: ct.Id(requesterId),
+		MemberId:    ct.Id(memberId),
+		Role:        ct.GroupRole(role),
+	}, nil
+}
+
+func inviteLinkToPB(l models.GroupInviteLink) *pb.GroupInviteLink {
+	return &pb.GroupInviteLink{
+		LinkId:         l.LinkId.Int64(),
+		GroupId:        l.GroupId.Int64(),
+		Token:          l.Token,
+		CreatedBy:      l.CreatedBy.Int64(),
+		CreatedAt:      l.CreatedAt.ToProto(),
+		ExpiresAt:      l.ExpiresAt.ToProto(),
+		MaxUses:        l.MaxUses,
+		AutoApprove:    l.AutoApprove,
+		Revoked:        l.Revoked,
+		Uses:           l.Uses,
+		JoinedCount:    l.JoinedCount,
+		RequestedCount: l.RequestedCount,
+		LastUsedAt:     l.LastUsedAt.ToProto(),
+	}
+}
+
+func joinAnswersToPB(answers []models.GroupJoinAnswer) []*pb.GroupJoinAnswer {
+	out := make([]*pb.GroupJoinAnswer, 0, len(answers))
+	for _, a := range answers {
+		out = append(out, &pb.GroupJoinAnswer{
+			QuestionId: a.QuestionId.Int64(),
+			Question:   a.Question,
+			Answer:     a.Answer,
+		})
+	}
+	return out
+}
+
+func generalGroupRequestFromPB(req *pb.GeneralGroupRequest) (models.GeneralGroupReq, error) {
+	groupId := req.GetGroupId()
+	if err := invalidId("groupId", groupId); err != nil {
+		return models.GeneralGroupReq{}, err
+	}
+
+	userId := req.GetUserId()
+	if err := invalidId("userId", userId); err != nil {
+		return models.GeneralGroupReq{}, err
+	}
+
+	return models.GeneralGroupReq{
+		GroupId: ct.Id(groupId),
+		UserId:  ct.Id(userId),
+	}, nil
+}
+
 func invalidId(varName string, value int64) error {
 	if value <= 0 {
 		pc, _, _, ok := runtime.Caller(1)
@@ -28,6 +28,7 @@ type User struct {
 	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
 	Avatar        int64                  `protobuf:"varint,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
 	AvatarUrl     string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	Deactivated   bool                   `protobuf:"varint,5,opt,name=deactivated,proto3" json:"deactivated,omitempty"` // account temporarily deactivated by its owner
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -90,6 +91,13 @@ func (x *User) GetAvatarUrl() string {
 	return ""
 }
 
+func (x *User) GetDeactivated() bool {
+	if x != nil {
+		return x.Deactivated
+	}
+	return false
+}
+
 // Holds a list of users
 type ListUsers struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -184,13 +192,14 @@ var File_common_proto protoreflect.FileDescriptor
 
 const file_common_proto_rawDesc = "" +
 	"\n" +
-	"\fcommon.proto\x12\x06common\"r\n" +
+	"\fcommon.proto\x12\x06common\"\x94\x01\n" +
 	"\x04User\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x16\n" +
 	"\x06avatar\x18\x03 \x01(\x03R\x06avatar\x12\x1d\n" +
 	"\n" +
-	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\"/\n" +
+	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\x12 \n" +
+	"\vdeactivated\x18\x05 \x01(\bR\vdeactivated\"/\n" +
 	"\tListUsers\x12\"\n" +
 	"\x05users\x18\x01 \x03(\v2\f.common.UserR\x05users\"!\n" +
 	"\aUserIds\x12\x16\n" +
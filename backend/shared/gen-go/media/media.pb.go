@@ -9,7 +9,7 @@ package media
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	_ "google.golang.org/protobuf/types/known/emptypb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -795,12 +795,13 @@ const file_media_proto_rawDesc = "" +
 	"\x15UPLOAD_STATUS_PENDING\x10\x01\x12\x1c\n" +
 	"\x18UPLOAD_STATUS_PROCESSING\x10\x02\x12\x1a\n" +
 	"\x16UPLOAD_STATUS_COMPLETE\x10\x03\x12\x18\n" +
-	"\x14UPLOAD_STATUS_FAILED\x10\x042\xa0\x02\n" +
+	"\x14UPLOAD_STATUS_FAILED\x10\x042\xd9\x02\n" +
 	"\fMediaService\x12D\n" +
 	"\vUploadImage\x12\x19.media.UploadImageRequest\x1a\x1a.media.UploadImageResponse\x12;\n" +
 	"\bGetImage\x12\x16.media.GetImageRequest\x1a\x17.media.GetImageResponse\x12>\n" +
 	"\tGetImages\x12\x17.media.GetImagesRequest\x1a\x18.media.GetImagesResponse\x12M\n" +
-	"\x0eValidateUpload\x12\x1c.media.ValidateUploadRequest\x1a\x1d.media.ValidateUploadResponseB*Z(social-network/shared/gen-go/media;mediab\x06proto3"
+	"\x0eValidateUpload\x12\x1c.media.ValidateUploadRequest\x1a\x1d.media.ValidateUploadResponse\x127\n" +
+	"\fDeleteImages\x12\x0f.media.ImageIds\x1a\x16.google.protobuf.EmptyB*Z(social-network/shared/gen-go/media;mediab\x06proto3"
 
 var (
 	file_media_proto_rawDescOnce sync.Once
@@ -831,6 +832,7 @@ var file_media_proto_goTypes = []any{
 	(*FailedId)(nil),               // 11: media.FailedId
 	(*GetImagesResponse)(nil),      // 12: media.GetImagesResponse
 	nil,                            // 13: media.GetImagesResponse.DownloadUrlsEntry
+	(*emptypb.Empty)(nil),          // 14: google.protobuf.Empty
 }
 var file_media_proto_depIdxs = []int32{
 	1,  // 0: media.UploadImageRequest.visibility:type_name -> media.FileVisibility
@@ -845,12 +847,14 @@ var file_media_proto_depIdxs = []int32{
 	5,  // 9: media.MediaService.GetImage:input_type -> media.GetImageRequest
 	10, // 10: media.MediaService.GetImages:input_type -> media.GetImagesRequest
 	7,  // 11: media.MediaService.ValidateUpload:input_type -> media.ValidateUploadRequest
-	4,  // 12: media.MediaService.UploadImage:output_type -> media.UploadImageResponse
-	6,  // 13: media.MediaService.GetImage:output_type -> media.GetImageResponse
-	12, // 14: media.MediaService.GetImages:output_type -> media.GetImagesResponse
-	8,  // 15: media.MediaService.ValidateUpload:output_type -> media.ValidateUploadResponse
-	12, // [12:16] is the sub-list for method output_type
-	8,  // [8:12] is the sub-list for method input_type
+	9,  // 12: media.MediaService.DeleteImages:input_type -> media.ImageIds
+	4,  // 13: media.MediaService.UploadImage:output_type -> media.UploadImageResponse
+	6,  // 14: media.MediaService.GetImage:output_type -> media.GetImageResponse
+	12, // 15: media.MediaService.GetImages:output_type -> media.GetImagesResponse
+	8,  // 16: media.MediaService.ValidateUpload:output_type -> media.ValidateUploadResponse
+	14, // 17: media.MediaService.DeleteImages:output_type -> google.protobuf.Empty
+	13, // [13:18] is the sub-list for method output_type
+	8,  // [8:13] is the sub-list for method input_type
 	8,  // [8:8] is the sub-list for extension type_name
 	8,  // [8:8] is the sub-list for extension extendee
 	0,  // [0:8] is the sub-list for field type_name
@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -23,6 +24,7 @@ const (
 	MediaService_GetImage_FullMethodName       = "/media.MediaService/GetImage"
 	MediaService_GetImages_FullMethodName      = "/media.MediaService/GetImages"
 	MediaService_ValidateUpload_FullMethodName = "/media.MediaService/ValidateUpload"
+	MediaService_DeleteImages_FullMethodName   = "/media.MediaService/DeleteImages"
 )
 
 // MediaServiceClient is the client API for MediaService service.
@@ -50,6 +52,9 @@ type MediaServiceClient interface {
 	// Unvalidated files expire in 24 hours and are automatically
 	// deleted from file service. If requested returns a download url.
 	ValidateUpload(ctx context.Context, in *ValidateUploadRequest, opts ...grpc.CallOption) (*ValidateUploadResponse, error)
+	// Deletes the files and all their variants from file service and db.
+	// Unknown ids are ignored. Callers must hold the only reference to the ids.
+	DeleteImages(ctx context.Context, in *ImageIds, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type mediaServiceClient struct {
@@ -100,6 +105,16 @@ func (c *mediaServiceClient) ValidateUpload(ctx context.Context, in *ValidateUpl
 	return out, nil
 }
 
+func (c *mediaServiceClient) DeleteImages(ctx context.Context, in *ImageIds, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MediaService_DeleteImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MediaServiceServer is the server API for MediaService service.
 // All implementations must embed UnimplementedMediaServiceServer
 // for forward compatibility.
@@ -125,6 +140,9 @@ type MediaServiceServer interface {
 	// Unvalidated files expire in 24 hours and are automatically
 	// deleted from file service. If requested returns a download url.
 	ValidateUpload(context.Context, *ValidateUploadRequest) (*ValidateUploadResponse, error)
+	// Deletes the files and all their variants from file service and db.
+	// Unknown ids are ignored. Callers must hold the only reference to the ids.
+	DeleteImages(context.Context, *ImageIds) (*emptypb.Empty, error)
 	mustEmbedUnimplementedMediaServiceServer()
 }
 
@@ -147,6 +165,9 @@ func (UnimplementedMediaServiceServer) GetImages(context.Context, *GetImagesRequ
 func (UnimplementedMediaServiceServer) ValidateUpload(context.Context, *ValidateUploadRequest) (*ValidateUploadResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ValidateUpload not implemented")
 }
+func (UnimplementedMediaServiceServer) DeleteImages(context.Context, *ImageIds) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteImages not implemented")
+}
 func (UnimplementedMediaServiceServer) mustEmbedUnimplementedMediaServiceServer() {}
 func (UnimplementedMediaServiceServer) testEmbeddedByValue()                      {}
 
@@ -240,6 +261,24 @@ func _MediaService_ValidateUpload_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MediaService_DeleteImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediaServiceServer).DeleteImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediaService_DeleteImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediaServiceServer).DeleteImages(ctx, req.(*ImageIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MediaService_ServiceDesc is the grpc.ServiceDesc for MediaService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -263,6 +302,10 @@ var MediaService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ValidateUpload",
 			Handler:    _MediaService_ValidateUpload_Handler,
 		},
+		{
+			MethodName: "DeleteImages",
+			Handler:    _MediaService_DeleteImages_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "media.proto",
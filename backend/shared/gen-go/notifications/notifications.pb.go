@@ -44,6 +44,13 @@ const (
 	NotificationType_NOTIFICATION_TYPE_GROUP_INVITE_REJECTED       NotificationType = 13
 	NotificationType_NOTIFICATION_TYPE_GROUP_JOIN_REQUEST_ACCEPTED NotificationType = 14
 	NotificationType_NOTIFICATION_TYPE_GROUP_JOIN_REQUEST_REJECTED NotificationType = 15
+	NotificationType_NOTIFICATION_TYPE_GROUP_ANNOUNCEMENT          NotificationType = 16
+	NotificationType_NOTIFICATION_TYPE_GROUP_POST_APPROVED         NotificationType = 17
+	NotificationType_NOTIFICATION_TYPE_GROUP_POST_REJECTED         NotificationType = 18
+	NotificationType_NOTIFICATION_TYPE_SCHEDULED_POST_PUBLISHED    NotificationType = 19
+	NotificationType_NOTIFICATION_TYPE_COMMENT_REPLY               NotificationType = 20
+	NotificationType_NOTIFICATION_TYPE_REPOST                      NotificationType = 21
+	NotificationType_NOTIFICATION_TYPE_QUOTE                       NotificationType = 22
 )
 
 // Enum value maps for NotificationType.
@@ -65,6 +72,13 @@ var (
 		13: "NOTIFICATION_TYPE_GROUP_INVITE_REJECTED",
 		14: "NOTIFICATION_TYPE_GROUP_JOIN_REQUEST_ACCEPTED",
 		15: "NOTIFICATION_TYPE_GROUP_JOIN_REQUEST_REJECTED",
+		16: "NOTIFICATION_TYPE_GROUP_ANNOUNCEMENT",
+		17: "NOTIFICATION_TYPE_GROUP_POST_APPROVED",
+		18: "NOTIFICATION_TYPE_GROUP_POST_REJECTED",
+		19: "NOTIFICATION_TYPE_SCHEDULED_POST_PUBLISHED",
+		20: "NOTIFICATION_TYPE_COMMENT_REPLY",
+		21: "NOTIFICATION_TYPE_REPOST",
+		22: "NOTIFICATION_TYPE_QUOTE",
 	}
 	NotificationType_value = map[string]int32{
 		"NOTIFICATION_TYPE_UNSPECIFIED":                 0,
@@ -83,6 +97,13 @@ var (
 		"NOTIFICATION_TYPE_GROUP_INVITE_REJECTED":       13,
 		"NOTIFICATION_TYPE_GROUP_JOIN_REQUEST_ACCEPTED": 14,
 		"NOTIFICATION_TYPE_GROUP_JOIN_REQUEST_REJECTED": 15,
+		"NOTIFICATION_TYPE_GROUP_ANNOUNCEMENT":          16,
+		"NOTIFICATION_TYPE_GROUP_POST_APPROVED":         17,
+		"NOTIFICATION_TYPE_GROUP_POST_REJECTED":         18,
+		"NOTIFICATION_TYPE_SCHEDULED_POST_PUBLISHED":    19,
+		"NOTIFICATION_TYPE_COMMENT_REPLY":               20,
+		"NOTIFICATION_TYPE_REPOST":                      21,
+		"NOTIFICATION_TYPE_QUOTE":                       22,
 	}
 )
 
@@ -188,6 +209,18 @@ const (
 	EventType_GROUP_JOIN_REQUEST_REJECTED  EventType = 15
 	EventType_FOLLOW_REQUEST_CANCELLED     EventType = 16
 	EventType_GROUP_JOIN_REQUEST_CANCELLED EventType = 17
+	EventType_GROUP_DELETED                EventType = 18
+	EventType_GROUP_INVITE_EXPIRED         EventType = 19
+	EventType_GROUP_JOIN_REQUEST_EXPIRED   EventType = 20
+	EventType_GROUP_ANNOUNCEMENT_CREATED   EventType = 21
+	EventType_GROUP_POST_REVIEWED          EventType = 22
+	EventType_SCHEDULED_POST_PUBLISHED     EventType = 23
+	EventType_COMMENT_REPLY_CREATED        EventType = 24
+	EventType_POST_SHARED                  EventType = 25
+	EventType_USER_DEACTIVATION_CHANGED    EventType = 26
+	EventType_GROUP_ARCHIVE_CHANGED        EventType = 27
+	EventType_GROUP_VISIBILITY_CHANGED     EventType = 28
+	EventType_GROUP_POST_APPROVAL_CHANGED  EventType = 29
 )
 
 // Enum value maps for EventType.
@@ -211,6 +244,18 @@ var (
 		15: "GROUP_JOIN_REQUEST_REJECTED",
 		16: "FOLLOW_REQUEST_CANCELLED",
 		17: "GROUP_JOIN_REQUEST_CANCELLED",
+		18: "GROUP_DELETED",
+		19: "GROUP_INVITE_EXPIRED",
+		20: "GROUP_JOIN_REQUEST_EXPIRED",
+		21: "GROUP_ANNOUNCEMENT_CREATED",
+		22: "GROUP_POST_REVIEWED",
+		23: "SCHEDULED_POST_PUBLISHED",
+		24: "COMMENT_REPLY_CREATED",
+		25: "POST_SHARED",
+		26: "USER_DEACTIVATION_CHANGED",
+		27: "GROUP_ARCHIVE_CHANGED",
+		28: "GROUP_VISIBILITY_CHANGED",
+		29: "GROUP_POST_APPROVAL_CHANGED",
 	}
 	EventType_value = map[string]int32{
 		"EVENT_TYPE_UNSPECIFIED":       0,
@@ -231,6 +276,18 @@ var (
 		"GROUP_JOIN_REQUEST_REJECTED":  15,
 		"FOLLOW_REQUEST_CANCELLED":     16,
 		"GROUP_JOIN_REQUEST_CANCELLED": 17,
+		"GROUP_DELETED":                18,
+		"GROUP_INVITE_EXPIRED":         19,
+		"GROUP_JOIN_REQUEST_EXPIRED":   20,
+		"GROUP_ANNOUNCEMENT_CREATED":   21,
+		"GROUP_POST_REVIEWED":          22,
+		"SCHEDULED_POST_PUBLISHED":     23,
+		"COMMENT_REPLY_CREATED":        24,
+		"POST_SHARED":                  25,
+		"USER_DEACTIVATION_CHANGED":    26,
+		"GROUP_ARCHIVE_CHANGED":        27,
+		"GROUP_VISIBILITY_CHANGED":     28,
+		"GROUP_POST_APPROVAL_CHANGED":  29,
 	}
 )
 
@@ -1641,6 +1698,7 @@ type CreatePostLikeRequest struct {
 	PostId        int64                  `protobuf:"varint,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`                     // id of the post
 	LikerUsername string                 `protobuf:"bytes,4,opt,name=liker_username,json=likerUsername,proto3" json:"liker_username,omitempty"` // username of the liker
 	Aggregate     bool                   `protobuf:"varint,5,opt,name=aggregate,proto3" json:"aggregate,omitempty"`                             // whether to aggregate this notification with existing ones
+	ReactionType  string                 `protobuf:"bytes,6,opt,name=reaction_type,json=reactionType,proto3" json:"reaction_type,omitempty"`    // like, love, laugh, wow, sad or angry, empty means like
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1710,6 +1768,13 @@ func (x *CreatePostLikeRequest) GetAggregate() bool {
 	return false
 }
 
+func (x *CreatePostLikeRequest) GetReactionType() string {
+	if x != nil {
+		return x.ReactionType
+	}
+	return ""
+}
+
 // Request to create a post comment notification
 type CreatePostCommentRequest struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
@@ -1804,6 +1869,7 @@ type CreateMentionRequest struct {
 	MentionerUsername string                 `protobuf:"bytes,4,opt,name=mentioner_username,json=mentionerUsername,proto3" json:"mentioner_username,omitempty"` // username of the mentioner
 	PostContent       string                 `protobuf:"bytes,5,opt,name=post_content,json=postContent,proto3" json:"post_content,omitempty"`                   // content of the post
 	MentionText       string                 `protobuf:"bytes,6,opt,name=mention_text,json=mentionText,proto3" json:"mention_text,omitempty"`                   // the actual text that was mentioned
+	CommentId         int64                  `protobuf:"varint,7,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`                        // id of the comment where mention occurred, 0 if in the post itself
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -1880,6 +1946,13 @@ func (x *CreateMentionRequest) GetMentionText() string {
 	return ""
 }
 
+func (x *CreateMentionRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
 // Request to create a new message notification
 type CreateNewMessageRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -2609,6 +2682,7 @@ type PostLiked struct {
 	LikerUserId     int64                  `protobuf:"varint,3,opt,name=liker_user_id,json=likerUserId,proto3" json:"liker_user_id,omitempty"`
 	LikerUsername   string                 `protobuf:"bytes,4,opt,name=liker_username,json=likerUsername,proto3" json:"liker_username,omitempty"`
 	Aggregate       bool                   `protobuf:"varint,5,opt,name=aggregate,proto3" json:"aggregate,omitempty"`
+	ReactionType    string                 `protobuf:"bytes,6,opt,name=reaction_type,json=reactionType,proto3" json:"reaction_type,omitempty"` // empty means like
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -2678,6 +2752,13 @@ func (x *PostLiked) GetAggregate() bool {
 	return false
 }
 
+func (x *PostLiked) GetReactionType() string {
+	if x != nil {
+		return x.ReactionType
+	}
+	return ""
+}
+
 type FollowRequestCreated struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	TargetUserId      int64                  `protobuf:"varint,1,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
@@ -3050,6 +3131,7 @@ type MentionCreated struct {
 	MentionerUsername string                 `protobuf:"bytes,4,opt,name=mentioner_username,json=mentionerUsername,proto3" json:"mentioner_username,omitempty"`
 	PostContent       string                 `protobuf:"bytes,5,opt,name=post_content,json=postContent,proto3" json:"post_content,omitempty"`
 	MentionText       string                 `protobuf:"bytes,6,opt,name=mention_text,json=mentionText,proto3" json:"mention_text,omitempty"`
+	CommentId         int64                  `protobuf:"varint,7,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // 0 when mentioned in the post itself
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -3126,6 +3208,13 @@ func (x *MentionCreated) GetMentionText() string {
 	return ""
 }
 
+func (x *MentionCreated) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
 type NewMessageCreated struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	UserId         []int64                `protobuf:"varint,1,rep,packed,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -3730,51 +3819,27 @@ func (x *GroupJoinRequestCancelled) GetGroupId() int64 {
 	return 0
 }
 
-// Main notification event wrapper
-type NotificationEvent struct {
-	state      protoimpl.MessageState `protogen:"open.v1"`
-	EventId    string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
-	EventType  EventType              `protobuf:"varint,3,opt,name=event_type,json=eventType,proto3,enum=notifications.EventType" json:"event_type,omitempty"`
-	Metadata   map[string]string      `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// Types that are valid to be assigned to Payload:
-	//
-	//	*NotificationEvent_PostCommentCreated
-	//	*NotificationEvent_PostLiked
-	//	*NotificationEvent_FollowRequestCreated
-	//	*NotificationEvent_NewFollowerCreated
-	//	*NotificationEvent_GroupInviteCreated
-	//	*NotificationEvent_GroupJoinRequestCreated
-	//	*NotificationEvent_NewEventCreated
-	//	*NotificationEvent_MentionCreated
-	//	*NotificationEvent_NewMessageCreated
-	//	*NotificationEvent_FollowRequestAccepted
-	//	*NotificationEvent_FollowRequestRejected
-	//	*NotificationEvent_GroupInviteAccepted
-	//	*NotificationEvent_GroupInviteRejected
-	//	*NotificationEvent_GroupJoinRequestAccepted
-	//	*NotificationEvent_GroupJoinRequestRejected
-	//	*NotificationEvent_FollowRequestCancelled
-	//	*NotificationEvent_GroupJoinRequestCancelled
-	Payload       isNotificationEvent_Payload `protobuf_oneof:"payload"`
+type GroupDeleted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NotificationEvent) Reset() {
-	*x = NotificationEvent{}
+func (x *GroupDeleted) Reset() {
+	*x = GroupDeleted{}
 	mi := &file_notifications_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NotificationEvent) String() string {
+func (x *GroupDeleted) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NotificationEvent) ProtoMessage() {}
+func (*GroupDeleted) ProtoMessage() {}
 
-func (x *NotificationEvent) ProtoReflect() protoreflect.Message {
+func (x *GroupDeleted) ProtoReflect() protoreflect.Message {
 	mi := &file_notifications_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3786,213 +3851,1175 @@ func (x *NotificationEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NotificationEvent.ProtoReflect.Descriptor instead.
-func (*NotificationEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use GroupDeleted.ProtoReflect.Descriptor instead.
+func (*GroupDeleted) Descriptor() ([]byte, []int) {
 	return file_notifications_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *NotificationEvent) GetEventId() string {
+func (x *GroupDeleted) GetGroupId() int64 {
 	if x != nil {
-		return x.EventId
+		return x.GroupId
 	}
-	return ""
+	return 0
 }
 
-func (x *NotificationEvent) GetOccurredAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.OccurredAt
-	}
-	return nil
+type GroupInviteExpired struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvitedUserId int64                  `protobuf:"varint,1,opt,name=invited_user_id,json=invitedUserId,proto3" json:"invited_user_id,omitempty"`
+	GroupId       int64                  `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NotificationEvent) GetEventType() EventType {
-	if x != nil {
-		return x.EventType
-	}
-	return EventType_EVENT_TYPE_UNSPECIFIED
+func (x *GroupInviteExpired) Reset() {
+	*x = GroupInviteExpired{}
+	mi := &file_notifications_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *NotificationEvent) GetMetadata() map[string]string {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
+func (x *GroupInviteExpired) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *NotificationEvent) GetPayload() isNotificationEvent_Payload {
-	if x != nil {
-		return x.Payload
-	}
-	return nil
-}
+func (*GroupInviteExpired) ProtoMessage() {}
 
-func (x *NotificationEvent) GetPostCommentCreated() *PostCommentCreated {
+func (x *GroupInviteExpired) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[50]
 	if x != nil {
-		if x, ok := x.Payload.(*NotificationEvent_PostCommentCreated); ok {
-			return x.PostCommentCreated
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *NotificationEvent) GetPostLiked() *PostLiked {
-	if x != nil {
-		if x, ok := x.Payload.(*NotificationEvent_PostLiked); ok {
-			return x.PostLiked
-		}
-	}
-	return nil
+// Deprecated: Use GroupInviteExpired.ProtoReflect.Descriptor instead.
+func (*GroupInviteExpired) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *NotificationEvent) GetFollowRequestCreated() *FollowRequestCreated {
+func (x *GroupInviteExpired) GetInvitedUserId() int64 {
 	if x != nil {
-		if x, ok := x.Payload.(*NotificationEvent_FollowRequestCreated); ok {
-			return x.FollowRequestCreated
-		}
+		return x.InvitedUserId
 	}
-	return nil
+	return 0
 }
 
-func (x *NotificationEvent) GetNewFollowerCreated() *NewFollowerCreated {
+func (x *GroupInviteExpired) GetGroupId() int64 {
 	if x != nil {
-		if x, ok := x.Payload.(*NotificationEvent_NewFollowerCreated); ok {
-			return x.NewFollowerCreated
-		}
+		return x.GroupId
 	}
-	return nil
+	return 0
 }
 
-func (x *NotificationEvent) GetGroupInviteCreated() *GroupInviteCreated {
-	if x != nil {
-		if x, ok := x.Payload.(*NotificationEvent_GroupInviteCreated); ok {
-			return x.GroupInviteCreated
-		}
-	}
-	return nil
+type GroupJoinRequestExpired struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	GroupOwnerId    int64                  `protobuf:"varint,1,opt,name=group_owner_id,json=groupOwnerId,proto3" json:"group_owner_id,omitempty"`
+	RequesterUserId int64                  `protobuf:"varint,2,opt,name=requester_user_id,json=requesterUserId,proto3" json:"requester_user_id,omitempty"`
+	GroupId         int64                  `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ApproverIds     []int64                `protobuf:"varint,4,rep,packed,name=approver_ids,json=approverIds,proto3" json:"approver_ids,omitempty"` // members allowed to approve joins, owner included
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *NotificationEvent) GetGroupJoinRequestCreated() *GroupJoinRequestCreated {
+func (x *GroupJoinRequestExpired) Reset() {
+	*x = GroupJoinRequestExpired{}
+	mi := &file_notifications_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupJoinRequestExpired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupJoinRequestExpired) ProtoMessage() {}
+
+func (x *GroupJoinRequestExpired) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[51]
 	if x != nil {
-		if x, ok := x.Payload.(*NotificationEvent_GroupJoinRequestCreated); ok {
-			return x.GroupJoinRequestCreated
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *NotificationEvent) GetNewEventCreated() *NewEventCreated {
+// Deprecated: Use GroupJoinRequestExpired.ProtoReflect.Descriptor instead.
+func (*GroupJoinRequestExpired) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GroupJoinRequestExpired) GetGroupOwnerId() int64 {
 	if x != nil {
-		if x, ok := x.Payload.(*NotificationEvent_NewEventCreated); ok {
-			return x.NewEventCreated
-		}
+		return x.GroupOwnerId
 	}
-	return nil
+	return 0
 }
 
-func (x *NotificationEvent) GetMentionCreated() *MentionCreated {
+func (x *GroupJoinRequestExpired) GetRequesterUserId() int64 {
 	if x != nil {
-		if x, ok := x.Payload.(*NotificationEvent_MentionCreated); ok {
-			return x.MentionCreated
-		}
+		return x.RequesterUserId
 	}
-	return nil
+	return 0
 }
 
-func (x *NotificationEvent) GetNewMessageCreated() *NewMessageCreated {
+func (x *GroupJoinRequestExpired) GetGroupId() int64 {
 	if x != nil {
-		if x, ok := x.Payload.(*NotificationEvent_NewMessageCreated); ok {
-			return x.NewMessageCreated
-		}
+		return x.GroupId
 	}
-	return nil
+	return 0
 }
 
-func (x *NotificationEvent) GetFollowRequestAccepted() *FollowRequestAccepted {
+func (x *GroupJoinRequestExpired) GetApproverIds() []int64 {
 	if x != nil {
-		if x, ok := x.Payload.(*NotificationEvent_FollowRequestAccepted); ok {
-			return x.FollowRequestAccepted
-		}
+		return x.ApproverIds
 	}
 	return nil
 }
 
-func (x *NotificationEvent) GetFollowRequestRejected() *FollowRequestRejected {
+type GroupAnnouncementCreated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        []int64                `protobuf:"varint,1,rep,packed,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AuthorId      int64                  `protobuf:"varint,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	GroupId       int64                  `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	PostId        int64                  `protobuf:"varint,4,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	GroupName     string                 `protobuf:"bytes,5,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	PostContent   string                 `protobuf:"bytes,6,opt,name=post_content,json=postContent,proto3" json:"post_content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupAnnouncementCreated) Reset() {
+	*x = GroupAnnouncementCreated{}
+	mi := &file_notifications_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupAnnouncementCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupAnnouncementCreated) ProtoMessage() {}
+
+func (x *GroupAnnouncementCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[52]
 	if x != nil {
-		if x, ok := x.Payload.(*NotificationEvent_FollowRequestRejected); ok {
-			return x.FollowRequestRejected
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *NotificationEvent) GetGroupInviteAccepted() *GroupInviteAccepted {
+// Deprecated: Use GroupAnnouncementCreated.ProtoReflect.Descriptor instead.
+func (*GroupAnnouncementCreated) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GroupAnnouncementCreated) GetUserId() []int64 {
 	if x != nil {
-		if x, ok := x.Payload.(*NotificationEvent_GroupInviteAccepted); ok {
-			return x.GroupInviteAccepted
-		}
+		return x.UserId
 	}
 	return nil
 }
 
-func (x *NotificationEvent) GetGroupInviteRejected() *GroupInviteRejected {
+func (x *GroupAnnouncementCreated) GetAuthorId() int64 {
 	if x != nil {
-		if x, ok := x.Payload.(*NotificationEvent_GroupInviteRejected); ok {
-			return x.GroupInviteRejected
-		}
+		return x.AuthorId
 	}
-	return nil
+	return 0
 }
 
-func (x *NotificationEvent) GetGroupJoinRequestAccepted() *GroupJoinRequestAccepted {
+func (x *GroupAnnouncementCreated) GetGroupId() int64 {
 	if x != nil {
-		if x, ok := x.Payload.(*NotificationEvent_GroupJoinRequestAccepted); ok {
-			return x.GroupJoinRequestAccepted
-		}
+		return x.GroupId
 	}
-	return nil
+	return 0
 }
 
-func (x *NotificationEvent) GetGroupJoinRequestRejected() *GroupJoinRequestRejected {
+func (x *GroupAnnouncementCreated) GetPostId() int64 {
 	if x != nil {
-		if x, ok := x.Payload.(*NotificationEvent_GroupJoinRequestRejected); ok {
-			return x.GroupJoinRequestRejected
-		}
+		return x.PostId
 	}
-	return nil
+	return 0
 }
 
-func (x *NotificationEvent) GetFollowRequestCancelled() *FollowRequestCancelled {
+func (x *GroupAnnouncementCreated) GetGroupName() string {
 	if x != nil {
-		if x, ok := x.Payload.(*NotificationEvent_FollowRequestCancelled); ok {
-			return x.FollowRequestCancelled
-		}
+		return x.GroupName
 	}
-	return nil
+	return ""
 }
 
-func (x *NotificationEvent) GetGroupJoinRequestCancelled() *GroupJoinRequestCancelled {
+func (x *GroupAnnouncementCreated) GetPostContent() string {
 	if x != nil {
-		if x, ok := x.Payload.(*NotificationEvent_GroupJoinRequestCancelled); ok {
-			return x.GroupJoinRequestCancelled
-		}
+		return x.PostContent
 	}
-	return nil
+	return ""
 }
 
-type isNotificationEvent_Payload interface {
-	isNotificationEvent_Payload()
+type GroupPostReviewed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId      int64                  `protobuf:"varint,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	ReviewerId    int64                  `protobuf:"varint,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	GroupId       int64                  `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	PostId        int64                  `protobuf:"varint,4,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	GroupName     string                 `protobuf:"bytes,5,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Approved      bool                   `protobuf:"varint,6,opt,name=approved,proto3" json:"approved,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"` // only set on rejection
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type NotificationEvent_PostCommentCreated struct {
-	PostCommentCreated *PostCommentCreated `protobuf:"bytes,10,opt,name=post_comment_created,json=postCommentCreated,proto3,oneof"`
+func (x *GroupPostReviewed) Reset() {
+	*x = GroupPostReviewed{}
+	mi := &file_notifications_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type NotificationEvent_PostLiked struct {
-	PostLiked *PostLiked `protobuf:"bytes,11,opt,name=post_liked,json=postLiked,proto3,oneof"`
+func (x *GroupPostReviewed) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type NotificationEvent_FollowRequestCreated struct {
-	FollowRequestCreated *FollowRequestCreated `protobuf:"bytes,12,opt,name=follow_request_created,json=followRequestCreated,proto3,oneof"`
+func (*GroupPostReviewed) ProtoMessage() {}
+
+func (x *GroupPostReviewed) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupPostReviewed.ProtoReflect.Descriptor instead.
+func (*GroupPostReviewed) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GroupPostReviewed) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *GroupPostReviewed) GetReviewerId() int64 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *GroupPostReviewed) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *GroupPostReviewed) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *GroupPostReviewed) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *GroupPostReviewed) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+func (x *GroupPostReviewed) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ScheduledPostPublished struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId        int64                  `protobuf:"varint,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	PostId          int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	GroupId         int64                  `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`                         // 0 if not a group post
+	PendingApproval bool                   `protobuf:"varint,4,opt,name=pending_approval,json=pendingApproval,proto3" json:"pending_approval,omitempty"` // the group needs approval, post went to the queue
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ScheduledPostPublished) Reset() {
+	*x = ScheduledPostPublished{}
+	mi := &file_notifications_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledPostPublished) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledPostPublished) ProtoMessage() {}
+
+func (x *ScheduledPostPublished) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledPostPublished.ProtoReflect.Descriptor instead.
+func (*ScheduledPostPublished) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ScheduledPostPublished) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *ScheduledPostPublished) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *ScheduledPostPublished) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *ScheduledPostPublished) GetPendingApproval() bool {
+	if x != nil {
+		return x.PendingApproval
+	}
+	return false
+}
+
+type CommentReplyCreated struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CommentCreatorId int64                  `protobuf:"varint,1,opt,name=comment_creator_id,json=commentCreatorId,proto3" json:"comment_creator_id,omitempty"` // author of the comment replied to
+	PostId           int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	CommentId        int64                  `protobuf:"varint,3,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // the comment replied to
+	ReplyId          int64                  `protobuf:"varint,4,opt,name=reply_id,json=replyId,proto3" json:"reply_id,omitempty"`
+	ReplierUserId    int64                  `protobuf:"varint,5,opt,name=replier_user_id,json=replierUserId,proto3" json:"replier_user_id,omitempty"`
+	ReplierUsername  string                 `protobuf:"bytes,6,opt,name=replier_username,json=replierUsername,proto3" json:"replier_username,omitempty"`
+	Body             string                 `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"` // body of the comment replied to
+	Aggregate        bool                   `protobuf:"varint,8,opt,name=aggregate,proto3" json:"aggregate,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CommentReplyCreated) Reset() {
+	*x = CommentReplyCreated{}
+	mi := &file_notifications_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentReplyCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentReplyCreated) ProtoMessage() {}
+
+func (x *CommentReplyCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentReplyCreated.ProtoReflect.Descriptor instead.
+func (*CommentReplyCreated) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CommentReplyCreated) GetCommentCreatorId() int64 {
+	if x != nil {
+		return x.CommentCreatorId
+	}
+	return 0
+}
+
+func (x *CommentReplyCreated) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *CommentReplyCreated) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *CommentReplyCreated) GetReplyId() int64 {
+	if x != nil {
+		return x.ReplyId
+	}
+	return 0
+}
+
+func (x *CommentReplyCreated) GetReplierUserId() int64 {
+	if x != nil {
+		return x.ReplierUserId
+	}
+	return 0
+}
+
+func (x *CommentReplyCreated) GetReplierUsername() string {
+	if x != nil {
+		return x.ReplierUsername
+	}
+	return ""
+}
+
+func (x *CommentReplyCreated) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *CommentReplyCreated) GetAggregate() bool {
+	if x != nil {
+		return x.Aggregate
+	}
+	return false
+}
+
+type PostShared struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PostCreatorId  int64                  `protobuf:"varint,1,opt,name=post_creator_id,json=postCreatorId,proto3" json:"post_creator_id,omitempty"` // author of the shared post
+	PostId         int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`                        // the shared post
+	ShareId        int64                  `protobuf:"varint,3,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`                     // the repost or quote
+	SharerUserId   int64                  `protobuf:"varint,4,opt,name=sharer_user_id,json=sharerUserId,proto3" json:"sharer_user_id,omitempty"`
+	SharerUsername string                 `protobuf:"bytes,5,opt,name=sharer_username,json=sharerUsername,proto3" json:"sharer_username,omitempty"`
+	Quote          bool                   `protobuf:"varint,6,opt,name=quote,proto3" json:"quote,omitempty"` // false for a plain repost
+	Body           string                 `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"`    // body of the quote, empty for a repost
+	Aggregate      bool                   `protobuf:"varint,8,opt,name=aggregate,proto3" json:"aggregate,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PostShared) Reset() {
+	*x = PostShared{}
+	mi := &file_notifications_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostShared) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostShared) ProtoMessage() {}
+
+func (x *PostShared) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostShared.ProtoReflect.Descriptor instead.
+func (*PostShared) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PostShared) GetPostCreatorId() int64 {
+	if x != nil {
+		return x.PostCreatorId
+	}
+	return 0
+}
+
+func (x *PostShared) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *PostShared) GetShareId() int64 {
+	if x != nil {
+		return x.ShareId
+	}
+	return 0
+}
+
+func (x *PostShared) GetSharerUserId() int64 {
+	if x != nil {
+		return x.SharerUserId
+	}
+	return 0
+}
+
+func (x *PostShared) GetSharerUsername() string {
+	if x != nil {
+		return x.SharerUsername
+	}
+	return ""
+}
+
+func (x *PostShared) GetQuote() bool {
+	if x != nil {
+		return x.Quote
+	}
+	return false
+}
+
+func (x *PostShared) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *PostShared) GetAggregate() bool {
+	if x != nil {
+		return x.Aggregate
+	}
+	return false
+}
+
+// State changes in users service mirrored by posts service.
+// Each carries the new state, so handling one twice is harmless.
+type UserDeactivationChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Deactivated   bool                   `protobuf:"varint,2,opt,name=deactivated,proto3" json:"deactivated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDeactivationChanged) Reset() {
+	*x = UserDeactivationChanged{}
+	mi := &file_notifications_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDeactivationChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDeactivationChanged) ProtoMessage() {}
+
+func (x *UserDeactivationChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDeactivationChanged.ProtoReflect.Descriptor instead.
+func (*UserDeactivationChanged) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UserDeactivationChanged) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserDeactivationChanged) GetDeactivated() bool {
+	if x != nil {
+		return x.Deactivated
+	}
+	return false
+}
+
+type GroupArchiveChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Archived      bool                   `protobuf:"varint,2,opt,name=archived,proto3" json:"archived,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupArchiveChanged) Reset() {
+	*x = GroupArchiveChanged{}
+	mi := &file_notifications_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupArchiveChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupArchiveChanged) ProtoMessage() {}
+
+func (x *GroupArchiveChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupArchiveChanged.ProtoReflect.Descriptor instead.
+func (*GroupArchiveChanged) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GroupArchiveChanged) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *GroupArchiveChanged) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+type GroupVisibilityChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Visibility    string                 `protobuf:"bytes,2,opt,name=visibility,proto3" json:"visibility,omitempty"` // public, private or secret
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupVisibilityChanged) Reset() {
+	*x = GroupVisibilityChanged{}
+	mi := &file_notifications_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupVisibilityChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupVisibilityChanged) ProtoMessage() {}
+
+func (x *GroupVisibilityChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupVisibilityChanged.ProtoReflect.Descriptor instead.
+func (*GroupVisibilityChanged) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GroupVisibilityChanged) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *GroupVisibilityChanged) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+type GroupPostApprovalChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Required      bool                   `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupPostApprovalChanged) Reset() {
+	*x = GroupPostApprovalChanged{}
+	mi := &file_notifications_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupPostApprovalChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupPostApprovalChanged) ProtoMessage() {}
+
+func (x *GroupPostApprovalChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupPostApprovalChanged.ProtoReflect.Descriptor instead.
+func (*GroupPostApprovalChanged) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GroupPostApprovalChanged) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *GroupPostApprovalChanged) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+// Main notification event wrapper
+type NotificationEvent struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	EventId    string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	EventType  EventType              `protobuf:"varint,3,opt,name=event_type,json=eventType,proto3,enum=notifications.EventType" json:"event_type,omitempty"`
+	Metadata   map[string]string      `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*NotificationEvent_PostCommentCreated
+	//	*NotificationEvent_PostLiked
+	//	*NotificationEvent_FollowRequestCreated
+	//	*NotificationEvent_NewFollowerCreated
+	//	*NotificationEvent_GroupInviteCreated
+	//	*NotificationEvent_GroupJoinRequestCreated
+	//	*NotificationEvent_NewEventCreated
+	//	*NotificationEvent_MentionCreated
+	//	*NotificationEvent_NewMessageCreated
+	//	*NotificationEvent_FollowRequestAccepted
+	//	*NotificationEvent_FollowRequestRejected
+	//	*NotificationEvent_GroupInviteAccepted
+	//	*NotificationEvent_GroupInviteRejected
+	//	*NotificationEvent_GroupJoinRequestAccepted
+	//	*NotificationEvent_GroupJoinRequestRejected
+	//	*NotificationEvent_FollowRequestCancelled
+	//	*NotificationEvent_GroupJoinRequestCancelled
+	//	*NotificationEvent_GroupDeleted
+	//	*NotificationEvent_GroupInviteExpired
+	//	*NotificationEvent_GroupJoinRequestExpired
+	//	*NotificationEvent_GroupAnnouncementCreated
+	//	*NotificationEvent_GroupPostReviewed
+	//	*NotificationEvent_ScheduledPostPublished
+	//	*NotificationEvent_CommentReplyCreated
+	//	*NotificationEvent_PostShared
+	//	*NotificationEvent_UserDeactivationChanged
+	//	*NotificationEvent_GroupArchiveChanged
+	//	*NotificationEvent_GroupVisibilityChanged
+	//	*NotificationEvent_GroupPostApprovalChanged
+	Payload       isNotificationEvent_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationEvent) Reset() {
+	*x = NotificationEvent{}
+	mi := &file_notifications_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationEvent) ProtoMessage() {}
+
+func (x *NotificationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationEvent.ProtoReflect.Descriptor instead.
+func (*NotificationEvent) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *NotificationEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *NotificationEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetEventType() EventType {
+	if x != nil {
+		return x.EventType
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *NotificationEvent) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetPayload() isNotificationEvent_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetPostCommentCreated() *PostCommentCreated {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_PostCommentCreated); ok {
+			return x.PostCommentCreated
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetPostLiked() *PostLiked {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_PostLiked); ok {
+			return x.PostLiked
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetFollowRequestCreated() *FollowRequestCreated {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_FollowRequestCreated); ok {
+			return x.FollowRequestCreated
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetNewFollowerCreated() *NewFollowerCreated {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_NewFollowerCreated); ok {
+			return x.NewFollowerCreated
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetGroupInviteCreated() *GroupInviteCreated {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_GroupInviteCreated); ok {
+			return x.GroupInviteCreated
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetGroupJoinRequestCreated() *GroupJoinRequestCreated {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_GroupJoinRequestCreated); ok {
+			return x.GroupJoinRequestCreated
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetNewEventCreated() *NewEventCreated {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_NewEventCreated); ok {
+			return x.NewEventCreated
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetMentionCreated() *MentionCreated {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_MentionCreated); ok {
+			return x.MentionCreated
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetNewMessageCreated() *NewMessageCreated {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_NewMessageCreated); ok {
+			return x.NewMessageCreated
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetFollowRequestAccepted() *FollowRequestAccepted {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_FollowRequestAccepted); ok {
+			return x.FollowRequestAccepted
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetFollowRequestRejected() *FollowRequestRejected {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_FollowRequestRejected); ok {
+			return x.FollowRequestRejected
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetGroupInviteAccepted() *GroupInviteAccepted {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_GroupInviteAccepted); ok {
+			return x.GroupInviteAccepted
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetGroupInviteRejected() *GroupInviteRejected {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_GroupInviteRejected); ok {
+			return x.GroupInviteRejected
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetGroupJoinRequestAccepted() *GroupJoinRequestAccepted {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_GroupJoinRequestAccepted); ok {
+			return x.GroupJoinRequestAccepted
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetGroupJoinRequestRejected() *GroupJoinRequestRejected {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_GroupJoinRequestRejected); ok {
+			return x.GroupJoinRequestRejected
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetFollowRequestCancelled() *FollowRequestCancelled {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_FollowRequestCancelled); ok {
+			return x.FollowRequestCancelled
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetGroupJoinRequestCancelled() *GroupJoinRequestCancelled {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_GroupJoinRequestCancelled); ok {
+			return x.GroupJoinRequestCancelled
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetGroupDeleted() *GroupDeleted {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_GroupDeleted); ok {
+			return x.GroupDeleted
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetGroupInviteExpired() *GroupInviteExpired {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_GroupInviteExpired); ok {
+			return x.GroupInviteExpired
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetGroupJoinRequestExpired() *GroupJoinRequestExpired {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_GroupJoinRequestExpired); ok {
+			return x.GroupJoinRequestExpired
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetGroupAnnouncementCreated() *GroupAnnouncementCreated {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_GroupAnnouncementCreated); ok {
+			return x.GroupAnnouncementCreated
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetGroupPostReviewed() *GroupPostReviewed {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_GroupPostReviewed); ok {
+			return x.GroupPostReviewed
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetScheduledPostPublished() *ScheduledPostPublished {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_ScheduledPostPublished); ok {
+			return x.ScheduledPostPublished
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetCommentReplyCreated() *CommentReplyCreated {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_CommentReplyCreated); ok {
+			return x.CommentReplyCreated
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetPostShared() *PostShared {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_PostShared); ok {
+			return x.PostShared
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetUserDeactivationChanged() *UserDeactivationChanged {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_UserDeactivationChanged); ok {
+			return x.UserDeactivationChanged
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetGroupArchiveChanged() *GroupArchiveChanged {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_GroupArchiveChanged); ok {
+			return x.GroupArchiveChanged
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetGroupVisibilityChanged() *GroupVisibilityChanged {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_GroupVisibilityChanged); ok {
+			return x.GroupVisibilityChanged
+		}
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetGroupPostApprovalChanged() *GroupPostApprovalChanged {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_GroupPostApprovalChanged); ok {
+			return x.GroupPostApprovalChanged
+		}
+	}
+	return nil
+}
+
+type isNotificationEvent_Payload interface {
+	isNotificationEvent_Payload()
+}
+
+type NotificationEvent_PostCommentCreated struct {
+	PostCommentCreated *PostCommentCreated `protobuf:"bytes,10,opt,name=post_comment_created,json=postCommentCreated,proto3,oneof"`
+}
+
+type NotificationEvent_PostLiked struct {
+	PostLiked *PostLiked `protobuf:"bytes,11,opt,name=post_liked,json=postLiked,proto3,oneof"`
+}
+
+type NotificationEvent_FollowRequestCreated struct {
+	FollowRequestCreated *FollowRequestCreated `protobuf:"bytes,12,opt,name=follow_request_created,json=followRequestCreated,proto3,oneof"`
 }
 
 type NotificationEvent_NewFollowerCreated struct {
@@ -4051,6 +5078,54 @@ type NotificationEvent_GroupJoinRequestCancelled struct {
 	GroupJoinRequestCancelled *GroupJoinRequestCancelled `protobuf:"bytes,26,opt,name=group_join_request_cancelled,json=groupJoinRequestCancelled,proto3,oneof"`
 }
 
+type NotificationEvent_GroupDeleted struct {
+	GroupDeleted *GroupDeleted `protobuf:"bytes,27,opt,name=group_deleted,json=groupDeleted,proto3,oneof"`
+}
+
+type NotificationEvent_GroupInviteExpired struct {
+	GroupInviteExpired *GroupInviteExpired `protobuf:"bytes,28,opt,name=group_invite_expired,json=groupInviteExpired,proto3,oneof"`
+}
+
+type NotificationEvent_GroupJoinRequestExpired struct {
+	GroupJoinRequestExpired *GroupJoinRequestExpired `protobuf:"bytes,29,opt,name=group_join_request_expired,json=groupJoinRequestExpired,proto3,oneof"`
+}
+
+type NotificationEvent_GroupAnnouncementCreated struct {
+	GroupAnnouncementCreated *GroupAnnouncementCreated `protobuf:"bytes,30,opt,name=group_announcement_created,json=groupAnnouncementCreated,proto3,oneof"`
+}
+
+type NotificationEvent_GroupPostReviewed struct {
+	GroupPostReviewed *GroupPostReviewed `protobuf:"bytes,31,opt,name=group_post_reviewed,json=groupPostReviewed,proto3,oneof"`
+}
+
+type NotificationEvent_ScheduledPostPublished struct {
+	ScheduledPostPublished *ScheduledPostPublished `protobuf:"bytes,32,opt,name=scheduled_post_published,json=scheduledPostPublished,proto3,oneof"`
+}
+
+type NotificationEvent_CommentReplyCreated struct {
+	CommentReplyCreated *CommentReplyCreated `protobuf:"bytes,33,opt,name=comment_reply_created,json=commentReplyCreated,proto3,oneof"`
+}
+
+type NotificationEvent_PostShared struct {
+	PostShared *PostShared `protobuf:"bytes,34,opt,name=post_shared,json=postShared,proto3,oneof"`
+}
+
+type NotificationEvent_UserDeactivationChanged struct {
+	UserDeactivationChanged *UserDeactivationChanged `protobuf:"bytes,35,opt,name=user_deactivation_changed,json=userDeactivationChanged,proto3,oneof"`
+}
+
+type NotificationEvent_GroupArchiveChanged struct {
+	GroupArchiveChanged *GroupArchiveChanged `protobuf:"bytes,36,opt,name=group_archive_changed,json=groupArchiveChanged,proto3,oneof"`
+}
+
+type NotificationEvent_GroupVisibilityChanged struct {
+	GroupVisibilityChanged *GroupVisibilityChanged `protobuf:"bytes,37,opt,name=group_visibility_changed,json=groupVisibilityChanged,proto3,oneof"`
+}
+
+type NotificationEvent_GroupPostApprovalChanged struct {
+	GroupPostApprovalChanged *GroupPostApprovalChanged `protobuf:"bytes,38,opt,name=group_post_approval_changed,json=groupPostApprovalChanged,proto3,oneof"`
+}
+
 func (*NotificationEvent_PostCommentCreated) isNotificationEvent_Payload() {}
 
 func (*NotificationEvent_PostLiked) isNotificationEvent_Payload() {}
@@ -4085,6 +5160,30 @@ func (*NotificationEvent_FollowRequestCancelled) isNotificationEvent_Payload() {
 
 func (*NotificationEvent_GroupJoinRequestCancelled) isNotificationEvent_Payload() {}
 
+func (*NotificationEvent_GroupDeleted) isNotificationEvent_Payload() {}
+
+func (*NotificationEvent_GroupInviteExpired) isNotificationEvent_Payload() {}
+
+func (*NotificationEvent_GroupJoinRequestExpired) isNotificationEvent_Payload() {}
+
+func (*NotificationEvent_GroupAnnouncementCreated) isNotificationEvent_Payload() {}
+
+func (*NotificationEvent_GroupPostReviewed) isNotificationEvent_Payload() {}
+
+func (*NotificationEvent_ScheduledPostPublished) isNotificationEvent_Payload() {}
+
+func (*NotificationEvent_CommentReplyCreated) isNotificationEvent_Payload() {}
+
+func (*NotificationEvent_PostShared) isNotificationEvent_Payload() {}
+
+func (*NotificationEvent_UserDeactivationChanged) isNotificationEvent_Payload() {}
+
+func (*NotificationEvent_GroupArchiveChanged) isNotificationEvent_Payload() {}
+
+func (*NotificationEvent_GroupVisibilityChanged) isNotificationEvent_Payload() {}
+
+func (*NotificationEvent_GroupPostApprovalChanged) isNotificationEvent_Payload() {}
+
 // Message for notification deletion events
 type NotificationDeletion struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -4097,7 +5196,7 @@ type NotificationDeletion struct {
 
 func (x *NotificationDeletion) Reset() {
 	*x = NotificationDeletion{}
-	mi := &file_notifications_proto_msgTypes[50]
+	mi := &file_notifications_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4109,7 +5208,7 @@ func (x *NotificationDeletion) String() string {
 func (*NotificationDeletion) ProtoMessage() {}
 
 func (x *NotificationDeletion) ProtoReflect() protoreflect.Message {
-	mi := &file_notifications_proto_msgTypes[50]
+	mi := &file_notifications_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4122,7 +5221,7 @@ func (x *NotificationDeletion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationDeletion.ProtoReflect.Descriptor instead.
 func (*NotificationDeletion) Descriptor() ([]byte, []int) {
-	return file_notifications_proto_rawDescGZIP(), []int{50}
+	return file_notifications_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *NotificationDeletion) GetNotificationId() int64 {
@@ -4273,27 +5372,30 @@ const file_notifications_proto_rawDesc = "" +
 	"\n" +
 	"group_name\x18\x05 \x01(\tR\tgroupName\x12\x1f\n" +
 	"\vevent_title\x18\x06 \x01(\tR\n" +
-	"eventTitle\"\xb2\x01\n" +
+	"eventTitle\"\xd7\x01\n" +
 	"\x15CreatePostLikeRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\"\n" +
 	"\rliker_user_id\x18\x02 \x01(\x03R\vlikerUserId\x12\x17\n" +
 	"\apost_id\x18\x03 \x01(\x03R\x06postId\x12%\n" +
 	"\x0eliker_username\x18\x04 \x01(\tR\rlikerUsername\x12\x1c\n" +
-	"\taggregate\x18\x05 \x01(\bR\taggregate\"\xee\x01\n" +
+	"\taggregate\x18\x05 \x01(\bR\taggregate\x12#\n" +
+	"\rreaction_type\x18\x06 \x01(\tR\freactionType\"\xee\x01\n" +
 	"\x18CreatePostCommentRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12*\n" +
 	"\x11commenter_user_id\x18\x02 \x01(\x03R\x0fcommenterUserId\x12\x17\n" +
 	"\apost_id\x18\x03 \x01(\x03R\x06postId\x12-\n" +
 	"\x12commenter_username\x18\x04 \x01(\tR\x11commenterUsername\x12'\n" +
 	"\x0fcomment_content\x18\x05 \x01(\tR\x0ecommentContent\x12\x1c\n" +
-	"\taggregate\x18\x06 \x01(\bR\taggregate\"\xe9\x01\n" +
+	"\taggregate\x18\x06 \x01(\bR\taggregate\"\x88\x02\n" +
 	"\x14CreateMentionRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12*\n" +
 	"\x11mentioner_user_id\x18\x02 \x01(\x03R\x0fmentionerUserId\x12\x17\n" +
 	"\apost_id\x18\x03 \x01(\x03R\x06postId\x12-\n" +
 	"\x12mentioner_username\x18\x04 \x01(\tR\x11mentionerUsername\x12!\n" +
 	"\fpost_content\x18\x05 \x01(\tR\vpostContent\x12!\n" +
-	"\fmention_text\x18\x06 \x01(\tR\vmentionText\"\xe1\x01\n" +
+	"\fmention_text\x18\x06 \x01(\tR\vmentionText\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\a \x01(\x03R\tcommentId\"\xe1\x01\n" +
 	"\x17CreateNewMessageRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12$\n" +
 	"\x0esender_user_id\x18\x02 \x01(\x03R\fsenderUserId\x12\x17\n" +
@@ -4352,13 +5454,14 @@ const file_notifications_proto_rawDesc = "" +
 	"\x11commenter_user_id\x18\x04 \x01(\x03R\x0fcommenterUserId\x12-\n" +
 	"\x12commenter_username\x18\x05 \x01(\tR\x11commenterUsername\x12\x12\n" +
 	"\x04body\x18\x06 \x01(\tR\x04body\x12\x1c\n" +
-	"\taggregate\x18\a \x01(\bR\taggregate\"\xb9\x01\n" +
+	"\taggregate\x18\a \x01(\bR\taggregate\"\xde\x01\n" +
 	"\tPostLiked\x12*\n" +
 	"\x11entity_creator_id\x18\x01 \x01(\x03R\x0fentityCreatorId\x12\x17\n" +
 	"\apost_id\x18\x02 \x01(\x03R\x06postId\x12\"\n" +
 	"\rliker_user_id\x18\x03 \x01(\x03R\vlikerUserId\x12%\n" +
 	"\x0eliker_username\x18\x04 \x01(\tR\rlikerUsername\x12\x1c\n" +
-	"\taggregate\x18\x05 \x01(\bR\taggregate\"\x97\x01\n" +
+	"\taggregate\x18\x05 \x01(\bR\taggregate\x12#\n" +
+	"\rreaction_type\x18\x06 \x01(\tR\freactionType\"\x97\x01\n" +
 	"\x14FollowRequestCreated\x12$\n" +
 	"\x0etarget_user_id\x18\x01 \x01(\x03R\ftargetUserId\x12*\n" +
 	"\x11requester_user_id\x18\x02 \x01(\x03R\x0frequesterUserId\x12-\n" +
@@ -4390,14 +5493,16 @@ const file_notifications_proto_rawDesc = "" +
 	"\n" +
 	"group_name\x18\x05 \x01(\tR\tgroupName\x12\x1f\n" +
 	"\vevent_title\x18\x06 \x01(\tR\n" +
-	"eventTitle\"\xf6\x01\n" +
+	"eventTitle\"\x95\x02\n" +
 	"\x0eMentionCreated\x12*\n" +
 	"\x11mentioned_user_id\x18\x01 \x01(\x03R\x0fmentionedUserId\x12*\n" +
 	"\x11mentioner_user_id\x18\x02 \x01(\x03R\x0fmentionerUserId\x12\x17\n" +
 	"\apost_id\x18\x03 \x01(\x03R\x06postId\x12-\n" +
 	"\x12mentioner_username\x18\x04 \x01(\tR\x11mentionerUsername\x12!\n" +
 	"\fpost_content\x18\x05 \x01(\tR\vpostContent\x12!\n" +
-	"\fmention_text\x18\x06 \x01(\tR\vmentionText\"\xdb\x01\n" +
+	"\fmention_text\x18\x06 \x01(\tR\vmentionText\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\a \x01(\x03R\tcommentId\"\xdb\x01\n" +
 	"\x11NewMessageCreated\x12\x17\n" +
 	"\auser_id\x18\x01 \x03(\x03R\x06userId\x12$\n" +
 	"\x0esender_user_id\x18\x02 \x01(\x03R\fsenderUserId\x12\x17\n" +
@@ -4445,7 +5550,74 @@ const file_notifications_proto_rawDesc = "" +
 	"\x19GroupJoinRequestCancelled\x12$\n" +
 	"\x0egroup_owner_id\x18\x01 \x01(\x03R\fgroupOwnerId\x12*\n" +
 	"\x11requester_user_id\x18\x02 \x01(\x03R\x0frequesterUserId\x12\x19\n" +
-	"\bgroup_id\x18\x03 \x01(\x03R\agroupId\"\xc0\x0e\n" +
+	"\bgroup_id\x18\x03 \x01(\x03R\agroupId\")\n" +
+	"\fGroupDeleted\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\"W\n" +
+	"\x12GroupInviteExpired\x12&\n" +
+	"\x0finvited_user_id\x18\x01 \x01(\x03R\rinvitedUserId\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\x03R\agroupId\"\xa9\x01\n" +
+	"\x17GroupJoinRequestExpired\x12$\n" +
+	"\x0egroup_owner_id\x18\x01 \x01(\x03R\fgroupOwnerId\x12*\n" +
+	"\x11requester_user_id\x18\x02 \x01(\x03R\x0frequesterUserId\x12\x19\n" +
+	"\bgroup_id\x18\x03 \x01(\x03R\agroupId\x12!\n" +
+	"\fapprover_ids\x18\x04 \x03(\x03R\vapproverIds\"\xc6\x01\n" +
+	"\x18GroupAnnouncementCreated\x12\x17\n" +
+	"\auser_id\x18\x01 \x03(\x03R\x06userId\x12\x1b\n" +
+	"\tauthor_id\x18\x02 \x01(\x03R\bauthorId\x12\x19\n" +
+	"\bgroup_id\x18\x03 \x01(\x03R\agroupId\x12\x17\n" +
+	"\apost_id\x18\x04 \x01(\x03R\x06postId\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x05 \x01(\tR\tgroupName\x12!\n" +
+	"\fpost_content\x18\x06 \x01(\tR\vpostContent\"\xd8\x01\n" +
+	"\x11GroupPostReviewed\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\x03R\bauthorId\x12\x1f\n" +
+	"\vreviewer_id\x18\x02 \x01(\x03R\n" +
+	"reviewerId\x12\x19\n" +
+	"\bgroup_id\x18\x03 \x01(\x03R\agroupId\x12\x17\n" +
+	"\apost_id\x18\x04 \x01(\x03R\x06postId\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x05 \x01(\tR\tgroupName\x12\x1a\n" +
+	"\bapproved\x18\x06 \x01(\bR\bapproved\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\"\x94\x01\n" +
+	"\x16ScheduledPostPublished\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\x03R\bauthorId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\x03R\x06postId\x12\x19\n" +
+	"\bgroup_id\x18\x03 \x01(\x03R\agroupId\x12)\n" +
+	"\x10pending_approval\x18\x04 \x01(\bR\x0fpendingApproval\"\x9b\x02\n" +
+	"\x13CommentReplyCreated\x12,\n" +
+	"\x12comment_creator_id\x18\x01 \x01(\x03R\x10commentCreatorId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\x03R\x06postId\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x03 \x01(\x03R\tcommentId\x12\x19\n" +
+	"\breply_id\x18\x04 \x01(\x03R\areplyId\x12&\n" +
+	"\x0freplier_user_id\x18\x05 \x01(\x03R\rreplierUserId\x12)\n" +
+	"\x10replier_username\x18\x06 \x01(\tR\x0freplierUsername\x12\x12\n" +
+	"\x04body\x18\a \x01(\tR\x04body\x12\x1c\n" +
+	"\taggregate\x18\b \x01(\bR\taggregate\"\xff\x01\n" +
+	"\n" +
+	"PostShared\x12&\n" +
+	"\x0fpost_creator_id\x18\x01 \x01(\x03R\rpostCreatorId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\x03R\x06postId\x12\x19\n" +
+	"\bshare_id\x18\x03 \x01(\x03R\ashareId\x12$\n" +
+	"\x0esharer_user_id\x18\x04 \x01(\x03R\fsharerUserId\x12'\n" +
+	"\x0fsharer_username\x18\x05 \x01(\tR\x0esharerUsername\x12\x14\n" +
+	"\x05quote\x18\x06 \x01(\bR\x05quote\x12\x12\n" +
+	"\x04body\x18\a \x01(\tR\x04body\x12\x1c\n" +
+	"\taggregate\x18\b \x01(\bR\taggregate\"T\n" +
+	"\x17UserDeactivationChanged\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12 \n" +
+	"\vdeactivated\x18\x02 \x01(\bR\vdeactivated\"L\n" +
+	"\x13GroupArchiveChanged\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12\x1a\n" +
+	"\barchived\x18\x02 \x01(\bR\barchived\"S\n" +
+	"\x16GroupVisibilityChanged\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x02 \x01(\tR\n" +
+	"visibility\"Q\n" +
+	"\x18GroupPostApprovalChanged\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12\x1a\n" +
+	"\brequired\x18\x02 \x01(\bR\brequired\"\x87\x17\n" +
 	"\x11NotificationEvent\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12;\n" +
 	"\voccurred_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
@@ -4471,7 +5643,20 @@ const file_notifications_proto_rawDesc = "" +
 	"\x1bgroup_join_request_accepted\x18\x17 \x01(\v2'.notifications.GroupJoinRequestAcceptedH\x00R\x18groupJoinRequestAccepted\x12h\n" +
 	"\x1bgroup_join_request_rejected\x18\x18 \x01(\v2'.notifications.GroupJoinRequestRejectedH\x00R\x18groupJoinRequestRejected\x12a\n" +
 	"\x18follow_request_cancelled\x18\x19 \x01(\v2%.notifications.FollowRequestCancelledH\x00R\x16followRequestCancelled\x12k\n" +
-	"\x1cgroup_join_request_cancelled\x18\x1a \x01(\v2(.notifications.GroupJoinRequestCancelledH\x00R\x19groupJoinRequestCancelled\x1a;\n" +
+	"\x1cgroup_join_request_cancelled\x18\x1a \x01(\v2(.notifications.GroupJoinRequestCancelledH\x00R\x19groupJoinRequestCancelled\x12B\n" +
+	"\rgroup_deleted\x18\x1b \x01(\v2\x1b.notifications.GroupDeletedH\x00R\fgroupDeleted\x12U\n" +
+	"\x14group_invite_expired\x18\x1c \x01(\v2!.notifications.GroupInviteExpiredH\x00R\x12groupInviteExpired\x12e\n" +
+	"\x1agroup_join_request_expired\x18\x1d \x01(\v2&.notifications.GroupJoinRequestExpiredH\x00R\x17groupJoinRequestExpired\x12g\n" +
+	"\x1agroup_announcement_created\x18\x1e \x01(\v2'.notifications.GroupAnnouncementCreatedH\x00R\x18groupAnnouncementCreated\x12R\n" +
+	"\x13group_post_reviewed\x18\x1f \x01(\v2 .notifications.GroupPostReviewedH\x00R\x11groupPostReviewed\x12a\n" +
+	"\x18scheduled_post_published\x18  \x01(\v2%.notifications.ScheduledPostPublishedH\x00R\x16scheduledPostPublished\x12X\n" +
+	"\x15comment_reply_created\x18! \x01(\v2\".notifications.CommentReplyCreatedH\x00R\x13commentReplyCreated\x12<\n" +
+	"\vpost_shared\x18\" \x01(\v2\x19.notifications.PostSharedH\x00R\n" +
+	"postShared\x12d\n" +
+	"\x19user_deactivation_changed\x18# \x01(\v2&.notifications.UserDeactivationChangedH\x00R\x17userDeactivationChanged\x12X\n" +
+	"\x15group_archive_changed\x18$ \x01(\v2\".notifications.GroupArchiveChangedH\x00R\x13groupArchiveChanged\x12a\n" +
+	"\x18group_visibility_changed\x18% \x01(\v2%.notifications.GroupVisibilityChangedH\x00R\x16groupVisibilityChanged\x12h\n" +
+	"\x1bgroup_post_approval_changed\x18& \x01(\v2'.notifications.GroupPostApprovalChangedH\x00R\x18groupPostApprovalChanged\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\t\n" +
@@ -4480,7 +5665,7 @@ const file_notifications_proto_rawDesc = "" +
 	"\x0fnotification_id\x18\x01 \x01(\x03R\x0enotificationId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x129\n" +
 	"\n" +
-	"deleted_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt*\x93\x05\n" +
+	"deleted_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt*\xa3\a\n" +
 	"\x10NotificationType\x12!\n" +
 	"\x1dNOTIFICATION_TYPE_UNSPECIFIED\x10\x00\x12$\n" +
 	" NOTIFICATION_TYPE_FOLLOW_REQUEST\x10\x01\x12\"\n" +
@@ -4498,12 +5683,19 @@ const file_notifications_proto_rawDesc = "" +
 	"'NOTIFICATION_TYPE_GROUP_INVITE_ACCEPTED\x10\f\x12+\n" +
 	"'NOTIFICATION_TYPE_GROUP_INVITE_REJECTED\x10\r\x121\n" +
 	"-NOTIFICATION_TYPE_GROUP_JOIN_REQUEST_ACCEPTED\x10\x0e\x121\n" +
-	"-NOTIFICATION_TYPE_GROUP_JOIN_REQUEST_REJECTED\x10\x0f*\x98\x01\n" +
+	"-NOTIFICATION_TYPE_GROUP_JOIN_REQUEST_REJECTED\x10\x0f\x12(\n" +
+	"$NOTIFICATION_TYPE_GROUP_ANNOUNCEMENT\x10\x10\x12)\n" +
+	"%NOTIFICATION_TYPE_GROUP_POST_APPROVED\x10\x11\x12)\n" +
+	"%NOTIFICATION_TYPE_GROUP_POST_REJECTED\x10\x12\x12.\n" +
+	"*NOTIFICATION_TYPE_SCHEDULED_POST_PUBLISHED\x10\x13\x12#\n" +
+	"\x1fNOTIFICATION_TYPE_COMMENT_REPLY\x10\x14\x12\x1c\n" +
+	"\x18NOTIFICATION_TYPE_REPOST\x10\x15\x12\x1b\n" +
+	"\x17NOTIFICATION_TYPE_QUOTE\x10\x16*\x98\x01\n" +
 	"\x12NotificationStatus\x12#\n" +
 	"\x1fNOTIFICATION_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aNOTIFICATION_STATUS_UNREAD\x10\x01\x12\x1c\n" +
 	"\x18NOTIFICATION_STATUS_READ\x10\x02\x12\x1f\n" +
-	"\x1bNOTIFICATION_STATUS_DELETED\x10\x03*\xf8\x03\n" +
+	"\x1bNOTIFICATION_STATUS_DELETED\x10\x03*\xc1\x06\n" +
 	"\tEventType\x12\x1a\n" +
 	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14POST_COMMENT_CREATED\x10\x01\x12\x0e\n" +
@@ -4524,7 +5716,19 @@ const file_notifications_proto_rawDesc = "" +
 	"\x1bGROUP_JOIN_REQUEST_ACCEPTED\x10\x0e\x12\x1f\n" +
 	"\x1bGROUP_JOIN_REQUEST_REJECTED\x10\x0f\x12\x1c\n" +
 	"\x18FOLLOW_REQUEST_CANCELLED\x10\x10\x12 \n" +
-	"\x1cGROUP_JOIN_REQUEST_CANCELLED\x10\x112\xe3\x16\n" +
+	"\x1cGROUP_JOIN_REQUEST_CANCELLED\x10\x11\x12\x11\n" +
+	"\rGROUP_DELETED\x10\x12\x12\x18\n" +
+	"\x14GROUP_INVITE_EXPIRED\x10\x13\x12\x1e\n" +
+	"\x1aGROUP_JOIN_REQUEST_EXPIRED\x10\x14\x12\x1e\n" +
+	"\x1aGROUP_ANNOUNCEMENT_CREATED\x10\x15\x12\x17\n" +
+	"\x13GROUP_POST_REVIEWED\x10\x16\x12\x1c\n" +
+	"\x18SCHEDULED_POST_PUBLISHED\x10\x17\x12\x19\n" +
+	"\x15COMMENT_REPLY_CREATED\x10\x18\x12\x0f\n" +
+	"\vPOST_SHARED\x10\x19\x12\x1d\n" +
+	"\x19USER_DEACTIVATION_CHANGED\x10\x1a\x12\x19\n" +
+	"\x15GROUP_ARCHIVE_CHANGED\x10\x1b\x12\x1c\n" +
+	"\x18GROUP_VISIBILITY_CHANGED\x10\x1c\x12\x1f\n" +
+	"\x1bGROUP_POST_APPROVAL_CHANGED\x10\x1d2\xe3\x16\n" +
 	"\x13NotificationService\x12[\n" +
 	"\x12CreateNotification\x12(.notifications.CreateNotificationRequest\x1a\x1b.notifications.Notification\x12l\n" +
 	"\x13CreateNotifications\x12).notifications.CreateNotificationsRequest\x1a*.notifications.CreateNotificationsResponse\x12]\n" +
@@ -4568,7 +5772,7 @@ func file_notifications_proto_rawDescGZIP() []byte {
 }
 
 var file_notifications_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_notifications_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
+var file_notifications_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
 var file_notifications_proto_goTypes = []any{
 	(NotificationType)(0),                             // 0: notifications.NotificationType
 	(NotificationStatus)(0),                           // 1: notifications.NotificationStatus
@@ -4622,36 +5826,48 @@ var file_notifications_proto_goTypes = []any{
 	(*GroupJoinRequestRejected)(nil),                  // 49: notifications.GroupJoinRequestRejected
 	(*FollowRequestCancelled)(nil),                    // 50: notifications.FollowRequestCancelled
 	(*GroupJoinRequestCancelled)(nil),                 // 51: notifications.GroupJoinRequestCancelled
-	(*NotificationEvent)(nil),                         // 52: notifications.NotificationEvent
-	(*NotificationDeletion)(nil),                      // 53: notifications.NotificationDeletion
-	nil,                                               // 54: notifications.Notification.PayloadEntry
-	nil,                                               // 55: notifications.CreateNotificationRequest.PayloadEntry
-	nil,                                               // 56: notifications.NotificationPreferences.PreferencesEntry
-	nil,                                               // 57: notifications.UpdateNotificationPreferencesRequest.PreferencesEntry
-	nil,                                               // 58: notifications.NotificationEvent.MetadataEntry
-	(*timestamppb.Timestamp)(nil),                     // 59: google.protobuf.Timestamp
-	(*wrapperspb.Int64Value)(nil),                     // 60: google.protobuf.Int64Value
-	(*emptypb.Empty)(nil),                             // 61: google.protobuf.Empty
+	(*GroupDeleted)(nil),                              // 52: notifications.GroupDeleted
+	(*GroupInviteExpired)(nil),                        // 53: notifications.GroupInviteExpired
+	(*GroupJoinRequestExpired)(nil),                   // 54: notifications.GroupJoinRequestExpired
+	(*GroupAnnouncementCreated)(nil),                  // 55: notifications.GroupAnnouncementCreated
+	(*GroupPostReviewed)(nil),                         // 56: notifications.GroupPostReviewed
+	(*ScheduledPostPublished)(nil),                    // 57: notifications.ScheduledPostPublished
+	(*CommentReplyCreated)(nil),                       // 58: notifications.CommentReplyCreated
+	(*PostShared)(nil),                                // 59: notifications.PostShared
+	(*UserDeactivationChanged)(nil),                   // 60: notifications.UserDeactivationChanged
+	(*GroupArchiveChanged)(nil),                       // 61: notifications.GroupArchiveChanged
+	(*GroupVisibilityChanged)(nil),                    // 62: notifications.GroupVisibilityChanged
+	(*GroupPostApprovalChanged)(nil),                  // 63: notifications.GroupPostApprovalChanged
+	(*NotificationEvent)(nil),                         // 64: notifications.NotificationEvent
+	(*NotificationDeletion)(nil),                      // 65: notifications.NotificationDeletion
+	nil,                                               // 66: notifications.Notification.PayloadEntry
+	nil,                                               // 67: notifications.CreateNotificationRequest.PayloadEntry
+	nil,                                               // 68: notifications.NotificationPreferences.PreferencesEntry
+	nil,                                               // 69: notifications.UpdateNotificationPreferencesRequest.PreferencesEntry
+	nil,                                               // 70: notifications.NotificationEvent.MetadataEntry
+	(*timestamppb.Timestamp)(nil),                     // 71: google.protobuf.Timestamp
+	(*wrapperspb.Int64Value)(nil),                     // 72: google.protobuf.Int64Value
+	(*emptypb.Empty)(nil),                             // 73: google.protobuf.Empty
 }
 var file_notifications_proto_depIdxs = []int32{
-	54, // 0: notifications.Notification.payload:type_name -> notifications.Notification.PayloadEntry
-	59, // 1: notifications.Notification.created_at:type_name -> google.protobuf.Timestamp
-	59, // 2: notifications.Notification.expires_at:type_name -> google.protobuf.Timestamp
+	66, // 0: notifications.Notification.payload:type_name -> notifications.Notification.PayloadEntry
+	71, // 1: notifications.Notification.created_at:type_name -> google.protobuf.Timestamp
+	71, // 2: notifications.Notification.expires_at:type_name -> google.protobuf.Timestamp
 	1,  // 3: notifications.Notification.status:type_name -> notifications.NotificationStatus
 	0,  // 4: notifications.CreateNotificationRequest.type:type_name -> notifications.NotificationType
-	55, // 5: notifications.CreateNotificationRequest.payload:type_name -> notifications.CreateNotificationRequest.PayloadEntry
+	67, // 5: notifications.CreateNotificationRequest.payload:type_name -> notifications.CreateNotificationRequest.PayloadEntry
 	4,  // 6: notifications.CreateNotificationsRequest.notifications:type_name -> notifications.CreateNotificationRequest
 	3,  // 7: notifications.CreateNotificationsResponse.created_notifications:type_name -> notifications.Notification
 	3,  // 8: notifications.CreateNewEventForMultipleUsersResponse.created_notifications:type_name -> notifications.Notification
 	0,  // 9: notifications.GetUserNotificationsRequest.types:type_name -> notifications.NotificationType
 	3,  // 10: notifications.GetUserNotificationsResponse.notifications:type_name -> notifications.Notification
-	56, // 11: notifications.NotificationPreferences.preferences:type_name -> notifications.NotificationPreferences.PreferencesEntry
-	57, // 12: notifications.UpdateNotificationPreferencesRequest.preferences:type_name -> notifications.UpdateNotificationPreferencesRequest.PreferencesEntry
+	68, // 11: notifications.NotificationPreferences.preferences:type_name -> notifications.NotificationPreferences.PreferencesEntry
+	69, // 12: notifications.UpdateNotificationPreferencesRequest.preferences:type_name -> notifications.UpdateNotificationPreferencesRequest.PreferencesEntry
 	3,  // 13: notifications.CreateGroupInviteForMultipleUsersResponse.created_notifications:type_name -> notifications.Notification
 	3,  // 14: notifications.CreateNewMessageForMultipleUsersResponse.created_notifications:type_name -> notifications.Notification
-	59, // 15: notifications.NotificationEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	71, // 15: notifications.NotificationEvent.occurred_at:type_name -> google.protobuf.Timestamp
 	2,  // 16: notifications.NotificationEvent.event_type:type_name -> notifications.EventType
-	58, // 17: notifications.NotificationEvent.metadata:type_name -> notifications.NotificationEvent.MetadataEntry
+	70, // 17: notifications.NotificationEvent.metadata:type_name -> notifications.NotificationEvent.MetadataEntry
 	35, // 18: notifications.NotificationEvent.post_comment_created:type_name -> notifications.PostCommentCreated
 	36, // 19: notifications.NotificationEvent.post_liked:type_name -> notifications.PostLiked
 	37, // 20: notifications.NotificationEvent.follow_request_created:type_name -> notifications.FollowRequestCreated
@@ -4669,68 +5885,80 @@ var file_notifications_proto_depIdxs = []int32{
 	49, // 32: notifications.NotificationEvent.group_join_request_rejected:type_name -> notifications.GroupJoinRequestRejected
 	50, // 33: notifications.NotificationEvent.follow_request_cancelled:type_name -> notifications.FollowRequestCancelled
 	51, // 34: notifications.NotificationEvent.group_join_request_cancelled:type_name -> notifications.GroupJoinRequestCancelled
-	59, // 35: notifications.NotificationDeletion.deleted_at:type_name -> google.protobuf.Timestamp
-	4,  // 36: notifications.NotificationService.CreateNotification:input_type -> notifications.CreateNotificationRequest
-	5,  // 37: notifications.NotificationService.CreateNotifications:input_type -> notifications.CreateNotificationsRequest
-	16, // 38: notifications.NotificationService.CreateFollowRequest:input_type -> notifications.CreateFollowRequestRequest
-	17, // 39: notifications.NotificationService.CreateNewFollower:input_type -> notifications.CreateNewFollowerRequest
-	18, // 40: notifications.NotificationService.CreateGroupInvite:input_type -> notifications.CreateGroupInviteRequest
-	19, // 41: notifications.NotificationService.CreateGroupInviteForMultipleUsers:input_type -> notifications.CreateGroupInviteForMultipleUsersRequest
-	21, // 42: notifications.NotificationService.CreateGroupJoinRequest:input_type -> notifications.CreateGroupJoinRequestRequest
-	22, // 43: notifications.NotificationService.CreateNewEvent:input_type -> notifications.CreateNewEventRequest
-	7,  // 44: notifications.NotificationService.CreateNewEventForMultipleUsers:input_type -> notifications.CreateNewEventForMultipleUsersRequest
-	23, // 45: notifications.NotificationService.CreatePostLike:input_type -> notifications.CreatePostLikeRequest
-	24, // 46: notifications.NotificationService.CreatePostComment:input_type -> notifications.CreatePostCommentRequest
-	25, // 47: notifications.NotificationService.CreateMention:input_type -> notifications.CreateMentionRequest
-	26, // 48: notifications.NotificationService.CreateNewMessage:input_type -> notifications.CreateNewMessageRequest
-	27, // 49: notifications.NotificationService.CreateNewMessageForMultipleUsers:input_type -> notifications.CreateNewMessageForMultipleUsersRequest
-	29, // 50: notifications.NotificationService.CreateFollowRequestAccepted:input_type -> notifications.CreateFollowRequestAcceptedRequest
-	30, // 51: notifications.NotificationService.CreateFollowRequestRejected:input_type -> notifications.CreateFollowRequestRejectedRequest
-	31, // 52: notifications.NotificationService.CreateGroupInviteAccepted:input_type -> notifications.CreateGroupInviteAcceptedRequest
-	32, // 53: notifications.NotificationService.CreateGroupInviteRejected:input_type -> notifications.CreateGroupInviteRejectedRequest
-	33, // 54: notifications.NotificationService.CreateGroupJoinRequestAccepted:input_type -> notifications.CreateGroupJoinRequestAcceptedRequest
-	34, // 55: notifications.NotificationService.CreateGroupJoinRequestRejected:input_type -> notifications.CreateGroupJoinRequestRejectedRequest
-	9,  // 56: notifications.NotificationService.GetUserNotifications:input_type -> notifications.GetUserNotificationsRequest
-	60, // 57: notifications.NotificationService.GetUnreadNotificationsCount:input_type -> google.protobuf.Int64Value
-	11, // 58: notifications.NotificationService.MarkNotificationAsRead:input_type -> notifications.MarkNotificationAsReadRequest
-	12, // 59: notifications.NotificationService.MarkNotificationAsActed:input_type -> notifications.MarkNotificationAsActedRequest
-	60, // 60: notifications.NotificationService.MarkAllAsRead:input_type -> google.protobuf.Int64Value
-	13, // 61: notifications.NotificationService.DeleteNotification:input_type -> notifications.DeleteNotificationRequest
-	60, // 62: notifications.NotificationService.GetNotificationPreferences:input_type -> google.protobuf.Int64Value
-	15, // 63: notifications.NotificationService.UpdateNotificationPreferences:input_type -> notifications.UpdateNotificationPreferencesRequest
-	3,  // 64: notifications.NotificationService.CreateNotification:output_type -> notifications.Notification
-	6,  // 65: notifications.NotificationService.CreateNotifications:output_type -> notifications.CreateNotificationsResponse
-	3,  // 66: notifications.NotificationService.CreateFollowRequest:output_type -> notifications.Notification
-	3,  // 67: notifications.NotificationService.CreateNewFollower:output_type -> notifications.Notification
-	3,  // 68: notifications.NotificationService.CreateGroupInvite:output_type -> notifications.Notification
-	20, // 69: notifications.NotificationService.CreateGroupInviteForMultipleUsers:output_type -> notifications.CreateGroupInviteForMultipleUsersResponse
-	3,  // 70: notifications.NotificationService.CreateGroupJoinRequest:output_type -> notifications.Notification
-	3,  // 71: notifications.NotificationService.CreateNewEvent:output_type -> notifications.Notification
-	8,  // 72: notifications.NotificationService.CreateNewEventForMultipleUsers:output_type -> notifications.CreateNewEventForMultipleUsersResponse
-	3,  // 73: notifications.NotificationService.CreatePostLike:output_type -> notifications.Notification
-	3,  // 74: notifications.NotificationService.CreatePostComment:output_type -> notifications.Notification
-	3,  // 75: notifications.NotificationService.CreateMention:output_type -> notifications.Notification
-	3,  // 76: notifications.NotificationService.CreateNewMessage:output_type -> notifications.Notification
-	28, // 77: notifications.NotificationService.CreateNewMessageForMultipleUsers:output_type -> notifications.CreateNewMessageForMultipleUsersResponse
-	3,  // 78: notifications.NotificationService.CreateFollowRequestAccepted:output_type -> notifications.Notification
-	3,  // 79: notifications.NotificationService.CreateFollowRequestRejected:output_type -> notifications.Notification
-	3,  // 80: notifications.NotificationService.CreateGroupInviteAccepted:output_type -> notifications.Notification
-	3,  // 81: notifications.NotificationService.CreateGroupInviteRejected:output_type -> notifications.Notification
-	3,  // 82: notifications.NotificationService.CreateGroupJoinRequestAccepted:output_type -> notifications.Notification
-	3,  // 83: notifications.NotificationService.CreateGroupJoinRequestRejected:output_type -> notifications.Notification
-	10, // 84: notifications.NotificationService.GetUserNotifications:output_type -> notifications.GetUserNotificationsResponse
-	60, // 85: notifications.NotificationService.GetUnreadNotificationsCount:output_type -> google.protobuf.Int64Value
-	61, // 86: notifications.NotificationService.MarkNotificationAsRead:output_type -> google.protobuf.Empty
-	61, // 87: notifications.NotificationService.MarkNotificationAsActed:output_type -> google.protobuf.Empty
-	61, // 88: notifications.NotificationService.MarkAllAsRead:output_type -> google.protobuf.Empty
-	61, // 89: notifications.NotificationService.DeleteNotification:output_type -> google.protobuf.Empty
-	14, // 90: notifications.NotificationService.GetNotificationPreferences:output_type -> notifications.NotificationPreferences
-	61, // 91: notifications.NotificationService.UpdateNotificationPreferences:output_type -> google.protobuf.Empty
-	64, // [64:92] is the sub-list for method output_type
-	36, // [36:64] is the sub-list for method input_type
-	36, // [36:36] is the sub-list for extension type_name
-	36, // [36:36] is the sub-list for extension extendee
-	0,  // [0:36] is the sub-list for field type_name
+	52, // 35: notifications.NotificationEvent.group_deleted:type_name -> notifications.GroupDeleted
+	53, // 36: notifications.NotificationEvent.group_invite_expired:type_name -> notifications.GroupInviteExpired
+	54, // 37: notifications.NotificationEvent.group_join_request_expired:type_name -> notifications.GroupJoinRequestExpired
+	55, // 38: notifications.NotificationEvent.group_announcement_created:type_name -> notifications.GroupAnnouncementCreated
+	56, // 39: notifications.NotificationEvent.group_post_reviewed:type_name -> notifications.GroupPostReviewed
+	57, // 40: notifications.NotificationEvent.scheduled_post_published:type_name -> notifications.ScheduledPostPublished
+	58, // 41: notifications.NotificationEvent.comment_reply_created:type_name -> notifications.CommentReplyCreated
+	59, // 42: notifications.NotificationEvent.post_shared:type_name -> notifications.PostShared
+	60, // 43: notifications.NotificationEvent.user_deactivation_changed:type_name -> notifications.UserDeactivationChanged
+	61, // 44: notifications.NotificationEvent.group_archive_changed:type_name -> notifications.GroupArchiveChanged
+	62, // 45: notifications.NotificationEvent.group_visibility_changed:type_name -> notifications.GroupVisibilityChanged
+	63, // 46: notifications.NotificationEvent.group_post_approval_changed:type_name -> notifications.GroupPostApprovalChanged
+	71, // 47: notifications.NotificationDeletion.deleted_at:type_name -> google.protobuf.Timestamp
+	4,  // 48: notifications.NotificationService.CreateNotification:input_type -> notifications.CreateNotificationRequest
+	5,  // 49: notifications.NotificationService.CreateNotifications:input_type -> notifications.CreateNotificationsRequest
+	16, // 50: notifications.NotificationService.CreateFollowRequest:input_type -> notifications.CreateFollowRequestRequest
+	17, // 51: notifications.NotificationService.CreateNewFollower:input_type -> notifications.CreateNewFollowerRequest
+	18, // 52: notifications.NotificationService.CreateGroupInvite:input_type -> notifications.CreateGroupInviteRequest
+	19, // 53: notifications.NotificationService.CreateGroupInviteForMultipleUsers:input_type -> notifications.CreateGroupInviteForMultipleUsersRequest
+	21, // 54: notifications.NotificationService.CreateGroupJoinRequest:input_type -> notifications.CreateGroupJoinRequestRequest
+	22, // 55: notifications.NotificationService.CreateNewEvent:input_type -> notifications.CreateNewEventRequest
+	7,  // 56: notifications.NotificationService.CreateNewEventForMultipleUsers:input_type -> notifications.CreateNewEventForMultipleUsersRequest
+	23, // 57: notifications.NotificationService.CreatePostLike:input_type -> notifications.CreatePostLikeRequest
+	24, // 58: notifications.NotificationService.CreatePostComment:input_type -> notifications.CreatePostCommentRequest
+	25, // 59: notifications.NotificationService.CreateMention:input_type -> notifications.CreateMentionRequest
+	26, // 60: notifications.NotificationService.CreateNewMessage:input_type -> notifications.CreateNewMessageRequest
+	27, // 61: notifications.NotificationService.CreateNewMessageForMultipleUsers:input_type -> notifications.CreateNewMessageForMultipleUsersRequest
+	29, // 62: notifications.NotificationService.CreateFollowRequestAccepted:input_type -> notifications.CreateFollowRequestAcceptedRequest
+	30, // 63: notifications.NotificationService.CreateFollowRequestRejected:input_type -> notifications.CreateFollowRequestRejectedRequest
+	31, // 64: notifications.NotificationService.CreateGroupInviteAccepted:input_type -> notifications.CreateGroupInviteAcceptedRequest
+	32, // 65: notifications.NotificationService.CreateGroupInviteRejected:input_type -> notifications.CreateGroupInviteRejectedRequest
+	33, // 66: notifications.NotificationService.CreateGroupJoinRequestAccepted:input_type -> notifications.CreateGroupJoinRequestAcceptedRequest
+	34, // 67: notifications.NotificationService.CreateGroupJoinRequestRejected:input_type -> notifications.CreateGroupJoinRequestRejectedRequest
+	9,  // 68: notifications.NotificationService.GetUserNotifications:input_type -> notifications.GetUserNotificationsRequest
+	72, // 69: notifications.NotificationService.GetUnreadNotificationsCount:input_type -> google.protobuf.Int64Value
+	11, // 70: notifications.NotificationService.MarkNotificationAsRead:input_type -> notifications.MarkNotificationAsReadRequest
+	12, // 71: notifications.NotificationService.MarkNotificationAsActed:input_type -> notifications.MarkNotificationAsActedRequest
+	72, // 72: notifications.NotificationService.MarkAllAsRead:input_type -> google.protobuf.Int64Value
+	13, // 73: notifications.NotificationService.DeleteNotification:input_type -> notifications.DeleteNotificationRequest
+	72, // 74: notifications.NotificationService.GetNotificationPreferences:input_type -> google.protobuf.Int64Value
+	15, // 75: notifications.NotificationService.UpdateNotificationPreferences:input_type -> notifications.UpdateNotificationPreferencesRequest
+	3,  // 76: notifications.NotificationService.CreateNotification:output_type -> notifications.Notification
+	6,  // 77: notifications.NotificationService.CreateNotifications:output_type -> notifications.CreateNotificationsResponse
+	3,  // 78: notifications.NotificationService.CreateFollowRequest:output_type -> notifications.Notification
+	3,  // 79: notifications.NotificationService.CreateNewFollower:output_type -> notifications.Notification
+	3,  // 80: notifications.NotificationService.CreateGroupInvite:output_type -> notifications.Notification
+	20, // 81: notifications.NotificationService.CreateGroupInviteForMultipleUsers:output_type -> notifications.CreateGroupInviteForMultipleUsersResponse
+	3,  // 82: notifications.NotificationService.CreateGroupJoinRequest:output_type -> notifications.Notification
+	3,  // 83: notifications.NotificationService.CreateNewEvent:output_type -> notifications.Notification
+	8,  // 84: notifications.NotificationService.CreateNewEventForMultipleUsers:output_type -> notifications.CreateNewEventForMultipleUsersResponse
+	3,  // 85: notifications.NotificationService.CreatePostLike:output_type -> notifications.Notification
+	3,  // 86: notifications.NotificationService.CreatePostComment:output_type -> notifications.Notification
+	3,  // 87: notifications.NotificationService.CreateMention:output_type -> notifications.Notification
+	3,  // 88: notifications.NotificationService.CreateNewMessage:output_type -> notifications.Notification
+	28, // 89: notifications.NotificationService.CreateNewMessageForMultipleUsers:output_type -> notifications.CreateNewMessageForMultipleUsersResponse
+	3,  // 90: notifications.NotificationService.CreateFollowRequestAccepted:output_type -> notifications.Notification
+	3,  // 91: notifications.NotificationService.CreateFollowRequestRejected:output_type -> notifications.Notification
+	3,  // 92: notifications.NotificationService.CreateGroupInviteAccepted:output_type -> notifications.Notification
+	3,  // 93: notifications.NotificationService.CreateGroupInviteRejected:output_type -> notifications.Notification
+	3,  // 94: notifications.NotificationService.CreateGroupJoinRequestAccepted:output_type -> notifications.Notification
+	3,  // 95: notifications.NotificationService.CreateGroupJoinRequestRejected:output_type -> notifications.Notification
+	10, // 96: notifications.NotificationService.GetUserNotifications:output_type -> notifications.GetUserNotificationsResponse
+	72, // 97: notifications.NotificationService.GetUnreadNotificationsCount:output_type -> google.protobuf.Int64Value
+	73, // 98: notifications.NotificationService.MarkNotificationAsRead:output_type -> google.protobuf.Empty
+	73, // 99: notifications.NotificationService.MarkNotificationAsActed:output_type -> google.protobuf.Empty
+	73, // 100: notifications.NotificationService.MarkAllAsRead:output_type -> google.protobuf.Empty
+	73, // 101: notifications.NotificationService.DeleteNotification:output_type -> google.protobuf.Empty
+	14, // 102: notifications.NotificationService.GetNotificationPreferences:output_type -> notifications.NotificationPreferences
+	73, // 103: notifications.NotificationService.UpdateNotificationPreferences:output_type -> google.protobuf.Empty
+	76, // [76:104] is the sub-list for method output_type
+	48, // [48:76] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_notifications_proto_init() }
@@ -4738,7 +5966,7 @@ func file_notifications_proto_init() {
 	if File_notifications_proto != nil {
 		return
 	}
-	file_notifications_proto_msgTypes[49].OneofWrappers = []any{
+	file_notifications_proto_msgTypes[61].OneofWrappers = []any{
 		(*NotificationEvent_PostCommentCreated)(nil),
 		(*NotificationEvent_PostLiked)(nil),
 		(*NotificationEvent_FollowRequestCreated)(nil),
@@ -4756,6 +5984,18 @@ func file_notifications_proto_init() {
 		(*NotificationEvent_GroupJoinRequestRejected)(nil),
 		(*NotificationEvent_FollowRequestCancelled)(nil),
 		(*NotificationEvent_GroupJoinRequestCancelled)(nil),
+		(*NotificationEvent_GroupDeleted)(nil),
+		(*NotificationEvent_GroupInviteExpired)(nil),
+		(*NotificationEvent_GroupJoinRequestExpired)(nil),
+		(*NotificationEvent_GroupAnnouncementCreated)(nil),
+		(*NotificationEvent_GroupPostReviewed)(nil),
+		(*NotificationEvent_ScheduledPostPublished)(nil),
+		(*NotificationEvent_CommentReplyCreated)(nil),
+		(*NotificationEvent_PostShared)(nil),
+		(*NotificationEvent_UserDeactivationChanged)(nil),
+		(*NotificationEvent_GroupArchiveChanged)(nil),
+		(*NotificationEvent_GroupVisibilityChanged)(nil),
+		(*NotificationEvent_GroupPostApprovalChanged)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4763,7 +6003,7 @@ func file_notifications_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notifications_proto_rawDesc), len(file_notifications_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   56,
+			NumMessages:   68,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -217,6 +217,67 @@ func (x *GenericReq) GetEntityId() int64 {
 	return 0
 }
 
+// Same fields as GenericReq, plus one of "like", "love", "laugh", "wow", "sad", "angry"
+type ReactionReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequesterId   int64                  `protobuf:"varint,1,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	EntityId      int64                  `protobuf:"varint,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	ReactionType  string                 `protobuf:"bytes,3,opt,name=reaction_type,json=reactionType,proto3" json:"reaction_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionReq) Reset() {
+	*x = ReactionReq{}
+	mi := &file_posts_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionReq) ProtoMessage() {}
+
+func (x *ReactionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionReq.ProtoReflect.Descriptor instead.
+func (*ReactionReq) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReactionReq) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *ReactionReq) GetEntityId() int64 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *ReactionReq) GetReactionType() string {
+	if x != nil {
+		return x.ReactionType
+	}
+	return ""
+}
+
 // generic request message that includes
 // the id of the requesting user
 // an entity id (can be post, comment, event, etc)
@@ -233,7 +294,7 @@ type EntityIdPaginatedReq struct {
 
 func (x *EntityIdPaginatedReq) Reset() {
 	*x = EntityIdPaginatedReq{}
-	mi := &file_posts_proto_msgTypes[4]
+	mi := &file_posts_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -245,7 +306,7 @@ func (x *EntityIdPaginatedReq) String() string {
 func (*EntityIdPaginatedReq) ProtoMessage() {}
 
 func (x *EntityIdPaginatedReq) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_proto_msgTypes[4]
+	mi := &file_posts_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -258,7 +319,7 @@ func (x *EntityIdPaginatedReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EntityIdPaginatedReq.ProtoReflect.Descriptor instead.
 func (*EntityIdPaginatedReq) Descriptor() ([]byte, []int) {
-	return file_posts_proto_rawDescGZIP(), []int{4}
+	return file_posts_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *EntityIdPaginatedReq) GetRequesterId() int64 {
@@ -303,7 +364,7 @@ type GenericPaginatedReq struct {
 
 func (x *GenericPaginatedReq) Reset() {
 	*x = GenericPaginatedReq{}
-	mi := &file_posts_proto_msgTypes[5]
+	mi := &file_posts_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -315,7 +376,7 @@ func (x *GenericPaginatedReq) String() string {
 func (*GenericPaginatedReq) ProtoMessage() {}
 
 func (x *GenericPaginatedReq) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_proto_msgTypes[5]
+	mi := &file_posts_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -328,7 +389,7 @@ func (x *GenericPaginatedReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenericPaginatedReq.ProtoReflect.Descriptor instead.
 func (*GenericPaginatedReq) Descriptor() ([]byte, []int) {
-	return file_posts_proto_rawDescGZIP(), []int{5}
+	return file_posts_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GenericPaginatedReq) GetRequesterId() int64 {
@@ -352,42 +413,30 @@ func (x *GenericPaginatedReq) GetOffset() int32 {
 	return 0
 }
 
-// Response message that describes a post
-type Post struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	PostId                int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	PostBody              string                 `protobuf:"bytes,2,opt,name=post_body,json=postBody,proto3" json:"post_body,omitempty"`
-	User                  *common.User           `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`                       // includes id, username and avatar url
-	GroupId               int64                  `protobuf:"varint,4,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"` //can be 0, meaning the post doesn't belong to a group
-	Audience              string                 `protobuf:"bytes,5,opt,name=audience,proto3" json:"audience,omitempty"`               // one of "everyone", "followers","selected","group"
-	CommentsCount         int32                  `protobuf:"varint,6,opt,name=comments_count,json=commentsCount,proto3" json:"comments_count,omitempty"`
-	ReactionsCount        int32                  `protobuf:"varint,7,opt,name=reactions_count,json=reactionsCount,proto3" json:"reactions_count,omitempty"`
-	LastCommentedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_commented_at,json=lastCommentedAt,proto3" json:"last_commented_at,omitempty"`
-	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	LikedByUser           bool                   `protobuf:"varint,11,opt,name=liked_by_user,json=likedByUser,proto3" json:"liked_by_user,omitempty"`
-	ImageId               int64                  `protobuf:"varint,12,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`                                            //can be 0, meaning no associated image
-	ImageUrl              string                 `protobuf:"bytes,13,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`                                          // can be an empty string if image_id is 0
-	SelectedAudienceUsers *common.ListUsers      `protobuf:"bytes,14,opt,name=selected_audience_users,json=selectedAudienceUsers,proto3" json:"selected_audience_users,omitempty"` //empty unless audience="selected"
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+// Request message for counting recent posts of several groups
+type GroupsActivityReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupIds      []int64                `protobuf:"varint,1,rep,packed,name=group_ids,json=groupIds,proto3" json:"group_ids,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Post) Reset() {
-	*x = Post{}
-	mi := &file_posts_proto_msgTypes[6]
+func (x *GroupsActivityReq) Reset() {
+	*x = GroupsActivityReq{}
+	mi := &file_posts_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Post) String() string {
+func (x *GroupsActivityReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Post) ProtoMessage() {}
+func (*GroupsActivityReq) ProtoMessage() {}
 
-func (x *Post) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_proto_msgTypes[6]
+func (x *GroupsActivityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -398,132 +447,147 @@ func (x *Post) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Post.ProtoReflect.Descriptor instead.
-func (*Post) Descriptor() ([]byte, []int) {
-	return file_posts_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GroupsActivityReq.ProtoReflect.Descriptor instead.
+func (*GroupsActivityReq) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Post) GetPostId() int64 {
+func (x *GroupsActivityReq) GetGroupIds() []int64 {
 	if x != nil {
-		return x.PostId
+		return x.GroupIds
 	}
-	return 0
+	return nil
 }
 
-func (x *Post) GetPostBody() string {
+func (x *GroupsActivityReq) GetSince() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PostBody
+		return x.Since
 	}
-	return ""
+	return nil
 }
 
-func (x *Post) GetUser() *common.User {
-	if x != nil {
-		return x.User
-	}
-	return nil
+// Response message with the number of recent posts per group id
+type GroupsActivityResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostCounts    map[int64]int64        `protobuf:"bytes,1,rep,name=post_counts,json=postCounts,proto3" json:"post_counts,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Post) GetGroupId() int64 {
-	if x != nil {
-		return x.GroupId
-	}
-	return 0
+func (x *GroupsActivityResp) Reset() {
+	*x = GroupsActivityResp{}
+	mi := &file_posts_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Post) GetAudience() string {
-	if x != nil {
-		return x.Audience
-	}
-	return ""
+func (x *GroupsActivityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Post) GetCommentsCount() int32 {
+func (*GroupsActivityResp) ProtoMessage() {}
+
+func (x *GroupsActivityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[8]
 	if x != nil {
-		return x.CommentsCount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Post) GetReactionsCount() int32 {
-	if x != nil {
-		return x.ReactionsCount
-	}
-	return 0
+// Deprecated: Use GroupsActivityResp.ProtoReflect.Descriptor instead.
+func (*GroupsActivityResp) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Post) GetLastCommentedAt() *timestamppb.Timestamp {
+func (x *GroupsActivityResp) GetPostCounts() map[int64]int64 {
 	if x != nil {
-		return x.LastCommentedAt
+		return x.PostCounts
 	}
 	return nil
 }
 
-func (x *Post) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+// Request message for the content part of group insights
+type GroupInsightsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Post) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
+func (x *GroupInsightsReq) Reset() {
+	*x = GroupInsightsReq{}
+	mi := &file_posts_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Post) GetLikedByUser() bool {
-	if x != nil {
-		return x.LikedByUser
-	}
-	return false
+func (x *GroupInsightsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Post) GetImageId() int64 {
+func (*GroupInsightsReq) ProtoMessage() {}
+
+func (x *GroupInsightsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[9]
 	if x != nil {
-		return x.ImageId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Post) GetImageUrl() string {
+// Deprecated: Use GroupInsightsReq.ProtoReflect.Descriptor instead.
+func (*GroupInsightsReq) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GroupInsightsReq) GetGroupId() int64 {
 	if x != nil {
-		return x.ImageUrl
+		return x.GroupId
 	}
-	return ""
+	return 0
 }
 
-func (x *Post) GetSelectedAudienceUsers() *common.ListUsers {
+func (x *GroupInsightsReq) GetSince() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SelectedAudienceUsers
+		return x.Since
 	}
 	return nil
 }
 
-// Response message with multiple posts
-type ListPosts struct {
+// Number of items created on one day (UTC)
+type DailyCount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	Day           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPosts) Reset() {
-	*x = ListPosts{}
-	mi := &file_posts_proto_msgTypes[7]
+func (x *DailyCount) Reset() {
+	*x = DailyCount{}
+	mi := &file_posts_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPosts) String() string {
+func (x *DailyCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPosts) ProtoMessage() {}
+func (*DailyCount) ProtoMessage() {}
 
-func (x *ListPosts) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_proto_msgTypes[7]
+func (x *DailyCount) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -534,46 +598,50 @@ func (x *ListPosts) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPosts.ProtoReflect.Descriptor instead.
-func (*ListPosts) Descriptor() ([]byte, []int) {
-	return file_posts_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use DailyCount.ProtoReflect.Descriptor instead.
+func (*DailyCount) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ListPosts) GetPosts() []*Post {
+func (x *DailyCount) GetDay() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Posts
+		return x.Day
 	}
 	return nil
 }
 
-// Request message for creating a post
-type CreatePostReq struct {
+func (x *DailyCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// How much a member posted and commented in a group
+type PosterActivity struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CreatorId     int64                  `protobuf:"varint,1,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
-	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
-	GroupId       int64                  `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`            //can be 0 if audience is not "group"
-	Audience      string                 `protobuf:"bytes,4,opt,name=audience,proto3" json:"audience,omitempty"`                          // one of "everyone", "followers","selected","group"
-	AudienceIds   *common.UserIds        `protobuf:"bytes,5,opt,name=audience_ids,json=audienceIds,proto3" json:"audience_ids,omitempty"` // empty unless audience="selected"
-	ImageId       int64                  `protobuf:"varint,6,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`            //can be 0 if no image
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostCount     int64                  `protobuf:"varint,2,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+	CommentCount  int64                  `protobuf:"varint,3,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePostReq) Reset() {
-	*x = CreatePostReq{}
-	mi := &file_posts_proto_msgTypes[8]
+func (x *PosterActivity) Reset() {
+	*x = PosterActivity{}
+	mi := &file_posts_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePostReq) String() string {
+func (x *PosterActivity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePostReq) ProtoMessage() {}
+func (*PosterActivity) ProtoMessage() {}
 
-func (x *CreatePostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_proto_msgTypes[8]
+func (x *PosterActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -584,172 +652,1918 @@ func (x *CreatePostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePostReq.ProtoReflect.Descriptor instead.
-func (*CreatePostReq) Descriptor() ([]byte, []int) {
-	return file_posts_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use PosterActivity.ProtoReflect.Descriptor instead.
+func (*PosterActivity) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CreatePostReq) GetCreatorId() int64 {
+func (x *PosterActivity) GetUserId() int64 {
 	if x != nil {
-		return x.CreatorId
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *CreatePostReq) GetBody() string {
+func (x *PosterActivity) GetPostCount() int64 {
 	if x != nil {
-		return x.Body
+		return x.PostCount
 	}
-	return ""
+	return 0
 }
 
-func (x *CreatePostReq) GetGroupId() int64 {
+func (x *PosterActivity) GetCommentCount() int64 {
 	if x != nil {
-		return x.GroupId
+		return x.CommentCount
 	}
 	return 0
 }
 
-func (x *CreatePostReq) GetAudience() string {
-	if x != nil {
-		return x.Audience
-	}
-	return ""
+// Responses to one group event
+type EventAttendance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	EventDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=event_date,json=eventDate,proto3" json:"event_date,omitempty"`
+	GoingCount    int32                  `protobuf:"varint,4,opt,name=going_count,json=goingCount,proto3" json:"going_count,omitempty"`
+	NotGoingCount int32                  `protobuf:"varint,5,opt,name=not_going_count,json=notGoingCount,proto3" json:"not_going_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePostReq) GetAudienceIds() *common.UserIds {
-	if x != nil {
-		return x.AudienceIds
-	}
-	return nil
+func (x *EventAttendance) Reset() {
+	*x = EventAttendance{}
+	mi := &file_posts_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePostReq) GetImageId() int64 {
-	if x != nil {
-		return x.ImageId
+func (x *EventAttendance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventAttendance) ProtoMessage() {}
+
+func (x *EventAttendance) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventAttendance.ProtoReflect.Descriptor instead.
+func (*EventAttendance) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EventAttendance) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *EventAttendance) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *EventAttendance) GetEventDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EventDate
+	}
+	return nil
+}
+
+func (x *EventAttendance) GetGoingCount() int32 {
+	if x != nil {
+		return x.GoingCount
+	}
+	return 0
+}
+
+func (x *EventAttendance) GetNotGoingCount() int32 {
+	if x != nil {
+		return x.NotGoingCount
+	}
+	return 0
+}
+
+// Response message with a group's content activity
+type GroupContentInsights struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PostsPerDay    []*DailyCount          `protobuf:"bytes,1,rep,name=posts_per_day,json=postsPerDay,proto3" json:"posts_per_day,omitempty"`
+	CommentsPerDay []*DailyCount          `protobuf:"bytes,2,rep,name=comments_per_day,json=commentsPerDay,proto3" json:"comments_per_day,omitempty"`
+	ActivePosters  []*PosterActivity      `protobuf:"bytes,3,rep,name=active_posters,json=activePosters,proto3" json:"active_posters,omitempty"` //most active first
+	Events         []*EventAttendance     `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`                                    //by event date
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GroupContentInsights) Reset() {
+	*x = GroupContentInsights{}
+	mi := &file_posts_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupContentInsights) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupContentInsights) ProtoMessage() {}
+
+func (x *GroupContentInsights) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupContentInsights.ProtoReflect.Descriptor instead.
+func (*GroupContentInsights) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GroupContentInsights) GetPostsPerDay() []*DailyCount {
+	if x != nil {
+		return x.PostsPerDay
+	}
+	return nil
+}
+
+func (x *GroupContentInsights) GetCommentsPerDay() []*DailyCount {
+	if x != nil {
+		return x.CommentsPerDay
+	}
+	return nil
+}
+
+func (x *GroupContentInsights) GetActivePosters() []*PosterActivity {
+	if x != nil {
+		return x.ActivePosters
+	}
+	return nil
+}
+
+func (x *GroupContentInsights) GetEvents() []*EventAttendance {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Response message that describes a post
+type Post struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	PostId                int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	PostBody              string                 `protobuf:"bytes,2,opt,name=post_body,json=postBody,proto3" json:"post_body,omitempty"`
+	User                  *common.User           `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`                       // includes id, username and avatar url
+	GroupId               int64                  `protobuf:"varint,4,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"` //can be 0, meaning the post doesn't belong to a group
+	Audience              string                 `protobuf:"bytes,5,opt,name=audience,proto3" json:"audience,omitempty"`               // one of "everyone", "followers","selected","group"
+	CommentsCount         int32                  `protobuf:"varint,6,opt,name=comments_count,json=commentsCount,proto3" json:"comments_count,omitempty"`
+	ReactionsCount        int32                  `protobuf:"varint,7,opt,name=reactions_count,json=reactionsCount,proto3" json:"reactions_count,omitempty"`
+	LastCommentedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_commented_at,json=lastCommentedAt,proto3" json:"last_commented_at,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	LikedByUser           bool                   `protobuf:"varint,11,opt,name=liked_by_user,json=likedByUser,proto3" json:"liked_by_user,omitempty"`
+	ImageId               int64                  `protobuf:"varint,12,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`                                                                                                //can be 0, meaning no associated image
+	ImageUrl              string                 `protobuf:"bytes,13,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`                                                                                              // can be an empty string if image_id is 0
+	SelectedAudienceUsers *common.ListUsers      `protobuf:"bytes,14,opt,name=selected_audience_users,json=selectedAudienceUsers,proto3" json:"selected_audience_users,omitempty"`                                                     //empty unless audience="selected"
+	Pinned                bool                   `protobuf:"varint,15,opt,name=pinned,proto3" json:"pinned,omitempty"`                                                                                                                 //only set for group posts
+	Announcement          bool                   `protobuf:"varint,16,opt,name=announcement,proto3" json:"announcement,omitempty"`                                                                                                     //only set for group posts
+	ApprovalStatus        string                 `protobuf:"bytes,17,opt,name=approval_status,json=approvalStatus,proto3" json:"approval_status,omitempty"`                                                                            //approved, pending or rejected, only pending or rejected for the author
+	RejectionReason       string                 `protobuf:"bytes,18,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`                                                                         //only set for the author of a rejected post
+	ImageIds              []int64                `protobuf:"varint,19,rep,packed,name=image_ids,json=imageIds,proto3" json:"image_ids,omitempty"`                                                                                      //whole gallery in display order, image_id is its first entry
+	ImageUrls             []string               `protobuf:"bytes,20,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`                                                                                           //same order as image_ids, failed images are left out of both, empty while an image is still uploading
+	PublishAt             *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`                                                                                           //only set for scheduled posts, which only their author sees
+	Edited                bool                   `protobuf:"varint,22,opt,name=edited,proto3" json:"edited,omitempty"`                                                                                                                 //true once the body was changed after publishing
+	ReactionCounts        map[string]int32       `protobuf:"bytes,23,rep,name=reaction_counts,json=reactionCounts,proto3" json:"reaction_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` //per reaction type, missing types have none
+	UserReaction          string                 `protobuf:"bytes,24,opt,name=user_reaction,json=userReaction,proto3" json:"user_reaction,omitempty"`                                                                                  //requester's reaction type, empty unless liked_by_user
+	Mentions              []*Mention             `protobuf:"bytes,25,rep,name=mentions,proto3" json:"mentions,omitempty"`                                                                                                              //resolved @usernames in post_body, in body order
+	SharedPostId          int64                  `protobuf:"varint,26,opt,name=shared_post_id,json=sharedPostId,proto3" json:"shared_post_id,omitempty"`                                                                               //set on reposts and quotes, a repost has an empty post_body
+	SharedPost            *Post                  `protobuf:"bytes,27,opt,name=shared_post,json=sharedPost,proto3" json:"shared_post,omitempty"`                                                                                        //unset if requester can't see the shared post or it's deleted, never has a shared_post itself
+	RepostsCount          int32                  `protobuf:"varint,28,opt,name=reposts_count,json=repostsCount,proto3" json:"reposts_count,omitempty"`
+	QuotesCount           int32                  `protobuf:"varint,29,opt,name=quotes_count,json=quotesCount,proto3" json:"quotes_count,omitempty"`
+	RepostedByUser        bool                   `protobuf:"varint,30,opt,name=reposted_by_user,json=repostedByUser,proto3" json:"reposted_by_user,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Post) Reset() {
+	*x = Post{}
+	mi := &file_posts_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Post) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Post) ProtoMessage() {}
+
+func (x *Post) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Post.ProtoReflect.Descriptor instead.
+func (*Post) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Post) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *Post) GetPostBody() string {
+	if x != nil {
+		return x.PostBody
+	}
+	return ""
+}
+
+func (x *Post) GetUser() *common.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Post) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *Post) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+func (x *Post) GetCommentsCount() int32 {
+	if x != nil {
+		return x.CommentsCount
+	}
+	return 0
+}
+
+func (x *Post) GetReactionsCount() int32 {
+	if x != nil {
+		return x.ReactionsCount
+	}
+	return 0
+}
+
+func (x *Post) GetLastCommentedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastCommentedAt
+	}
+	return nil
+}
+
+func (x *Post) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Post) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Post) GetLikedByUser() bool {
+	if x != nil {
+		return x.LikedByUser
+	}
+	return false
+}
+
+func (x *Post) GetImageId() int64 {
+	if x != nil {
+		return x.ImageId
+	}
+	return 0
+}
+
+func (x *Post) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Post) GetSelectedAudienceUsers() *common.ListUsers {
+	if x != nil {
+		return x.SelectedAudienceUsers
+	}
+	return nil
+}
+
+func (x *Post) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *Post) GetAnnouncement() bool {
+	if x != nil {
+		return x.Announcement
+	}
+	return false
+}
+
+func (x *Post) GetApprovalStatus() string {
+	if x != nil {
+		return x.ApprovalStatus
+	}
+	return ""
+}
+
+func (x *Post) GetRejectionReason() string {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return ""
+}
+
+func (x *Post) GetImageIds() []int64 {
+	if x != nil {
+		return x.ImageIds
+	}
+	return nil
+}
+
+func (x *Post) GetImageUrls() []string {
+	if x != nil {
+		return x.ImageUrls
+	}
+	return nil
+}
+
+func (x *Post) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *Post) GetEdited() bool {
+	if x != nil {
+		return x.Edited
+	}
+	return false
+}
+
+func (x *Post) GetReactionCounts() map[string]int32 {
+	if x != nil {
+		return x.ReactionCounts
+	}
+	return nil
+}
+
+func (x *Post) GetUserReaction() string {
+	if x != nil {
+		return x.UserReaction
+	}
+	return ""
+}
+
+func (x *Post) GetMentions() []*Mention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *Post) GetSharedPostId() int64 {
+	if x != nil {
+		return x.SharedPostId
+	}
+	return 0
+}
+
+func (x *Post) GetSharedPost() *Post {
+	if x != nil {
+		return x.SharedPost
+	}
+	return nil
+}
+
+func (x *Post) GetRepostsCount() int32 {
+	if x != nil {
+		return x.RepostsCount
+	}
+	return 0
+}
+
+func (x *Post) GetQuotesCount() int32 {
+	if x != nil {
+		return x.QuotesCount
+	}
+	return 0
+}
+
+func (x *Post) GetRepostedByUser() bool {
+	if x != nil {
+		return x.RepostedByUser
+	}
+	return false
+}
+
+// A resolved @username, start and length count characters and include the @
+type Mention struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Start         int32                  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Length        int32                  `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mention) Reset() {
+	*x = Mention{}
+	mi := &file_posts_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mention) ProtoMessage() {}
+
+func (x *Mention) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mention.ProtoReflect.Descriptor instead.
+func (*Mention) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Mention) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Mention) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Mention) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+// Response message with multiple posts
+type ListPosts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPosts) Reset() {
+	*x = ListPosts{}
+	mi := &file_posts_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPosts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPosts) ProtoMessage() {}
+
+func (x *ListPosts) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPosts.ProtoReflect.Descriptor instead.
+func (*ListPosts) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListPosts) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+// Request message for creating a post
+type CreatePostReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreatorId     int64                  `protobuf:"varint,1,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	GroupId       int64                  `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`            //can be 0 if audience is not "group"
+	Audience      string                 `protobuf:"bytes,4,opt,name=audience,proto3" json:"audience,omitempty"`                          // one of "everyone", "followers","selected","group"
+	AudienceIds   *common.UserIds        `protobuf:"bytes,5,opt,name=audience_ids,json=audienceIds,proto3" json:"audience_ids,omitempty"` // empty unless audience="selected"
+	ImageId       int64                  `protobuf:"varint,6,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`            //can be 0 if no image
+	ImageIds      []int64                `protobuf:"varint,7,rep,packed,name=image_ids,json=imageIds,proto3" json:"image_ids,omitempty"`  //gallery in display order, takes precedence over image_id
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`       //unset to publish right away
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePostReq) Reset() {
+	*x = CreatePostReq{}
+	mi := &file_posts_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostReq) ProtoMessage() {}
+
+func (x *CreatePostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostReq.ProtoReflect.Descriptor instead.
+func (*CreatePostReq) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreatePostReq) GetCreatorId() int64 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *CreatePostReq) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *CreatePostReq) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *CreatePostReq) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+func (x *CreatePostReq) GetAudienceIds() *common.UserIds {
+	if x != nil {
+		return x.AudienceIds
+	}
+	return nil
+}
+
+func (x *CreatePostReq) GetImageId() int64 {
+	if x != nil {
+		return x.ImageId
+	}
+	return 0
+}
+
+func (x *CreatePostReq) GetImageIds() []int64 {
+	if x != nil {
+		return x.ImageIds
+	}
+	return nil
+}
+
+func (x *CreatePostReq) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+// Request message for editing a post
+type EditPostReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequesterId   int64                  `protobuf:"varint,1,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	PostId        int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	ImageId       int64                  `protobuf:"varint,4,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`             //can be 0 if no image
+	Audience      string                 `protobuf:"bytes,5,opt,name=audience,proto3" json:"audience,omitempty"`                           // one of "everyone", "followers","selected","group"
+	AudienceIds   *common.UserIds        `protobuf:"bytes,6,opt,name=audience_ids,json=audienceIds,proto3" json:"audience_ids,omitempty"`  //empty unless audience="selected"
+	DeleteImage   bool                   `protobuf:"varint,7,opt,name=delete_image,json=deleteImage,proto3" json:"delete_image,omitempty"` //true if removing preexisting image(s)
+	ImageIds      []int64                `protobuf:"varint,8,rep,packed,name=image_ids,json=imageIds,proto3" json:"image_ids,omitempty"`   //new gallery in display order, takes precedence over image_id
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditPostReq) Reset() {
+	*x = EditPostReq{}
+	mi := &file_posts_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditPostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditPostReq) ProtoMessage() {}
+
+func (x *EditPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditPostReq.ProtoReflect.Descriptor instead.
+func (*EditPostReq) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *EditPostReq) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *EditPostReq) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *EditPostReq) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *EditPostReq) GetImageId() int64 {
+	if x != nil {
+		return x.ImageId
+	}
+	return 0
+}
+
+func (x *EditPostReq) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+func (x *EditPostReq) GetAudienceIds() *common.UserIds {
+	if x != nil {
+		return x.AudienceIds
+	}
+	return nil
+}
+
+func (x *EditPostReq) GetDeleteImage() bool {
+	if x != nil {
+		return x.DeleteImage
+	}
+	return false
+}
+
+func (x *EditPostReq) GetImageIds() []int64 {
+	if x != nil {
+		return x.ImageIds
+	}
+	return nil
+}
+
+// Request message for reposting or quoting a post
+type SharePostReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequesterId   int64                  `protobuf:"varint,1,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	PostId        int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`                                  //empty for a repost
+	Audience      string                 `protobuf:"bytes,4,opt,name=audience,proto3" json:"audience,omitempty"`                          // one of "everyone", "followers","selected"
+	AudienceIds   *common.UserIds        `protobuf:"bytes,5,opt,name=audience_ids,json=audienceIds,proto3" json:"audience_ids,omitempty"` //empty unless audience="selected"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharePostReq) Reset() {
+	*x = SharePostReq{}
+	mi := &file_posts_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharePostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharePostReq) ProtoMessage() {}
+
+func (x *SharePostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharePostReq.ProtoReflect.Descriptor instead.
+func (*SharePostReq) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SharePostReq) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *SharePostReq) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *SharePostReq) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *SharePostReq) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+func (x *SharePostReq) GetAudienceIds() *common.UserIds {
+	if x != nil {
+		return x.AudienceIds
+	}
+	return nil
+}
+
+// Request message for retrieving a user's posts
+type GetUserPostsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreatorId     int64                  `protobuf:"varint,1,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	RequesterId   int64                  `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserPostsReq) Reset() {
+	*x = GetUserPostsReq{}
+	mi := &file_posts_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPostsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPostsReq) ProtoMessage() {}
+
+func (x *GetUserPostsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPostsReq.ProtoReflect.Descriptor instead.
+func (*GetUserPostsReq) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetUserPostsReq) GetCreatorId() int64 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *GetUserPostsReq) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *GetUserPostsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetUserPostsReq) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// Request message for retrieving the personalized feed
+// (posts by followers with restricted visibility that requester is allowed to view)
+type GetPersonalizedFeedReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequesterId   int64                  `protobuf:"varint,1,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPersonalizedFeedReq) Reset() {
+	*x = GetPersonalizedFeedReq{}
+	mi := &file_posts_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPersonalizedFeedReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPersonalizedFeedReq) ProtoMessage() {}
+
+func (x *GetPersonalizedFeedReq) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPersonalizedFeedReq.ProtoReflect.Descriptor instead.
+func (*GetPersonalizedFeedReq) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetPersonalizedFeedReq) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *GetPersonalizedFeedReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPersonalizedFeedReq) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// Request message for pinning or marking a group post as an announcement
+type SetPostFlagReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequesterId   int64                  `protobuf:"varint,1,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	PostId        int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Value         bool                   `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"` //true to set, false to clear
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPostFlagReq) Reset() {
+	*x = SetPostFlagReq{}
+	mi := &file_posts_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPostFlagReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPostFlagReq) ProtoMessage() {}
+
+func (x *SetPostFlagReq) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPostFlagReq.ProtoReflect.Descriptor instead.
+func (*SetPostFlagReq) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetPostFlagReq) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *SetPostFlagReq) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *SetPostFlagReq) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+// Request message for approving or rejecting a pending group post
+type ReviewPostReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequesterId   int64                  `protobuf:"varint,1,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	PostId        int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` //only used on rejection, can be empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewPostReq) Reset() {
+	*x = ReviewPostReq{}
+	mi := &file_posts_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewPostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewPostReq) ProtoMessage() {}
+
+func (x *ReviewPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewPostReq.ProtoReflect.Descriptor instead.
+func (*ReviewPostReq) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReviewPostReq) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *ReviewPostReq) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *ReviewPostReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Request message for moving the publish time of a scheduled post
+type ReschedulePostReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequesterId   int64                  `protobuf:"varint,1,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	PostId        int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReschedulePostReq) Reset() {
+	*x = ReschedulePostReq{}
+	mi := &file_posts_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReschedulePostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReschedulePostReq) ProtoMessage() {}
+
+func (x *ReschedulePostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReschedulePostReq.ProtoReflect.Descriptor instead.
+func (*ReschedulePostReq) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReschedulePostReq) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *ReschedulePostReq) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *ReschedulePostReq) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+// Request message for retrieving posts belonging to a group
+type GetGroupPostsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequesterId   int64                  `protobuf:"varint,1,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	GroupId       int64                  `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupPostsReq) Reset() {
+	*x = GetGroupPostsReq{}
+	mi := &file_posts_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupPostsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupPostsReq) ProtoMessage() {}
+
+func (x *GetGroupPostsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupPostsReq.ProtoReflect.Descriptor instead.
+func (*GetGroupPostsReq) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetGroupPostsReq) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *GetGroupPostsReq) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *GetGroupPostsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetGroupPostsReq) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// Request message for retrieving posts with a hashtag
+type GetHashtagFeedReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequesterId   int64                  `protobuf:"varint,1,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"` //lowercase, without the #
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHashtagFeedReq) Reset() {
+	*x = GetHashtagFeedReq{}
+	mi := &file_posts_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHashtagFeedReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHashtagFeedReq) ProtoMessage() {}
+
+func (x *GetHashtagFeedReq) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHashtagFeedReq.ProtoReflect.Descriptor instead.
+func (*GetHashtagFeedReq) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetHashtagFeedReq) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *GetHashtagFeedReq) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *GetHashtagFeedReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetHashtagFeedReq) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// Request message for the most used hashtags of a recent time window
+type GetTrendingHashtagsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WindowHours   int32                  `protobuf:"varint,1,opt,name=window_hours,json=windowHours,proto3" json:"window_hours,omitempty"` //window ending now, 1 to 168 hours
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrendingHashtagsReq) Reset() {
+	*x = GetTrendingHashtagsReq{}
+	mi := &file_posts_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendingHashtagsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendingHashtagsReq) ProtoMessage() {}
+
+func (x *GetTrendingHashtagsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendingHashtagsReq.ProtoReflect.Descriptor instead.
+func (*GetTrendingHashtagsReq) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetTrendingHashtagsReq) GetWindowHours() int32 {
+	if x != nil {
+		return x.WindowHours
+	}
+	return 0
+}
+
+func (x *GetTrendingHashtagsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TrendingHashtag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	PostsCount    int32                  `protobuf:"varint,2,opt,name=posts_count,json=postsCount,proto3" json:"posts_count,omitempty"`
+	AuthorsCount  int32                  `protobuf:"varint,3,opt,name=authors_count,json=authorsCount,proto3" json:"authors_count,omitempty"` //distinct users who posted with the tag
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingHashtag) Reset() {
+	*x = TrendingHashtag{}
+	mi := &file_posts_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingHashtag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingHashtag) ProtoMessage() {}
+
+func (x *TrendingHashtag) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingHashtag.ProtoReflect.Descriptor instead.
+func (*TrendingHashtag) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TrendingHashtag) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TrendingHashtag) GetPostsCount() int32 {
+	if x != nil {
+		return x.PostsCount
+	}
+	return 0
+}
+
+func (x *TrendingHashtag) GetAuthorsCount() int32 {
+	if x != nil {
+		return x.AuthorsCount
+	}
+	return 0
+}
+
+// Response message with trending hashtags, most used first
+type ListTrendingHashtags struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hashtags      []*TrendingHashtag     `protobuf:"bytes,1,rep,name=hashtags,proto3" json:"hashtags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrendingHashtags) Reset() {
+	*x = ListTrendingHashtags{}
+	mi := &file_posts_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrendingHashtags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrendingHashtags) ProtoMessage() {}
+
+func (x *ListTrendingHashtags) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrendingHashtags.ProtoReflect.Descriptor instead.
+func (*ListTrendingHashtags) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListTrendingHashtags) GetHashtags() []*TrendingHashtag {
+	if x != nil {
+		return x.Hashtags
 	}
-	return 0
+	return nil
 }
 
-// Request message for editing a post
-type EditPostReq struct {
+// Request message for searching posts, comments and events
+type SearchContentReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RequesterId   int64                  `protobuf:"varint,1,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
-	PostId        int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
-	ImageId       int64                  `protobuf:"varint,4,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`             //can be 0 if no image
-	Audience      string                 `protobuf:"bytes,5,opt,name=audience,proto3" json:"audience,omitempty"`                           // one of "everyone", "followers","selected","group"
-	AudienceIds   *common.UserIds        `protobuf:"bytes,6,opt,name=audience_ids,json=audienceIds,proto3" json:"audience_ids,omitempty"`  //empty unless audience="selected"
-	DeleteImage   bool                   `protobuf:"varint,7,opt,name=delete_image,json=deleteImage,proto3" json:"delete_image,omitempty"` //true if removing preexisting image
+	SearchTerm    string                 `protobuf:"bytes,2,opt,name=search_term,json=searchTerm,proto3" json:"search_term,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EditPostReq) Reset() {
-	*x = EditPostReq{}
-	mi := &file_posts_proto_msgTypes[9]
+func (x *SearchContentReq) Reset() {
+	*x = SearchContentReq{}
+	mi := &file_posts_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchContentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchContentReq) ProtoMessage() {}
+
+func (x *SearchContentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchContentReq.ProtoReflect.Descriptor instead.
+func (*SearchContentReq) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SearchContentReq) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *SearchContentReq) GetSearchTerm() string {
+	if x != nil {
+		return x.SearchTerm
+	}
+	return ""
+}
+
+func (x *SearchContentReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchContentReq) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// A post, comment or event matching a search
+type SearchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityType    string                 `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"` //post, comment or event
+	EntityId      int64                  `protobuf:"varint,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	PostId        int64                  `protobuf:"varint,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"` //post of a comment, same as entity_id for posts, 0 for events
+	GroupId       int64                  `protobuf:"varint,4,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	User          *common.User           `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	Title         string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"` //events only
+	Body          string                 `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_posts_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SearchResult) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *SearchResult) GetEntityId() int64 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *SearchResult) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *SearchResult) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *SearchResult) GetUser() *common.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *SearchResult) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SearchResult) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *SearchResult) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Response message with search results, best match first
+type ListSearchResults struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*SearchResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSearchResults) Reset() {
+	*x = ListSearchResults{}
+	mi := &file_posts_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSearchResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSearchResults) ProtoMessage() {}
+
+func (x *ListSearchResults) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSearchResults.ProtoReflect.Descriptor instead.
+func (*ListSearchResults) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListSearchResults) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Response message that describes a comment
+type Comment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CommentId      int64                  `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	ParentId       int64                  `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Body           string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	User           *common.User           `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"` // includes id, username and avatar url
+	ReactionsCount int32                  `protobuf:"varint,5,opt,name=reactions_count,json=reactionsCount,proto3" json:"reactions_count,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	LikedByUser    bool                   `protobuf:"varint,8,opt,name=liked_by_user,json=likedByUser,proto3" json:"liked_by_user,omitempty"`
+	ImageId        int64                  `protobuf:"varint,9,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`          // can be 0 if no image
+	ImageUrl       string                 `protobuf:"bytes,10,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`       // can be empty string if image id=0
+	Edited         bool                   `protobuf:"varint,11,opt,name=edited,proto3" json:"edited,omitempty"`                          //true once the body was changed
+	ReplyToId      int64                  `protobuf:"varint,12,opt,name=reply_to_id,json=replyToId,proto3" json:"reply_to_id,omitempty"` // 0 for top level comments
+	Depth          int32                  `protobuf:"varint,13,opt,name=depth,proto3" json:"depth,omitempty"`                            // 0 for top level comments
+	RepliesCount   int32                  `protobuf:"varint,14,opt,name=replies_count,json=repliesCount,proto3" json:"replies_count,omitempty"`
+	Deleted        bool                   `protobuf:"varint,15,opt,name=deleted,proto3" json:"deleted,omitempty"`                                                                                                               // true if only kept for its replies
+	ReactionCounts map[string]int32       `protobuf:"bytes,16,rep,name=reaction_counts,json=reactionCounts,proto3" json:"reaction_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // per reaction type, missing types have none
+	UserReaction   string                 `protobuf:"bytes,17,opt,name=user_reaction,json=userReaction,proto3" json:"user_reaction,omitempty"`                                                                                  // requester's reaction type, empty unless liked_by_user
+	Mentions       []*Mention             `protobuf:"bytes,18,rep,name=mentions,proto3" json:"mentions,omitempty"`                                                                                                              // resolved @usernames in body, in body order
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_posts_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EditPostReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Comment) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *Comment) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Comment) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Comment) GetUser() *common.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Comment) GetReactionsCount() int32 {
+	if x != nil {
+		return x.ReactionsCount
+	}
+	return 0
+}
+
+func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetLikedByUser() bool {
+	if x != nil {
+		return x.LikedByUser
+	}
+	return false
+}
+
+func (x *Comment) GetImageId() int64 {
+	if x != nil {
+		return x.ImageId
+	}
+	return 0
 }
 
-func (*EditPostReq) ProtoMessage() {}
+func (x *Comment) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
 
-func (x *EditPostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_proto_msgTypes[9]
+func (x *Comment) GetEdited() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Edited
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use EditPostReq.ProtoReflect.Descriptor instead.
-func (*EditPostReq) Descriptor() ([]byte, []int) {
-	return file_posts_proto_rawDescGZIP(), []int{9}
+func (x *Comment) GetReplyToId() int64 {
+	if x != nil {
+		return x.ReplyToId
+	}
+	return 0
 }
 
-func (x *EditPostReq) GetRequesterId() int64 {
+func (x *Comment) GetDepth() int32 {
 	if x != nil {
-		return x.RequesterId
+		return x.Depth
 	}
 	return 0
 }
 
-func (x *EditPostReq) GetPostId() int64 {
+func (x *Comment) GetRepliesCount() int32 {
 	if x != nil {
-		return x.PostId
+		return x.RepliesCount
 	}
 	return 0
 }
 
-func (x *EditPostReq) GetBody() string {
+func (x *Comment) GetDeleted() bool {
 	if x != nil {
-		return x.Body
+		return x.Deleted
 	}
-	return ""
+	return false
 }
 
-func (x *EditPostReq) GetImageId() int64 {
+func (x *Comment) GetReactionCounts() map[string]int32 {
 	if x != nil {
-		return x.ImageId
+		return x.ReactionCounts
 	}
-	return 0
+	return nil
 }
 
-func (x *EditPostReq) GetAudience() string {
+func (x *Comment) GetUserReaction() string {
 	if x != nil {
-		return x.Audience
+		return x.UserReaction
 	}
 	return ""
 }
 
-func (x *EditPostReq) GetAudienceIds() *common.UserIds {
+func (x *Comment) GetMentions() []*Mention {
 	if x != nil {
-		return x.AudienceIds
+		return x.Mentions
 	}
 	return nil
 }
 
-func (x *EditPostReq) GetDeleteImage() bool {
+// Response message with multiple comments
+type ListComments struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListComments) Reset() {
+	*x = ListComments{}
+	mi := &file_posts_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListComments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListComments) ProtoMessage() {}
+
+func (x *ListComments) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[34]
 	if x != nil {
-		return x.DeleteImage
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-// Request message for retrieving a user's posts
-type GetUserPostsReq struct {
+// Deprecated: Use ListComments.ProtoReflect.Descriptor instead.
+func (*ListComments) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListComments) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+// Request message for creating a comment
+type CreateCommentReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CreatorId     int64                  `protobuf:"varint,1,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
-	RequesterId   int64                  `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	ParentId      int64                  `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	ImageId       int64                  `protobuf:"varint,4,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`         //can be 0 if no image
+	ReplyToId     int64                  `protobuf:"varint,5,opt,name=reply_to_id,json=replyToId,proto3" json:"reply_to_id,omitempty"` //can be 0 for a top level comment
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserPostsReq) Reset() {
-	*x = GetUserPostsReq{}
-	mi := &file_posts_proto_msgTypes[10]
+func (x *CreateCommentReq) Reset() {
+	*x = CreateCommentReq{}
+	mi := &file_posts_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserPostsReq) String() string {
+func (x *CreateCommentReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserPostsReq) ProtoMessage() {}
+func (*CreateCommentReq) ProtoMessage() {}
 
-func (x *GetUserPostsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_proto_msgTypes[10]
+func (x *CreateCommentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -760,65 +2574,73 @@ func (x *GetUserPostsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserPostsReq.ProtoReflect.Descriptor instead.
-func (*GetUserPostsReq) Descriptor() ([]byte, []int) {
-	return file_posts_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use CreateCommentReq.ProtoReflect.Descriptor instead.
+func (*CreateCommentReq) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetUserPostsReq) GetCreatorId() int64 {
+func (x *CreateCommentReq) GetCreatorId() int64 {
 	if x != nil {
 		return x.CreatorId
 	}
 	return 0
 }
 
-func (x *GetUserPostsReq) GetRequesterId() int64 {
+func (x *CreateCommentReq) GetParentId() int64 {
 	if x != nil {
-		return x.RequesterId
+		return x.ParentId
 	}
 	return 0
 }
 
-func (x *GetUserPostsReq) GetLimit() int32 {
+func (x *CreateCommentReq) GetBody() string {
 	if x != nil {
-		return x.Limit
+		return x.Body
+	}
+	return ""
+}
+
+func (x *CreateCommentReq) GetImageId() int64 {
+	if x != nil {
+		return x.ImageId
 	}
 	return 0
 }
 
-func (x *GetUserPostsReq) GetOffset() int32 {
+func (x *CreateCommentReq) GetReplyToId() int64 {
 	if x != nil {
-		return x.Offset
+		return x.ReplyToId
 	}
 	return 0
 }
 
-// Request message for retrieving the personalized feed
-// (posts by followers with restricted visibility that requester is allowed to view)
-type GetPersonalizedFeedReq struct {
+// Request message for editing a comment
+type EditCommentReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequesterId   int64                  `protobuf:"varint,1,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	CreatorId     int64                  `protobuf:"varint,1,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	CommentId     int64                  `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	ImageId       int64                  `protobuf:"varint,4,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`             //can be 0 if no image
+	DeleteImage   bool                   `protobuf:"varint,5,opt,name=delete_image,json=deleteImage,proto3" json:"delete_image,omitempty"` //true if removing preexisting image
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPersonalizedFeedReq) Reset() {
-	*x = GetPersonalizedFeedReq{}
-	mi := &file_posts_proto_msgTypes[11]
+func (x *EditCommentReq) Reset() {
+	*x = EditCommentReq{}
+	mi := &file_posts_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPersonalizedFeedReq) String() string {
+func (x *EditCommentReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPersonalizedFeedReq) ProtoMessage() {}
+func (*EditCommentReq) ProtoMessage() {}
 
-func (x *GetPersonalizedFeedReq) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_proto_msgTypes[11]
+func (x *EditCommentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -829,58 +2651,71 @@ func (x *GetPersonalizedFeedReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPersonalizedFeedReq.ProtoReflect.Descriptor instead.
-func (*GetPersonalizedFeedReq) Descriptor() ([]byte, []int) {
-	return file_posts_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use EditCommentReq.ProtoReflect.Descriptor instead.
+func (*EditCommentReq) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetPersonalizedFeedReq) GetRequesterId() int64 {
+func (x *EditCommentReq) GetCreatorId() int64 {
 	if x != nil {
-		return x.RequesterId
+		return x.CreatorId
 	}
 	return 0
 }
 
-func (x *GetPersonalizedFeedReq) GetLimit() int32 {
+func (x *EditCommentReq) GetCommentId() int64 {
 	if x != nil {
-		return x.Limit
+		return x.CommentId
 	}
 	return 0
 }
 
-func (x *GetPersonalizedFeedReq) GetOffset() int32 {
+func (x *EditCommentReq) GetBody() string {
 	if x != nil {
-		return x.Offset
+		return x.Body
+	}
+	return ""
+}
+
+func (x *EditCommentReq) GetImageId() int64 {
+	if x != nil {
+		return x.ImageId
 	}
 	return 0
 }
 
-// Request message for retrieving posts belonging to a group
-type GetGroupPostsReq struct {
+func (x *EditCommentReq) GetDeleteImage() bool {
+	if x != nil {
+		return x.DeleteImage
+	}
+	return false
+}
+
+// Response message that describes a previous version of a post or comment body
+type Revision struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequesterId   int64                  `protobuf:"varint,1,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
-	GroupId       int64                  `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	RevisionId    int64                  `protobuf:"varint,1,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` //when this version was written
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupPostsReq) Reset() {
-	*x = GetGroupPostsReq{}
-	mi := &file_posts_proto_msgTypes[12]
+func (x *Revision) Reset() {
+	*x = Revision{}
+	mi := &file_posts_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupPostsReq) String() string {
+func (x *Revision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupPostsReq) ProtoMessage() {}
+func (*Revision) ProtoMessage() {}
 
-func (x *GetGroupPostsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_proto_msgTypes[12]
+func (x *Revision) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -891,71 +2726,111 @@ func (x *GetGroupPostsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupPostsReq.ProtoReflect.Descriptor instead.
-func (*GetGroupPostsReq) Descriptor() ([]byte, []int) {
-	return file_posts_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use Revision.ProtoReflect.Descriptor instead.
+func (*Revision) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetGroupPostsReq) GetRequesterId() int64 {
+func (x *Revision) GetRevisionId() int64 {
 	if x != nil {
-		return x.RequesterId
+		return x.RevisionId
 	}
 	return 0
 }
 
-func (x *GetGroupPostsReq) GetGroupId() int64 {
+func (x *Revision) GetBody() string {
 	if x != nil {
-		return x.GroupId
+		return x.Body
 	}
-	return 0
+	return ""
 }
 
-func (x *GetGroupPostsReq) GetLimit() int32 {
+func (x *Revision) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Limit
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *GetGroupPostsReq) GetOffset() int32 {
+// Response message with multiple revisions
+type ListRevisions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*Revision            `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRevisions) Reset() {
+	*x = ListRevisions{}
+	mi := &file_posts_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRevisions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRevisions) ProtoMessage() {}
+
+func (x *ListRevisions) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[38]
 	if x != nil {
-		return x.Offset
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-// Response message that describes a comment
-type Comment struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	CommentId      int64                  `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	ParentId       int64                  `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
-	Body           string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
-	User           *common.User           `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"` // includes id, username and avatar url
-	ReactionsCount int32                  `protobuf:"varint,5,opt,name=reactions_count,json=reactionsCount,proto3" json:"reactions_count,omitempty"`
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	LikedByUser    bool                   `protobuf:"varint,8,opt,name=liked_by_user,json=likedByUser,proto3" json:"liked_by_user,omitempty"`
-	ImageId        int64                  `protobuf:"varint,9,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`    // can be 0 if no image
-	ImageUrl       string                 `protobuf:"bytes,10,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"` // can be empty string if image id=0
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Deprecated: Use ListRevisions.ProtoReflect.Descriptor instead.
+func (*ListRevisions) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListRevisions) GetRevisions() []*Revision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
 }
 
-func (x *Comment) Reset() {
-	*x = Comment{}
-	mi := &file_posts_proto_msgTypes[13]
+// Response message that describes a draft
+type Draft struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DraftId       int64                  `protobuf:"varint,1,opt,name=draft_id,json=draftId,proto3" json:"draft_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`                                          //post or comment
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`                                          //can be empty
+	ParentId      int64                  `protobuf:"varint,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`                 //post a comment draft replies to, 0 for post drafts
+	GroupId       int64                  `protobuf:"varint,5,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`                    //can be 0
+	Audience      string                 `protobuf:"bytes,6,opt,name=audience,proto3" json:"audience,omitempty"`                                  //can be empty, otherwise one of "everyone", "followers","selected","group"
+	AudienceIds   []int64                `protobuf:"varint,7,rep,packed,name=audience_ids,json=audienceIds,proto3" json:"audience_ids,omitempty"` //empty unless audience="selected"
+	ImageIds      []int64                `protobuf:"varint,8,rep,packed,name=image_ids,json=imageIds,proto3" json:"image_ids,omitempty"`          //in display order
+	ImageUrls     []string               `protobuf:"bytes,9,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`               //same order as image_ids, failed images are left out of both
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` //the draft and its images are dropped if it isn't saved again by then
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Draft) Reset() {
+	*x = Draft{}
+	mi := &file_posts_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Comment) String() string {
+func (x *Draft) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Comment) ProtoMessage() {}
+func (*Draft) ProtoMessage() {}
 
-func (x *Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_proto_msgTypes[13]
+func (x *Draft) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -966,104 +2841,118 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
-func (*Comment) Descriptor() ([]byte, []int) {
-	return file_posts_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use Draft.ProtoReflect.Descriptor instead.
+func (*Draft) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *Comment) GetCommentId() int64 {
+func (x *Draft) GetDraftId() int64 {
 	if x != nil {
-		return x.CommentId
+		return x.DraftId
 	}
 	return 0
 }
 
-func (x *Comment) GetParentId() int64 {
+func (x *Draft) GetKind() string {
 	if x != nil {
-		return x.ParentId
+		return x.Kind
 	}
-	return 0
+	return ""
 }
 
-func (x *Comment) GetBody() string {
+func (x *Draft) GetBody() string {
 	if x != nil {
 		return x.Body
 	}
 	return ""
 }
 
-func (x *Comment) GetUser() *common.User {
+func (x *Draft) GetParentId() int64 {
 	if x != nil {
-		return x.User
+		return x.ParentId
 	}
-	return nil
+	return 0
 }
 
-func (x *Comment) GetReactionsCount() int32 {
+func (x *Draft) GetGroupId() int64 {
 	if x != nil {
-		return x.ReactionsCount
+		return x.GroupId
 	}
 	return 0
 }
 
-func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+func (x *Draft) GetAudience() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Audience
+	}
+	return ""
+}
+
+func (x *Draft) GetAudienceIds() []int64 {
+	if x != nil {
+		return x.AudienceIds
 	}
 	return nil
 }
 
-func (x *Comment) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *Draft) GetImageIds() []int64 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.ImageIds
 	}
 	return nil
 }
 
-func (x *Comment) GetLikedByUser() bool {
+func (x *Draft) GetImageUrls() []string {
 	if x != nil {
-		return x.LikedByUser
+		return x.ImageUrls
 	}
-	return false
+	return nil
 }
 
-func (x *Comment) GetImageId() int64 {
+func (x *Draft) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ImageId
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *Comment) GetImageUrl() string {
+func (x *Draft) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ImageUrl
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-// Response message with multiple comments
-type ListComments struct {
+func (x *Draft) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// Response message with multiple drafts
+type ListDrafts struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	Drafts        []*Draft               `protobuf:"bytes,1,rep,name=drafts,proto3" json:"drafts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListComments) Reset() {
-	*x = ListComments{}
-	mi := &file_posts_proto_msgTypes[14]
+func (x *ListDrafts) Reset() {
+	*x = ListDrafts{}
+	mi := &file_posts_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListComments) String() string {
+func (x *ListDrafts) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListComments) ProtoMessage() {}
+func (*ListDrafts) ProtoMessage() {}
 
-func (x *ListComments) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_proto_msgTypes[14]
+func (x *ListDrafts) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1074,44 +2963,48 @@ func (x *ListComments) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListComments.ProtoReflect.Descriptor instead.
-func (*ListComments) Descriptor() ([]byte, []int) {
-	return file_posts_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ListDrafts.ProtoReflect.Descriptor instead.
+func (*ListDrafts) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ListComments) GetComments() []*Comment {
+func (x *ListDrafts) GetDrafts() []*Draft {
 	if x != nil {
-		return x.Comments
+		return x.Drafts
 	}
 	return nil
 }
 
-// Request message for creating a comment
-type CreateCommentReq struct {
+// Request message for creating a draft
+type CreateDraftReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CreatorId     int64                  `protobuf:"varint,1,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
-	ParentId      int64                  `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
-	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
-	ImageId       int64                  `protobuf:"varint,4,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"` //can be 0 if no image
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`                                          //post or comment
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`                                          //can be empty
+	ParentId      int64                  `protobuf:"varint,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`                 //required for comment drafts, 0 for post drafts
+	GroupId       int64                  `protobuf:"varint,5,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`                    //can be 0, post drafts only
+	Audience      string                 `protobuf:"bytes,6,opt,name=audience,proto3" json:"audience,omitempty"`                                  //can be empty, post drafts only
+	AudienceIds   []int64                `protobuf:"varint,7,rep,packed,name=audience_ids,json=audienceIds,proto3" json:"audience_ids,omitempty"` //empty unless audience="selected"
+	ImageIds      []int64                `protobuf:"varint,8,rep,packed,name=image_ids,json=imageIds,proto3" json:"image_ids,omitempty"`          //in display order, at most one for comment drafts
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCommentReq) Reset() {
-	*x = CreateCommentReq{}
-	mi := &file_posts_proto_msgTypes[15]
+func (x *CreateDraftReq) Reset() {
+	*x = CreateDraftReq{}
+	mi := &file_posts_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCommentReq) String() string {
+func (x *CreateDraftReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCommentReq) ProtoMessage() {}
+func (*CreateDraftReq) ProtoMessage() {}
 
-func (x *CreateCommentReq) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_proto_msgTypes[15]
+func (x *CreateDraftReq) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1122,66 +3015,96 @@ func (x *CreateCommentReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCommentReq.ProtoReflect.Descriptor instead.
-func (*CreateCommentReq) Descriptor() ([]byte, []int) {
-	return file_posts_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use CreateDraftReq.ProtoReflect.Descriptor instead.
+func (*CreateDraftReq) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *CreateCommentReq) GetCreatorId() int64 {
+func (x *CreateDraftReq) GetCreatorId() int64 {
 	if x != nil {
 		return x.CreatorId
 	}
 	return 0
 }
 
-func (x *CreateCommentReq) GetParentId() int64 {
+func (x *CreateDraftReq) GetKind() string {
 	if x != nil {
-		return x.ParentId
+		return x.Kind
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateCommentReq) GetBody() string {
+func (x *CreateDraftReq) GetBody() string {
 	if x != nil {
 		return x.Body
 	}
 	return ""
 }
 
-func (x *CreateCommentReq) GetImageId() int64 {
+func (x *CreateDraftReq) GetParentId() int64 {
 	if x != nil {
-		return x.ImageId
+		return x.ParentId
 	}
 	return 0
 }
 
-// Request message for editing a comment
-type EditCommentReq struct {
+func (x *CreateDraftReq) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *CreateDraftReq) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+func (x *CreateDraftReq) GetAudienceIds() []int64 {
+	if x != nil {
+		return x.AudienceIds
+	}
+	return nil
+}
+
+func (x *CreateDraftReq) GetImageIds() []int64 {
+	if x != nil {
+		return x.ImageIds
+	}
+	return nil
+}
+
+// Request message for updating a draft, kind and parent can't be changed
+type UpdateDraftReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CreatorId     int64                  `protobuf:"varint,1,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
-	CommentId     int64                  `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
-	ImageId       int64                  `protobuf:"varint,4,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`             //can be 0 if no image
-	DeleteImage   bool                   `protobuf:"varint,5,opt,name=delete_image,json=deleteImage,proto3" json:"delete_image,omitempty"` //true if removing preexisting image
+	RequesterId   int64                  `protobuf:"varint,1,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	DraftId       int64                  `protobuf:"varint,2,opt,name=draft_id,json=draftId,proto3" json:"draft_id,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`                                          //can be empty
+	GroupId       int64                  `protobuf:"varint,4,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`                    //can be 0, post drafts only
+	Audience      string                 `protobuf:"bytes,5,opt,name=audience,proto3" json:"audience,omitempty"`                                  //can be empty, post drafts only
+	AudienceIds   []int64                `protobuf:"varint,6,rep,packed,name=audience_ids,json=audienceIds,proto3" json:"audience_ids,omitempty"` //empty unless audience="selected"
+	ImageIds      []int64                `protobuf:"varint,7,rep,packed,name=image_ids,json=imageIds,proto3" json:"image_ids,omitempty"`          //in display order, at most one for comment drafts
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EditCommentReq) Reset() {
-	*x = EditCommentReq{}
-	mi := &file_posts_proto_msgTypes[16]
+func (x *UpdateDraftReq) Reset() {
+	*x = UpdateDraftReq{}
+	mi := &file_posts_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EditCommentReq) String() string {
+func (x *UpdateDraftReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditCommentReq) ProtoMessage() {}
+func (*UpdateDraftReq) ProtoMessage() {}
 
-func (x *EditCommentReq) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_proto_msgTypes[16]
+func (x *UpdateDraftReq) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1192,44 +3115,119 @@ func (x *EditCommentReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EditCommentReq.ProtoReflect.Descriptor instead.
-func (*EditCommentReq) Descriptor() ([]byte, []int) {
-	return file_posts_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use UpdateDraftReq.ProtoReflect.Descriptor instead.
+func (*UpdateDraftReq) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *EditCommentReq) GetCreatorId() int64 {
+func (x *UpdateDraftReq) GetRequesterId() int64 {
 	if x != nil {
-		return x.CreatorId
+		return x.RequesterId
 	}
 	return 0
 }
 
-func (x *EditCommentReq) GetCommentId() int64 {
+func (x *UpdateDraftReq) GetDraftId() int64 {
 	if x != nil {
-		return x.CommentId
+		return x.DraftId
 	}
 	return 0
 }
 
-func (x *EditCommentReq) GetBody() string {
+func (x *UpdateDraftReq) GetBody() string {
 	if x != nil {
 		return x.Body
 	}
 	return ""
 }
 
-func (x *EditCommentReq) GetImageId() int64 {
+func (x *UpdateDraftReq) GetGroupId() int64 {
 	if x != nil {
-		return x.ImageId
+		return x.GroupId
 	}
 	return 0
 }
 
-func (x *EditCommentReq) GetDeleteImage() bool {
+func (x *UpdateDraftReq) GetAudience() string {
 	if x != nil {
-		return x.DeleteImage
+		return x.Audience
 	}
-	return false
+	return ""
+}
+
+func (x *UpdateDraftReq) GetAudienceIds() []int64 {
+	if x != nil {
+		return x.AudienceIds
+	}
+	return nil
+}
+
+func (x *UpdateDraftReq) GetImageIds() []int64 {
+	if x != nil {
+		return x.ImageIds
+	}
+	return nil
+}
+
+// Request message for publishing a draft
+type PublishDraftReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequesterId   int64                  `protobuf:"varint,1,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	DraftId       int64                  `protobuf:"varint,2,opt,name=draft_id,json=draftId,proto3" json:"draft_id,omitempty"`
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"` //unset to publish right away, post drafts only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishDraftReq) Reset() {
+	*x = PublishDraftReq{}
+	mi := &file_posts_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishDraftReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishDraftReq) ProtoMessage() {}
+
+func (x *PublishDraftReq) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishDraftReq.ProtoReflect.Descriptor instead.
+func (*PublishDraftReq) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PublishDraftReq) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *PublishDraftReq) GetDraftId() int64 {
+	if x != nil {
+		return x.DraftId
+	}
+	return 0
+}
+
+func (x *PublishDraftReq) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
 }
 
 // Response message that describes an event
@@ -1254,7 +3252,7 @@ type Event struct {
 
 func (x *Event) Reset() {
 	*x = Event{}
-	mi := &file_posts_proto_msgTypes[17]
+	mi := &file_posts_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1266,7 +3264,7 @@ func (x *Event) String() string {
 func (*Event) ProtoMessage() {}
 
 func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_proto_msgTypes[17]
+	mi := &file_posts_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1279,7 +3277,7 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Event.ProtoReflect.Descriptor instead.
 func (*Event) Descriptor() ([]byte, []int) {
-	return file_posts_proto_rawDescGZIP(), []int{17}
+	return file_posts_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *Event) GetEventId() int64 {
@@ -1383,7 +3381,7 @@ type ListEvents struct {
 
 func (x *ListEvents) Reset() {
 	*x = ListEvents{}
-	mi := &file_posts_proto_msgTypes[18]
+	mi := &file_posts_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1395,7 +3393,7 @@ func (x *ListEvents) String() string {
 func (*ListEvents) ProtoMessage() {}
 
 func (x *ListEvents) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_proto_msgTypes[18]
+	mi := &file_posts_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1408,7 +3406,7 @@ func (x *ListEvents) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEvents.ProtoReflect.Descriptor instead.
 func (*ListEvents) Descriptor() ([]byte, []int) {
-	return file_posts_proto_rawDescGZIP(), []int{18}
+	return file_posts_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *ListEvents) GetEvents() []*Event {
@@ -1433,7 +3431,7 @@ type CreateEventReq struct {
 
 func (x *CreateEventReq) Reset() {
 	*x = CreateEventReq{}
-	mi := &file_posts_proto_msgTypes[19]
+	mi := &file_posts_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1445,7 +3443,7 @@ func (x *CreateEventReq) String() string {
 func (*CreateEventReq) ProtoMessage() {}
 
 func (x *CreateEventReq) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_proto_msgTypes[19]
+	mi := &file_posts_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1458,7 +3456,7 @@ func (x *CreateEventReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEventReq.ProtoReflect.Descriptor instead.
 func (*CreateEventReq) Descriptor() ([]byte, []int) {
-	return file_posts_proto_rawDescGZIP(), []int{19}
+	return file_posts_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *CreateEventReq) GetTitle() string {
@@ -1519,7 +3517,7 @@ type EditEventReq struct {
 
 func (x *EditEventReq) Reset() {
 	*x = EditEventReq{}
-	mi := &file_posts_proto_msgTypes[20]
+	mi := &file_posts_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1531,7 +3529,7 @@ func (x *EditEventReq) String() string {
 func (*EditEventReq) ProtoMessage() {}
 
 func (x *EditEventReq) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_proto_msgTypes[20]
+	mi := &file_posts_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1544,7 +3542,7 @@ func (x *EditEventReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditEventReq.ProtoReflect.Descriptor instead.
 func (*EditEventReq) Descriptor() ([]byte, []int) {
-	return file_posts_proto_rawDescGZIP(), []int{20}
+	return file_posts_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *EditEventReq) GetEventId() int64 {
@@ -1608,7 +3606,7 @@ type RespondToEventReq struct {
 
 func (x *RespondToEventReq) Reset() {
 	*x = RespondToEventReq{}
-	mi := &file_posts_proto_msgTypes[21]
+	mi := &file_posts_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1620,7 +3618,7 @@ func (x *RespondToEventReq) String() string {
 func (*RespondToEventReq) ProtoMessage() {}
 
 func (x *RespondToEventReq) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_proto_msgTypes[21]
+	mi := &file_posts_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1633,7 +3631,7 @@ func (x *RespondToEventReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RespondToEventReq.ProtoReflect.Descriptor instead.
 func (*RespondToEventReq) Descriptor() ([]byte, []int) {
-	return file_posts_proto_rawDescGZIP(), []int{21}
+	return file_posts_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *RespondToEventReq) GetEventId() int64 {
@@ -1671,7 +3669,11 @@ const file_posts_proto_rawDesc = "" +
 	"\n" +
 	"GenericReq\x12!\n" +
 	"\frequester_id\x18\x01 \x01(\x03R\vrequesterId\x12\x1b\n" +
-	"\tentity_id\x18\x02 \x01(\x03R\bentityId\"\x84\x01\n" +
+	"\tentity_id\x18\x02 \x01(\x03R\bentityId\"r\n" +
+	"\vReactionReq\x12!\n" +
+	"\frequester_id\x18\x01 \x01(\x03R\vrequesterId\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\x03R\bentityId\x12#\n" +
+	"\rreaction_type\x18\x03 \x01(\tR\freactionType\"\x84\x01\n" +
 	"\x14EntityIdPaginatedReq\x12!\n" +
 	"\frequester_id\x18\x01 \x01(\x03R\vrequesterId\x12\x1b\n" +
 	"\tentity_id\x18\x02 \x01(\x03R\bentityId\x12\x14\n" +
@@ -1680,7 +3682,42 @@ const file_posts_proto_rawDesc = "" +
 	"\x13GenericPaginatedReq\x12!\n" +
 	"\frequester_id\x18\x01 \x01(\x03R\vrequesterId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\"\xca\x04\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"b\n" +
+	"\x11GroupsActivityReq\x12\x1b\n" +
+	"\tgroup_ids\x18\x01 \x03(\x03R\bgroupIds\x120\n" +
+	"\x05since\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\"\x9f\x01\n" +
+	"\x12GroupsActivityResp\x12J\n" +
+	"\vpost_counts\x18\x01 \x03(\v2).posts.GroupsActivityResp.PostCountsEntryR\n" +
+	"postCounts\x1a=\n" +
+	"\x0fPostCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"_\n" +
+	"\x10GroupInsightsReq\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x120\n" +
+	"\x05since\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\"P\n" +
+	"\n" +
+	"DailyCount\x12,\n" +
+	"\x03day\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x03day\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"m\n" +
+	"\x0ePosterActivity\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"post_count\x18\x02 \x01(\x03R\tpostCount\x12#\n" +
+	"\rcomment_count\x18\x03 \x01(\x03R\fcommentCount\"\xc6\x01\n" +
+	"\x0fEventAttendance\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x129\n" +
+	"\n" +
+	"event_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\teventDate\x12\x1f\n" +
+	"\vgoing_count\x18\x04 \x01(\x05R\n" +
+	"goingCount\x12&\n" +
+	"\x0fnot_going_count\x18\x05 \x01(\x05R\rnotGoingCount\"\xf8\x01\n" +
+	"\x14GroupContentInsights\x125\n" +
+	"\rposts_per_day\x18\x01 \x03(\v2\x11.posts.DailyCountR\vpostsPerDay\x12;\n" +
+	"\x10comments_per_day\x18\x02 \x03(\v2\x11.posts.DailyCountR\x0ecommentsPerDay\x12<\n" +
+	"\x0eactive_posters\x18\x03 \x03(\v2\x15.posts.PosterActivityR\ractivePosters\x12.\n" +
+	"\x06events\x18\x04 \x03(\v2\x16.posts.EventAttendanceR\x06events\"\x8d\n" +
+	"\n" +
 	"\x04Post\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x1b\n" +
 	"\tpost_body\x18\x02 \x01(\tR\bpostBody\x12 \n" +
@@ -1698,9 +3735,35 @@ const file_posts_proto_rawDesc = "" +
 	"\rliked_by_user\x18\v \x01(\bR\vlikedByUser\x12\x19\n" +
 	"\bimage_id\x18\f \x01(\x03R\aimageId\x12\x1b\n" +
 	"\timage_url\x18\r \x01(\tR\bimageUrl\x12I\n" +
-	"\x17selected_audience_users\x18\x0e \x01(\v2\x11.common.ListUsersR\x15selectedAudienceUsers\".\n" +
+	"\x17selected_audience_users\x18\x0e \x01(\v2\x11.common.ListUsersR\x15selectedAudienceUsers\x12\x16\n" +
+	"\x06pinned\x18\x0f \x01(\bR\x06pinned\x12\"\n" +
+	"\fannouncement\x18\x10 \x01(\bR\fannouncement\x12'\n" +
+	"\x0fapproval_status\x18\x11 \x01(\tR\x0eapprovalStatus\x12)\n" +
+	"\x10rejection_reason\x18\x12 \x01(\tR\x0frejectionReason\x12\x1b\n" +
+	"\timage_ids\x18\x13 \x03(\x03R\bimageIds\x12\x1d\n" +
+	"\n" +
+	"image_urls\x18\x14 \x03(\tR\timageUrls\x129\n" +
+	"\n" +
+	"publish_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x12\x16\n" +
+	"\x06edited\x18\x16 \x01(\bR\x06edited\x12H\n" +
+	"\x0freaction_counts\x18\x17 \x03(\v2\x1f.posts.Post.ReactionCountsEntryR\x0ereactionCounts\x12#\n" +
+	"\ruser_reaction\x18\x18 \x01(\tR\fuserReaction\x12*\n" +
+	"\bmentions\x18\x19 \x03(\v2\x0e.posts.MentionR\bmentions\x12$\n" +
+	"\x0eshared_post_id\x18\x1a \x01(\x03R\fsharedPostId\x12,\n" +
+	"\vshared_post\x18\x1b \x01(\v2\v.posts.PostR\n" +
+	"sharedPost\x12#\n" +
+	"\rreposts_count\x18\x1c \x01(\x05R\frepostsCount\x12!\n" +
+	"\fquotes_count\x18\x1d \x01(\x05R\vquotesCount\x12(\n" +
+	"\x10reposted_by_user\x18\x1e \x01(\bR\x0erepostedByUser\x1aA\n" +
+	"\x13ReactionCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"P\n" +
+	"\aMention\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\x05R\x05start\x12\x16\n" +
+	"\x06length\x18\x03 \x01(\x05R\x06length\".\n" +
 	"\tListPosts\x12!\n" +
-	"\x05posts\x18\x01 \x03(\v2\v.posts.PostR\x05posts\"\xc8\x01\n" +
+	"\x05posts\x18\x01 \x03(\v2\v.posts.PostR\x05posts\"\xa0\x02\n" +
 	"\rCreatePostReq\x12\x1d\n" +
 	"\n" +
 	"creator_id\x18\x01 \x01(\x03R\tcreatorId\x12\x12\n" +
@@ -1708,7 +3771,10 @@ const file_posts_proto_rawDesc = "" +
 	"\bgroup_id\x18\x03 \x01(\x03R\agroupId\x12\x1a\n" +
 	"\baudience\x18\x04 \x01(\tR\baudience\x122\n" +
 	"\faudience_ids\x18\x05 \x01(\v2\x0f.common.UserIdsR\vaudienceIds\x12\x19\n" +
-	"\bimage_id\x18\x06 \x01(\x03R\aimageId\"\xeb\x01\n" +
+	"\bimage_id\x18\x06 \x01(\x03R\aimageId\x12\x1b\n" +
+	"\timage_ids\x18\a \x03(\x03R\bimageIds\x129\n" +
+	"\n" +
+	"publish_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\"\x88\x02\n" +
 	"\vEditPostReq\x12!\n" +
 	"\frequester_id\x18\x01 \x01(\x03R\vrequesterId\x12\x17\n" +
 	"\apost_id\x18\x02 \x01(\x03R\x06postId\x12\x12\n" +
@@ -1716,7 +3782,14 @@ const file_posts_proto_rawDesc = "" +
 	"\bimage_id\x18\x04 \x01(\x03R\aimageId\x12\x1a\n" +
 	"\baudience\x18\x05 \x01(\tR\baudience\x122\n" +
 	"\faudience_ids\x18\x06 \x01(\v2\x0f.common.UserIdsR\vaudienceIds\x12!\n" +
-	"\fdelete_image\x18\a \x01(\bR\vdeleteImage\"\x81\x01\n" +
+	"\fdelete_image\x18\a \x01(\bR\vdeleteImage\x12\x1b\n" +
+	"\timage_ids\x18\b \x03(\x03R\bimageIds\"\xae\x01\n" +
+	"\fSharePostReq\x12!\n" +
+	"\frequester_id\x18\x01 \x01(\x03R\vrequesterId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\x03R\x06postId\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x1a\n" +
+	"\baudience\x18\x04 \x01(\tR\baudience\x122\n" +
+	"\faudience_ids\x18\x05 \x01(\v2\x0f.common.UserIdsR\vaudienceIds\"\x81\x01\n" +
 	"\x0fGetUserPostsReq\x12\x1d\n" +
 	"\n" +
 	"creator_id\x18\x01 \x01(\x03R\tcreatorId\x12!\n" +
@@ -1726,12 +3799,59 @@ const file_posts_proto_rawDesc = "" +
 	"\x16GetPersonalizedFeedReq\x12!\n" +
 	"\frequester_id\x18\x01 \x01(\x03R\vrequesterId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\"~\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"b\n" +
+	"\x0eSetPostFlagReq\x12!\n" +
+	"\frequester_id\x18\x01 \x01(\x03R\vrequesterId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\x03R\x06postId\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\bR\x05value\"c\n" +
+	"\rReviewPostReq\x12!\n" +
+	"\frequester_id\x18\x01 \x01(\x03R\vrequesterId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\x03R\x06postId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x8a\x01\n" +
+	"\x11ReschedulePostReq\x12!\n" +
+	"\frequester_id\x18\x01 \x01(\x03R\vrequesterId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\x03R\x06postId\x129\n" +
+	"\n" +
+	"publish_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\"~\n" +
 	"\x10GetGroupPostsReq\x12!\n" +
 	"\frequester_id\x18\x01 \x01(\x03R\vrequesterId\x12\x19\n" +
 	"\bgroup_id\x18\x02 \x01(\x03R\agroupId\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x04 \x01(\x05R\x06offset\"\xf6\x02\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"v\n" +
+	"\x11GetHashtagFeedReq\x12!\n" +
+	"\frequester_id\x18\x01 \x01(\x03R\vrequesterId\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"Q\n" +
+	"\x16GetTrendingHashtagsReq\x12!\n" +
+	"\fwindow_hours\x18\x01 \x01(\x05R\vwindowHours\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"i\n" +
+	"\x0fTrendingHashtag\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x1f\n" +
+	"\vposts_count\x18\x02 \x01(\x05R\n" +
+	"postsCount\x12#\n" +
+	"\rauthors_count\x18\x03 \x01(\x05R\fauthorsCount\"J\n" +
+	"\x14ListTrendingHashtags\x122\n" +
+	"\bhashtags\x18\x01 \x03(\v2\x16.posts.TrendingHashtagR\bhashtags\"\x84\x01\n" +
+	"\x10SearchContentReq\x12!\n" +
+	"\frequester_id\x18\x01 \x01(\x03R\vrequesterId\x12\x1f\n" +
+	"\vsearch_term\x18\x02 \x01(\tR\n" +
+	"searchTerm\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"\x87\x02\n" +
+	"\fSearchResult\x12\x1f\n" +
+	"\ventity_type\x18\x01 \x01(\tR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\x03R\bentityId\x12\x17\n" +
+	"\apost_id\x18\x03 \x01(\x03R\x06postId\x12\x19\n" +
+	"\bgroup_id\x18\x04 \x01(\x03R\agroupId\x12 \n" +
+	"\x04user\x18\x05 \x01(\v2\f.common.UserR\x04user\x12\x14\n" +
+	"\x05title\x18\x06 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\a \x01(\tR\x04body\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"B\n" +
+	"\x11ListSearchResults\x12-\n" +
+	"\aresults\x18\x01 \x03(\v2\x13.posts.SearchResultR\aresults\"\xe4\x05\n" +
 	"\aComment\x12\x1d\n" +
 	"\n" +
 	"comment_id\x18\x01 \x01(\x03R\tcommentId\x12\x1b\n" +
@@ -1746,15 +3866,27 @@ const file_posts_proto_rawDesc = "" +
 	"\rliked_by_user\x18\b \x01(\bR\vlikedByUser\x12\x19\n" +
 	"\bimage_id\x18\t \x01(\x03R\aimageId\x12\x1b\n" +
 	"\timage_url\x18\n" +
-	" \x01(\tR\bimageUrl\":\n" +
+	" \x01(\tR\bimageUrl\x12\x16\n" +
+	"\x06edited\x18\v \x01(\bR\x06edited\x12\x1e\n" +
+	"\vreply_to_id\x18\f \x01(\x03R\treplyToId\x12\x14\n" +
+	"\x05depth\x18\r \x01(\x05R\x05depth\x12#\n" +
+	"\rreplies_count\x18\x0e \x01(\x05R\frepliesCount\x12\x18\n" +
+	"\adeleted\x18\x0f \x01(\bR\adeleted\x12K\n" +
+	"\x0freaction_counts\x18\x10 \x03(\v2\".posts.Comment.ReactionCountsEntryR\x0ereactionCounts\x12#\n" +
+	"\ruser_reaction\x18\x11 \x01(\tR\fuserReaction\x12*\n" +
+	"\bmentions\x18\x12 \x03(\v2\x0e.posts.MentionR\bmentions\x1aA\n" +
+	"\x13ReactionCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\":\n" +
 	"\fListComments\x12*\n" +
-	"\bcomments\x18\x01 \x03(\v2\x0e.posts.CommentR\bcomments\"}\n" +
+	"\bcomments\x18\x01 \x03(\v2\x0e.posts.CommentR\bcomments\"\x9d\x01\n" +
 	"\x10CreateCommentReq\x12\x1d\n" +
 	"\n" +
 	"creator_id\x18\x01 \x01(\x03R\tcreatorId\x12\x1b\n" +
 	"\tparent_id\x18\x02 \x01(\x03R\bparentId\x12\x12\n" +
 	"\x04body\x18\x03 \x01(\tR\x04body\x12\x19\n" +
-	"\bimage_id\x18\x04 \x01(\x03R\aimageId\"\xa0\x01\n" +
+	"\bimage_id\x18\x04 \x01(\x03R\aimageId\x12\x1e\n" +
+	"\vreply_to_id\x18\x05 \x01(\x03R\treplyToId\"\xa0\x01\n" +
 	"\x0eEditCommentReq\x12\x1d\n" +
 	"\n" +
 	"creator_id\x18\x01 \x01(\x03R\tcreatorId\x12\x1d\n" +
@@ -1762,7 +3894,59 @@ const file_posts_proto_rawDesc = "" +
 	"comment_id\x18\x02 \x01(\x03R\tcommentId\x12\x12\n" +
 	"\x04body\x18\x03 \x01(\tR\x04body\x12\x19\n" +
 	"\bimage_id\x18\x04 \x01(\x03R\aimageId\x12!\n" +
-	"\fdelete_image\x18\x05 \x01(\bR\vdeleteImage\"\xfc\x03\n" +
+	"\fdelete_image\x18\x05 \x01(\bR\vdeleteImage\"z\n" +
+	"\bRevision\x12\x1f\n" +
+	"\vrevision_id\x18\x01 \x01(\x03R\n" +
+	"revisionId\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\">\n" +
+	"\rListRevisions\x12-\n" +
+	"\trevisions\x18\x01 \x03(\v2\x0f.posts.RevisionR\trevisions\"\xae\x03\n" +
+	"\x05Draft\x12\x19\n" +
+	"\bdraft_id\x18\x01 \x01(\x03R\adraftId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\x03R\bparentId\x12\x19\n" +
+	"\bgroup_id\x18\x05 \x01(\x03R\agroupId\x12\x1a\n" +
+	"\baudience\x18\x06 \x01(\tR\baudience\x12!\n" +
+	"\faudience_ids\x18\a \x03(\x03R\vaudienceIds\x12\x1b\n" +
+	"\timage_ids\x18\b \x03(\x03R\bimageIds\x12\x1d\n" +
+	"\n" +
+	"image_urls\x18\t \x03(\tR\timageUrls\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"2\n" +
+	"\n" +
+	"ListDrafts\x12$\n" +
+	"\x06drafts\x18\x01 \x03(\v2\f.posts.DraftR\x06drafts\"\xeb\x01\n" +
+	"\x0eCreateDraftReq\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x01 \x01(\x03R\tcreatorId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\x03R\bparentId\x12\x19\n" +
+	"\bgroup_id\x18\x05 \x01(\x03R\agroupId\x12\x1a\n" +
+	"\baudience\x18\x06 \x01(\tR\baudience\x12!\n" +
+	"\faudience_ids\x18\a \x03(\x03R\vaudienceIds\x12\x1b\n" +
+	"\timage_ids\x18\b \x03(\x03R\bimageIds\"\xd9\x01\n" +
+	"\x0eUpdateDraftReq\x12!\n" +
+	"\frequester_id\x18\x01 \x01(\x03R\vrequesterId\x12\x19\n" +
+	"\bdraft_id\x18\x02 \x01(\x03R\adraftId\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x19\n" +
+	"\bgroup_id\x18\x04 \x01(\x03R\agroupId\x12\x1a\n" +
+	"\baudience\x18\x05 \x01(\tR\baudience\x12!\n" +
+	"\faudience_ids\x18\x06 \x03(\x03R\vaudienceIds\x12\x1b\n" +
+	"\timage_ids\x18\a \x03(\x03R\bimageIds\"\x8a\x01\n" +
+	"\x0fPublishDraftReq\x12!\n" +
+	"\frequester_id\x18\x01 \x01(\x03R\vrequesterId\x12\x19\n" +
+	"\bdraft_id\x18\x02 \x01(\x03R\adraftId\x129\n" +
+	"\n" +
+	"publish_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\"\xfc\x03\n" +
 	"\x05Event\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
@@ -1806,33 +3990,56 @@ const file_posts_proto_rawDesc = "" +
 	"\x11RespondToEventReq\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12!\n" +
 	"\fresponder_id\x18\x02 \x01(\x03R\vresponderId\x12\x14\n" +
-	"\x05going\x18\x03 \x01(\bR\x05going2\xae\v\n" +
+	"\x05going\x18\x03 \x01(\bR\x05going2\xfc\x15\n" +
 	"\fPostsService\x12-\n" +
 	"\vGetPostById\x12\x11.posts.GenericReq\x1a\v.posts.Post\x121\n" +
 	"\n" +
 	"CreatePost\x12\x14.posts.CreatePostReq\x1a\r.posts.IdResp\x127\n" +
 	"\n" +
 	"DeletePost\x12\x11.posts.GenericReq\x1a\x16.google.protobuf.Empty\x126\n" +
-	"\bEditPost\x12\x12.posts.EditPostReq\x1a\x16.google.protobuf.Empty\x12<\n" +
+	"\bEditPost\x12\x12.posts.EditPostReq\x1a\x16.google.protobuf.Empty\x12/\n" +
+	"\tSharePost\x12\x13.posts.SharePostReq\x1a\r.posts.IdResp\x127\n" +
+	"\n" +
+	"UndoRepost\x12\x11.posts.GenericReq\x1a\x16.google.protobuf.Empty\x12<\n" +
 	"\x19GetMostPopularPostInGroup\x12\x12.posts.SimpleIdReq\x1a\v.posts.Post\x12F\n" +
 	"\x13GetPersonalizedFeed\x12\x1d.posts.GetPersonalizedFeedReq\x1a\x10.posts.ListPosts\x12=\n" +
-	"\rGetPublicFeed\x12\x1a.posts.GenericPaginatedReq\x1a\x10.posts.ListPosts\x12A\n" +
+	"\rGetPublicFeed\x12\x1a.posts.GenericPaginatedReq\x1a\x10.posts.ListPosts\x12<\n" +
+	"\x0eGetHashtagFeed\x12\x18.posts.GetHashtagFeedReq\x1a\x10.posts.ListPosts\x12Q\n" +
+	"\x13GetTrendingHashtags\x12\x1d.posts.GetTrendingHashtagsReq\x1a\x1b.posts.ListTrendingHashtags\x12B\n" +
+	"\rSearchContent\x12\x17.posts.SearchContentReq\x1a\x18.posts.ListSearchResults\x12A\n" +
 	"\x15GetUserPostsPaginated\x12\x16.posts.GetUserPostsReq\x1a\x10.posts.ListPosts\x12C\n" +
-	"\x16GetGroupPostsPaginated\x12\x17.posts.GetGroupPostsReq\x1a\x10.posts.ListPosts\x127\n" +
+	"\x16GetGroupPostsPaginated\x12\x17.posts.GetGroupPostsReq\x1a\x10.posts.ListPosts\x12>\n" +
+	"\rSetPostPinned\x12\x15.posts.SetPostFlagReq\x1a\x16.google.protobuf.Empty\x12D\n" +
+	"\x13SetPostAnnouncement\x12\x15.posts.SetPostFlagReq\x1a\x16.google.protobuf.Empty\x12A\n" +
+	"\x14GetPendingGroupPosts\x12\x17.posts.GetGroupPostsReq\x1a\x10.posts.ListPosts\x12;\n" +
+	"\vApprovePost\x12\x14.posts.ReviewPostReq\x1a\x16.google.protobuf.Empty\x12:\n" +
+	"\n" +
+	"RejectPost\x12\x14.posts.ReviewPostReq\x1a\x16.google.protobuf.Empty\x12A\n" +
+	"\x11GetScheduledPosts\x12\x1a.posts.GenericPaginatedReq\x1a\x10.posts.ListPosts\x12B\n" +
+	"\x0eReschedulePost\x12\x18.posts.ReschedulePostReq\x1a\x16.google.protobuf.Empty\x12@\n" +
+	"\x13CancelScheduledPost\x12\x11.posts.GenericReq\x1a\x16.google.protobuf.Empty\x127\n" +
 	"\rCreateComment\x12\x17.posts.CreateCommentReq\x1a\r.posts.IdResp\x12<\n" +
 	"\vEditComment\x12\x15.posts.EditCommentReq\x1a\x16.google.protobuf.Empty\x12:\n" +
 	"\rDeleteComment\x12\x11.posts.GenericReq\x1a\x16.google.protobuf.Empty\x12I\n" +
 	"\x15GetCommentsByParentId\x12\x1b.posts.EntityIdPaginatedReq\x1a\x13.posts.ListComments\x12D\n" +
-	"\x19GetPostAudienceForComment\x12\x12.posts.SimpleIdReq\x1a\x13.posts.AudienceResp\x123\n" +
+	"\x19GetPostAudienceForComment\x12\x12.posts.SimpleIdReq\x1a\x13.posts.AudienceResp\x12A\n" +
+	"\fGetRevisions\x12\x1b.posts.EntityIdPaginatedReq\x1a\x14.posts.ListRevisions\x123\n" +
+	"\vCreateDraft\x12\x15.posts.CreateDraftReq\x1a\r.posts.IdResp\x12<\n" +
+	"\vUpdateDraft\x12\x15.posts.UpdateDraftReq\x1a\x16.google.protobuf.Empty\x12:\n" +
+	"\tGetDrafts\x12\x1a.posts.GenericPaginatedReq\x1a\x11.posts.ListDrafts\x128\n" +
+	"\vDeleteDraft\x12\x11.posts.GenericReq\x1a\x16.google.protobuf.Empty\x125\n" +
+	"\fPublishDraft\x12\x16.posts.PublishDraftReq\x1a\r.posts.IdResp\x123\n" +
 	"\vCreateEvent\x12\x15.posts.CreateEventReq\x1a\r.posts.IdResp\x128\n" +
 	"\vDeleteEvent\x12\x11.posts.GenericReq\x1a\x16.google.protobuf.Empty\x128\n" +
 	"\tEditEvent\x12\x13.posts.EditEventReq\x1a\x16.google.protobuf.Empty\x12D\n" +
 	"\x12GetEventsByGroupId\x12\x1b.posts.EntityIdPaginatedReq\x1a\x11.posts.ListEvents\x12B\n" +
 	"\x0eRespondToEvent\x12\x18.posts.RespondToEventReq\x1a\x16.google.protobuf.Empty\x12@\n" +
 	"\x13RemoveEventResponse\x12\x11.posts.GenericReq\x1a\x16.google.protobuf.Empty\x12C\n" +
-	"\x1aSuggestUsersByPostActivity\x12\x12.posts.SimpleIdReq\x1a\x11.common.ListUsers\x12C\n" +
-	"\x16ToggleOrInsertReaction\x12\x11.posts.GenericReq\x1a\x16.google.protobuf.Empty\x12;\n" +
-	"\x13GetWhoLikedEntityId\x12\x11.posts.GenericReq\x1a\x11.common.ListUsersB*Z(social-network/shared/gen-go/posts;postsb\x06proto3"
+	"\x1aSuggestUsersByPostActivity\x12\x12.posts.SimpleIdReq\x1a\x11.common.ListUsers\x12D\n" +
+	"\x16ToggleOrInsertReaction\x12\x12.posts.ReactionReq\x1a\x16.google.protobuf.Empty\x12<\n" +
+	"\x13GetWhoLikedEntityId\x12\x12.posts.ReactionReq\x1a\x11.common.ListUsers\x12L\n" +
+	"\x15GetGroupsPostActivity\x12\x18.posts.GroupsActivityReq\x1a\x19.posts.GroupsActivityResp\x12O\n" +
+	"\x17GetGroupContentInsights\x12\x17.posts.GroupInsightsReq\x1a\x1b.posts.GroupContentInsightsB*Z(social-network/shared/gen-go/posts;postsb\x06proto3"
 
 var (
 	file_posts_proto_rawDescOnce sync.Once
@@ -1846,109 +4053,210 @@ func file_posts_proto_rawDescGZIP() []byte {
 	return file_posts_proto_rawDescData
 }
 
-var file_posts_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_posts_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
 var file_posts_proto_goTypes = []any{
 	(*SimpleIdReq)(nil),            // 0: posts.SimpleIdReq
 	(*IdResp)(nil),                 // 1: posts.IdResp
 	(*AudienceResp)(nil),           // 2: posts.AudienceResp
 	(*GenericReq)(nil),             // 3: posts.GenericReq
-	(*EntityIdPaginatedReq)(nil),   // 4: posts.EntityIdPaginatedReq
-	(*GenericPaginatedReq)(nil),    // 5: posts.GenericPaginatedReq
-	(*Post)(nil),                   // 6: posts.Post
-	(*ListPosts)(nil),              // 7: posts.ListPosts
-	(*CreatePostReq)(nil),          // 8: posts.CreatePostReq
-	(*EditPostReq)(nil),            // 9: posts.EditPostReq
-	(*GetUserPostsReq)(nil),        // 10: posts.GetUserPostsReq
-	(*GetPersonalizedFeedReq)(nil), // 11: posts.GetPersonalizedFeedReq
-	(*GetGroupPostsReq)(nil),       // 12: posts.GetGroupPostsReq
-	(*Comment)(nil),                // 13: posts.Comment
-	(*ListComments)(nil),           // 14: posts.ListComments
-	(*CreateCommentReq)(nil),       // 15: posts.CreateCommentReq
-	(*EditCommentReq)(nil),         // 16: posts.EditCommentReq
-	(*Event)(nil),                  // 17: posts.Event
-	(*ListEvents)(nil),             // 18: posts.ListEvents
-	(*CreateEventReq)(nil),         // 19: posts.CreateEventReq
-	(*EditEventReq)(nil),           // 20: posts.EditEventReq
-	(*RespondToEventReq)(nil),      // 21: posts.RespondToEventReq
-	(*common.User)(nil),            // 22: common.User
-	(*timestamppb.Timestamp)(nil),  // 23: google.protobuf.Timestamp
-	(*common.ListUsers)(nil),       // 24: common.ListUsers
-	(*common.UserIds)(nil),         // 25: common.UserIds
-	(*wrapperspb.BoolValue)(nil),   // 26: google.protobuf.BoolValue
-	(*emptypb.Empty)(nil),          // 27: google.protobuf.Empty
+	(*ReactionReq)(nil),            // 4: posts.ReactionReq
+	(*EntityIdPaginatedReq)(nil),   // 5: posts.EntityIdPaginatedReq
+	(*GenericPaginatedReq)(nil),    // 6: posts.GenericPaginatedReq
+	(*GroupsActivityReq)(nil),      // 7: posts.GroupsActivityReq
+	(*GroupsActivityResp)(nil),     // 8: posts.GroupsActivityResp
+	(*GroupInsightsReq)(nil),       // 9: posts.GroupInsightsReq
+	(*DailyCount)(nil),             // 10: posts.DailyCount
+	(*PosterActivity)(nil),         // 11: posts.PosterActivity
+	(*EventAttendance)(nil),        // 12: posts.EventAttendance
+	(*GroupContentInsights)(nil),   // 13: posts.GroupContentInsights
+	(*Post)(nil),                   // 14: posts.Post
+	(*Mention)(nil),                // 15: posts.Mention
+	(*ListPosts)(nil),              // 16: posts.ListPosts
+	(*CreatePostReq)(nil),          // 17: posts.CreatePostReq
+	(*EditPostReq)(nil),            // 18: posts.EditPostReq
+	(*SharePostReq)(nil),           // 19: posts.SharePostReq
+	(*GetUserPostsReq)(nil),        // 20: posts.GetUserPostsReq
+	(*GetPersonalizedFeedReq)(nil), // 21: posts.GetPersonalizedFeedReq
+	(*SetPostFlagReq)(nil),         // 22: posts.SetPostFlagReq
+	(*ReviewPostReq)(nil),          // 23: posts.ReviewPostReq
+	(*ReschedulePostReq)(nil),      // 24: posts.ReschedulePostReq
+	(*GetGroupPostsReq)(nil),       // 25: posts.GetGroupPostsReq
+	(*GetHashtagFeedReq)(nil),      // 26: posts.GetHashtagFeedReq
+	(*GetTrendingHashtagsReq)(nil), // 27: posts.GetTrendingHashtagsReq
+	(*TrendingHashtag)(nil),        // 28: posts.TrendingHashtag
+	(*ListTrendingHashtags)(nil),   // 29: posts.ListTrendingHashtags
+	(*SearchContentReq)(nil),       // 30: posts.SearchContentReq
+	(*SearchResult)(nil),           // 31: posts.SearchResult
+	(*ListSearchResults)(nil),      // 32: posts.ListSearchResults
+	(*Comment)(nil),                // 33: posts.Comment
+	(*ListComments)(nil),           // 34: posts.ListComments
+	(*CreateCommentReq)(nil),       // 35: posts.CreateCommentReq
+	(*EditCommentReq)(nil),         // 36: posts.EditCommentReq
+	(*Revision)(nil),               // 37: posts.Revision
+	(*ListRevisions)(nil),          // 38: posts.ListRevisions
+	(*Draft)(nil),                  // 39: posts.Draft
+	(*ListDrafts)(nil),             // 40: posts.ListDrafts
+	(*CreateDraftReq)(nil),         // 41: posts.CreateDraftReq
+	(*UpdateDraftReq)(nil),         // 42: posts.UpdateDraftReq
+	(*PublishDraftReq)(nil),        // 43: posts.PublishDraftReq
+	(*Event)(nil),                  // 44: posts.Event
+	(*ListEvents)(nil),             // 45: posts.ListEvents
+	(*CreateEventReq)(nil),         // 46: posts.CreateEventReq
+	(*EditEventReq)(nil),           // 47: posts.EditEventReq
+	(*RespondToEventReq)(nil),      // 48: posts.RespondToEventReq
+	nil,                            // 49: posts.GroupsActivityResp.PostCountsEntry
+	nil,                            // 50: posts.Post.ReactionCountsEntry
+	nil,                            // 51: posts.Comment.ReactionCountsEntry
+	(*timestamppb.Timestamp)(nil),  // 52: google.protobuf.Timestamp
+	(*common.User)(nil),            // 53: common.User
+	(*common.ListUsers)(nil),       // 54: common.ListUsers
+	(*common.UserIds)(nil),         // 55: common.UserIds
+	(*wrapperspb.BoolValue)(nil),   // 56: google.protobuf.BoolValue
+	(*emptypb.Empty)(nil),          // 57: google.protobuf.Empty
 }
 var file_posts_proto_depIdxs = []int32{
-	22, // 0: posts.Post.user:type_name -> common.User
-	23, // 1: posts.Post.last_commented_at:type_name -> google.protobuf.Timestamp
-	23, // 2: posts.Post.created_at:type_name -> google.protobuf.Timestamp
-	23, // 3: posts.Post.updated_at:type_name -> google.protobuf.Timestamp
-	24, // 4: posts.Post.selected_audience_users:type_name -> common.ListUsers
-	6,  // 5: posts.ListPosts.posts:type_name -> posts.Post
-	25, // 6: posts.CreatePostReq.audience_ids:type_name -> common.UserIds
-	25, // 7: posts.EditPostReq.audience_ids:type_name -> common.UserIds
-	22, // 8: posts.Comment.user:type_name -> common.User
-	23, // 9: posts.Comment.created_at:type_name -> google.protobuf.Timestamp
-	23, // 10: posts.Comment.updated_at:type_name -> google.protobuf.Timestamp
-	13, // 11: posts.ListComments.comments:type_name -> posts.Comment
-	22, // 12: posts.Event.user:type_name -> common.User
-	23, // 13: posts.Event.event_date:type_name -> google.protobuf.Timestamp
-	23, // 14: posts.Event.created_at:type_name -> google.protobuf.Timestamp
-	23, // 15: posts.Event.updated_at:type_name -> google.protobuf.Timestamp
-	26, // 16: posts.Event.user_response:type_name -> google.protobuf.BoolValue
-	17, // 17: posts.ListEvents.events:type_name -> posts.Event
-	23, // 18: posts.CreateEventReq.event_date:type_name -> google.protobuf.Timestamp
-	23, // 19: posts.EditEventReq.event_date:type_name -> google.protobuf.Timestamp
-	3,  // 20: posts.PostsService.GetPostById:input_type -> posts.GenericReq
-	8,  // 21: posts.PostsService.CreatePost:input_type -> posts.CreatePostReq
-	3,  // 22: posts.PostsService.DeletePost:input_type -> posts.GenericReq
-	9,  // 23: posts.PostsService.EditPost:input_type -> posts.EditPostReq
-	0,  // 24: posts.PostsService.GetMostPopularPostInGroup:input_type -> posts.SimpleIdReq
-	11, // 25: posts.PostsService.GetPersonalizedFeed:input_type -> posts.GetPersonalizedFeedReq
-	5,  // 26: posts.PostsService.GetPublicFeed:input_type -> posts.GenericPaginatedReq
-	10, // 27: posts.PostsService.GetUserPostsPaginated:input_type -> posts.GetUserPostsReq
-	12, // 28: posts.PostsService.GetGroupPostsPaginated:input_type -> posts.GetGroupPostsReq
-	15, // 29: posts.PostsService.CreateComment:input_type -> posts.CreateCommentReq
-	16, // 30: posts.PostsService.EditComment:input_type -> posts.EditCommentReq
-	3,  // 31: posts.PostsService.DeleteComment:input_type -> posts.GenericReq
-	4,  // 32: posts.PostsService.GetCommentsByParentId:input_type -> posts.EntityIdPaginatedReq
-	0,  // 33: posts.PostsService.GetPostAudienceForComment:input_type -> posts.SimpleIdReq
-	19, // 34: posts.PostsService.CreateEvent:input_type -> posts.CreateEventReq
-	3,  // 35: posts.PostsService.DeleteEvent:input_type -> posts.GenericReq
-	20, // 36: posts.PostsService.EditEvent:input_type -> posts.EditEventReq
-	4,  // 37: posts.PostsService.GetEventsByGroupId:input_type -> posts.EntityIdPaginatedReq
-	21, // 38: posts.PostsService.RespondToEvent:input_type -> posts.RespondToEventReq
-	3,  // 39: posts.PostsService.RemoveEventResponse:input_type -> posts.GenericReq
-	0,  // 40: posts.PostsService.SuggestUsersByPostActivity:input_type -> posts.SimpleIdReq
-	3,  // 41: posts.PostsService.ToggleOrInsertReaction:input_type -> posts.GenericReq
-	3,  // 42: posts.PostsService.GetWhoLikedEntityId:input_type -> posts.GenericReq
-	6,  // 43: posts.PostsService.GetPostById:output_type -> posts.Post
-	1,  // 44: posts.PostsService.CreatePost:output_type -> posts.IdResp
-	27, // 45: posts.PostsService.DeletePost:output_type -> google.protobuf.Empty
-	27, // 46: posts.PostsService.EditPost:output_type -> google.protobuf.Empty
-	6,  // 47: posts.PostsService.GetMostPopularPostInGroup:output_type -> posts.Post
-	7,  // 48: posts.PostsService.GetPersonalizedFeed:output_type -> posts.ListPosts
-	7,  // 49: posts.PostsService.GetPublicFeed:output_type -> posts.ListPosts
-	7,  // 50: posts.PostsService.GetUserPostsPaginated:output_type -> posts.ListPosts
-	7,  // 51: posts.PostsService.GetGroupPostsPaginated:output_type -> posts.ListPosts
-	1,  // 52: posts.PostsService.CreateComment:output_type -> posts.IdResp
-	27, // 53: posts.PostsService.EditComment:output_type -> google.protobuf.Empty
-	27, // 54: posts.PostsService.DeleteComment:output_type -> google.protobuf.Empty
-	14, // 55: posts.PostsService.GetCommentsByParentId:output_type -> posts.ListComments
-	2,  // 56: posts.PostsService.GetPostAudienceForComment:output_type -> posts.AudienceResp
-	1,  // 57: posts.PostsService.CreateEvent:output_type -> posts.IdResp
-	27, // 58: posts.PostsService.DeleteEvent:output_type -> google.protobuf.Empty
-	27, // 59: posts.PostsService.EditEvent:output_type -> google.protobuf.Empty
-	18, // 60: posts.PostsService.GetEventsByGroupId:output_type -> posts.ListEvents
-	27, // 61: posts.PostsService.RespondToEvent:output_type -> google.protobuf.Empty
-	27, // 62: posts.PostsService.RemoveEventResponse:output_type -> google.protobuf.Empty
-	24, // 63: posts.PostsService.SuggestUsersByPostActivity:output_type -> common.ListUsers
-	27, // 64: posts.PostsService.ToggleOrInsertReaction:output_type -> google.protobuf.Empty
-	24, // 65: posts.PostsService.GetWhoLikedEntityId:output_type -> common.ListUsers
-	43, // [43:66] is the sub-list for method output_type
-	20, // [20:43] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	52, // 0: posts.GroupsActivityReq.since:type_name -> google.protobuf.Timestamp
+	49, // 1: posts.GroupsActivityResp.post_counts:type_name -> posts.GroupsActivityResp.PostCountsEntry
+	52, // 2: posts.GroupInsightsReq.since:type_name -> google.protobuf.Timestamp
+	52, // 3: posts.DailyCount.day:type_name -> google.protobuf.Timestamp
+	52, // 4: posts.EventAttendance.event_date:type_name -> google.protobuf.Timestamp
+	10, // 5: posts.GroupContentInsights.posts_per_day:type_name -> posts.DailyCount
+	10, // 6: posts.GroupContentInsights.comments_per_day:type_name -> posts.DailyCount
+	11, // 7: posts.GroupContentInsights.active_posters:type_name -> posts.PosterActivity
+	12, // 8: posts.GroupContentInsights.events:type_name -> posts.EventAttendance
+	53, // 9: posts.Post.user:type_name -> common.User
+	52, // 10: posts.Post.last_commented_at:type_name -> google.protobuf.Timestamp
+	52, // 11: posts.Post.created_at:type_name -> google.protobuf.Timestamp
+	52, // 12: posts.Post.updated_at:type_name -> google.protobuf.Timestamp
+	54, // 13: posts.Post.selected_audience_users:type_name -> common.ListUsers
+	52, // 14: posts.Post.publish_at:type_name -> google.protobuf.Timestamp
+	50, // 15: posts.Post.reaction_counts:type_name -> posts.Post.ReactionCountsEntry
+	15, // 16: posts.Post.mentions:type_name -> posts.Mention
+	14, // 17: posts.Post.shared_post:type_name -> posts.Post
+	14, // 18: posts.ListPosts.posts:type_name -> posts.Post
+	55, // 19: posts.CreatePostReq.audience_ids:type_name -> common.UserIds
+	52, // 20: posts.CreatePostReq.publish_at:type_name -> google.protobuf.Timestamp
+	55, // 21: posts.EditPostReq.audience_ids:type_name -> common.UserIds
+	55, // 22: posts.SharePostReq.audience_ids:type_name -> common.UserIds
+	52, // 23: posts.ReschedulePostReq.publish_at:type_name -> google.protobuf.Timestamp
+	28, // 24: posts.ListTrendingHashtags.hashtags:type_name -> posts.TrendingHashtag
+	53, // 25: posts.SearchResult.user:type_name -> common.User
+	52, // 26: posts.SearchResult.created_at:type_name -> google.protobuf.Timestamp
+	31, // 27: posts.ListSearchResults.results:type_name -> posts.SearchResult
+	53, // 28: posts.Comment.user:type_name -> common.User
+	52, // 29: posts.Comment.created_at:type_name -> google.protobuf.Timestamp
+	52, // 30: posts.Comment.updated_at:type_name -> google.protobuf.Timestamp
+	51, // 31: posts.Comment.reaction_counts:type_name -> posts.Comment.ReactionCountsEntry
+	15, // 32: posts.Comment.mentions:type_name -> posts.Mention
+	33, // 33: posts.ListComments.comments:type_name -> posts.Comment
+	52, // 34: posts.Revision.created_at:type_name -> google.protobuf.Timestamp
+	37, // 35: posts.ListRevisions.revisions:type_name -> posts.Revision
+	52, // 36: posts.Draft.created_at:type_name -> google.protobuf.Timestamp
+	52, // 37: posts.Draft.updated_at:type_name -> google.protobuf.Timestamp
+	52, // 38: posts.Draft.expires_at:type_name -> google.protobuf.Timestamp
+	39, // 39: posts.ListDrafts.drafts:type_name -> posts.Draft
+	52, // 40: posts.PublishDraftReq.publish_at:type_name -> google.protobuf.Timestamp
+	53, // 41: posts.Event.user:type_name -> common.User
+	52, // 42: posts.Event.event_date:type_name -> google.protobuf.Timestamp
+	52, // 43: posts.Event.created_at:type_name -> google.protobuf.Timestamp
+	52, // 44: posts.Event.updated_at:type_name -> google.protobuf.Timestamp
+	56, // 45: posts.Event.user_response:type_name -> google.protobuf.BoolValue
+	44, // 46: posts.ListEvents.events:type_name -> posts.Event
+	52, // 47: posts.CreateEventReq.event_date:type_name -> google.protobuf.Timestamp
+	52, // 48: posts.EditEventReq.event_date:type_name -> google.protobuf.Timestamp
+	3,  // 49: posts.PostsService.GetPostById:input_type -> posts.GenericReq
+	17, // 50: posts.PostsService.CreatePost:input_type -> posts.CreatePostReq
+	3,  // 51: posts.PostsService.DeletePost:input_type -> posts.GenericReq
+	18, // 52: posts.PostsService.EditPost:input_type -> posts.EditPostReq
+	19, // 53: posts.PostsService.SharePost:input_type -> posts.SharePostReq
+	3,  // 54: posts.PostsService.UndoRepost:input_type -> posts.GenericReq
+	0,  // 55: posts.PostsService.GetMostPopularPostInGroup:input_type -> posts.SimpleIdReq
+	21, // 56: posts.PostsService.GetPersonalizedFeed:input_type -> posts.GetPersonalizedFeedReq
+	6,  // 57: posts.PostsService.GetPublicFeed:input_type -> posts.GenericPaginatedReq
+	26, // 58: posts.PostsService.GetHashtagFeed:input_type -> posts.GetHashtagFeedReq
+	27, // 59: posts.PostsService.GetTrendingHashtags:input_type -> posts.GetTrendingHashtagsReq
+	30, // 60: posts.PostsService.SearchContent:input_type -> posts.SearchContentReq
+	20, // 61: posts.PostsService.GetUserPostsPaginated:input_type -> posts.GetUserPostsReq
+	25, // 62: posts.PostsService.GetGroupPostsPaginated:input_type -> posts.GetGroupPostsReq
+	22, // 63: posts.PostsService.SetPostPinned:input_type -> posts.SetPostFlagReq
+	22, // 64: posts.PostsService.SetPostAnnouncement:input_type -> posts.SetPostFlagReq
+	25, // 65: posts.PostsService.GetPendingGroupPosts:input_type -> posts.GetGroupPostsReq
+	23, // 66: posts.PostsService.ApprovePost:input_type -> posts.ReviewPostReq
+	23, // 67: posts.PostsService.RejectPost:input_type -> posts.ReviewPostReq
+	6,  // 68: posts.PostsService.GetScheduledPosts:input_type -> posts.GenericPaginatedReq
+	24, // 69: posts.PostsService.ReschedulePost:input_type -> posts.ReschedulePostReq
+	3,  // 70: posts.PostsService.CancelScheduledPost:input_type -> posts.GenericReq
+	35, // 71: posts.PostsService.CreateComment:input_type -> posts.CreateCommentReq
+	36, // 72: posts.PostsService.EditComment:input_type -> posts.EditCommentReq
+	3,  // 73: posts.PostsService.DeleteComment:input_type -> posts.GenericReq
+	5,  // 74: posts.PostsService.GetCommentsByParentId:input_type -> posts.EntityIdPaginatedReq
+	0,  // 75: posts.PostsService.GetPostAudienceForComment:input_type -> posts.SimpleIdReq
+	5,  // 76: posts.PostsService.GetRevisions:input_type -> posts.EntityIdPaginatedReq
+	41, // 77: posts.PostsService.CreateDraft:input_type -> posts.CreateDraftReq
+	42, // 78: posts.PostsService.UpdateDraft:input_type -> posts.UpdateDraftReq
+	6,  // 79: posts.PostsService.GetDrafts:input_type -> posts.GenericPaginatedReq
+	3,  // 80: posts.PostsService.DeleteDraft:input_type -> posts.GenericReq
+	43, // 81: posts.PostsService.PublishDraft:input_type -> posts.PublishDraftReq
+	46, // 82: posts.PostsService.CreateEvent:input_type -> posts.CreateEventReq
+	3,  // 83: posts.PostsService.DeleteEvent:input_type -> posts.GenericReq
+	47, // 84: posts.PostsService.EditEvent:input_type -> posts.EditEventReq
+	5,  // 85: posts.PostsService.GetEventsByGroupId:input_type -> posts.EntityIdPaginatedReq
+	48, // 86: posts.PostsService.RespondToEvent:input_type -> posts.RespondToEventReq
+	3,  // 87: posts.PostsService.RemoveEventResponse:input_type -> posts.GenericReq
+	0,  // 88: posts.PostsService.SuggestUsersByPostActivity:input_type -> posts.SimpleIdReq
+	4,  // 89: posts.PostsService.ToggleOrInsertReaction:input_type -> posts.ReactionReq
+	4,  // 90: posts.PostsService.GetWhoLikedEntityId:input_type -> posts.ReactionReq
+	7,  // 91: posts.PostsService.GetGroupsPostActivity:input_type -> posts.GroupsActivityReq
+	9,  // 92: posts.PostsService.GetGroupContentInsights:input_type -> posts.GroupInsightsReq
+	14, // 93: posts.PostsService.GetPostById:output_type -> posts.Post
+	1,  // 94: posts.PostsService.CreatePost:output_type -> posts.IdResp
+	57, // 95: posts.PostsService.DeletePost:output_type -> google.protobuf.Empty
+	57, // 96: posts.PostsService.EditPost:output_type -> google.protobuf.Empty
+	1,  // 97: posts.PostsService.SharePost:output_type -> posts.IdResp
+	57, // 98: posts.PostsService.UndoRepost:output_type -> google.protobuf.Empty
+	14, // 99: posts.PostsService.GetMostPopularPostInGroup:output_type -> posts.Post
+	16, // 100: posts.PostsService.GetPersonalizedFeed:output_type -> posts.ListPosts
+	16, // 101: posts.PostsService.GetPublicFeed:output_type -> posts.ListPosts
+	16, // 102: posts.PostsService.GetHashtagFeed:output_type -> posts.ListPosts
+	29, // 103: posts.PostsService.GetTrendingHashtags:output_type -> posts.ListTrendingHashtags
+	32, // 104: posts.PostsService.SearchContent:output_type -> posts.ListSearchResults
+	16, // 105: posts.PostsService.GetUserPostsPaginated:output_type -> posts.ListPosts
+	16, // 106: posts.PostsService.GetGroupPostsPaginated:output_type -> posts.ListPosts
+	57, // 107: posts.PostsService.SetPostPinned:output_type -> google.protobuf.Empty
+	57, // 108: posts.PostsService.SetPostAnnouncement:output_type -> google.protobuf.Empty
+	16, // 109: posts.PostsService.GetPendingGroupPosts:output_type -> posts.ListPosts
+	57, // 110: posts.PostsService.ApprovePost:output_type -> google.protobuf.Empty
+	57, // 111: posts.PostsService.RejectPost:output_type -> google.protobuf.Empty
+	16, // 112: posts.PostsService.GetScheduledPosts:output_type -> posts.ListPosts
+	57, // 113: posts.PostsService.ReschedulePost:output_type -> google.protobuf.Empty
+	57, // 114: posts.PostsService.CancelScheduledPost:output_type -> google.protobuf.Empty
+	1,  // 115: posts.PostsService.CreateComment:output_type -> posts.IdResp
+	57, // 116: posts.PostsService.EditComment:output_type -> google.protobuf.Empty
+	57, // 117: posts.PostsService.DeleteComment:output_type -> google.protobuf.Empty
+	34, // 118: posts.PostsService.GetCommentsByParentId:output_type -> posts.ListComments
+	2,  // 119: posts.PostsService.GetPostAudienceForComment:output_type -> posts.AudienceResp
+	38, // 120: posts.PostsService.GetRevisions:output_type -> posts.ListRevisions
+	1,  // 121: posts.PostsService.CreateDraft:output_type -> posts.IdResp
+	57, // 122: posts.PostsService.UpdateDraft:output_type -> google.protobuf.Empty
+	40, // 123: posts.PostsService.GetDrafts:output_type -> posts.ListDrafts
+	57, // 124: posts.PostsService.DeleteDraft:output_type -> google.protobuf.Empty
+	1,  // 125: posts.PostsService.PublishDraft:output_type -> posts.IdResp
+	1,  // 126: posts.PostsService.CreateEvent:output_type -> posts.IdResp
+	57, // 127: posts.PostsService.DeleteEvent:output_type -> google.protobuf.Empty
+	57, // 128: posts.PostsService.EditEvent:output_type -> google.protobuf.Empty
+	45, // 129: posts.PostsService.GetEventsByGroupId:output_type -> posts.ListEvents
+	57, // 130: posts.PostsService.RespondToEvent:output_type -> google.protobuf.Empty
+	57, // 131: posts.PostsService.RemoveEventResponse:output_type -> google.protobuf.Empty
+	54, // 132: posts.PostsService.SuggestUsersByPostActivity:output_type -> common.ListUsers
+	57, // 133: posts.PostsService.ToggleOrInsertReaction:output_type -> google.protobuf.Empty
+	54, // 134: posts.PostsService.GetWhoLikedEntityId:output_type -> common.ListUsers
+	8,  // 135: posts.PostsService.GetGroupsPostActivity:output_type -> posts.GroupsActivityResp
+	13, // 136: posts.PostsService.GetGroupContentInsights:output_type -> posts.GroupContentInsights
+	93, // [93:137] is the sub-list for method output_type
+	49, // [49:93] is the sub-list for method input_type
+	49, // [49:49] is the sub-list for extension type_name
+	49, // [49:49] is the sub-list for extension extendee
+	0,  // [0:49] is the sub-list for field type_name
 }
 
 func init() { file_posts_proto_init() }
@@ -1962,7 +4270,7 @@ func file_posts_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_posts_proto_rawDesc), len(file_posts_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
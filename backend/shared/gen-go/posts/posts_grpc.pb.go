@@ -25,16 +25,35 @@ const (
 	PostsService_CreatePost_FullMethodName                 = "/posts.PostsService/CreatePost"
 	PostsService_DeletePost_FullMethodName                 = "/posts.PostsService/DeletePost"
 	PostsService_EditPost_FullMethodName                   = "/posts.PostsService/EditPost"
+	PostsService_SharePost_FullMethodName                  = "/posts.PostsService/SharePost"
+	PostsService_UndoRepost_FullMethodName                 = "/posts.PostsService/UndoRepost"
 	PostsService_GetMostPopularPostInGroup_FullMethodName  = "/posts.PostsService/GetMostPopularPostInGroup"
 	PostsService_GetPersonalizedFeed_FullMethodName        = "/posts.PostsService/GetPersonalizedFeed"
 	PostsService_GetPublicFeed_FullMethodName              = "/posts.PostsService/GetPublicFeed"
+	PostsService_GetHashtagFeed_FullMethodName             = "/posts.PostsService/GetHashtagFeed"
+	PostsService_GetTrendingHashtags_FullMethodName        = "/posts.PostsService/GetTrendingHashtags"
+	PostsService_SearchContent_FullMethodName              = "/posts.PostsService/SearchContent"
 	PostsService_GetUserPostsPaginated_FullMethodName      = "/posts.PostsService/GetUserPostsPaginated"
 	PostsService_GetGroupPostsPaginated_FullMethodName     = "/posts.PostsService/GetGroupPostsPaginated"
+	PostsService_SetPostPinned_FullMethodName              = "/posts.PostsService/SetPostPinned"
+	PostsService_SetPostAnnouncement_FullMethodName        = "/posts.PostsService/SetPostAnnouncement"
+	PostsService_GetPendingGroupPosts_FullMethodName       = "/posts.PostsService/GetPendingGroupPosts"
+	PostsService_ApprovePost_FullMethodName                = "/posts.PostsService/ApprovePost"
+	PostsService_RejectPost_FullMethodName                 = "/posts.PostsService/RejectPost"
+	PostsService_GetScheduledPosts_FullMethodName          = "/posts.PostsService/GetScheduledPosts"
+	PostsService_ReschedulePost_FullMethodName             = "/posts.PostsService/ReschedulePost"
+	PostsService_CancelScheduledPost_FullMethodName        = "/posts.PostsService/CancelScheduledPost"
 	PostsService_CreateComment_FullMethodName              = "/posts.PostsService/CreateComment"
 	PostsService_EditComment_FullMethodName                = "/posts.PostsService/EditComment"
 	PostsService_DeleteComment_FullMethodName              = "/posts.PostsService/DeleteComment"
 	PostsService_GetCommentsByParentId_FullMethodName      = "/posts.PostsService/GetCommentsByParentId"
 	PostsService_GetPostAudienceForComment_FullMethodName  = "/posts.PostsService/GetPostAudienceForComment"
+	PostsService_GetRevisions_FullMethodName               = "/posts.PostsService/GetRevisions"
+	PostsService_CreateDraft_FullMethodName                = "/posts.PostsService/CreateDraft"
+	PostsService_UpdateDraft_FullMethodName                = "/posts.PostsService/UpdateDraft"
+	PostsService_GetDrafts_FullMethodName                  = "/posts.PostsService/GetDrafts"
+	PostsService_DeleteDraft_FullMethodName                = "/posts.PostsService/DeleteDraft"
+	PostsService_PublishDraft_FullMethodName               = "/posts.PostsService/PublishDraft"
 	PostsService_CreateEvent_FullMethodName                = "/posts.PostsService/CreateEvent"
 	PostsService_DeleteEvent_FullMethodName                = "/posts.PostsService/DeleteEvent"
 	PostsService_EditEvent_FullMethodName                  = "/posts.PostsService/EditEvent"
@@ -44,6 +63,8 @@ const (
 	PostsService_SuggestUsersByPostActivity_FullMethodName = "/posts.PostsService/SuggestUsersByPostActivity"
 	PostsService_ToggleOrInsertReaction_FullMethodName     = "/posts.PostsService/ToggleOrInsertReaction"
 	PostsService_GetWhoLikedEntityId_FullMethodName        = "/posts.PostsService/GetWhoLikedEntityId"
+	PostsService_GetGroupsPostActivity_FullMethodName      = "/posts.PostsService/GetGroupsPostActivity"
+	PostsService_GetGroupContentInsights_FullMethodName    = "/posts.PostsService/GetGroupContentInsights"
 )
 
 // PostsServiceClient is the client API for PostsService service.
@@ -61,13 +82,26 @@ type PostsServiceClient interface {
 	// For a group post, returns permission denied if creator is not a member of the group.
 	// Post audience can be set to everyone, followers, selected and group.
 	// If audience is selected, user ids are expected for the post's selected audience.
+	// If publish_at is set, the post is scheduled and stays hidden from every feed until then.
 	CreatePost(ctx context.Context, in *CreatePostReq, opts ...grpc.CallOption) (*IdResp, error)
 	// Deletes a post authored by requester.
+	// Group posts can also be deleted by group staff whose role allows deleting posts.
 	DeletePost(ctx context.Context, in *GenericReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Updates a post authored by requester.
-	// Body, image, audience and selected audience ids (if applicable) can be updated.
+	// Body, images, audience and selected audience ids (if applicable) can be updated.
+	// A non empty image_ids replaces the whole gallery and sets its order.
 	// All fields must be included in the request even if they remain unchanged, otherwise they'll be deleted.
 	EditPost(ctx context.Context, in *EditPostReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Reposts a post, or quotes it if body is set, and returns the id of the new post.
+	// Sharing a repost shares the post it reposts. Group audience isn't allowed.
+	// Returns permission denied if requester can't see the post, or if the audience
+	// would show it to users who can't see it (non public posts can only be shared
+	// with selected users who can, or to followers for the author's own followers posts).
+	// Returns already exists if requester already reposted the post.
+	SharePost(ctx context.Context, in *SharePostReq, opts ...grpc.CallOption) (*IdResp, error)
+	// Deletes requester's repost of the given post (the original, not the repost).
+	// Returns not found if requester hasn't reposted it.
+	UndoRepost(ctx context.Context, in *GenericReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Returns the most popular post in the given group.
 	// Popularity is measured as reactions count + comments count.
 	// Includes comment and reaction count, but not whether requester has reacted.
@@ -84,17 +118,66 @@ type PostsServiceClient interface {
 	// Every post includes comment count, reaction count and whether requester has reacted.
 	// A call to users and media service is made for user information and images.
 	GetPublicFeed(ctx context.Context, in *GenericPaginatedReq, opts ...grpc.CallOption) (*ListPosts, error)
+	// Returns the posts with the given hashtag that the requester can see, newest first, paginated.
+	// Group posts are only included from public groups. Tags are matched lowercase and without the #.
+	// A call to users and media service is made for user information and images.
+	GetHashtagFeed(ctx context.Context, in *GetHashtagFeedReq, opts ...grpc.CallOption) (*ListPosts, error)
+	// Returns the most used hashtags in posts created during the last window_hours hours.
+	// Only public content counts: posts for everyone and posts of public groups.
+	// Tags are ranked by how many distinct users posted with them, then by number of posts.
+	GetTrendingHashtags(ctx context.Context, in *GetTrendingHashtagsReq, opts ...grpc.CallOption) (*ListTrendingHashtags, error)
+	// Full-text search over the posts, comments and events the requester can see, best match first, paginated.
+	// search_term uses web search syntax: quoted phrases, "or" and -excluded words.
+	// Comments follow the visibility of their post; group content needs membership unless the group is public.
+	// A call to users service is made for user information.
+	SearchContent(ctx context.Context, in *SearchContentReq, opts ...grpc.CallOption) (*ListSearchResults, error)
 	// Returns all of a user's posts visible to the requester, paginated.
 	// Every post includes comment count, reaction count and whether requester has reacted.
 	// A call to users and media service is made for user information and images.
 	GetUserPostsPaginated(ctx context.Context, in *GetUserPostsReq, opts ...grpc.CallOption) (*ListPosts, error)
-	// Returns all posts within a group in descending order by date created, paginated.
+	// Returns all posts within a group, pinned posts first (most recently pinned first),
+	// then the rest in descending order by date created, paginated.
+	// Posts awaiting approval are only included for their author.
 	// Returns permission denied if requester is not a member of the group.
 	// Every post includes comment count, reaction count and whether requester has reacted.
 	// A call to users and media service is made for user information and images.
 	GetGroupPostsPaginated(ctx context.Context, in *GetGroupPostsReq, opts ...grpc.CallOption) (*ListPosts, error)
-	// Creates a comment on a parent entity (post).
+	// Pins a group post to the top of the group feed, or unpins it.
+	// Returns permission denied if requester's group role can't pin posts,
+	// failed precondition if the group already has the maximum number of pinned posts
+	// and invalid argument if the post doesn't belong to a group.
+	SetPostPinned(ctx context.Context, in *SetPostFlagReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Marks a group post as an announcement, or unmarks it.
+	// All group members except the author are notified when a post becomes an announcement.
+	// Returns permission denied if requester's group role can't make announcements
+	// and invalid argument if the post doesn't belong to a group.
+	SetPostAnnouncement(ctx context.Context, in *SetPostFlagReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Returns the posts of a group awaiting approval, oldest first, paginated.
+	// Returns permission denied if requester's group role can't approve posts.
+	// A call to users and media service is made for user information and images.
+	GetPendingGroupPosts(ctx context.Context, in *GetGroupPostsReq, opts ...grpc.CallOption) (*ListPosts, error)
+	// Approves a pending group post, making it visible to the group. The author is notified.
+	// Returns permission denied if requester's group role can't approve posts
+	// and failed precondition if the post is not pending.
+	ApprovePost(ctx context.Context, in *ReviewPostReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Rejects a pending group post with an optional reason. The author is notified.
+	// The post stays visible to its author only.
+	// Returns permission denied if requester's group role can't approve posts
+	// and failed precondition if the post is not pending.
+	RejectPost(ctx context.Context, in *ReviewPostReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Returns the requester's posts waiting to be published, next to go out first, paginated.
+	// Their content is edited with EditPost.
+	// A call to users and media service is made for user information and images.
+	GetScheduledPosts(ctx context.Context, in *GenericPaginatedReq, opts ...grpc.CallOption) (*ListPosts, error)
+	// Moves the publish time of one of the requester's scheduled posts.
+	// Returns not found if the post doesn't exist, isn't owned by the requester or was already published.
+	ReschedulePost(ctx context.Context, in *ReschedulePostReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Deletes one of the requester's scheduled posts before it's published.
+	// Returns not found if the post doesn't exist, isn't owned by the requester or was already published.
+	CancelScheduledPost(ctx context.Context, in *GenericReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Creates a comment on a parent entity (post), or a reply to one of its comments if reply_to_id is set.
 	// Returns permission denied if requester is not allowed to view parent entity.
+	// Returns invalid argument if the replied comment is deleted, on another post or already at max depth.
 	CreateComment(ctx context.Context, in *CreateCommentReq, opts ...grpc.CallOption) (*IdResp, error)
 	// Updates an existing comment by the creator.
 	// Returns permission denied if requester is not allowed to view parent entity.
@@ -104,18 +187,42 @@ type PostsServiceClient interface {
 	// Returns permission denied if requester is not allowed to view parent entity.
 	DeleteComment(ctx context.Context, in *GenericReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Returns paginated comments for a parent entity, ordered by descending date created.
+	// For a comment, returns its direct replies instead, ordered by ascending date created.
+	// Deleted comments that still have replies are returned with no body or user.
 	// Every comment includes reactions count and whether requester has reacted.
 	// A call to users and media service is made for user information and images.
 	GetCommentsByParentId(ctx context.Context, in *EntityIdPaginatedReq, opts ...grpc.CallOption) (*ListComments, error)
 	// Returns the parent post's audience
 	GetPostAudienceForComment(ctx context.Context, in *SimpleIdReq, opts ...grpc.CallOption) (*AudienceResp, error)
+	// Returns the previous versions of a post or comment body, most recent first, paginated.
+	// Every edit that changes the body keeps the version it replaces; the current body isn't included.
+	// Returns permission denied if requester has no right to view the post (or the comment's parent post).
+	GetRevisions(ctx context.Context, in *EntityIdPaginatedReq, opts ...grpc.CallOption) (*ListRevisions, error)
+	// Saves a new post or comment draft for the requester.
+	// Only the kind, and the parent post for comment drafts, are required; the rest can be filled in later.
+	// Returns failed precondition if the requester already has the maximum number of drafts.
+	CreateDraft(ctx context.Context, in *CreateDraftReq, opts ...grpc.CallOption) (*IdResp, error)
+	// Replaces the content of one of the requester's drafts and pushes back its expiry.
+	// All fields must be included in the request even if they remain unchanged, otherwise they'll be deleted.
+	// Returns not found if the draft doesn't exist, isn't owned by the requester or has expired.
+	UpdateDraft(ctx context.Context, in *UpdateDraftReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Returns the requester's drafts, most recently saved first, paginated.
+	// A call to media service is made for images.
+	GetDrafts(ctx context.Context, in *GenericPaginatedReq, opts ...grpc.CallOption) (*ListDrafts, error)
+	// Deletes one of the requester's drafts.
+	// Returns not found if the draft doesn't exist or isn't owned by the requester.
+	DeleteDraft(ctx context.Context, in *GenericReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Publishes one of the requester's drafts as a post or comment, then deletes the draft.
+	// Runs the same checks as CreatePost or CreateComment and returns their errors, keeping the draft.
+	// Post drafts can be scheduled with publish_at. Returns the id of the new post or comment.
+	PublishDraft(ctx context.Context, in *PublishDraftReq, opts ...grpc.CallOption) (*IdResp, error)
 	// Creates a group event.
 	// Returns permission denied if requester is not a member of the group.
 	CreateEvent(ctx context.Context, in *CreateEventReq, opts ...grpc.CallOption) (*IdResp, error)
-	// Deletes an event by creator.
+	// Deletes an event by creator, or by group staff whose role allows managing events.
 	// Returns permission denied if requester is not a member of the group.
 	DeleteEvent(ctx context.Context, in *GenericReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// Updates an existing event by creator.
+	// Updates an existing event by creator, or by group staff whose role allows managing events.
 	// Returns permission denied if requester is not a member of the group.
 	// All fields must be included in the request even if they remain unchanged, otherwise they'll be deleted.
 	EditEvent(ctx context.Context, in *EditEventReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -144,11 +251,18 @@ type PostsServiceClient interface {
 	// A call to users and media service is made for user information and images.
 	SuggestUsersByPostActivity(ctx context.Context, in *SimpleIdReq, opts ...grpc.CallOption) (*common.ListUsers, error)
 	// Toggles or inserts a reaction for the requester on a post/comment.
+	// Reacting again with the same type removes the reaction, a different type replaces it.
 	// Returns permission denied if requester is not allowed to view parent entity.
-	ToggleOrInsertReaction(ctx context.Context, in *GenericReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// Returns a list of users who liked given entity id.
+	ToggleOrInsertReaction(ctx context.Context, in *ReactionReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Returns a list of users who reacted to given entity id, only with the given type unless it's empty.
 	// A call to users and media service is made for user information and images.
-	GetWhoLikedEntityId(ctx context.Context, in *GenericReq, opts ...grpc.CallOption) (*common.ListUsers, error)
+	GetWhoLikedEntityId(ctx context.Context, in *ReactionReq, opts ...grpc.CallOption) (*common.ListUsers, error)
+	// Counts the posts created in each of the given groups since a point in time.
+	// Used by users service to rank groups in discovery. Groups without posts are omitted.
+	GetGroupsPostActivity(ctx context.Context, in *GroupsActivityReq, opts ...grpc.CallOption) (*GroupsActivityResp, error)
+	// Aggregates a group's posts, comments and events since a point in time.
+	// Used by users service to build group insights, days without activity are omitted.
+	GetGroupContentInsights(ctx context.Context, in *GroupInsightsReq, opts ...grpc.CallOption) (*GroupContentInsights, error)
 }
 
 type postsServiceClient struct {
@@ -199,6 +313,26 @@ func (c *postsServiceClient) EditPost(ctx context.Context, in *EditPostReq, opts
 	return out, nil
 }
 
+func (c *postsServiceClient) SharePost(ctx context.Context, in *SharePostReq, opts ...grpc.CallOption) (*IdResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IdResp)
+	err := c.cc.Invoke(ctx, PostsService_SharePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsServiceClient) UndoRepost(ctx context.Context, in *GenericReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostsService_UndoRepost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postsServiceClient) GetMostPopularPostInGroup(ctx context.Context, in *SimpleIdReq, opts ...grpc.CallOption) (*Post, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Post)
@@ -229,6 +363,36 @@ func (c *postsServiceClient) GetPublicFeed(ctx context.Context, in *GenericPagin
 	return out, nil
 }
 
+func (c *postsServiceClient) GetHashtagFeed(ctx context.Context, in *GetHashtagFeedReq, opts ...grpc.CallOption) (*ListPosts, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPosts)
+	err := c.cc.Invoke(ctx, PostsService_GetHashtagFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsServiceClient) GetTrendingHashtags(ctx context.Context, in *GetTrendingHashtagsReq, opts ...grpc.CallOption) (*ListTrendingHashtags, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrendingHashtags)
+	err := c.cc.Invoke(ctx, PostsService_GetTrendingHashtags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsServiceClient) SearchContent(ctx context.Context, in *SearchContentReq, opts ...grpc.CallOption) (*ListSearchResults, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSearchResults)
+	err := c.cc.Invoke(ctx, PostsService_SearchContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postsServiceClient) GetUserPostsPaginated(ctx context.Context, in *GetUserPostsReq, opts ...grpc.CallOption) (*ListPosts, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListPosts)
@@ -249,6 +413,86 @@ func (c *postsServiceClient) GetGroupPostsPaginated(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *postsServiceClient) SetPostPinned(ctx context.Context, in *SetPostFlagReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostsService_SetPostPinned_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsServiceClient) SetPostAnnouncement(ctx context.Context, in *SetPostFlagReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostsService_SetPostAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsServiceClient) GetPendingGroupPosts(ctx context.Context, in *GetGroupPostsReq, opts ...grpc.CallOption) (*ListPosts, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPosts)
+	err := c.cc.Invoke(ctx, PostsService_GetPendingGroupPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsServiceClient) ApprovePost(ctx context.Context, in *ReviewPostReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostsService_ApprovePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsServiceClient) RejectPost(ctx context.Context, in *ReviewPostReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostsService_RejectPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsServiceClient) GetScheduledPosts(ctx context.Context, in *GenericPaginatedReq, opts ...grpc.CallOption) (*ListPosts, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPosts)
+	err := c.cc.Invoke(ctx, PostsService_GetScheduledPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsServiceClient) ReschedulePost(ctx context.Context, in *ReschedulePostReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostsService_ReschedulePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsServiceClient) CancelScheduledPost(ctx context.Context, in *GenericReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostsService_CancelScheduledPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postsServiceClient) CreateComment(ctx context.Context, in *CreateCommentReq, opts ...grpc.CallOption) (*IdResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(IdResp)
@@ -299,6 +543,66 @@ func (c *postsServiceClient) GetPostAudienceForComment(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *postsServiceClient) GetRevisions(ctx context.Context, in *EntityIdPaginatedReq, opts ...grpc.CallOption) (*ListRevisions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRevisions)
+	err := c.cc.Invoke(ctx, PostsService_GetRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsServiceClient) CreateDraft(ctx context.Context, in *CreateDraftReq, opts ...grpc.CallOption) (*IdResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IdResp)
+	err := c.cc.Invoke(ctx, PostsService_CreateDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsServiceClient) UpdateDraft(ctx context.Context, in *UpdateDraftReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostsService_UpdateDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsServiceClient) GetDrafts(ctx context.Context, in *GenericPaginatedReq, opts ...grpc.CallOption) (*ListDrafts, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDrafts)
+	err := c.cc.Invoke(ctx, PostsService_GetDrafts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsServiceClient) DeleteDraft(ctx context.Context, in *GenericReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostsService_DeleteDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsServiceClient) PublishDraft(ctx context.Context, in *PublishDraftReq, opts ...grpc.CallOption) (*IdResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IdResp)
+	err := c.cc.Invoke(ctx, PostsService_PublishDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postsServiceClient) CreateEvent(ctx context.Context, in *CreateEventReq, opts ...grpc.CallOption) (*IdResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(IdResp)
@@ -369,7 +673,7 @@ func (c *postsServiceClient) SuggestUsersByPostActivity(ctx context.Context, in
 	return out, nil
 }
 
-func (c *postsServiceClient) ToggleOrInsertReaction(ctx context.Context, in *GenericReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *postsServiceClient) ToggleOrInsertReaction(ctx context.Context, in *ReactionReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, PostsService_ToggleOrInsertReaction_FullMethodName, in, out, cOpts...)
@@ -379,7 +683,7 @@ func (c *postsServiceClient) ToggleOrInsertReaction(ctx context.Context, in *Gen
 	return out, nil
 }
 
-func (c *postsServiceClient) GetWhoLikedEntityId(ctx context.Context, in *GenericReq, opts ...grpc.CallOption) (*common.ListUsers, error) {
+func (c *postsServiceClient) GetWhoLikedEntityId(ctx context.Context, in *ReactionReq, opts ...grpc.CallOption) (*common.ListUsers, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(common.ListUsers)
 	err := c.cc.Invoke(ctx, PostsService_GetWhoLikedEntityId_FullMethodName, in, out, cOpts...)
@@ -389,6 +693,26 @@ func (c *postsServiceClient) GetWhoLikedEntityId(ctx context.Context, in *Generi
 	return out, nil
 }
 
+func (c *postsServiceClient) GetGroupsPostActivity(ctx context.Context, in *GroupsActivityReq, opts ...grpc.CallOption) (*GroupsActivityResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupsActivityResp)
+	err := c.cc.Invoke(ctx, PostsService_GetGroupsPostActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsServiceClient) GetGroupContentInsights(ctx context.Context, in *GroupInsightsReq, opts ...grpc.CallOption) (*GroupContentInsights, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupContentInsights)
+	err := c.cc.Invoke(ctx, PostsService_GetGroupContentInsights_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PostsServiceServer is the server API for PostsService service.
 // All implementations must embed UnimplementedPostsServiceServer
 // for forward compatibility.
@@ -404,13 +728,26 @@ type PostsServiceServer interface {
 	// For a group post, returns permission denied if creator is not a member of the group.
 	// Post audience can be set to everyone, followers, selected and group.
 	// If audience is selected, user ids are expected for the post's selected audience.
+	// If publish_at is set, the post is scheduled and stays hidden from every feed until then.
 	CreatePost(context.Context, *CreatePostReq) (*IdResp, error)
 	// Deletes a post authored by requester.
+	// Group posts can also be deleted by group staff whose role allows deleting posts.
 	DeletePost(context.Context, *GenericReq) (*emptypb.Empty, error)
 	// Updates a post authored by requester.
-	// Body, image, audience and selected audience ids (if applicable) can be updated.
+	// Body, images, audience and selected audience ids (if applicable) can be updated.
+	// A non empty image_ids replaces the whole gallery and sets its order.
 	// All fields must be included in the request even if they remain unchanged, otherwise they'll be deleted.
 	EditPost(context.Context, *EditPostReq) (*emptypb.Empty, error)
+	// Reposts a post, or quotes it if body is set, and returns the id of the new post.
+	// Sharing a repost shares the post it reposts. Group audience isn't allowed.
+	// Returns permission denied if requester can't see the post, or if the audience
+	// would show it to users who can't see it (non public posts can only be shared
+	// with selected users who can, or to followers for the author's own followers posts).
+	// Returns already exists if requester already reposted the post.
+	SharePost(context.Context, *SharePostReq) (*IdResp, error)
+	// Deletes requester's repost of the given post (the original, not the repost).
+	// Returns not found if requester hasn't reposted it.
+	UndoRepost(context.Context, *GenericReq) (*emptypb.Empty, error)
 	// Returns the most popular post in the given group.
 	// Popularity is measured as reactions count + comments count.
 	// Includes comment and reaction count, but not whether requester has reacted.
@@ -427,17 +764,66 @@ type PostsServiceServer interface {
 	// Every post includes comment count, reaction count and whether requester has reacted.
 	// A call to users and media service is made for user information and images.
 	GetPublicFeed(context.Context, *GenericPaginatedReq) (*ListPosts, error)
+	// Returns the posts with the given hashtag that the requester can see, newest first, paginated.
+	// Group posts are only included from public groups. Tags are matched lowercase and without the #.
+	// A call to users and media service is made for user information and images.
+	GetHashtagFeed(context.Context, *GetHashtagFeedReq) (*ListPosts, error)
+	// Returns the most used hashtags in posts created during the last window_hours hours.
+	// Only public content counts: posts for everyone and posts of public groups.
+	// Tags are ranked by how many distinct users posted with them, then by number of posts.
+	GetTrendingHashtags(context.Context, *GetTrendingHashtagsReq) (*ListTrendingHashtags, error)
+	// Full-text search over the posts, comments and events the requester can see, best match first, paginated.
+	// search_term uses web search syntax: quoted phrases, "or" and -excluded words.
+	// Comments follow the visibility of their post; group content needs membership unless the group is public.
+	// A call to users service is made for user information.
+	SearchContent(context.Context, *SearchContentReq) (*ListSearchResults, error)
 	// Returns all of a user's posts visible to the requester, paginated.
 	// Every post includes comment count, reaction count and whether requester has reacted.
 	// A call to users and media service is made for user information and images.
 	GetUserPostsPaginated(context.Context, *GetUserPostsReq) (*ListPosts, error)
-	// Returns all posts within a group in descending order by date created, paginated.
+	// Returns all posts within a group, pinned posts first (most recently pinned first),
+	// then the rest in descending order by date created, paginated.
+	// Posts awaiting approval are only included for their author.
 	// Returns permission denied if requester is not a member of the group.
 	// Every post includes comment count, reaction count and whether requester has reacted.
 	// A call to users and media service is made for user information and images.
 	GetGroupPostsPaginated(context.Context, *GetGroupPostsReq) (*ListPosts, error)
-	// Creates a comment on a parent entity (post).
+	// Pins a group post to the top of the group feed, or unpins it.
+	// Returns permission denied if requester's group role can't pin posts,
+	// failed precondition if the group already has the maximum number of pinned posts
+	// and invalid argument if the post doesn't belong to a group.
+	SetPostPinned(context.Context, *SetPostFlagReq) (*emptypb.Empty, error)
+	// Marks a group post as an announcement, or unmarks it.
+	// All group members except the author are notified when a post becomes an announcement.
+	// Returns permission denied if requester's group role can't make announcements
+	// and invalid argument if the post doesn't belong to a group.
+	SetPostAnnouncement(context.Context, *SetPostFlagReq) (*emptypb.Empty, error)
+	// Returns the posts of a group awaiting approval, oldest first, paginated.
+	// Returns permission denied if requester's group role can't approve posts.
+	// A call to users and media service is made for user information and images.
+	GetPendingGroupPosts(context.Context, *GetGroupPostsReq) (*ListPosts, error)
+	// Approves a pending group post, making it visible to the group. The author is notified.
+	// Returns permission denied if requester's group role can't approve posts
+	// and failed precondition if the post is not pending.
+	ApprovePost(context.Context, *ReviewPostReq) (*emptypb.Empty, error)
+	// Rejects a pending group post with an optional reason. The author is notified.
+	// The post stays visible to its author only.
+	// Returns permission denied if requester's group role can't approve posts
+	// and failed precondition if the post is not pending.
+	RejectPost(context.Context, *ReviewPostReq) (*emptypb.Empty, error)
+	// Returns the requester's posts waiting to be published, next to go out first, paginated.
+	// Their content is edited with EditPost.
+	// A call to users and media service is made for user information and images.
+	GetScheduledPosts(context.Context, *GenericPaginatedReq) (*ListPosts, error)
+	// Moves the publish time of one of the requester's scheduled posts.
+	// Returns not found if the post doesn't exist, isn't owned by the requester or was already published.
+	ReschedulePost(context.Context, *ReschedulePostReq) (*emptypb.Empty, error)
+	// Deletes one of the requester's scheduled posts before it's published.
+	// Returns not found if the post doesn't exist, isn't owned by the requester or was already published.
+	CancelScheduledPost(context.Context, *GenericReq) (*emptypb.Empty, error)
+	// Creates a comment on a parent entity (post), or a reply to one of its comments if reply_to_id is set.
 	// Returns permission denied if requester is not allowed to view parent entity.
+	// Returns invalid argument if the replied comment is deleted, on another post or already at max depth.
 	CreateComment(context.Context, *CreateCommentReq) (*IdResp, error)
 	// Updates an existing comment by the creator.
 	// Returns permission denied if requester is not allowed to view parent entity.
@@ -447,18 +833,42 @@ type PostsServiceServer interface {
 	// Returns permission denied if requester is not allowed to view parent entity.
 	DeleteComment(context.Context, *GenericReq) (*emptypb.Empty, error)
 	// Returns paginated comments for a parent entity, ordered by descending date created.
+	// For a comment, returns its direct replies instead, ordered by ascending date created.
+	// Deleted comments that still have replies are returned with no body or user.
 	// Every comment includes reactions count and whether requester has reacted.
 	// A call to users and media service is made for user information and images.
 	GetCommentsByParentId(context.Context, *EntityIdPaginatedReq) (*ListComments, error)
 	// Returns the parent post's audience
 	GetPostAudienceForComment(context.Context, *SimpleIdReq) (*AudienceResp, error)
+	// Returns the previous versions of a post or comment body, most recent first, paginated.
+	// Every edit that changes the body keeps the version it replaces; the current body isn't included.
+	// Returns permission denied if requester has no right to view the post (or the comment's parent post).
+	GetRevisions(context.Context, *EntityIdPaginatedReq) (*ListRevisions, error)
+	// Saves a new post or comment draft for the requester.
+	// Only the kind, and the parent post for comment drafts, are required; the rest can be filled in later.
+	// Returns failed precondition if the requester already has the maximum number of drafts.
+	CreateDraft(context.Context, *CreateDraftReq) (*IdResp, error)
+	// Replaces the content of one of the requester's drafts and pushes back its expiry.
+	// All fields must be included in the request even if they remain unchanged, otherwise they'll be deleted.
+	// Returns not found if the draft doesn't exist, isn't owned by the requester or has expired.
+	UpdateDraft(context.Context, *UpdateDraftReq) (*emptypb.Empty, error)
+	// Returns the requester's drafts, most recently saved first, paginated.
+	// A call to media service is made for images.
+	GetDrafts(context.Context, *GenericPaginatedReq) (*ListDrafts, error)
+	// Deletes one of the requester's drafts.
+	// Returns not found if the draft doesn't exist or isn't owned by the requester.
+	DeleteDraft(context.Context, *GenericReq) (*emptypb.Empty, error)
+	// Publishes one of the requester's drafts as a post or comment, then deletes the draft.
+	// Runs the same checks as CreatePost or CreateComment and returns their errors, keeping the draft.
+	// Post drafts can be scheduled with publish_at. Returns the id of the new post or comment.
+	PublishDraft(context.Context, *PublishDraftReq) (*IdResp, error)
 	// Creates a group event.
 	// Returns permission denied if requester is not a member of the group.
 	CreateEvent(context.Context, *CreateEventReq) (*IdResp, error)
-	// Deletes an event by creator.
+	// Deletes an event by creator, or by group staff whose role allows managing events.
 	// Returns permission denied if requester is not a member of the group.
 	DeleteEvent(context.Context, *GenericReq) (*emptypb.Empty, error)
-	// Updates an existing event by creator.
+	// Updates an existing event by creator, or by group staff whose role allows managing events.
 	// Returns permission denied if requester is not a member of the group.
 	// All fields must be included in the request even if they remain unchanged, otherwise they'll be deleted.
 	EditEvent(context.Context, *EditEventReq) (*emptypb.Empty, error)
@@ -487,11 +897,18 @@ type PostsServiceServer interface {
 	// A call to users and media service is made for user information and images.
 	SuggestUsersByPostActivity(context.Context, *SimpleIdReq) (*common.ListUsers, error)
 	// Toggles or inserts a reaction for the requester on a post/comment.
+	// Reacting again with the same type removes the reaction, a different type replaces it.
 	// Returns permission denied if requester is not allowed to view parent entity.
-	ToggleOrInsertReaction(context.Context, *GenericReq) (*emptypb.Empty, error)
-	// Returns a list of users who liked given entity id.
+	ToggleOrInsertReaction(context.Context, *ReactionReq) (*emptypb.Empty, error)
+	// Returns a list of users who reacted to given entity id, only with the given type unless it's empty.
 	// A call to users and media service is made for user information and images.
-	GetWhoLikedEntityId(context.Context, *GenericReq) (*common.ListUsers, error)
+	GetWhoLikedEntityId(context.Context, *ReactionReq) (*common.ListUsers, error)
+	// Counts the posts created in each of the given groups since a point in time.
+	// Used by users service to rank groups in discovery. Groups without posts are omitted.
+	GetGroupsPostActivity(context.Context, *GroupsActivityReq) (*GroupsActivityResp, error)
+	// Aggregates a group's posts, comments and events since a point in time.
+	// Used by users service to build group insights, days without activity are omitted.
+	GetGroupContentInsights(context.Context, *GroupInsightsReq) (*GroupContentInsights, error)
 	mustEmbedUnimplementedPostsServiceServer()
 }
 
@@ -514,6 +931,12 @@ func (UnimplementedPostsServiceServer) DeletePost(context.Context, *GenericReq)
 func (UnimplementedPostsServiceServer) EditPost(context.Context, *EditPostReq) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method EditPost not implemented")
 }
+func (UnimplementedPostsServiceServer) SharePost(context.Context, *SharePostReq) (*IdResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SharePost not implemented")
+}
+func (UnimplementedPostsServiceServer) UndoRepost(context.Context, *GenericReq) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UndoRepost not implemented")
+}
 func (UnimplementedPostsServiceServer) GetMostPopularPostInGroup(context.Context, *SimpleIdReq) (*Post, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetMostPopularPostInGroup not implemented")
 }
@@ -523,12 +946,45 @@ func (UnimplementedPostsServiceServer) GetPersonalizedFeed(context.Context, *Get
 func (UnimplementedPostsServiceServer) GetPublicFeed(context.Context, *GenericPaginatedReq) (*ListPosts, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetPublicFeed not implemented")
 }
+func (UnimplementedPostsServiceServer) GetHashtagFeed(context.Context, *GetHashtagFeedReq) (*ListPosts, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHashtagFeed not implemented")
+}
+func (UnimplementedPostsServiceServer) GetTrendingHashtags(context.Context, *GetTrendingHashtagsReq) (*ListTrendingHashtags, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTrendingHashtags not implemented")
+}
+func (UnimplementedPostsServiceServer) SearchContent(context.Context, *SearchContentReq) (*ListSearchResults, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchContent not implemented")
+}
 func (UnimplementedPostsServiceServer) GetUserPostsPaginated(context.Context, *GetUserPostsReq) (*ListPosts, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetUserPostsPaginated not implemented")
 }
 func (UnimplementedPostsServiceServer) GetGroupPostsPaginated(context.Context, *GetGroupPostsReq) (*ListPosts, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetGroupPostsPaginated not implemented")
 }
+func (UnimplementedPostsServiceServer) SetPostPinned(context.Context, *SetPostFlagReq) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPostPinned not implemented")
+}
+func (UnimplementedPostsServiceServer) SetPostAnnouncement(context.Context, *SetPostFlagReq) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPostAnnouncement not implemented")
+}
+func (UnimplementedPostsServiceServer) GetPendingGroupPosts(context.Context, *GetGroupPostsReq) (*ListPosts, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPendingGroupPosts not implemented")
+}
+func (UnimplementedPostsServiceServer) ApprovePost(context.Context, *ReviewPostReq) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApprovePost not implemented")
+}
+func (UnimplementedPostsServiceServer) RejectPost(context.Context, *ReviewPostReq) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectPost not implemented")
+}
+func (UnimplementedPostsServiceServer) GetScheduledPosts(context.Context, *GenericPaginatedReq) (*ListPosts, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetScheduledPosts not implemented")
+}
+func (UnimplementedPostsServiceServer) ReschedulePost(context.Context, *ReschedulePostReq) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReschedulePost not implemented")
+}
+func (UnimplementedPostsServiceServer) CancelScheduledPost(context.Context, *GenericReq) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelScheduledPost not implemented")
+}
 func (UnimplementedPostsServiceServer) CreateComment(context.Context, *CreateCommentReq) (*IdResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateComment not implemented")
 }
@@ -544,6 +1000,24 @@ func (UnimplementedPostsServiceServer) GetCommentsByParentId(context.Context, *E
 func (UnimplementedPostsServiceServer) GetPostAudienceForComment(context.Context, *SimpleIdReq) (*AudienceResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetPostAudienceForComment not implemented")
 }
+func (UnimplementedPostsServiceServer) GetRevisions(context.Context, *EntityIdPaginatedReq) (*ListRevisions, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRevisions not implemented")
+}
+func (UnimplementedPostsServiceServer) CreateDraft(context.Context, *CreateDraftReq) (*IdResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateDraft not implemented")
+}
+func (UnimplementedPostsServiceServer) UpdateDraft(context.Context, *UpdateDraftReq) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateDraft not implemented")
+}
+func (UnimplementedPostsServiceServer) GetDrafts(context.Context, *GenericPaginatedReq) (*ListDrafts, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDrafts not implemented")
+}
+func (UnimplementedPostsServiceServer) DeleteDraft(context.Context, *GenericReq) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteDraft not implemented")
+}
+func (UnimplementedPostsServiceServer) PublishDraft(context.Context, *PublishDraftReq) (*IdResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method PublishDraft not implemented")
+}
 func (UnimplementedPostsServiceServer) CreateEvent(context.Context, *CreateEventReq) (*IdResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateEvent not implemented")
 }
@@ -565,12 +1039,18 @@ func (UnimplementedPostsServiceServer) RemoveEventResponse(context.Context, *Gen
 func (UnimplementedPostsServiceServer) SuggestUsersByPostActivity(context.Context, *SimpleIdReq) (*common.ListUsers, error) {
 	return nil, status.Error(codes.Unimplemented, "method SuggestUsersByPostActivity not implemented")
 }
-func (UnimplementedPostsServiceServer) ToggleOrInsertReaction(context.Context, *GenericReq) (*emptypb.Empty, error) {
+func (UnimplementedPostsServiceServer) ToggleOrInsertReaction(context.Context, *ReactionReq) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method ToggleOrInsertReaction not implemented")
 }
-func (UnimplementedPostsServiceServer) GetWhoLikedEntityId(context.Context, *GenericReq) (*common.ListUsers, error) {
+func (UnimplementedPostsServiceServer) GetWhoLikedEntityId(context.Context, *ReactionReq) (*common.ListUsers, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetWhoLikedEntityId not implemented")
 }
+func (UnimplementedPostsServiceServer) GetGroupsPostActivity(context.Context, *GroupsActivityReq) (*GroupsActivityResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGroupsPostActivity not implemented")
+}
+func (UnimplementedPostsServiceServer) GetGroupContentInsights(context.Context, *GroupInsightsReq) (*GroupContentInsights, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGroupContentInsights not implemented")
+}
 func (UnimplementedPostsServiceServer) mustEmbedUnimplementedPostsServiceServer() {}
 func (UnimplementedPostsServiceServer) testEmbeddedByValue()                      {}
 
@@ -664,6 +1144,42 @@ func _PostsService_EditPost_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PostsService_SharePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SharePostReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).SharePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsService_SharePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).SharePost(ctx, req.(*SharePostReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostsService_UndoRepost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).UndoRepost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsService_UndoRepost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).UndoRepost(ctx, req.(*GenericReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PostsService_GetMostPopularPostInGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SimpleIdReq)
 	if err := dec(in); err != nil {
@@ -718,103 +1234,301 @@ func _PostsService_GetPublicFeed_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostsService_GetUserPostsPaginated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserPostsReq)
+func _PostsService_GetHashtagFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHashtagFeedReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostsServiceServer).GetUserPostsPaginated(ctx, in)
+		return srv.(PostsServiceServer).GetHashtagFeed(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostsService_GetUserPostsPaginated_FullMethodName,
+		FullMethod: PostsService_GetHashtagFeed_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostsServiceServer).GetUserPostsPaginated(ctx, req.(*GetUserPostsReq))
+		return srv.(PostsServiceServer).GetHashtagFeed(ctx, req.(*GetHashtagFeedReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostsService_GetGroupPostsPaginated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGroupPostsReq)
+func _PostsService_GetTrendingHashtags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrendingHashtagsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostsServiceServer).GetGroupPostsPaginated(ctx, in)
+		return srv.(PostsServiceServer).GetTrendingHashtags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostsService_GetGroupPostsPaginated_FullMethodName,
+		FullMethod: PostsService_GetTrendingHashtags_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostsServiceServer).GetGroupPostsPaginated(ctx, req.(*GetGroupPostsReq))
+		return srv.(PostsServiceServer).GetTrendingHashtags(ctx, req.(*GetTrendingHashtagsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostsService_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCommentReq)
+func _PostsService_SearchContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchContentReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostsServiceServer).CreateComment(ctx, in)
+		return srv.(PostsServiceServer).SearchContent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostsService_CreateComment_FullMethodName,
+		FullMethod: PostsService_SearchContent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostsServiceServer).CreateComment(ctx, req.(*CreateCommentReq))
+		return srv.(PostsServiceServer).SearchContent(ctx, req.(*SearchContentReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostsService_EditComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EditCommentReq)
+func _PostsService_GetUserPostsPaginated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserPostsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostsServiceServer).EditComment(ctx, in)
+		return srv.(PostsServiceServer).GetUserPostsPaginated(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostsService_EditComment_FullMethodName,
+		FullMethod: PostsService_GetUserPostsPaginated_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostsServiceServer).EditComment(ctx, req.(*EditCommentReq))
+		return srv.(PostsServiceServer).GetUserPostsPaginated(ctx, req.(*GetUserPostsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostsService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericReq)
+func _PostsService_GetGroupPostsPaginated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupPostsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostsServiceServer).DeleteComment(ctx, in)
+		return srv.(PostsServiceServer).GetGroupPostsPaginated(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostsService_DeleteComment_FullMethodName,
+		FullMethod: PostsService_GetGroupPostsPaginated_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostsServiceServer).DeleteComment(ctx, req.(*GenericReq))
+		return srv.(PostsServiceServer).GetGroupPostsPaginated(ctx, req.(*GetGroupPostsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostsService_GetCommentsByParentId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EntityIdPaginatedReq)
+func _PostsService_SetPostPinned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPostFlagReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostsServiceServer).GetCommentsByParentId(ctx, in)
+		return srv.(PostsServiceServer).SetPostPinned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsService_SetPostPinned_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).SetPostPinned(ctx, req.(*SetPostFlagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostsService_SetPostAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPostFlagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).SetPostAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsService_SetPostAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).SetPostAnnouncement(ctx, req.(*SetPostFlagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostsService_GetPendingGroupPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupPostsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).GetPendingGroupPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsService_GetPendingGroupPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).GetPendingGroupPosts(ctx, req.(*GetGroupPostsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostsService_ApprovePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewPostReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).ApprovePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsService_ApprovePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).ApprovePost(ctx, req.(*ReviewPostReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostsService_RejectPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewPostReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).RejectPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsService_RejectPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).RejectPost(ctx, req.(*ReviewPostReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostsService_GetScheduledPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericPaginatedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).GetScheduledPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsService_GetScheduledPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).GetScheduledPosts(ctx, req.(*GenericPaginatedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostsService_ReschedulePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReschedulePostReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).ReschedulePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsService_ReschedulePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).ReschedulePost(ctx, req.(*ReschedulePostReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostsService_CancelScheduledPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).CancelScheduledPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsService_CancelScheduledPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).CancelScheduledPost(ctx, req.(*GenericReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostsService_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).CreateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsService_CreateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).CreateComment(ctx, req.(*CreateCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostsService_EditComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).EditComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsService_EditComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).EditComment(ctx, req.(*EditCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostsService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsService_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).DeleteComment(ctx, req.(*GenericReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostsService_GetCommentsByParentId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityIdPaginatedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).GetCommentsByParentId(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -844,6 +1558,114 @@ func _PostsService_GetPostAudienceForComment_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PostsService_GetRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityIdPaginatedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).GetRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsService_GetRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).GetRevisions(ctx, req.(*EntityIdPaginatedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostsService_CreateDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDraftReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).CreateDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsService_CreateDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).CreateDraft(ctx, req.(*CreateDraftReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostsService_UpdateDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDraftReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).UpdateDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsService_UpdateDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).UpdateDraft(ctx, req.(*UpdateDraftReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostsService_GetDrafts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericPaginatedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).GetDrafts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsService_GetDrafts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).GetDrafts(ctx, req.(*GenericPaginatedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostsService_DeleteDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).DeleteDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsService_DeleteDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).DeleteDraft(ctx, req.(*GenericReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostsService_PublishDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishDraftReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).PublishDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsService_PublishDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).PublishDraft(ctx, req.(*PublishDraftReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PostsService_CreateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateEventReq)
 	if err := dec(in); err != nil {
@@ -971,7 +1793,7 @@ func _PostsService_SuggestUsersByPostActivity_Handler(srv interface{}, ctx conte
 }
 
 func _PostsService_ToggleOrInsertReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericReq)
+	in := new(ReactionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -983,13 +1805,13 @@ func _PostsService_ToggleOrInsertReaction_Handler(srv interface{}, ctx context.C
 		FullMethod: PostsService_ToggleOrInsertReaction_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostsServiceServer).ToggleOrInsertReaction(ctx, req.(*GenericReq))
+		return srv.(PostsServiceServer).ToggleOrInsertReaction(ctx, req.(*ReactionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _PostsService_GetWhoLikedEntityId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericReq)
+	in := new(ReactionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1001,7 +1823,43 @@ func _PostsService_GetWhoLikedEntityId_Handler(srv interface{}, ctx context.Cont
 		FullMethod: PostsService_GetWhoLikedEntityId_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostsServiceServer).GetWhoLikedEntityId(ctx, req.(*GenericReq))
+		return srv.(PostsServiceServer).GetWhoLikedEntityId(ctx, req.(*ReactionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostsService_GetGroupsPostActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupsActivityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).GetGroupsPostActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsService_GetGroupsPostActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).GetGroupsPostActivity(ctx, req.(*GroupsActivityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostsService_GetGroupContentInsights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupInsightsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).GetGroupContentInsights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostsService_GetGroupContentInsights_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).GetGroupContentInsights(ctx, req.(*GroupInsightsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1029,6 +1887,14 @@ var PostsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EditPost",
 			Handler:    _PostsService_EditPost_Handler,
 		},
+		{
+			MethodName: "SharePost",
+			Handler:    _PostsService_SharePost_Handler,
+		},
+		{
+			MethodName: "UndoRepost",
+			Handler:    _PostsService_UndoRepost_Handler,
+		},
 		{
 			MethodName: "GetMostPopularPostInGroup",
 			Handler:    _PostsService_GetMostPopularPostInGroup_Handler,
@@ -1041,6 +1907,18 @@ var PostsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPublicFeed",
 			Handler:    _PostsService_GetPublicFeed_Handler,
 		},
+		{
+			MethodName: "GetHashtagFeed",
+			Handler:    _PostsService_GetHashtagFeed_Handler,
+		},
+		{
+			MethodName: "GetTrendingHashtags",
+			Handler:    _PostsService_GetTrendingHashtags_Handler,
+		},
+		{
+			MethodName: "SearchContent",
+			Handler:    _PostsService_SearchContent_Handler,
+		},
 		{
 			MethodName: "GetUserPostsPaginated",
 			Handler:    _PostsService_GetUserPostsPaginated_Handler,
@@ -1049,6 +1927,38 @@ var PostsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetGroupPostsPaginated",
 			Handler:    _PostsService_GetGroupPostsPaginated_Handler,
 		},
+		{
+			MethodName: "SetPostPinned",
+			Handler:    _PostsService_SetPostPinned_Handler,
+		},
+		{
+			MethodName: "SetPostAnnouncement",
+			Handler:    _PostsService_SetPostAnnouncement_Handler,
+		},
+		{
+			MethodName: "GetPendingGroupPosts",
+			Handler:    _PostsService_GetPendingGroupPosts_Handler,
+		},
+		{
+			MethodName: "ApprovePost",
+			Handler:    _PostsService_ApprovePost_Handler,
+		},
+		{
+			MethodName: "RejectPost",
+			Handler:    _PostsService_RejectPost_Handler,
+		},
+		{
+			MethodName: "GetScheduledPosts",
+			Handler:    _PostsService_GetScheduledPosts_Handler,
+		},
+		{
+			MethodName: "ReschedulePost",
+			Handler:    _PostsService_ReschedulePost_Handler,
+		},
+		{
+			MethodName: "CancelScheduledPost",
+			Handler:    _PostsService_CancelScheduledPost_Handler,
+		},
 		{
 			MethodName: "CreateComment",
 			Handler:    _PostsService_CreateComment_Handler,
@@ -1069,6 +1979,30 @@ var PostsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPostAudienceForComment",
 			Handler:    _PostsService_GetPostAudienceForComment_Handler,
 		},
+		{
+			MethodName: "GetRevisions",
+			Handler:    _PostsService_GetRevisions_Handler,
+		},
+		{
+			MethodName: "CreateDraft",
+			Handler:    _PostsService_CreateDraft_Handler,
+		},
+		{
+			MethodName: "UpdateDraft",
+			Handler:    _PostsService_UpdateDraft_Handler,
+		},
+		{
+			MethodName: "GetDrafts",
+			Handler:    _PostsService_GetDrafts_Handler,
+		},
+		{
+			MethodName: "DeleteDraft",
+			Handler:    _PostsService_DeleteDraft_Handler,
+		},
+		{
+			MethodName: "PublishDraft",
+			Handler:    _PostsService_PublishDraft_Handler,
+		},
 		{
 			MethodName: "CreateEvent",
 			Handler:    _PostsService_CreateEvent_Handler,
@@ -1105,6 +2039,14 @@ var PostsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetWhoLikedEntityId",
 			Handler:    _PostsService_GetWhoLikedEntityId_Handler,
 		},
+		{
+			MethodName: "GetGroupsPostActivity",
+			Handler:    _PostsService_GetGroupsPostActivity_Handler,
+		},
+		{
+			MethodName: "GetGroupContentInsights",
+			Handler:    _PostsService_GetGroupContentInsights_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "posts.proto",
@@ -730,6 +730,7 @@ type Pagination struct {
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	RequesterId   int64                  `protobuf:"varint,4,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"` //required for followers/following lists, ignored elsewhere
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -785,6 +786,13 @@ func (x *Pagination) GetOffset() int32 {
 	return 0
 }
 
+func (x *Pagination) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
 // Request message for following a target user
 type FollowUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1061,20 +1069,26 @@ func (x *AreFollowingEachOtherResponse) GetTargetFollowsFollower() bool {
 // Response message describing a group
 // including viewer specific information in relation to group
 type Group struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	GroupId          int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	GroupOwnerId     int64                  `protobuf:"varint,2,opt,name=group_owner_id,json=groupOwnerId,proto3" json:"group_owner_id,omitempty"`
-	GroupTitle       string                 `protobuf:"bytes,3,opt,name=group_title,json=groupTitle,proto3" json:"group_title,omitempty"`
-	GroupDescription string                 `protobuf:"bytes,4,opt,name=group_description,json=groupDescription,proto3" json:"group_description,omitempty"`
-	GroupImageId     int64                  `protobuf:"varint,5,opt,name=group_image_id,json=groupImageId,proto3" json:"group_image_id,omitempty"`
-	GroupImageUrl    string                 `protobuf:"bytes,6,opt,name=group_image_url,json=groupImageUrl,proto3" json:"group_image_url,omitempty"`
-	MembersCount     int32                  `protobuf:"varint,7,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
-	IsMember         bool                   `protobuf:"varint,8,opt,name=is_member,json=isMember,proto3" json:"is_member,omitempty"`
-	IsOwner          bool                   `protobuf:"varint,9,opt,name=is_owner,json=isOwner,proto3" json:"is_owner,omitempty"`
-	PendingRequest   bool                   `protobuf:"varint,10,opt,name=pending_request,json=pendingRequest,proto3" json:"pending_request,omitempty"`
-	PendingInvite    bool                   `protobuf:"varint,11,opt,name=pending_invite,json=pendingInvite,proto3" json:"pending_invite,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	GroupId              int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	GroupOwnerId         int64                  `protobuf:"varint,2,opt,name=group_owner_id,json=groupOwnerId,proto3" json:"group_owner_id,omitempty"`
+	GroupTitle           string                 `protobuf:"bytes,3,opt,name=group_title,json=groupTitle,proto3" json:"group_title,omitempty"`
+	GroupDescription     string                 `protobuf:"bytes,4,opt,name=group_description,json=groupDescription,proto3" json:"group_description,omitempty"`
+	GroupImageId         int64                  `protobuf:"varint,5,opt,name=group_image_id,json=groupImageId,proto3" json:"group_image_id,omitempty"`
+	GroupImageUrl        string                 `protobuf:"bytes,6,opt,name=group_image_url,json=groupImageUrl,proto3" json:"group_image_url,omitempty"`
+	MembersCount         int32                  `protobuf:"varint,7,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
+	IsMember             bool                   `protobuf:"varint,8,opt,name=is_member,json=isMember,proto3" json:"is_member,omitempty"`
+	IsOwner              bool                   `protobuf:"varint,9,opt,name=is_owner,json=isOwner,proto3" json:"is_owner,omitempty"`
+	PendingRequest       bool                   `protobuf:"varint,10,opt,name=pending_request,json=pendingRequest,proto3" json:"pending_request,omitempty"`
+	PendingInvite        bool                   `protobuf:"varint,11,opt,name=pending_invite,json=pendingInvite,proto3" json:"pending_invite,omitempty"`
+	OwnershipOffered     bool                   `protobuf:"varint,12,opt,name=ownership_offered,json=ownershipOffered,proto3" json:"ownership_offered,omitempty"` //viewer has a pending offer to become owner
+	Archived             bool                   `protobuf:"varint,13,opt,name=archived,proto3" json:"archived,omitempty"`                                         //group is read-only
+	Visibility           string                 `protobuf:"bytes,14,opt,name=visibility,proto3" json:"visibility,omitempty"`                                      //public, private or secret
+	Category             string                 `protobuf:"bytes,15,opt,name=category,proto3" json:"category,omitempty"`
+	Tags                 []string               `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags,omitempty"`
+	PostsRequireApproval bool                   `protobuf:"varint,17,opt,name=posts_require_approval,json=postsRequireApproval,proto3" json:"posts_require_approval,omitempty"` //new posts wait for staff approval
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *Group) Reset() {
@@ -1184,6 +1198,48 @@ func (x *Group) GetPendingInvite() bool {
 	return false
 }
 
+func (x *Group) GetOwnershipOffered() bool {
+	if x != nil {
+		return x.OwnershipOffered
+	}
+	return false
+}
+
+func (x *Group) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *Group) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *Group) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Group) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Group) GetPostsRequireApproval() bool {
+	if x != nil {
+		return x.PostsRequireApproval
+	}
+	return false
+}
+
 // Response message including multiple groups
 type GroupArr struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1358,7 +1414,7 @@ type GroupUser struct {
 	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
 	Avatar        int64                  `protobuf:"varint,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
 	AvatarUrl     string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
-	GroupRole     string                 `protobuf:"bytes,5,opt,name=group_role,json=groupRole,proto3" json:"group_role,omitempty"` //owner or member
+	GroupRole     string                 `protobuf:"bytes,5,opt,name=group_role,json=groupRole,proto3" json:"group_role,omitempty"` //owner, admin, moderator or member
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1542,30 +1598,31 @@ func (x *GroupSearchRequest) GetOffset() int32 {
 	return 0
 }
 
-// Request message for inviting users to a group
-type InviteToGroupRequest struct {
+// Request message for group discovery
+type DiscoverGroupsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	InviterId     int64                  `protobuf:"varint,1,opt,name=inviter_id,json=inviterId,proto3" json:"inviter_id,omitempty"`
-	InvitedIds    *common.UserIds        `protobuf:"bytes,2,opt,name=invited_ids,json=invitedIds,proto3" json:"invited_ids,omitempty"`
-	GroupId       int64                  `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"` //empty means any category
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InviteToGroupRequest) Reset() {
-	*x = InviteToGroupRequest{}
+func (x *DiscoverGroupsRequest) Reset() {
+	*x = DiscoverGroupsRequest{}
 	mi := &file_users_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InviteToGroupRequest) String() string {
+func (x *DiscoverGroupsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InviteToGroupRequest) ProtoMessage() {}
+func (*DiscoverGroupsRequest) ProtoMessage() {}
 
-func (x *InviteToGroupRequest) ProtoReflect() protoreflect.Message {
+func (x *DiscoverGroupsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_users_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1577,55 +1634,62 @@ func (x *InviteToGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InviteToGroupRequest.ProtoReflect.Descriptor instead.
-func (*InviteToGroupRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiscoverGroupsRequest.ProtoReflect.Descriptor instead.
+func (*DiscoverGroupsRequest) Descriptor() ([]byte, []int) {
 	return file_users_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *InviteToGroupRequest) GetInviterId() int64 {
+func (x *DiscoverGroupsRequest) GetUserId() int64 {
 	if x != nil {
-		return x.InviterId
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *InviteToGroupRequest) GetInvitedIds() *common.UserIds {
+func (x *DiscoverGroupsRequest) GetCategory() string {
 	if x != nil {
-		return x.InvitedIds
+		return x.Category
 	}
-	return nil
+	return ""
 }
 
-func (x *InviteToGroupRequest) GetGroupId() int64 {
+func (x *DiscoverGroupsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.GroupId
+		return x.Limit
 	}
 	return 0
 }
 
-// Request message for requesting to join a group
-type GroupJoinRequest struct {
+func (x *DiscoverGroupsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// Number of items created on one day (UTC)
+type GroupDailyCount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	RequesterId   int64                  `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	Day           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GroupJoinRequest) Reset() {
-	*x = GroupJoinRequest{}
+func (x *GroupDailyCount) Reset() {
+	*x = GroupDailyCount{}
 	mi := &file_users_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GroupJoinRequest) String() string {
+func (x *GroupDailyCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupJoinRequest) ProtoMessage() {}
+func (*GroupDailyCount) ProtoMessage() {}
 
-func (x *GroupJoinRequest) ProtoReflect() protoreflect.Message {
+func (x *GroupDailyCount) ProtoReflect() protoreflect.Message {
 	mi := &file_users_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1637,49 +1701,49 @@ func (x *GroupJoinRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupJoinRequest.ProtoReflect.Descriptor instead.
-func (*GroupJoinRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GroupDailyCount.ProtoReflect.Descriptor instead.
+func (*GroupDailyCount) Descriptor() ([]byte, []int) {
 	return file_users_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GroupJoinRequest) GetGroupId() int64 {
+func (x *GroupDailyCount) GetDay() *timestamppb.Timestamp {
 	if x != nil {
-		return x.GroupId
+		return x.Day
 	}
-	return 0
+	return nil
 }
 
-func (x *GroupJoinRequest) GetRequesterId() int64 {
+func (x *GroupDailyCount) GetCount() int64 {
 	if x != nil {
-		return x.RequesterId
+		return x.Count
 	}
 	return 0
 }
 
-// Request message for accepting or declining an invite to a group
-type HandleGroupInviteRequest struct {
+// One day of a group's member growth
+type GroupMemberGrowth struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	InvitedId     int64                  `protobuf:"varint,2,opt,name=invited_id,json=invitedId,proto3" json:"invited_id,omitempty"`
-	Accepted      bool                   `protobuf:"varint,3,opt,name=accepted,proto3" json:"accepted,omitempty"` // true for accepting, false for declining
+	Day           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	Joined        int64                  `protobuf:"varint,2,opt,name=joined,proto3" json:"joined,omitempty"`
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"` //members at the end of the day
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HandleGroupInviteRequest) Reset() {
-	*x = HandleGroupInviteRequest{}
+func (x *GroupMemberGrowth) Reset() {
+	*x = GroupMemberGrowth{}
 	mi := &file_users_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HandleGroupInviteRequest) String() string {
+func (x *GroupMemberGrowth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HandleGroupInviteRequest) ProtoMessage() {}
+func (*GroupMemberGrowth) ProtoMessage() {}
 
-func (x *HandleGroupInviteRequest) ProtoReflect() protoreflect.Message {
+func (x *GroupMemberGrowth) ProtoReflect() protoreflect.Message {
 	mi := &file_users_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1691,57 +1755,56 @@ func (x *HandleGroupInviteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HandleGroupInviteRequest.ProtoReflect.Descriptor instead.
-func (*HandleGroupInviteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GroupMemberGrowth.ProtoReflect.Descriptor instead.
+func (*GroupMemberGrowth) Descriptor() ([]byte, []int) {
 	return file_users_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *HandleGroupInviteRequest) GetGroupId() int64 {
+func (x *GroupMemberGrowth) GetDay() *timestamppb.Timestamp {
 	if x != nil {
-		return x.GroupId
+		return x.Day
 	}
-	return 0
+	return nil
 }
 
-func (x *HandleGroupInviteRequest) GetInvitedId() int64 {
+func (x *GroupMemberGrowth) GetJoined() int64 {
 	if x != nil {
-		return x.InvitedId
+		return x.Joined
 	}
 	return 0
 }
 
-func (x *HandleGroupInviteRequest) GetAccepted() bool {
+func (x *GroupMemberGrowth) GetTotal() int64 {
 	if x != nil {
-		return x.Accepted
+		return x.Total
 	}
-	return false
+	return 0
 }
 
-// Request message for accepting or rejecting a group join request
-type HandleJoinRequest struct {
+// A group member and how much they posted and commented
+type GroupActivePoster struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	RequesterId   int64                  `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"` //the user who sent the join request
-	OwnerId       int64                  `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`             //the user handling the request (must be owner)
-	Accepted      bool                   `protobuf:"varint,4,opt,name=accepted,proto3" json:"accepted,omitempty"`                          //true for accepting, false for rejecting
+	User          *common.User           `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	PostCount     int64                  `protobuf:"varint,2,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+	CommentCount  int64                  `protobuf:"varint,3,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HandleJoinRequest) Reset() {
-	*x = HandleJoinRequest{}
+func (x *GroupActivePoster) Reset() {
+	*x = GroupActivePoster{}
 	mi := &file_users_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HandleJoinRequest) String() string {
+func (x *GroupActivePoster) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HandleJoinRequest) ProtoMessage() {}
+func (*GroupActivePoster) ProtoMessage() {}
 
-func (x *HandleJoinRequest) ProtoReflect() protoreflect.Message {
+func (x *GroupActivePoster) ProtoReflect() protoreflect.Message {
 	mi := &file_users_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1753,63 +1816,59 @@ func (x *HandleJoinRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HandleJoinRequest.ProtoReflect.Descriptor instead.
-func (*HandleJoinRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GroupActivePoster.ProtoReflect.Descriptor instead.
+func (*GroupActivePoster) Descriptor() ([]byte, []int) {
 	return file_users_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *HandleJoinRequest) GetGroupId() int64 {
+func (x *GroupActivePoster) GetUser() *common.User {
 	if x != nil {
-		return x.GroupId
+		return x.User
 	}
-	return 0
+	return nil
 }
 
-func (x *HandleJoinRequest) GetRequesterId() int64 {
+func (x *GroupActivePoster) GetPostCount() int64 {
 	if x != nil {
-		return x.RequesterId
+		return x.PostCount
 	}
 	return 0
 }
 
-func (x *HandleJoinRequest) GetOwnerId() int64 {
+func (x *GroupActivePoster) GetCommentCount() int64 {
 	if x != nil {
-		return x.OwnerId
+		return x.CommentCount
 	}
 	return 0
 }
 
-func (x *HandleJoinRequest) GetAccepted() bool {
-	if x != nil {
-		return x.Accepted
-	}
-	return false
-}
-
-// Request for removing user from group (can be used for self)
-type RemoveFromGroupRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	MemberId      int64                  `protobuf:"varint,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
-	OwnerId       int64                  `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Responses to one group event
+type GroupEventAttendance struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	EventId        int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	EventDate      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=event_date,json=eventDate,proto3" json:"event_date,omitempty"`
+	GoingCount     int32                  `protobuf:"varint,4,opt,name=going_count,json=goingCount,proto3" json:"going_count,omitempty"`
+	NotGoingCount  int32                  `protobuf:"varint,5,opt,name=not_going_count,json=notGoingCount,proto3" json:"not_going_count,omitempty"`
+	AttendanceRate float64                `protobuf:"fixed64,6,opt,name=attendance_rate,json=attendanceRate,proto3" json:"attendance_rate,omitempty"` //going members out of current members
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *RemoveFromGroupRequest) Reset() {
-	*x = RemoveFromGroupRequest{}
+func (x *GroupEventAttendance) Reset() {
+	*x = GroupEventAttendance{}
 	mi := &file_users_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveFromGroupRequest) String() string {
+func (x *GroupEventAttendance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveFromGroupRequest) ProtoMessage() {}
+func (*GroupEventAttendance) ProtoMessage() {}
 
-func (x *RemoveFromGroupRequest) ProtoReflect() protoreflect.Message {
+func (x *GroupEventAttendance) ProtoReflect() protoreflect.Message {
 	mi := &file_users_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1821,57 +1880,79 @@ func (x *RemoveFromGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveFromGroupRequest.ProtoReflect.Descriptor instead.
-func (*RemoveFromGroupRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GroupEventAttendance.ProtoReflect.Descriptor instead.
+func (*GroupEventAttendance) Descriptor() ([]byte, []int) {
 	return file_users_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *RemoveFromGroupRequest) GetGroupId() int64 {
+func (x *GroupEventAttendance) GetEventId() int64 {
 	if x != nil {
-		return x.GroupId
+		return x.EventId
 	}
 	return 0
 }
 
-func (x *RemoveFromGroupRequest) GetMemberId() int64 {
+func (x *GroupEventAttendance) GetTitle() string {
 	if x != nil {
-		return x.MemberId
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GroupEventAttendance) GetEventDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EventDate
+	}
+	return nil
+}
+
+func (x *GroupEventAttendance) GetGoingCount() int32 {
+	if x != nil {
+		return x.GoingCount
 	}
 	return 0
 }
 
-func (x *RemoveFromGroupRequest) GetOwnerId() int64 {
+func (x *GroupEventAttendance) GetNotGoingCount() int32 {
 	if x != nil {
-		return x.OwnerId
+		return x.NotGoingCount
 	}
 	return 0
 }
 
-// Request message for creating a group
-type CreateGroupRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	OwnerId          int64                  `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	GroupTitle       string                 `protobuf:"bytes,2,opt,name=group_title,json=groupTitle,proto3" json:"group_title,omitempty"`
-	GroupDescription string                 `protobuf:"bytes,3,opt,name=group_description,json=groupDescription,proto3" json:"group_description,omitempty"`
-	GroupImageId     int64                  `protobuf:"varint,4,opt,name=group_image_id,json=groupImageId,proto3" json:"group_image_id,omitempty"` //can be 0 if no image
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *GroupEventAttendance) GetAttendanceRate() float64 {
+	if x != nil {
+		return x.AttendanceRate
+	}
+	return 0
 }
 
-func (x *CreateGroupRequest) Reset() {
-	*x = CreateGroupRequest{}
+// Join requests answered in the insights window
+type GroupJoinRequestStats struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Accepted              int64                  `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Rejected              int64                  `protobuf:"varint,2,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	Pending               int64                  `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"` //currently pending, regardless of window
+	AvgApprovalSeconds    float64                `protobuf:"fixed64,4,opt,name=avg_approval_seconds,json=avgApprovalSeconds,proto3" json:"avg_approval_seconds,omitempty"`
+	MedianApprovalSeconds float64                `protobuf:"fixed64,5,opt,name=median_approval_seconds,json=medianApprovalSeconds,proto3" json:"median_approval_seconds,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GroupJoinRequestStats) Reset() {
+	*x = GroupJoinRequestStats{}
 	mi := &file_users_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGroupRequest) String() string {
+func (x *GroupJoinRequestStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGroupRequest) ProtoMessage() {}
+func (*GroupJoinRequestStats) ProtoMessage() {}
 
-func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+func (x *GroupJoinRequestStats) ProtoReflect() protoreflect.Message {
 	mi := &file_users_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1883,66 +1964,78 @@ func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
-func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GroupJoinRequestStats.ProtoReflect.Descriptor instead.
+func (*GroupJoinRequestStats) Descriptor() ([]byte, []int) {
 	return file_users_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *CreateGroupRequest) GetOwnerId() int64 {
+func (x *GroupJoinRequestStats) GetAccepted() int64 {
 	if x != nil {
-		return x.OwnerId
+		return x.Accepted
 	}
 	return 0
 }
 
-func (x *CreateGroupRequest) GetGroupTitle() string {
+func (x *GroupJoinRequestStats) GetRejected() int64 {
 	if x != nil {
-		return x.GroupTitle
+		return x.Rejected
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateGroupRequest) GetGroupDescription() string {
+func (x *GroupJoinRequestStats) GetPending() int64 {
 	if x != nil {
-		return x.GroupDescription
+		return x.Pending
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateGroupRequest) GetGroupImageId() int64 {
+func (x *GroupJoinRequestStats) GetAvgApprovalSeconds() float64 {
 	if x != nil {
-		return x.GroupImageId
+		return x.AvgApprovalSeconds
 	}
 	return 0
 }
 
-// Request message for updating a group's info
-type UpdateGroupRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	RequesterId      int64                  `protobuf:"varint,1,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
-	GroupId          int64                  `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	GroupTitle       string                 `protobuf:"bytes,3,opt,name=group_title,json=groupTitle,proto3" json:"group_title,omitempty"`
-	GroupDescription string                 `protobuf:"bytes,4,opt,name=group_description,json=groupDescription,proto3" json:"group_description,omitempty"`
-	GroupImageId     int64                  `protobuf:"varint,5,opt,name=group_image_id,json=groupImageId,proto3" json:"group_image_id,omitempty"` //can be 0 if no image
-	DeleteImage      bool                   `protobuf:"varint,6,opt,name=delete_image,json=deleteImage,proto3" json:"delete_image,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *GroupJoinRequestStats) GetMedianApprovalSeconds() float64 {
+	if x != nil {
+		return x.MedianApprovalSeconds
+	}
+	return 0
 }
 
-func (x *UpdateGroupRequest) Reset() {
-	*x = UpdateGroupRequest{}
+// Response message with a group's insights
+type GroupInsights struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	GroupId        int64                   `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Since          *timestamppb.Timestamp  `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+	ComputedAt     *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+	MembersCount   int32                   `protobuf:"varint,4,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
+	MemberGrowth   []*GroupMemberGrowth    `protobuf:"bytes,5,rep,name=member_growth,json=memberGrowth,proto3" json:"member_growth,omitempty"`
+	PostsPerDay    []*GroupDailyCount      `protobuf:"bytes,6,rep,name=posts_per_day,json=postsPerDay,proto3" json:"posts_per_day,omitempty"`
+	CommentsPerDay []*GroupDailyCount      `protobuf:"bytes,7,rep,name=comments_per_day,json=commentsPerDay,proto3" json:"comments_per_day,omitempty"`
+	ActivePosters  []*GroupActivePoster    `protobuf:"bytes,8,rep,name=active_posters,json=activePosters,proto3" json:"active_posters,omitempty"`
+	Events         []*GroupEventAttendance `protobuf:"bytes,9,rep,name=events,proto3" json:"events,omitempty"`
+	AttendanceRate float64                 `protobuf:"fixed64,10,opt,name=attendance_rate,json=attendanceRate,proto3" json:"attendance_rate,omitempty"` //average of the events' attendance rates
+	JoinRequests   *GroupJoinRequestStats  `protobuf:"bytes,11,opt,name=join_requests,json=joinRequests,proto3" json:"join_requests,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GroupInsights) Reset() {
+	*x = GroupInsights{}
 	mi := &file_users_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGroupRequest) String() string {
+func (x *GroupInsights) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGroupRequest) ProtoMessage() {}
+func (*GroupInsights) ProtoMessage() {}
 
-func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
+func (x *GroupInsights) ProtoReflect() protoreflect.Message {
 	mi := &file_users_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1954,65 +2047,2057 @@ func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGroupRequest.ProtoReflect.Descriptor instead.
-func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GroupInsights.ProtoReflect.Descriptor instead.
+func (*GroupInsights) Descriptor() ([]byte, []int) {
 	return file_users_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *UpdateGroupRequest) GetRequesterId() int64 {
+func (x *GroupInsights) GetGroupId() int64 {
 	if x != nil {
-		return x.RequesterId
+		return x.GroupId
 	}
 	return 0
 }
 
-func (x *UpdateGroupRequest) GetGroupId() int64 {
+func (x *GroupInsights) GetSince() *timestamppb.Timestamp {
 	if x != nil {
-		return x.GroupId
+		return x.Since
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateGroupRequest) GetGroupTitle() string {
+func (x *GroupInsights) GetComputedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.GroupTitle
+		return x.ComputedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateGroupRequest) GetGroupDescription() string {
+func (x *GroupInsights) GetMembersCount() int32 {
 	if x != nil {
-		return x.GroupDescription
+		return x.MembersCount
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateGroupRequest) GetGroupImageId() int64 {
+func (x *GroupInsights) GetMemberGrowth() []*GroupMemberGrowth {
 	if x != nil {
-		return x.GroupImageId
+		return x.MemberGrowth
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateGroupRequest) GetDeleteImage() bool {
+func (x *GroupInsights) GetPostsPerDay() []*GroupDailyCount {
 	if x != nil {
-		return x.DeleteImage
+		return x.PostsPerDay
 	}
-	return false
+	return nil
 }
 
-// Request message for retrieving a user's profile
-type GetUserProfileRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	RequesterId   int64                  `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+func (x *GroupInsights) GetCommentsPerDay() []*GroupDailyCount {
+	if x != nil {
+		return x.CommentsPerDay
+	}
+	return nil
+}
+
+func (x *GroupInsights) GetActivePosters() []*GroupActivePoster {
+	if x != nil {
+		return x.ActivePosters
+	}
+	return nil
+}
+
+func (x *GroupInsights) GetEvents() []*GroupEventAttendance {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GroupInsights) GetAttendanceRate() float64 {
+	if x != nil {
+		return x.AttendanceRate
+	}
+	return 0
+}
+
+func (x *GroupInsights) GetJoinRequests() *GroupJoinRequestStats {
+	if x != nil {
+		return x.JoinRequests
+	}
+	return nil
+}
+
+// Request message for inviting users to a group
+type InviteToGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InviterId     int64                  `protobuf:"varint,1,opt,name=inviter_id,json=inviterId,proto3" json:"inviter_id,omitempty"`
+	InvitedIds    *common.UserIds        `protobuf:"bytes,2,opt,name=invited_ids,json=invitedIds,proto3" json:"invited_ids,omitempty"`
+	GroupId       int64                  `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteToGroupRequest) Reset() {
+	*x = InviteToGroupRequest{}
+	mi := &file_users_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteToGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToGroupRequest) ProtoMessage() {}
+
+func (x *InviteToGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToGroupRequest.ProtoReflect.Descriptor instead.
+func (*InviteToGroupRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *InviteToGroupRequest) GetInviterId() int64 {
+	if x != nil {
+		return x.InviterId
+	}
+	return 0
+}
+
+func (x *InviteToGroupRequest) GetInvitedIds() *common.UserIds {
+	if x != nil {
+		return x.InvitedIds
+	}
+	return nil
+}
+
+func (x *InviteToGroupRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+// Request message for requesting to join a group
+type GroupJoinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	RequesterId   int64                  `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	AcceptRules   bool                   `protobuf:"varint,3,opt,name=accept_rules,json=acceptRules,proto3" json:"accept_rules,omitempty"` //applicant agrees to the group rules
+	Answers       []*GroupJoinAnswer     `protobuf:"bytes,4,rep,name=answers,proto3" json:"answers,omitempty"`                             //answers to the join questions
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupJoinRequest) Reset() {
+	*x = GroupJoinRequest{}
+	mi := &file_users_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupJoinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupJoinRequest) ProtoMessage() {}
+
+func (x *GroupJoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupJoinRequest.ProtoReflect.Descriptor instead.
+func (*GroupJoinRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GroupJoinRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *GroupJoinRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *GroupJoinRequest) GetAcceptRules() bool {
+	if x != nil {
+		return x.AcceptRules
+	}
+	return false
+}
+
+func (x *GroupJoinRequest) GetAnswers() []*GroupJoinAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+// A question applicants answer when requesting to join a group
+type GroupJoinQuestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"` //ignored when setting the join form
+	Question      string                 `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	Required      bool                   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupJoinQuestion) Reset() {
+	*x = GroupJoinQuestion{}
+	mi := &file_users_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupJoinQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupJoinQuestion) ProtoMessage() {}
+
+func (x *GroupJoinQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupJoinQuestion.ProtoReflect.Descriptor instead.
+func (*GroupJoinQuestion) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GroupJoinQuestion) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *GroupJoinQuestion) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *GroupJoinQuestion) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+// An applicant's answer to a join question
+type GroupJoinAnswer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Question      string                 `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"` //as asked when the request was sent, ignored when answering
+	Answer        string                 `protobuf:"bytes,3,opt,name=answer,proto3" json:"answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupJoinAnswer) Reset() {
+	*x = GroupJoinAnswer{}
+	mi := &file_users_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupJoinAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupJoinAnswer) ProtoMessage() {}
+
+func (x *GroupJoinAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupJoinAnswer.ProtoReflect.Descriptor instead.
+func (*GroupJoinAnswer) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GroupJoinAnswer) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *GroupJoinAnswer) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *GroupJoinAnswer) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+// Rules and join questions of a group
+type GroupJoinForm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Rules         []string               `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	Questions     []*GroupJoinQuestion   `protobuf:"bytes,3,rep,name=questions,proto3" json:"questions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupJoinForm) Reset() {
+	*x = GroupJoinForm{}
+	mi := &file_users_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupJoinForm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupJoinForm) ProtoMessage() {}
+
+func (x *GroupJoinForm) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupJoinForm.ProtoReflect.Descriptor instead.
+func (*GroupJoinForm) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GroupJoinForm) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *GroupJoinForm) GetRules() []string {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *GroupJoinForm) GetQuestions() []*GroupJoinQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+// Request message for replacing the rules and join questions of a group
+type SetGroupJoinFormRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequesterId   int64                  `protobuf:"varint,1,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	GroupId       int64                  `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Rules         []string               `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+	Questions     []*GroupJoinQuestion   `protobuf:"bytes,4,rep,name=questions,proto3" json:"questions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGroupJoinFormRequest) Reset() {
+	*x = SetGroupJoinFormRequest{}
+	mi := &file_users_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGroupJoinFormRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupJoinFormRequest) ProtoMessage() {}
+
+func (x *SetGroupJoinFormRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupJoinFormRequest.ProtoReflect.Descriptor instead.
+func (*SetGroupJoinFormRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetGroupJoinFormRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *SetGroupJoinFormRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *SetGroupJoinFormRequest) GetRules() []string {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *SetGroupJoinFormRequest) GetQuestions() []*GroupJoinQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+// Request message for turning post approval on or off
+type SetGroupPostApprovalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequesterId   int64                  `protobuf:"varint,1,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	GroupId       int64                  `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Required      bool                   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGroupPostApprovalRequest) Reset() {
+	*x = SetGroupPostApprovalRequest{}
+	mi := &file_users_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGroupPostApprovalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupPostApprovalRequest) ProtoMessage() {}
+
+func (x *SetGroupPostApprovalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupPostApprovalRequest.ProtoReflect.Descriptor instead.
+func (*SetGroupPostApprovalRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetGroupPostApprovalRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *SetGroupPostApprovalRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *SetGroupPostApprovalRequest) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+// Request message for accepting or declining an invite to a group
+type HandleGroupInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	InvitedId     int64                  `protobuf:"varint,2,opt,name=invited_id,json=invitedId,proto3" json:"invited_id,omitempty"`
+	Accepted      bool                   `protobuf:"varint,3,opt,name=accepted,proto3" json:"accepted,omitempty"` // true for accepting, false for declining
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandleGroupInviteRequest) Reset() {
+	*x = HandleGroupInviteRequest{}
+	mi := &file_users_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandleGroupInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleGroupInviteRequest) ProtoMessage() {}
+
+func (x *HandleGroupInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleGroupInviteRequest.ProtoReflect.Descriptor instead.
+func (*HandleGroupInviteRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *HandleGroupInviteRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *HandleGroupInviteRequest) GetInvitedId() int64 {
+	if x != nil {
+		return x.InvitedId
+	}
+	return 0
+}
+
+func (x *HandleGroupInviteRequest) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+// Request message for accepting or rejecting a group join request
+type HandleJoinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	RequesterId   int64                  `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"` //the user who sent the join request
+	OwnerId       int64                  `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`             //the user handling the request (must be allowed to approve joins)
+	Accepted      bool                   `protobuf:"varint,4,opt,name=accepted,proto3" json:"accepted,omitempty"`                          //true for accepting, false for rejecting
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandleJoinRequest) Reset() {
+	*x = HandleJoinRequest{}
+	mi := &file_users_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandleJoinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleJoinRequest) ProtoMessage() {}
+
+func (x *HandleJoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleJoinRequest.ProtoReflect.Descriptor instead.
+func (*HandleJoinRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *HandleJoinRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *HandleJoinRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *HandleJoinRequest) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *HandleJoinRequest) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+// Request for removing user from group (can be used for self)
+type RemoveFromGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	MemberId      int64                  `protobuf:"varint,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	OwnerId       int64                  `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromGroupRequest) Reset() {
+	*x = RemoveFromGroupRequest{}
+	mi := &file_users_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromGroupRequest) ProtoMessage() {}
+
+func (x *RemoveFromGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromGroupRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFromGroupRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RemoveFromGroupRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *RemoveFromGroupRequest) GetMemberId() int64 {
+	if x != nil {
+		return x.MemberId
+	}
+	return 0
+}
+
+func (x *RemoveFromGroupRequest) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+// Request message for creating a group
+type CreateGroupRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId          int64                  `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	GroupTitle       string                 `protobuf:"bytes,2,opt,name=group_title,json=groupTitle,proto3" json:"group_title,omitempty"`
+	GroupDescription string                 `protobuf:"bytes,3,opt,name=group_description,json=groupDescription,proto3" json:"group_description,omitempty"`
+	GroupImageId     int64                  `protobuf:"varint,4,opt,name=group_image_id,json=groupImageId,proto3" json:"group_image_id,omitempty"` //can be 0 if no image
+	Visibility       string                 `protobuf:"bytes,5,opt,name=visibility,proto3" json:"visibility,omitempty"`                            //public, private or secret, defaults to private
+	Category         string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`                                //defaults to other
+	Tags             []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateGroupRequest) Reset() {
+	*x = CreateGroupRequest{}
+	mi := &file_users_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRequest) ProtoMessage() {}
+
+func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateGroupRequest) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *CreateGroupRequest) GetGroupTitle() string {
+	if x != nil {
+		return x.GroupTitle
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetGroupDescription() string {
+	if x != nil {
+		return x.GroupDescription
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetGroupImageId() int64 {
+	if x != nil {
+		return x.GroupImageId
+	}
+	return 0
+}
+
+func (x *CreateGroupRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// Request message for updating a group's info
+type UpdateGroupRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RequesterId      int64                  `protobuf:"varint,1,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	GroupId          int64                  `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	GroupTitle       string                 `protobuf:"bytes,3,opt,name=group_title,json=groupTitle,proto3" json:"group_title,omitempty"`
+	GroupDescription string                 `protobuf:"bytes,4,opt,name=group_description,json=groupDescription,proto3" json:"group_description,omitempty"`
+	GroupImageId     int64                  `protobuf:"varint,5,opt,name=group_image_id,json=groupImageId,proto3" json:"group_image_id,omitempty"` //can be 0 if no image
+	DeleteImage      bool                   `protobuf:"varint,6,opt,name=delete_image,json=deleteImage,proto3" json:"delete_image,omitempty"`
+	Visibility       string                 `protobuf:"bytes,7,opt,name=visibility,proto3" json:"visibility,omitempty"` //public, private or secret, empty keeps the current one
+	Category         string                 `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`     //empty keeps the current one
+	Tags             *GroupTags             `protobuf:"bytes,9,opt,name=tags,proto3" json:"tags,omitempty"`             //unset keeps the current ones, empty list clears them
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateGroupRequest) Reset() {
+	*x = UpdateGroupRequest{}
+	mi := &file_users_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupRequest) ProtoMessage() {}
+
+func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateGroupRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *UpdateGroupRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *UpdateGroupRequest) GetGroupTitle() string {
+	if x != nil {
+		return x.GroupTitle
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetGroupDescription() string {
+	if x != nil {
+		return x.GroupDescription
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetGroupImageId() int64 {
+	if x != nil {
+		return x.GroupImageId
+	}
+	return 0
+}
+
+func (x *UpdateGroupRequest) GetDeleteImage() bool {
+	if x != nil {
+		return x.DeleteImage
+	}
+	return false
+}
+
+func (x *UpdateGroupRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetTags() *GroupTags {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// List of group tags, wrapped so an update can tell "unchanged" from "cleared"
+type GroupTags struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupTags) Reset() {
+	*x = GroupTags{}
+	mi := &file_users_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupTags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupTags) ProtoMessage() {}
+
+func (x *GroupTags) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupTags.ProtoReflect.Descriptor instead.
+func (*GroupTags) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GroupTags) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// Request message for promoting or demoting a group member
+type GroupRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	RequesterId   int64                  `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	MemberId      int64                  `protobuf:"varint,3,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"` //admin, moderator or member
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupRoleRequest) Reset() {
+	*x = GroupRoleRequest{}
+	mi := &file_users_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupRoleRequest) ProtoMessage() {}
+
+func (x *GroupRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupRoleRequest.ProtoReflect.Descriptor instead.
+func (*GroupRoleRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GroupRoleRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *GroupRoleRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *GroupRoleRequest) GetMemberId() int64 {
+	if x != nil {
+		return x.MemberId
+	}
+	return 0
+}
+
+func (x *GroupRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// Request message for offering group ownership to a member
+type TransferOwnershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	OwnerId       int64                  `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	NewOwnerId    int64                  `protobuf:"varint,3,opt,name=new_owner_id,json=newOwnerId,proto3" json:"new_owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferOwnershipRequest) Reset() {
+	*x = TransferOwnershipRequest{}
+	mi := &file_users_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TransferOwnershipRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *TransferOwnershipRequest) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *TransferOwnershipRequest) GetNewOwnerId() int64 {
+	if x != nil {
+		return x.NewOwnerId
+	}
+	return 0
+}
+
+// Request message for accepting or declining a group ownership offer
+type HandleOwnershipTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` //the user ownership was offered to
+	Accepted      bool                   `protobuf:"varint,3,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandleOwnershipTransferRequest) Reset() {
+	*x = HandleOwnershipTransferRequest{}
+	mi := &file_users_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandleOwnershipTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleOwnershipTransferRequest) ProtoMessage() {}
+
+func (x *HandleOwnershipTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleOwnershipTransferRequest.ProtoReflect.Descriptor instead.
+func (*HandleOwnershipTransferRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *HandleOwnershipTransferRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *HandleOwnershipTransferRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *HandleOwnershipTransferRequest) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+// Request message for checking a user's permission in a group
+type GroupPermissionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Permission    string                 `protobuf:"bytes,3,opt,name=permission,proto3" json:"permission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupPermissionRequest) Reset() {
+	*x = GroupPermissionRequest{}
+	mi := &file_users_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupPermissionRequest) ProtoMessage() {}
+
+func (x *GroupPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupPermissionRequest.ProtoReflect.Descriptor instead.
+func (*GroupPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GroupPermissionRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *GroupPermissionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GroupPermissionRequest) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+// A pending join request with its requester
+type PendingJoinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *common.User           `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	RequestedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` //nil if join requests don't expire
+	RulesAccepted bool                   `protobuf:"varint,4,opt,name=rules_accepted,json=rulesAccepted,proto3" json:"rules_accepted,omitempty"`
+	Answers       []*GroupJoinAnswer     `protobuf:"bytes,5,rep,name=answers,proto3" json:"answers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PendingJoinRequest) Reset() {
+	*x = PendingJoinRequest{}
+	mi := &file_users_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PendingJoinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingJoinRequest) ProtoMessage() {}
+
+func (x *PendingJoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingJoinRequest.ProtoReflect.Descriptor instead.
+func (*PendingJoinRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PendingJoinRequest) GetUser() *common.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *PendingJoinRequest) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+func (x *PendingJoinRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *PendingJoinRequest) GetRulesAccepted() bool {
+	if x != nil {
+		return x.RulesAccepted
+	}
+	return false
+}
+
+func (x *PendingJoinRequest) GetAnswers() []*GroupJoinAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type PendingJoinRequestArr struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*PendingJoinRequest  `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PendingJoinRequestArr) Reset() {
+	*x = PendingJoinRequestArr{}
+	mi := &file_users_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PendingJoinRequestArr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingJoinRequestArr) ProtoMessage() {}
+
+func (x *PendingJoinRequestArr) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingJoinRequestArr.ProtoReflect.Descriptor instead.
+func (*PendingJoinRequestArr) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PendingJoinRequestArr) GetRequests() []*PendingJoinRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+// Request message for creating a group invite link
+type CreateGroupInviteLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	RequesterId   int64                  `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` //nil if the link never expires
+	MaxUses       int32                  `protobuf:"varint,4,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`      //0 for unlimited uses
+	AutoApprove   bool                   `protobuf:"varint,5,opt,name=auto_approve,json=autoApprove,proto3" json:"auto_approve,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupInviteLinkRequest) Reset() {
+	*x = CreateGroupInviteLinkRequest{}
+	mi := &file_users_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupInviteLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupInviteLinkRequest) ProtoMessage() {}
+
+func (x *CreateGroupInviteLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupInviteLinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupInviteLinkRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreateGroupInviteLinkRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *CreateGroupInviteLinkRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *CreateGroupInviteLinkRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateGroupInviteLinkRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreateGroupInviteLinkRequest) GetAutoApprove() bool {
+	if x != nil {
+		return x.AutoApprove
+	}
+	return false
+}
+
+// A group invite link with its usage stats
+type GroupInviteLink struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	LinkId         int64                  `protobuf:"varint,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	GroupId        int64                  `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Token          string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	CreatedBy      int64                  `protobuf:"varint,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` //nil if the link never expires
+	MaxUses        int32                  `protobuf:"varint,7,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`      //0 for unlimited uses
+	AutoApprove    bool                   `protobuf:"varint,8,opt,name=auto_approve,json=autoApprove,proto3" json:"auto_approve,omitempty"`
+	Revoked        bool                   `protobuf:"varint,9,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	Uses           int32                  `protobuf:"varint,10,opt,name=uses,proto3" json:"uses,omitempty"`
+	JoinedCount    int64                  `protobuf:"varint,11,opt,name=joined_count,json=joinedCount,proto3" json:"joined_count,omitempty"`          //users that joined through the link
+	RequestedCount int64                  `protobuf:"varint,12,opt,name=requested_count,json=requestedCount,proto3" json:"requested_count,omitempty"` //users that requested to join through the link
+	LastUsedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GroupInviteLink) Reset() {
+	*x = GroupInviteLink{}
+	mi := &file_users_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupInviteLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupInviteLink) ProtoMessage() {}
+
+func (x *GroupInviteLink) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupInviteLink.ProtoReflect.Descriptor instead.
+func (*GroupInviteLink) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GroupInviteLink) GetLinkId() int64 {
+	if x != nil {
+		return x.LinkId
+	}
+	return 0
+}
+
+func (x *GroupInviteLink) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *GroupInviteLink) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GroupInviteLink) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *GroupInviteLink) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GroupInviteLink) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *GroupInviteLink) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *GroupInviteLink) GetAutoApprove() bool {
+	if x != nil {
+		return x.AutoApprove
+	}
+	return false
+}
+
+func (x *GroupInviteLink) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+func (x *GroupInviteLink) GetUses() int32 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
+}
+
+func (x *GroupInviteLink) GetJoinedCount() int64 {
+	if x != nil {
+		return x.JoinedCount
+	}
+	return 0
+}
+
+func (x *GroupInviteLink) GetRequestedCount() int64 {
+	if x != nil {
+		return x.RequestedCount
+	}
+	return 0
+}
+
+func (x *GroupInviteLink) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+type GroupInviteLinkArr struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Links         []*GroupInviteLink     `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupInviteLinkArr) Reset() {
+	*x = GroupInviteLinkArr{}
+	mi := &file_users_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupInviteLinkArr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupInviteLinkArr) ProtoMessage() {}
+
+func (x *GroupInviteLinkArr) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupInviteLinkArr.ProtoReflect.Descriptor instead.
+func (*GroupInviteLinkArr) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GroupInviteLinkArr) GetLinks() []*GroupInviteLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+// Request message for revoking a group invite link
+type RevokeGroupInviteLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	RequesterId   int64                  `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	LinkId        int64                  `protobuf:"varint,3,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeGroupInviteLinkRequest) Reset() {
+	*x = RevokeGroupInviteLinkRequest{}
+	mi := &file_users_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeGroupInviteLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeGroupInviteLinkRequest) ProtoMessage() {}
+
+func (x *RevokeGroupInviteLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeGroupInviteLinkRequest.ProtoReflect.Descriptor instead.
+func (*RevokeGroupInviteLinkRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RevokeGroupInviteLinkRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *RevokeGroupInviteLinkRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *RevokeGroupInviteLinkRequest) GetLinkId() int64 {
+	if x != nil {
+		return x.LinkId
+	}
+	return 0
+}
+
+// Request message for banning a user from a group
+type BanFromGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	RequesterId   int64                  `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` //nil for a permanent ban
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanFromGroupRequest) Reset() {
+	*x = BanFromGroupRequest{}
+	mi := &file_users_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanFromGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanFromGroupRequest) ProtoMessage() {}
+
+func (x *BanFromGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanFromGroupRequest.ProtoReflect.Descriptor instead.
+func (*BanFromGroupRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *BanFromGroupRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *BanFromGroupRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *BanFromGroupRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BanFromGroupRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BanFromGroupRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// Request message for lifting a group ban
+type UnbanFromGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	RequesterId   int64                  `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnbanFromGroupRequest) Reset() {
+	*x = UnbanFromGroupRequest{}
+	mi := &file_users_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbanFromGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanFromGroupRequest) ProtoMessage() {}
+
+func (x *UnbanFromGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanFromGroupRequest.ProtoReflect.Descriptor instead.
+func (*UnbanFromGroupRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UnbanFromGroupRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *UnbanFromGroupRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *UnbanFromGroupRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// An active group ban with the banned user
+type GroupBan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *common.User           `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	BannedBy      int64                  `protobuf:"varint,2,opt,name=banned_by,json=bannedBy,proto3" json:"banned_by,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` //nil if the ban is permanent
+	BannedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=banned_at,json=bannedAt,proto3" json:"banned_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupBan) Reset() {
+	*x = GroupBan{}
+	mi := &file_users_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupBan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupBan) ProtoMessage() {}
+
+func (x *GroupBan) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupBan.ProtoReflect.Descriptor instead.
+func (*GroupBan) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GroupBan) GetUser() *common.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GroupBan) GetBannedBy() int64 {
+	if x != nil {
+		return x.BannedBy
+	}
+	return 0
+}
+
+func (x *GroupBan) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *GroupBan) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *GroupBan) GetBannedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BannedAt
+	}
+	return nil
+}
+
+type GroupBanArr struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bans          []*GroupBan            `protobuf:"bytes,1,rep,name=bans,proto3" json:"bans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupBanArr) Reset() {
+	*x = GroupBanArr{}
+	mi := &file_users_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupBanArr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupBanArr) ProtoMessage() {}
+
+func (x *GroupBanArr) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupBanArr.ProtoReflect.Descriptor instead.
+func (*GroupBanArr) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GroupBanArr) GetBans() []*GroupBan {
+	if x != nil {
+		return x.Bans
+	}
+	return nil
+}
+
+// Request message for joining a group through an invite link
+type JoinGroupByLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	AcceptRules   bool                   `protobuf:"varint,3,opt,name=accept_rules,json=acceptRules,proto3" json:"accept_rules,omitempty"` //only checked when the link creates a join request
+	Answers       []*GroupJoinAnswer     `protobuf:"bytes,4,rep,name=answers,proto3" json:"answers,omitempty"`                             //only checked when the link creates a join request
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinGroupByLinkRequest) Reset() {
+	*x = JoinGroupByLinkRequest{}
+	mi := &file_users_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinGroupByLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGroupByLinkRequest) ProtoMessage() {}
+
+func (x *JoinGroupByLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGroupByLinkRequest.ProtoReflect.Descriptor instead.
+func (*JoinGroupByLinkRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *JoinGroupByLinkRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *JoinGroupByLinkRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *JoinGroupByLinkRequest) GetAcceptRules() bool {
+	if x != nil {
+		return x.AcceptRules
+	}
+	return false
+}
+
+func (x *JoinGroupByLinkRequest) GetAnswers() []*GroupJoinAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type JoinGroupByLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Joined        bool                   `protobuf:"varint,2,opt,name=joined,proto3" json:"joined,omitempty"` //false if a join request was created instead
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinGroupByLinkResponse) Reset() {
+	*x = JoinGroupByLinkResponse{}
+	mi := &file_users_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinGroupByLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGroupByLinkResponse) ProtoMessage() {}
+
+func (x *JoinGroupByLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGroupByLinkResponse.ProtoReflect.Descriptor instead.
+func (*JoinGroupByLinkResponse) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *JoinGroupByLinkResponse) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *JoinGroupByLinkResponse) GetJoined() bool {
+	if x != nil {
+		return x.Joined
+	}
+	return false
+}
+
+// Request message for retrieving a user's profile
+type GetUserProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RequesterId   int64                  `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetUserProfileRequest) Reset() {
 	*x = GetUserProfileRequest{}
-	mi := &file_users_proto_msgTypes[30]
+	mi := &file_users_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2024,7 +4109,7 @@ func (x *GetUserProfileRequest) String() string {
 func (*GetUserProfileRequest) ProtoMessage() {}
 
 func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_users_proto_msgTypes[30]
+	mi := &file_users_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2037,7 +4122,7 @@ func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserProfileRequest.ProtoReflect.Descriptor instead.
 func (*GetUserProfileRequest) Descriptor() ([]byte, []int) {
-	return file_users_proto_rawDescGZIP(), []int{30}
+	return file_users_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *GetUserProfileRequest) GetUserId() int64 {
@@ -2065,7 +4150,7 @@ type UserSearchRequest struct {
 
 func (x *UserSearchRequest) Reset() {
 	*x = UserSearchRequest{}
-	mi := &file_users_proto_msgTypes[31]
+	mi := &file_users_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2077,7 +4162,7 @@ func (x *UserSearchRequest) String() string {
 func (*UserSearchRequest) ProtoMessage() {}
 
 func (x *UserSearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_users_proto_msgTypes[31]
+	mi := &file_users_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2090,7 +4175,7 @@ func (x *UserSearchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSearchRequest.ProtoReflect.Descriptor instead.
 func (*UserSearchRequest) Descriptor() ([]byte, []int) {
-	return file_users_proto_rawDescGZIP(), []int{31}
+	return file_users_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *UserSearchRequest) GetSearchTerm() string {
@@ -2124,7 +4209,7 @@ type UpdateProfileRequest struct {
 
 func (x *UpdateProfileRequest) Reset() {
 	*x = UpdateProfileRequest{}
-	mi := &file_users_proto_msgTypes[32]
+	mi := &file_users_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2136,7 +4221,7 @@ func (x *UpdateProfileRequest) String() string {
 func (*UpdateProfileRequest) ProtoMessage() {}
 
 func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_users_proto_msgTypes[32]
+	mi := &file_users_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2149,7 +4234,7 @@ func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
-	return file_users_proto_rawDescGZIP(), []int{32}
+	return file_users_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *UpdateProfileRequest) GetUserId() int64 {
@@ -2219,7 +4304,7 @@ type UpdateProfilePrivacyRequest struct {
 
 func (x *UpdateProfilePrivacyRequest) Reset() {
 	*x = UpdateProfilePrivacyRequest{}
-	mi := &file_users_proto_msgTypes[33]
+	mi := &file_users_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2231,7 +4316,7 @@ func (x *UpdateProfilePrivacyRequest) String() string {
 func (*UpdateProfilePrivacyRequest) ProtoMessage() {}
 
 func (x *UpdateProfilePrivacyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_users_proto_msgTypes[33]
+	mi := &file_users_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2244,7 +4329,7 @@ func (x *UpdateProfilePrivacyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProfilePrivacyRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProfilePrivacyRequest) Descriptor() ([]byte, []int) {
-	return file_users_proto_rawDescGZIP(), []int{33}
+	return file_users_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *UpdateProfilePrivacyRequest) GetUserId() int64 {
@@ -2261,6 +4346,267 @@ func (x *UpdateProfilePrivacyRequest) GetPublic() bool {
 	return false
 }
 
+// Request message for changing own username
+type ChangeUsernameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeUsernameRequest) Reset() {
+	*x = ChangeUsernameRequest{}
+	mi := &file_users_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeUsernameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUsernameRequest) ProtoMessage() {}
+
+func (x *ChangeUsernameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUsernameRequest.ProtoReflect.Descriptor instead.
+func (*ChangeUsernameRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ChangeUsernameRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ChangeUsernameRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// Response message for resolving a username to a user
+type ResolvedHandle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`      //current username
+	Redirected    bool                   `protobuf:"varint,3,opt,name=redirected,proto3" json:"redirected,omitempty"` //true if resolved through a former username
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolvedHandle) Reset() {
+	*x = ResolvedHandle{}
+	mi := &file_users_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolvedHandle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolvedHandle) ProtoMessage() {}
+
+func (x *ResolvedHandle) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolvedHandle.ProtoReflect.Descriptor instead.
+func (*ResolvedHandle) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ResolvedHandle) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ResolvedHandle) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ResolvedHandle) GetRedirected() bool {
+	if x != nil {
+		return x.Redirected
+	}
+	return false
+}
+
+// Message describing a user's granular privacy settings
+// Audience values are one of "everyone", "followers", "mutuals", "nobody"
+type PrivacySettings struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UserId               int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WhoCanMessage        string                 `protobuf:"bytes,2,opt,name=who_can_message,json=whoCanMessage,proto3" json:"who_can_message,omitempty"`
+	WhoCanComment        string                 `protobuf:"bytes,3,opt,name=who_can_comment,json=whoCanComment,proto3" json:"who_can_comment,omitempty"`                          //comments on the user's posts
+	WhoCanSeeFollowLists string                 `protobuf:"bytes,4,opt,name=who_can_see_follow_lists,json=whoCanSeeFollowLists,proto3" json:"who_can_see_follow_lists,omitempty"` //followers and following lists
+	WhoCanInviteToGroups string                 `protobuf:"bytes,5,opt,name=who_can_invite_to_groups,json=whoCanInviteToGroups,proto3" json:"who_can_invite_to_groups,omitempty"`
+	Discoverable         bool                   `protobuf:"varint,6,opt,name=discoverable,proto3" json:"discoverable,omitempty"` //appears in user search and follow suggestions
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *PrivacySettings) Reset() {
+	*x = PrivacySettings{}
+	mi := &file_users_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivacySettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivacySettings) ProtoMessage() {}
+
+func (x *PrivacySettings) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivacySettings.ProtoReflect.Descriptor instead.
+func (*PrivacySettings) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *PrivacySettings) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PrivacySettings) GetWhoCanMessage() string {
+	if x != nil {
+		return x.WhoCanMessage
+	}
+	return ""
+}
+
+func (x *PrivacySettings) GetWhoCanComment() string {
+	if x != nil {
+		return x.WhoCanComment
+	}
+	return ""
+}
+
+func (x *PrivacySettings) GetWhoCanSeeFollowLists() string {
+	if x != nil {
+		return x.WhoCanSeeFollowLists
+	}
+	return ""
+}
+
+func (x *PrivacySettings) GetWhoCanInviteToGroups() string {
+	if x != nil {
+		return x.WhoCanInviteToGroups
+	}
+	return ""
+}
+
+func (x *PrivacySettings) GetDiscoverable() bool {
+	if x != nil {
+		return x.Discoverable
+	}
+	return false
+}
+
+// Request message for checking whether actor can interact with target
+type CanInteractRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorId       int64                  `protobuf:"varint,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	TargetId      int64                  `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"` //one of "message", "comment", "view_follow_lists", "invite_to_group"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanInteractRequest) Reset() {
+	*x = CanInteractRequest{}
+	mi := &file_users_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanInteractRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanInteractRequest) ProtoMessage() {}
+
+func (x *CanInteractRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanInteractRequest.ProtoReflect.Descriptor instead.
+func (*CanInteractRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CanInteractRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *CanInteractRequest) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *CanInteractRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
 var File_users_proto protoreflect.FileDescriptor
 
 const file_users_proto_rawDesc = "" +
@@ -2325,12 +4671,13 @@ const file_users_proto_rawDesc = "" +
 	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\"C\n" +
 	"\x12UpdateEmailRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
-	"\x05email\x18\x02 \x01(\tR\x05email\"S\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\"v\n" +
 	"\n" +
 	"Pagination\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\"Z\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12!\n" +
+	"\frequester_id\x18\x04 \x01(\x03R\vrequesterId\"Z\n" +
 	"\x11FollowUserRequest\x12\x1f\n" +
 	"\vfollower_id\x18\x01 \x01(\x03R\n" +
 	"followerId\x12$\n" +
@@ -2349,7 +4696,7 @@ const file_users_proto_rawDesc = "" +
 	"\x0etarget_user_id\x18\x02 \x01(\x03R\ftargetUserId\"\x8f\x01\n" +
 	"\x1dAreFollowingEachOtherResponse\x126\n" +
 	"\x17follower_follows_target\x18\x01 \x01(\bR\x15followerFollowsTarget\x126\n" +
-	"\x17target_follows_follower\x18\x02 \x01(\bR\x15targetFollowsFollower\"\x91\x03\n" +
+	"\x17target_follows_follower\x18\x02 \x01(\bR\x15targetFollowsFollower\"\xe0\x04\n" +
 	"\x05Group\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12$\n" +
 	"\x0egroup_owner_id\x18\x02 \x01(\x03R\fgroupOwnerId\x12\x1f\n" +
@@ -2363,7 +4710,15 @@ const file_users_proto_rawDesc = "" +
 	"\bis_owner\x18\t \x01(\bR\aisOwner\x12'\n" +
 	"\x0fpending_request\x18\n" +
 	" \x01(\bR\x0ependingRequest\x12%\n" +
-	"\x0epending_invite\x18\v \x01(\bR\rpendingInvite\"5\n" +
+	"\x0epending_invite\x18\v \x01(\bR\rpendingInvite\x12+\n" +
+	"\x11ownership_offered\x18\f \x01(\bR\x10ownershipOffered\x12\x1a\n" +
+	"\barchived\x18\r \x01(\bR\barchived\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x0e \x01(\tR\n" +
+	"visibility\x12\x1a\n" +
+	"\bcategory\x18\x0f \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\x10 \x03(\tR\x04tags\x124\n" +
+	"\x16posts_require_approval\x18\x11 \x01(\bR\x14postsRequireApproval\"5\n" +
 	"\bGroupArr\x12)\n" +
 	"\tgroup_arr\x18\x01 \x03(\v2\f.users.GroupR\bgroupArr\"I\n" +
 	"\x13GeneralGroupRequest\x12\x19\n" +
@@ -2389,16 +4744,87 @@ const file_users_proto_rawDesc = "" +
 	"searchTerm\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x04 \x01(\x05R\x06offset\"\x82\x01\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"z\n" +
+	"\x15DiscoverGroupsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"U\n" +
+	"\x0fGroupDailyCount\x12,\n" +
+	"\x03day\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x03day\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"o\n" +
+	"\x11GroupMemberGrowth\x12,\n" +
+	"\x03day\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x03day\x12\x16\n" +
+	"\x06joined\x18\x02 \x01(\x03R\x06joined\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\"y\n" +
+	"\x11GroupActivePoster\x12 \n" +
+	"\x04user\x18\x01 \x01(\v2\f.common.UserR\x04user\x12\x1d\n" +
+	"\n" +
+	"post_count\x18\x02 \x01(\x03R\tpostCount\x12#\n" +
+	"\rcomment_count\x18\x03 \x01(\x03R\fcommentCount\"\xf4\x01\n" +
+	"\x14GroupEventAttendance\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x129\n" +
+	"\n" +
+	"event_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\teventDate\x12\x1f\n" +
+	"\vgoing_count\x18\x04 \x01(\x05R\n" +
+	"goingCount\x12&\n" +
+	"\x0fnot_going_count\x18\x05 \x01(\x05R\rnotGoingCount\x12'\n" +
+	"\x0fattendance_rate\x18\x06 \x01(\x01R\x0eattendanceRate\"\xd3\x01\n" +
+	"\x15GroupJoinRequestStats\x12\x1a\n" +
+	"\baccepted\x18\x01 \x01(\x03R\baccepted\x12\x1a\n" +
+	"\brejected\x18\x02 \x01(\x03R\brejected\x12\x18\n" +
+	"\apending\x18\x03 \x01(\x03R\apending\x120\n" +
+	"\x14avg_approval_seconds\x18\x04 \x01(\x01R\x12avgApprovalSeconds\x126\n" +
+	"\x17median_approval_seconds\x18\x05 \x01(\x01R\x15medianApprovalSeconds\"\xdd\x04\n" +
+	"\rGroupInsights\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x120\n" +
+	"\x05since\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x12;\n" +
+	"\vcomputed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"computedAt\x12#\n" +
+	"\rmembers_count\x18\x04 \x01(\x05R\fmembersCount\x12=\n" +
+	"\rmember_growth\x18\x05 \x03(\v2\x18.users.GroupMemberGrowthR\fmemberGrowth\x12:\n" +
+	"\rposts_per_day\x18\x06 \x03(\v2\x16.users.GroupDailyCountR\vpostsPerDay\x12@\n" +
+	"\x10comments_per_day\x18\a \x03(\v2\x16.users.GroupDailyCountR\x0ecommentsPerDay\x12?\n" +
+	"\x0eactive_posters\x18\b \x03(\v2\x18.users.GroupActivePosterR\ractivePosters\x123\n" +
+	"\x06events\x18\t \x03(\v2\x1b.users.GroupEventAttendanceR\x06events\x12'\n" +
+	"\x0fattendance_rate\x18\n" +
+	" \x01(\x01R\x0eattendanceRate\x12A\n" +
+	"\rjoin_requests\x18\v \x01(\v2\x1c.users.GroupJoinRequestStatsR\fjoinRequests\"\x82\x01\n" +
 	"\x14InviteToGroupRequest\x12\x1d\n" +
 	"\n" +
 	"inviter_id\x18\x01 \x01(\x03R\tinviterId\x120\n" +
 	"\vinvited_ids\x18\x02 \x01(\v2\x0f.common.UserIdsR\n" +
 	"invitedIds\x12\x19\n" +
-	"\bgroup_id\x18\x03 \x01(\x03R\agroupId\"P\n" +
+	"\bgroup_id\x18\x03 \x01(\x03R\agroupId\"\xa5\x01\n" +
 	"\x10GroupJoinRequest\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12!\n" +
-	"\frequester_id\x18\x02 \x01(\x03R\vrequesterId\"p\n" +
+	"\frequester_id\x18\x02 \x01(\x03R\vrequesterId\x12!\n" +
+	"\faccept_rules\x18\x03 \x01(\bR\vacceptRules\x120\n" +
+	"\aanswers\x18\x04 \x03(\v2\x16.users.GroupJoinAnswerR\aanswers\"l\n" +
+	"\x11GroupJoinQuestion\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\x03R\n" +
+	"questionId\x12\x1a\n" +
+	"\bquestion\x18\x02 \x01(\tR\bquestion\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\bR\brequired\"f\n" +
+	"\x0fGroupJoinAnswer\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\x03R\n" +
+	"questionId\x12\x1a\n" +
+	"\bquestion\x18\x02 \x01(\tR\bquestion\x12\x16\n" +
+	"\x06answer\x18\x03 \x01(\tR\x06answer\"x\n" +
+	"\rGroupJoinForm\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12\x14\n" +
+	"\x05rules\x18\x02 \x03(\tR\x05rules\x126\n" +
+	"\tquestions\x18\x03 \x03(\v2\x18.users.GroupJoinQuestionR\tquestions\"\xa5\x01\n" +
+	"\x17SetGroupJoinFormRequest\x12!\n" +
+	"\frequester_id\x18\x01 \x01(\x03R\vrequesterId\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\x03R\agroupId\x12\x14\n" +
+	"\x05rules\x18\x03 \x03(\tR\x05rules\x126\n" +
+	"\tquestions\x18\x04 \x03(\v2\x18.users.GroupJoinQuestionR\tquestions\"w\n" +
+	"\x1bSetGroupPostApprovalRequest\x12!\n" +
+	"\frequester_id\x18\x01 \x01(\x03R\vrequesterId\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\x03R\agroupId\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\bR\brequired\"p\n" +
 	"\x18HandleGroupInviteRequest\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12\x1d\n" +
 	"\n" +
@@ -2412,13 +4838,18 @@ const file_users_proto_rawDesc = "" +
 	"\x16RemoveFromGroupRequest\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12\x1b\n" +
 	"\tmember_id\x18\x02 \x01(\x03R\bmemberId\x12\x19\n" +
-	"\bowner_id\x18\x03 \x01(\x03R\aownerId\"\xa3\x01\n" +
+	"\bowner_id\x18\x03 \x01(\x03R\aownerId\"\xf3\x01\n" +
 	"\x12CreateGroupRequest\x12\x19\n" +
 	"\bowner_id\x18\x01 \x01(\x03R\aownerId\x12\x1f\n" +
 	"\vgroup_title\x18\x02 \x01(\tR\n" +
 	"groupTitle\x12+\n" +
 	"\x11group_description\x18\x03 \x01(\tR\x10groupDescription\x12$\n" +
-	"\x0egroup_image_id\x18\x04 \x01(\x03R\fgroupImageId\"\xe9\x01\n" +
+	"\x0egroup_image_id\x18\x04 \x01(\x03R\fgroupImageId\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x05 \x01(\tR\n" +
+	"visibility\x12\x1a\n" +
+	"\bcategory\x18\x06 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\"\xcb\x02\n" +
 	"\x12UpdateGroupRequest\x12!\n" +
 	"\frequester_id\x18\x01 \x01(\x03R\vrequesterId\x12\x19\n" +
 	"\bgroup_id\x18\x02 \x01(\x03R\agroupId\x12\x1f\n" +
@@ -2426,7 +4857,103 @@ const file_users_proto_rawDesc = "" +
 	"groupTitle\x12+\n" +
 	"\x11group_description\x18\x04 \x01(\tR\x10groupDescription\x12$\n" +
 	"\x0egroup_image_id\x18\x05 \x01(\x03R\fgroupImageId\x12!\n" +
-	"\fdelete_image\x18\x06 \x01(\bR\vdeleteImage\"S\n" +
+	"\fdelete_image\x18\x06 \x01(\bR\vdeleteImage\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\a \x01(\tR\n" +
+	"visibility\x12\x1a\n" +
+	"\bcategory\x18\b \x01(\tR\bcategory\x12$\n" +
+	"\x04tags\x18\t \x01(\v2\x10.users.GroupTagsR\x04tags\"#\n" +
+	"\tGroupTags\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\tR\x06values\"\x81\x01\n" +
+	"\x10GroupRoleRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12!\n" +
+	"\frequester_id\x18\x02 \x01(\x03R\vrequesterId\x12\x1b\n" +
+	"\tmember_id\x18\x03 \x01(\x03R\bmemberId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\"r\n" +
+	"\x18TransferOwnershipRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\x03R\aownerId\x12 \n" +
+	"\fnew_owner_id\x18\x03 \x01(\x03R\n" +
+	"newOwnerId\"p\n" +
+	"\x1eHandleOwnershipTransferRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\baccepted\x18\x03 \x01(\bR\baccepted\"l\n" +
+	"\x16GroupPermissionRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x03 \x01(\tR\n" +
+	"permission\"\x89\x02\n" +
+	"\x12PendingJoinRequest\x12 \n" +
+	"\x04user\x18\x01 \x01(\v2\f.common.UserR\x04user\x12=\n" +
+	"\frequested_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12%\n" +
+	"\x0erules_accepted\x18\x04 \x01(\bR\rrulesAccepted\x120\n" +
+	"\aanswers\x18\x05 \x03(\v2\x16.users.GroupJoinAnswerR\aanswers\"N\n" +
+	"\x15PendingJoinRequestArr\x125\n" +
+	"\brequests\x18\x01 \x03(\v2\x19.users.PendingJoinRequestR\brequests\"\xd5\x01\n" +
+	"\x1cCreateGroupInviteLinkRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12!\n" +
+	"\frequester_id\x18\x02 \x01(\x03R\vrequesterId\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x19\n" +
+	"\bmax_uses\x18\x04 \x01(\x05R\amaxUses\x12!\n" +
+	"\fauto_approve\x18\x05 \x01(\bR\vautoApprove\"\xe6\x03\n" +
+	"\x0fGroupInviteLink\x12\x17\n" +
+	"\alink_id\x18\x01 \x01(\x03R\x06linkId\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\x03R\agroupId\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\x03R\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x19\n" +
+	"\bmax_uses\x18\a \x01(\x05R\amaxUses\x12!\n" +
+	"\fauto_approve\x18\b \x01(\bR\vautoApprove\x12\x18\n" +
+	"\arevoked\x18\t \x01(\bR\arevoked\x12\x12\n" +
+	"\x04uses\x18\n" +
+	" \x01(\x05R\x04uses\x12!\n" +
+	"\fjoined_count\x18\v \x01(\x03R\vjoinedCount\x12'\n" +
+	"\x0frequested_count\x18\f \x01(\x03R\x0erequestedCount\x12<\n" +
+	"\flast_used_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\"B\n" +
+	"\x12GroupInviteLinkArr\x12,\n" +
+	"\x05links\x18\x01 \x03(\v2\x16.users.GroupInviteLinkR\x05links\"u\n" +
+	"\x1cRevokeGroupInviteLinkRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12!\n" +
+	"\frequester_id\x18\x02 \x01(\x03R\vrequesterId\x12\x17\n" +
+	"\alink_id\x18\x03 \x01(\x03R\x06linkId\"\xbf\x01\n" +
+	"\x13BanFromGroupRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12!\n" +
+	"\frequester_id\x18\x02 \x01(\x03R\vrequesterId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"n\n" +
+	"\x15UnbanFromGroupRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12!\n" +
+	"\frequester_id\x18\x02 \x01(\x03R\vrequesterId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\"\xd5\x01\n" +
+	"\bGroupBan\x12 \n" +
+	"\x04user\x18\x01 \x01(\v2\f.common.UserR\x04user\x12\x1b\n" +
+	"\tbanned_by\x18\x02 \x01(\x03R\bbannedBy\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x127\n" +
+	"\tbanned_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bbannedAt\"2\n" +
+	"\vGroupBanArr\x12#\n" +
+	"\x04bans\x18\x01 \x03(\v2\x0f.users.GroupBanR\x04bans\"\x9c\x01\n" +
+	"\x16JoinGroupByLinkRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12!\n" +
+	"\faccept_rules\x18\x03 \x01(\bR\vacceptRules\x120\n" +
+	"\aanswers\x18\x04 \x03(\v2\x16.users.GroupJoinAnswerR\aanswers\"L\n" +
+	"\x17JoinGroupByLinkResponse\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12\x16\n" +
+	"\x06joined\x18\x02 \x01(\bR\x06joined\"S\n" +
 	"\x15GetUserProfileRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12!\n" +
 	"\frequester_id\x18\x02 \x01(\x03R\vrequesterId\"J\n" +
@@ -2446,19 +4973,41 @@ const file_users_proto_rawDesc = "" +
 	"\fdelete_image\x18\b \x01(\bR\vdeleteImage\"N\n" +
 	"\x1bUpdateProfilePrivacyRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
-	"\x06public\x18\x02 \x01(\bR\x06public2\xd3\x15\n" +
+	"\x06public\x18\x02 \x01(\bR\x06public\"L\n" +
+	"\x15ChangeUsernameRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"e\n" +
+	"\x0eResolvedHandle\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1e\n" +
+	"\n" +
+	"redirected\x18\x03 \x01(\bR\n" +
+	"redirected\"\x8e\x02\n" +
+	"\x0fPrivacySettings\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12&\n" +
+	"\x0fwho_can_message\x18\x02 \x01(\tR\rwhoCanMessage\x12&\n" +
+	"\x0fwho_can_comment\x18\x03 \x01(\tR\rwhoCanComment\x126\n" +
+	"\x18who_can_see_follow_lists\x18\x04 \x01(\tR\x14whoCanSeeFollowLists\x126\n" +
+	"\x18who_can_invite_to_groups\x18\x05 \x01(\tR\x14whoCanInviteToGroups\x12\"\n" +
+	"\fdiscoverable\x18\x06 \x01(\bR\fdiscoverable\"d\n" +
+	"\x12CanInteractRequest\x12\x19\n" +
+	"\bactor_id\x18\x01 \x01(\x03R\aactorId\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\x03R\btargetId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action2\xec%\n" +
 	"\vUserService\x12G\n" +
 	"\fRegisterUser\x12\x1a.users.RegisterUserRequest\x1a\x1b.users.RegisterUserResponse\x12.\n" +
 	"\tLoginUser\x12\x13.users.LoginRequest\x1a\f.common.User\x12J\n" +
 	"\x12UpdateUserPassword\x12\x1c.users.UpdatePasswordRequest\x1a\x16.google.protobuf.Empty\x12D\n" +
-	"\x0fUpdateUserEmail\x12\x19.users.UpdateEmailRequest\x1a\x16.google.protobuf.Empty\x12=\n" +
+	"\x0fUpdateUserEmail\x12\x19.users.UpdateEmailRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
+	"\x11DeactivateAccount\x12\x1b.google.protobuf.Int64Value\x1a\x16.google.protobuf.Empty\x12=\n" +
 	"\x15GetFollowersPaginated\x12\x11.users.Pagination\x1a\x11.common.ListUsers\x12=\n" +
 	"\x15GetFollowingPaginated\x12\x11.users.Pagination\x1a\x11.common.ListUsers\x12A\n" +
 	"\n" +
 	"FollowUser\x12\x18.users.FollowUserRequest\x1a\x19.users.FollowUserResponse\x12@\n" +
 	"\fUnFollowUser\x12\x18.users.FollowUserRequest\x1a\x16.google.protobuf.Empty\x12P\n" +
 	"\x13HandleFollowRequest\x12!.users.HandleFollowRequestRequest\x1a\x16.google.protobuf.Empty\x12?\n" +
-	"\x0fGetFollowingIds\x12\x1b.google.protobuf.Int64Value\x1a\x0f.common.UserIds\x12F\n" +
+	"\x0fGetFollowingIds\x12\x1b.google.protobuf.Int64Value\x1a\x0f.common.UserIds\x12>\n" +
+	"\x0eGetFollowerIds\x12\x1b.google.protobuf.Int64Value\x1a\x0f.common.UserIds\x12F\n" +
 	"\x14GetFollowSuggestions\x12\x1b.google.protobuf.Int64Value\x1a\x11.common.ListUsers\x12D\n" +
 	"\vIsFollowing\x12\x19.users.IsFollowingRequest\x1a\x1a.google.protobuf.BoolValue\x12W\n" +
 	"\x15AreFollowingEachOther\x12\x18.users.FollowUserRequest\x1a$.users.AreFollowingEachOtherResponse\x12;\n" +
@@ -2468,29 +5017,56 @@ const file_users_proto_rawDesc = "" +
 	"\x11GetGroupBasicInfo\x12\f.users.IdReq\x1a\f.users.Group\x12B\n" +
 	"\x0fGetGroupMembers\x12\x1a.users.GroupMembersRequest\x1a\x13.users.GroupUserArr\x120\n" +
 	"\x14GetAllGroupMemberIds\x12\f.users.IdReq\x1a\n" +
-	".users.Ids\x12L\n" +
-	"\x1bGetPendingGroupJoinRequests\x12\x1a.users.GroupMembersRequest\x1a\x11.common.ListUsers\x12P\n" +
+	".users.Ids\x12:\n" +
+	"\x0fGetUserGroupIds\x12\x1b.google.protobuf.Int64Value\x1a\n" +
+	".users.Ids\x12W\n" +
+	"\x1bGetPendingGroupJoinRequests\x12\x1a.users.GroupMembersRequest\x1a\x1c.users.PendingJoinRequestArr\x12P\n" +
 	" GetPendingGroupJoinRequestsCount\x12\x1a.users.GeneralGroupRequest\x1a\x10.users.CountResp\x12N\n" +
 	"\x1dGetFollowersNotInvitedToGroup\x12\x1a.users.GroupMembersRequest\x1a\x11.common.ListUsers\x12:\n" +
-	"\fSearchGroups\x12\x19.users.GroupSearchRequest\x1a\x0f.users.GroupArr\x12D\n" +
+	"\fSearchGroups\x12\x19.users.GroupSearchRequest\x1a\x0f.users.GroupArr\x12?\n" +
+	"\x0eDiscoverGroups\x12\x1c.users.DiscoverGroupsRequest\x1a\x0f.users.GroupArr\x12D\n" +
+	"\x10GetGroupInsights\x12\x1a.users.GeneralGroupRequest\x1a\x14.users.GroupInsights\x12D\n" +
 	"\rInviteToGroup\x12\x1b.users.InviteToGroupRequest\x1a\x16.google.protobuf.Empty\x12G\n" +
 	"\rIsGroupMember\x12\x1a.users.GeneralGroupRequest\x1a\x1a.google.protobuf.BoolValue\x12C\n" +
-	"\x10RequestJoinGroup\x12\x17.users.GroupJoinRequest\x1a\x16.google.protobuf.Empty\x12I\n" +
+	"\x10RequestJoinGroup\x12\x17.users.GroupJoinRequest\x1a\x16.google.protobuf.Empty\x12D\n" +
+	"\x10GetGroupJoinForm\x12\x1a.users.GeneralGroupRequest\x1a\x14.users.GroupJoinForm\x12J\n" +
+	"\x10SetGroupJoinForm\x12\x1e.users.SetGroupJoinFormRequest\x1a\x16.google.protobuf.Empty\x12R\n" +
+	"\x14SetGroupPostApproval\x12\".users.SetGroupPostApprovalRequest\x1a\x16.google.protobuf.Empty\x12I\n" +
 	"\x16CancelJoinGroupRequest\x12\x17.users.GroupJoinRequest\x1a\x16.google.protobuf.Empty\x12O\n" +
 	"\x14RespondToGroupInvite\x12\x1f.users.HandleGroupInviteRequest\x1a\x16.google.protobuf.Empty\x12J\n" +
 	"\x16HandleGroupJoinRequest\x12\x18.users.HandleJoinRequest\x1a\x16.google.protobuf.Empty\x12@\n" +
 	"\n" +
 	"LeaveGroup\x12\x1a.users.GeneralGroupRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
-	"\x0fRemoveFromGroup\x12\x1d.users.RemoveFromGroupRequest\x1a\x16.google.protobuf.Empty\x12E\n" +
+	"\x0fRemoveFromGroup\x12\x1d.users.RemoveFromGroupRequest\x1a\x16.google.protobuf.Empty\x12B\n" +
+	"\fBanFromGroup\x12\x1a.users.BanFromGroupRequest\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\x0eUnbanFromGroup\x12\x1c.users.UnbanFromGroupRequest\x1a\x16.google.protobuf.Empty\x12>\n" +
+	"\fGetGroupBans\x12\x1a.users.GroupMembersRequest\x1a\x12.users.GroupBanArr\x12E\n" +
 	"\vCreateGroup\x12\x19.users.CreateGroupRequest\x1a\x1b.google.protobuf.Int64Value\x12@\n" +
-	"\vUpdateGroup\x12\x19.users.UpdateGroupRequest\x1a\x16.google.protobuf.Empty\x12=\n" +
+	"\vUpdateGroup\x12\x19.users.UpdateGroupRequest\x1a\x16.google.protobuf.Empty\x12E\n" +
+	"\x12PromoteGroupMember\x12\x17.users.GroupRoleRequest\x1a\x16.google.protobuf.Empty\x12D\n" +
+	"\x11DemoteGroupMember\x12\x17.users.GroupRoleRequest\x1a\x16.google.protobuf.Empty\x12O\n" +
+	"\x12HasGroupPermission\x12\x1d.users.GroupPermissionRequest\x1a\x1a.google.protobuf.BoolValue\x12Q\n" +
+	"\x16TransferGroupOwnership\x12\x1f.users.TransferOwnershipRequest\x1a\x16.google.protobuf.Empty\x12[\n" +
+	"\x1aRespondToOwnershipTransfer\x12%.users.HandleOwnershipTransferRequest\x1a\x16.google.protobuf.Empty\x12B\n" +
+	"\fArchiveGroup\x12\x1a.users.GeneralGroupRequest\x1a\x16.google.protobuf.Empty\x12D\n" +
+	"\x0eUnarchiveGroup\x12\x1a.users.GeneralGroupRequest\x1a\x16.google.protobuf.Empty\x12A\n" +
+	"\vDeleteGroup\x12\x1a.users.GeneralGroupRequest\x1a\x16.google.protobuf.Empty\x12T\n" +
+	"\x15CreateGroupInviteLink\x12#.users.CreateGroupInviteLinkRequest\x1a\x16.users.GroupInviteLink\x12L\n" +
+	"\x13GetGroupInviteLinks\x12\x1a.users.GeneralGroupRequest\x1a\x19.users.GroupInviteLinkArr\x12T\n" +
+	"\x15RevokeGroupInviteLink\x12#.users.RevokeGroupInviteLinkRequest\x1a\x16.google.protobuf.Empty\x12P\n" +
+	"\x0fJoinGroupByLink\x12\x1d.users.JoinGroupByLinkRequest\x1a\x1e.users.JoinGroupByLinkResponse\x12=\n" +
 	"\x10GetBasicUserInfo\x12\x1b.google.protobuf.Int64Value\x1a\f.common.User\x12;\n" +
 	"\x15GetBatchBasicUserInfo\x12\x0f.common.UserIds\x1a\x11.common.ListUsers\x12J\n" +
 	"\x0eGetUserProfile\x12\x1c.users.GetUserProfileRequest\x1a\x1a.users.UserProfileResponse\x12:\n" +
 	"\vSearchUsers\x12\x18.users.UserSearchRequest\x1a\x11.common.ListUsers\x12L\n" +
 	"\x11UpdateUserProfile\x12\x1b.users.UpdateProfileRequest\x1a\x1a.users.UserProfileResponse\x12R\n" +
-	"\x14UpdateProfilePrivacy\x12\".users.UpdateProfilePrivacyRequest\x1a\x16.google.protobuf.Empty\x12=\n" +
-	"\fRemoveImages\x12\x15.users.FailedImageIds\x1a\x16.google.protobuf.EmptyB*Z(social-network/shared/gen-go/users;usersb\x06proto3"
+	"\x14UpdateProfilePrivacy\x12\".users.UpdateProfilePrivacyRequest\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\x0eChangeUsername\x12\x1c.users.ChangeUsernameRequest\x1a\x16.google.protobuf.Empty\x12D\n" +
+	"\rResolveHandle\x12\x1c.google.protobuf.StringValue\x1a\x15.users.ResolvedHandle\x12=\n" +
+	"\fRemoveImages\x12\x15.users.FailedImageIds\x1a\x16.google.protobuf.Empty\x12I\n" +
+	"\x12GetPrivacySettings\x12\x1b.google.protobuf.Int64Value\x1a\x16.users.PrivacySettings\x12G\n" +
+	"\x15UpdatePrivacySettings\x12\x16.users.PrivacySettings\x1a\x16.google.protobuf.Empty\x12D\n" +
+	"\vCanInteract\x12\x19.users.CanInteractRequest\x1a\x1a.google.protobuf.BoolValueB*Z(social-network/shared/gen-go/users;usersb\x06proto3"
 
 var (
 	file_users_proto_rawDescOnce sync.Once
@@ -2504,143 +5080,265 @@ func file_users_proto_rawDescGZIP() []byte {
 	return file_users_proto_rawDescData
 }
 
-var file_users_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_users_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
 var file_users_proto_goTypes = []any{
-	(*IdReq)(nil),                         // 0: users.IdReq
-	(*CountResp)(nil),                     // 1: users.CountResp
-	(*Ids)(nil),                           // 2: users.Ids
-	(*FailedImageIds)(nil),                // 3: users.FailedImageIds
-	(*UserProfileResponse)(nil),           // 4: users.UserProfileResponse
-	(*RegisterUserRequest)(nil),           // 5: users.RegisterUserRequest
-	(*RegisterUserResponse)(nil),          // 6: users.RegisterUserResponse
-	(*LoginRequest)(nil),                  // 7: users.LoginRequest
-	(*UpdatePasswordRequest)(nil),         // 8: users.UpdatePasswordRequest
-	(*UpdateEmailRequest)(nil),            // 9: users.UpdateEmailRequest
-	(*Pagination)(nil),                    // 10: users.Pagination
-	(*FollowUserRequest)(nil),             // 11: users.FollowUserRequest
-	(*FollowUserResponse)(nil),            // 12: users.FollowUserResponse
-	(*HandleFollowRequestRequest)(nil),    // 13: users.HandleFollowRequestRequest
-	(*IsFollowingRequest)(nil),            // 14: users.IsFollowingRequest
-	(*AreFollowingEachOtherResponse)(nil), // 15: users.AreFollowingEachOtherResponse
-	(*Group)(nil),                         // 16: users.Group
-	(*GroupArr)(nil),                      // 17: users.GroupArr
-	(*GeneralGroupRequest)(nil),           // 18: users.GeneralGroupRequest
-	(*GroupMembersRequest)(nil),           // 19: users.GroupMembersRequest
-	(*GroupUser)(nil),                     // 20: users.GroupUser
-	(*GroupUserArr)(nil),                  // 21: users.GroupUserArr
-	(*GroupSearchRequest)(nil),            // 22: users.GroupSearchRequest
-	(*InviteToGroupRequest)(nil),          // 23: users.InviteToGroupRequest
-	(*GroupJoinRequest)(nil),              // 24: users.GroupJoinRequest
-	(*HandleGroupInviteRequest)(nil),      // 25: users.HandleGroupInviteRequest
-	(*HandleJoinRequest)(nil),             // 26: users.HandleJoinRequest
-	(*RemoveFromGroupRequest)(nil),        // 27: users.RemoveFromGroupRequest
-	(*CreateGroupRequest)(nil),            // 28: users.CreateGroupRequest
-	(*UpdateGroupRequest)(nil),            // 29: users.UpdateGroupRequest
-	(*GetUserProfileRequest)(nil),         // 30: users.GetUserProfileRequest
-	(*UserSearchRequest)(nil),             // 31: users.UserSearchRequest
-	(*UpdateProfileRequest)(nil),          // 32: users.UpdateProfileRequest
-	(*UpdateProfilePrivacyRequest)(nil),   // 33: users.UpdateProfilePrivacyRequest
-	(*timestamppb.Timestamp)(nil),         // 34: google.protobuf.Timestamp
-	(*common.UserIds)(nil),                // 35: common.UserIds
-	(*wrapperspb.Int64Value)(nil),         // 36: google.protobuf.Int64Value
-	(*common.User)(nil),                   // 37: common.User
-	(*emptypb.Empty)(nil),                 // 38: google.protobuf.Empty
-	(*common.ListUsers)(nil),              // 39: common.ListUsers
-	(*wrapperspb.BoolValue)(nil),          // 40: google.protobuf.BoolValue
+	(*IdReq)(nil),                          // 0: users.IdReq
+	(*CountResp)(nil),                      // 1: users.CountResp
+	(*Ids)(nil),                            // 2: users.Ids
+	(*FailedImageIds)(nil),                 // 3: users.FailedImageIds
+	(*UserProfileResponse)(nil),            // 4: users.UserProfileResponse
+	(*RegisterUserRequest)(nil),            // 5: users.RegisterUserRequest
+	(*RegisterUserResponse)(nil),           // 6: users.RegisterUserResponse
+	(*LoginRequest)(nil),                   // 7: users.LoginRequest
+	(*UpdatePasswordRequest)(nil),          // 8: users.UpdatePasswordRequest
+	(*UpdateEmailRequest)(nil),             // 9: users.UpdateEmailRequest
+	(*Pagination)(nil),                     // 10: users.Pagination
+	(*FollowUserRequest)(nil),              // 11: users.FollowUserRequest
+	(*FollowUserResponse)(nil),             // 12: users.FollowUserResponse
+	(*HandleFollowRequestRequest)(nil),     // 13: users.HandleFollowRequestRequest
+	(*IsFollowingRequest)(nil),             // 14: users.IsFollowingRequest
+	(*AreFollowingEachOtherResponse)(nil),  // 15: users.AreFollowingEachOtherResponse
+	(*Group)(nil),                          // 16: users.Group
+	(*GroupArr)(nil),                       // 17: users.GroupArr
+	(*GeneralGroupRequest)(nil),            // 18: users.GeneralGroupRequest
+	(*GroupMembersRequest)(nil),            // 19: users.GroupMembersRequest
+	(*GroupUser)(nil),                      // 20: users.GroupUser
+	(*GroupUserArr)(nil),                   // 21: users.GroupUserArr
+	(*GroupSearchRequest)(nil),             // 22: users.GroupSearchRequest
+	(*DiscoverGroupsRequest)(nil),          // 23: users.DiscoverGroupsRequest
+	(*GroupDailyCount)(nil),                // 24: users.GroupDailyCount
+	(*GroupMemberGrowth)(nil),              // 25: users.GroupMemberGrowth
+	(*GroupActivePoster)(nil),              // 26: users.GroupActivePoster
+	(*GroupEventAttendance)(nil),           // 27: users.GroupEventAttendance
+	(*GroupJoinRequestStats)(nil),          // 28: users.GroupJoinRequestStats
+	(*GroupInsights)(nil),                  // 29: users.GroupInsights
+	(*InviteToGroupRequest)(nil),           // 30: users.InviteToGroupRequest
+	(*GroupJoinRequest)(nil),               // 31: users.GroupJoinRequest
+	(*GroupJoinQuestion)(nil),              // 32: users.GroupJoinQuestion
+	(*GroupJoinAnswer)(nil),                // 33: users.GroupJoinAnswer
+	(*GroupJoinForm)(nil),                  // 34: users.GroupJoinForm
+	(*SetGroupJoinFormRequest)(nil),        // 35: users.SetGroupJoinFormRequest
+	(*SetGroupPostApprovalRequest)(nil),    // 36: users.SetGroupPostApprovalRequest
+	(*HandleGroupInviteRequest)(nil),       // 37: users.HandleGroupInviteRequest
+	(*HandleJoinRequest)(nil),              // 38: users.HandleJoinRequest
+	(*RemoveFromGroupRequest)(nil),         // 39: users.RemoveFromGroupRequest
+	(*CreateGroupRequest)(nil),             // 40: users.CreateGroupRequest
+	(*UpdateGroupRequest)(nil),             // 41: users.UpdateGroupRequest
+	(*GroupTags)(nil),                      // 42: users.GroupTags
+	(*GroupRoleRequest)(nil),               // 43: users.GroupRoleRequest
+	(*TransferOwnershipRequest)(nil),       // 44: users.TransferOwnershipRequest
+	(*HandleOwnershipTransferRequest)(nil), // 45: users.HandleOwnershipTransferRequest
+	(*GroupPermissionRequest)(nil),         // 46: users.GroupPermissionRequest
+	(*PendingJoinRequest)(nil),             // 47: users.PendingJoinRequest
+	(*PendingJoinRequestArr)(nil),          // 48: users.PendingJoinRequestArr
+	(*CreateGroupInviteLinkRequest)(nil),   // 49: users.CreateGroupInviteLinkRequest
+	(*GroupInviteLink)(nil),                // 50: users.GroupInviteLink
+	(*GroupInviteLinkArr)(nil),             // 51: users.GroupInviteLinkArr
+	(*RevokeGroupInviteLinkRequest)(nil),   // 52: users.RevokeGroupInviteLinkRequest
+	(*BanFromGroupRequest)(nil),            // 53: users.BanFromGroupRequest
+	(*UnbanFromGroupRequest)(nil),          // 54: users.UnbanFromGroupRequest
+	(*GroupBan)(nil),                       // 55: users.GroupBan
+	(*GroupBanArr)(nil),                    // 56: users.GroupBanArr
+	(*JoinGroupByLinkRequest)(nil),         // 57: users.JoinGroupByLinkRequest
+	(*JoinGroupByLinkResponse)(nil),        // 58: users.JoinGroupByLinkResponse
+	(*GetUserProfileRequest)(nil),          // 59: users.GetUserProfileRequest
+	(*UserSearchRequest)(nil),              // 60: users.UserSearchRequest
+	(*UpdateProfileRequest)(nil),           // 61: users.UpdateProfileRequest
+	(*UpdateProfilePrivacyRequest)(nil),    // 62: users.UpdateProfilePrivacyRequest
+	(*ChangeUsernameRequest)(nil),          // 63: users.ChangeUsernameRequest
+	(*ResolvedHandle)(nil),                 // 64: users.ResolvedHandle
+	(*PrivacySettings)(nil),                // 65: users.PrivacySettings
+	(*CanInteractRequest)(nil),             // 66: users.CanInteractRequest
+	(*timestamppb.Timestamp)(nil),          // 67: google.protobuf.Timestamp
+	(*common.User)(nil),                    // 68: common.User
+	(*common.UserIds)(nil),                 // 69: common.UserIds
+	(*wrapperspb.Int64Value)(nil),          // 70: google.protobuf.Int64Value
+	(*wrapperspb.StringValue)(nil),         // 71: google.protobuf.StringValue
+	(*emptypb.Empty)(nil),                  // 72: google.protobuf.Empty
+	(*common.ListUsers)(nil),               // 73: common.ListUsers
+	(*wrapperspb.BoolValue)(nil),           // 74: google.protobuf.BoolValue
 }
 var file_users_proto_depIdxs = []int32{
-	34, // 0: users.UserProfileResponse.date_of_birth:type_name -> google.protobuf.Timestamp
-	34, // 1: users.UserProfileResponse.created_at:type_name -> google.protobuf.Timestamp
-	34, // 2: users.RegisterUserRequest.date_of_birth:type_name -> google.protobuf.Timestamp
-	16, // 3: users.GroupArr.group_arr:type_name -> users.Group
-	20, // 4: users.GroupUserArr.group_user_arr:type_name -> users.GroupUser
-	35, // 5: users.InviteToGroupRequest.invited_ids:type_name -> common.UserIds
-	34, // 6: users.UpdateProfileRequest.date_of_birth:type_name -> google.protobuf.Timestamp
-	5,  // 7: users.UserService.RegisterUser:input_type -> users.RegisterUserRequest
-	7,  // 8: users.UserService.LoginUser:input_type -> users.LoginRequest
-	8,  // 9: users.UserService.UpdateUserPassword:input_type -> users.UpdatePasswordRequest
-	9,  // 10: users.UserService.UpdateUserEmail:input_type -> users.UpdateEmailRequest
-	10, // 11: users.UserService.GetFollowersPaginated:input_type -> users.Pagination
-	10, // 12: users.UserService.GetFollowingPaginated:input_type -> users.Pagination
-	11, // 13: users.UserService.FollowUser:input_type -> users.FollowUserRequest
-	11, // 14: users.UserService.UnFollowUser:input_type -> users.FollowUserRequest
-	13, // 15: users.UserService.HandleFollowRequest:input_type -> users.HandleFollowRequestRequest
-	36, // 16: users.UserService.GetFollowingIds:input_type -> google.protobuf.Int64Value
-	36, // 17: users.UserService.GetFollowSuggestions:input_type -> google.protobuf.Int64Value
-	14, // 18: users.UserService.IsFollowing:input_type -> users.IsFollowingRequest
-	11, // 19: users.UserService.AreFollowingEachOther:input_type -> users.FollowUserRequest
-	10, // 20: users.UserService.GetAllGroupsPaginated:input_type -> users.Pagination
-	10, // 21: users.UserService.GetUserGroupsPaginated:input_type -> users.Pagination
-	18, // 22: users.UserService.GetGroupInfo:input_type -> users.GeneralGroupRequest
-	0,  // 23: users.UserService.GetGroupBasicInfo:input_type -> users.IdReq
-	19, // 24: users.UserService.GetGroupMembers:input_type -> users.GroupMembersRequest
-	0,  // 25: users.UserService.GetAllGroupMemberIds:input_type -> users.IdReq
-	19, // 26: users.UserService.GetPendingGroupJoinRequests:input_type -> users.GroupMembersRequest
-	18, // 27: users.UserService.GetPendingGroupJoinRequestsCount:input_type -> users.GeneralGroupRequest
-	19, // 28: users.UserService.GetFollowersNotInvitedToGroup:input_type -> users.GroupMembersRequest
-	22, // 29: users.UserService.SearchGroups:input_type -> users.GroupSearchRequest
-	23, // 30: users.UserService.InviteToGroup:input_type -> users.InviteToGroupRequest
-	18, // 31: users.UserService.IsGroupMember:input_type -> users.GeneralGroupRequest
-	24, // 32: users.UserService.RequestJoinGroup:input_type -> users.GroupJoinRequest
-	24, // 33: users.UserService.CancelJoinGroupRequest:input_type -> users.GroupJoinRequest
-	25, // 34: users.UserService.RespondToGroupInvite:input_type -> users.HandleGroupInviteRequest
-	26, // 35: users.UserService.HandleGroupJoinRequest:input_type -> users.HandleJoinRequest
-	18, // 36: users.UserService.LeaveGroup:input_type -> users.GeneralGroupRequest
-	27, // 37: users.UserService.RemoveFromGroup:input_type -> users.RemoveFromGroupRequest
-	28, // 38: users.UserService.CreateGroup:input_type -> users.CreateGroupRequest
-	29, // 39: users.UserService.UpdateGroup:input_type -> users.UpdateGroupRequest
-	36, // 40: users.UserService.GetBasicUserInfo:input_type -> google.protobuf.Int64Value
-	35, // 41: users.UserService.GetBatchBasicUserInfo:input_type -> common.UserIds
-	30, // 42: users.UserService.GetUserProfile:input_type -> users.GetUserProfileRequest
-	31, // 43: users.UserService.SearchUsers:input_type -> users.UserSearchRequest
-	32, // 44: users.UserService.UpdateUserProfile:input_type -> users.UpdateProfileRequest
-	33, // 45: users.UserService.UpdateProfilePrivacy:input_type -> users.UpdateProfilePrivacyRequest
-	3,  // 46: users.UserService.RemoveImages:input_type -> users.FailedImageIds
-	6,  // 47: users.UserService.RegisterUser:output_type -> users.RegisterUserResponse
-	37, // 48: users.UserService.LoginUser:output_type -> common.User
-	38, // 49: users.UserService.UpdateUserPassword:output_type -> google.protobuf.Empty
-	38, // 50: users.UserService.UpdateUserEmail:output_type -> google.protobuf.Empty
-	39, // 51: users.UserService.GetFollowersPaginated:output_type -> common.ListUsers
-	39, // 52: users.UserService.GetFollowingPaginated:output_type -> common.ListUsers
-	12, // 53: users.UserService.FollowUser:output_type -> users.FollowUserResponse
-	38, // 54: users.UserService.UnFollowUser:output_type -> google.protobuf.Empty
-	38, // 55: users.UserService.HandleFollowRequest:output_type -> google.protobuf.Empty
-	35, // 56: users.UserService.GetFollowingIds:output_type -> common.UserIds
-	39, // 57: users.UserService.GetFollowSuggestions:output_type -> common.ListUsers
-	40, // 58: users.UserService.IsFollowing:output_type -> google.protobuf.BoolValue
-	15, // 59: users.UserService.AreFollowingEachOther:output_type -> users.AreFollowingEachOtherResponse
-	17, // 60: users.UserService.GetAllGroupsPaginated:output_type -> users.GroupArr
-	17, // 61: users.UserService.GetUserGroupsPaginated:output_type -> users.GroupArr
-	16, // 62: users.UserService.GetGroupInfo:output_type -> users.Group
-	16, // 63: users.UserService.GetGroupBasicInfo:output_type -> users.Group
-	21, // 64: users.UserService.GetGroupMembers:output_type -> users.GroupUserArr
-	2,  // 65: users.UserService.GetAllGroupMemberIds:output_type -> users.Ids
-	39, // 66: users.UserService.GetPendingGroupJoinRequests:output_type -> common.ListUsers
-	1,  // 67: users.UserService.GetPendingGroupJoinRequestsCount:output_type -> users.CountResp
-	39, // 68: users.UserService.GetFollowersNotInvitedToGroup:output_type -> common.ListUsers
-	17, // 69: users.UserService.SearchGroups:output_type -> users.GroupArr
-	38, // 70: users.UserService.InviteToGroup:output_type -> google.protobuf.Empty
-	40, // 71: users.UserService.IsGroupMember:output_type -> google.protobuf.BoolValue
-	38, // 72: users.UserService.RequestJoinGroup:output_type -> google.protobuf.Empty
-	38, // 73: users.UserService.CancelJoinGroupRequest:output_type -> google.protobuf.Empty
-	38, // 74: users.UserService.RespondToGroupInvite:output_type -> google.protobuf.Empty
-	38, // 75: users.UserService.HandleGroupJoinRequest:output_type -> google.protobuf.Empty
-	38, // 76: users.UserService.LeaveGroup:output_type -> google.protobuf.Empty
-	38, // 77: users.UserService.RemoveFromGroup:output_type -> google.protobuf.Empty
-	36, // 78: users.UserService.CreateGroup:output_type -> google.protobuf.Int64Value
-	38, // 79: users.UserService.UpdateGroup:output_type -> google.protobuf.Empty
-	37, // 80: users.UserService.GetBasicUserInfo:output_type -> common.User
-	39, // 81: users.UserService.GetBatchBasicUserInfo:output_type -> common.ListUsers
-	4,  // 82: users.UserService.GetUserProfile:output_type -> users.UserProfileResponse
-	39, // 83: users.UserService.SearchUsers:output_type -> common.ListUsers
-	4,  // 84: users.UserService.UpdateUserProfile:output_type -> users.UserProfileResponse
-	38, // 85: users.UserService.UpdateProfilePrivacy:output_type -> google.protobuf.Empty
-	38, // 86: users.UserService.RemoveImages:output_type -> google.protobuf.Empty
-	47, // [47:87] is the sub-list for method output_type
-	7,  // [7:47] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	67,  // 0: users.UserProfileResponse.date_of_birth:type_name -> google.protobuf.Timestamp
+	67,  // 1: users.UserProfileResponse.created_at:type_name -> google.protobuf.Timestamp
+	67,  // 2: users.RegisterUserRequest.date_of_birth:type_name -> google.protobuf.Timestamp
+	16,  // 3: users.GroupArr.group_arr:type_name -> users.Group
+	20,  // 4: users.GroupUserArr.group_user_arr:type_name -> users.GroupUser
+	67,  // 5: users.GroupDailyCount.day:type_name -> google.protobuf.Timestamp
+	67,  // 6: users.GroupMemberGrowth.day:type_name -> google.protobuf.Timestamp
+	68,  // 7: users.GroupActivePoster.user:type_name -> common.User
+	67,  // 8: users.GroupEventAttendance.event_date:type_name -> google.protobuf.Timestamp
+	67,  // 9: users.GroupInsights.since:type_name -> google.protobuf.Timestamp
+	67,  // 10: users.GroupInsights.computed_at:type_name -> google.protobuf.Timestamp
+	25,  // 11: users.GroupInsights.member_growth:type_name -> users.GroupMemberGrowth
+	24,  // 12: users.GroupInsights.posts_per_day:type_name -> users.GroupDailyCount
+	24,  // 13: users.GroupInsights.comments_per_day:type_name -> users.GroupDailyCount
+	26,  // 14: users.GroupInsights.active_posters:type_name -> users.GroupActivePoster
+	27,  // 15: users.GroupInsights.events:type_name -> users.GroupEventAttendance
+	28,  // 16: users.GroupInsights.join_requests:type_name -> users.GroupJoinRequestStats
+	69,  // 17: users.InviteToGroupRequest.invited_ids:type_name -> common.UserIds
+	33,  // 18: users.GroupJoinRequest.answers:type_name -> users.GroupJoinAnswer
+	32,  // 19: users.GroupJoinForm.questions:type_name -> users.GroupJoinQuestion
+	32,  // 20: users.SetGroupJoinFormRequest.questions:type_name -> users.GroupJoinQuestion
+	42,  // 21: users.UpdateGroupRequest.tags:type_name -> users.GroupTags
+	68,  // 22: users.PendingJoinRequest.user:type_name -> common.User
+	67,  // 23: users.PendingJoinRequest.requested_at:type_name -> google.protobuf.Timestamp
+	67,  // 24: users.PendingJoinRequest.expires_at:type_name -> google.protobuf.Timestamp
+	33,  // 25: users.PendingJoinRequest.answers:type_name -> users.GroupJoinAnswer
+	47,  // 26: users.PendingJoinRequestArr.requests:type_name -> users.PendingJoinRequest
+	67,  // 27: users.CreateGroupInviteLinkRequest.expires_at:type_name -> google.protobuf.Timestamp
+	67,  // 28: users.GroupInviteLink.created_at:type_name -> google.protobuf.Timestamp
+	67,  // 29: users.GroupInviteLink.expires_at:type_name -> google.protobuf.Timestamp
+	67,  // 30: users.GroupInviteLink.last_used_at:type_name -> google.protobuf.Timestamp
+	50,  // 31: users.GroupInviteLinkArr.links:type_name -> users.GroupInviteLink
+	67,  // 32: users.BanFromGroupRequest.expires_at:type_name -> google.protobuf.Timestamp
+	68,  // 33: users.GroupBan.user:type_name -> common.User
+	67,  // 34: users.GroupBan.expires_at:type_name -> google.protobuf.Timestamp
+	67,  // 35: users.GroupBan.banned_at:type_name -> google.protobuf.Timestamp
+	55,  // 36: users.GroupBanArr.bans:type_name -> users.GroupBan
+	33,  // 37: users.JoinGroupByLinkRequest.answers:type_name -> users.GroupJoinAnswer
+	67,  // 38: users.UpdateProfileRequest.date_of_birth:type_name -> google.protobuf.Timestamp
+	5,   // 39: users.UserService.RegisterUser:input_type -> users.RegisterUserRequest
+	7,   // 40: users.UserService.LoginUser:input_type -> users.LoginRequest
+	8,   // 41: users.UserService.UpdateUserPassword:input_type -> users.UpdatePasswordRequest
+	9,   // 42: users.UserService.UpdateUserEmail:input_type -> users.UpdateEmailRequest
+	70,  // 43: users.UserService.DeactivateAccount:input_type -> google.protobuf.Int64Value
+	10,  // 44: users.UserService.GetFollowersPaginated:input_type -> users.Pagination
+	10,  // 45: users.UserService.GetFollowingPaginated:input_type -> users.Pagination
+	11,  // 46: users.UserService.FollowUser:input_type -> users.FollowUserRequest
+	11,  // 47: users.UserService.UnFollowUser:input_type -> users.FollowUserRequest
+	13,  // 48: users.UserService.HandleFollowRequest:input_type -> users.HandleFollowRequestRequest
+	70,  // 49: users.UserService.GetFollowingIds:input_type -> google.protobuf.Int64Value
+	70,  // 50: users.UserService.GetFollowerIds:input_type -> google.protobuf.Int64Value
+	70,  // 51: users.UserService.GetFollowSuggestions:input_type -> google.protobuf.Int64Value
+	14,  // 52: users.UserService.IsFollowing:input_type -> users.IsFollowingRequest
+	11,  // 53: users.UserService.AreFollowingEachOther:input_type -> users.FollowUserRequest
+	10,  // 54: users.UserService.GetAllGroupsPaginated:input_type -> users.Pagination
+	10,  // 55: users.UserService.GetUserGroupsPaginated:input_type -> users.Pagination
+	18,  // 56: users.UserService.GetGroupInfo:input_type -> users.GeneralGroupRequest
+	0,   // 57: users.UserService.GetGroupBasicInfo:input_type -> users.IdReq
+	19,  // 58: users.UserService.GetGroupMembers:input_type -> users.GroupMembersRequest
+	0,   // 59: users.UserService.GetAllGroupMemberIds:input_type -> users.IdReq
+	70,  // 60: users.UserService.GetUserGroupIds:input_type -> google.protobuf.Int64Value
+	19,  // 61: users.UserService.GetPendingGroupJoinRequests:input_type -> users.GroupMembersRequest
+	18,  // 62: users.UserService.GetPendingGroupJoinRequestsCount:input_type -> users.GeneralGroupRequest
+	19,  // 63: users.UserService.GetFollowersNotInvitedToGroup:input_type -> users.GroupMembersRequest
+	22,  // 64: users.UserService.SearchGroups:input_type -> users.GroupSearchRequest
+	23,  // 65: users.UserService.DiscoverGroups:input_type -> users.DiscoverGroupsRequest
+	18,  // 66: users.UserService.GetGroupInsights:input_type -> users.GeneralGroupRequest
+	30,  // 67: users.UserService.InviteToGroup:input_type -> users.InviteToGroupRequest
+	18,  // 68: users.UserService.IsGroupMember:input_type -> users.GeneralGroupRequest
+	31,  // 69: users.UserService.RequestJoinGroup:input_type -> users.GroupJoinRequest
+	18,  // 70: users.UserService.GetGroupJoinForm:input_type -> users.GeneralGroupRequest
+	35,  // 71: users.UserService.SetGroupJoinForm:input_type -> users.SetGroupJoinFormRequest
+	36,  // 72: users.UserService.SetGroupPostApproval:input_type -> users.SetGroupPostApprovalRequest
+	31,  // 73: users.UserService.CancelJoinGroupRequest:input_type -> users.GroupJoinRequest
+	37,  // 74: users.UserService.RespondToGroupInvite:input_type -> users.HandleGroupInviteRequest
+	38,  // 75: users.UserService.HandleGroupJoinRequest:input_type -> users.HandleJoinRequest
+	18,  // 76: users.UserService.LeaveGroup:input_type -> users.GeneralGroupRequest
+	39,  // 77: users.UserService.RemoveFromGroup:input_type -> users.RemoveFromGroupRequest
+	53,  // 78: users.UserService.BanFromGroup:input_type -> users.BanFromGroupRequest
+	54,  // 79: users.UserService.UnbanFromGroup:input_type -> users.UnbanFromGroupRequest
+	19,  // 80: users.UserService.GetGroupBans:input_type -> users.GroupMembersRequest
+	40,  // 81: users.UserService.CreateGroup:input_type -> users.CreateGroupRequest
+	41,  // 82: users.UserService.UpdateGroup:input_type -> users.UpdateGroupRequest
+	43,  // 83: users.UserService.PromoteGroupMember:input_type -> users.GroupRoleRequest
+	43,  // 84: users.UserService.DemoteGroupMember:input_type -> users.GroupRoleRequest
+	46,  // 85: users.UserService.HasGroupPermission:input_type -> users.GroupPermissionRequest
+	44,  // 86: users.UserService.TransferGroupOwnership:input_type -> users.TransferOwnershipRequest
+	45,  // 87: users.UserService.RespondToOwnershipTransfer:input_type -> users.HandleOwnershipTransferRequest
+	18,  // 88: users.UserService.ArchiveGroup:input_type -> users.GeneralGroupRequest
+	18,  // 89: users.UserService.UnarchiveGroup:input_type -> users.GeneralGroupRequest
+	18,  // 90: users.UserService.DeleteGroup:input_type -> users.GeneralGroupRequest
+	49,  // 91: users.UserService.CreateGroupInviteLink:input_type -> users.CreateGroupInviteLinkRequest
+	18,  // 92: users.UserService.GetGroupInviteLinks:input_type -> users.GeneralGroupRequest
+	52,  // 93: users.UserService.RevokeGroupInviteLink:input_type -> users.RevokeGroupInviteLinkRequest
+	57,  // 94: users.UserService.JoinGroupByLink:input_type -> users.JoinGroupByLinkRequest
+	70,  // 95: users.UserService.GetBasicUserInfo:input_type -> google.protobuf.Int64Value
+	69,  // 96: users.UserService.GetBatchBasicUserInfo:input_type -> common.UserIds
+	59,  // 97: users.UserService.GetUserProfile:input_type -> users.GetUserProfileRequest
+	60,  // 98: users.UserService.SearchUsers:input_type -> users.UserSearchRequest
+	61,  // 99: users.UserService.UpdateUserProfile:input_type -> users.UpdateProfileRequest
+	62,  // 100: users.UserService.UpdateProfilePrivacy:input_type -> users.UpdateProfilePrivacyRequest
+	63,  // 101: users.UserService.ChangeUsername:input_type -> users.ChangeUsernameRequest
+	71,  // 102: users.UserService.ResolveHandle:input_type -> google.protobuf.StringValue
+	3,   // 103: users.UserService.RemoveImages:input_type -> users.FailedImageIds
+	70,  // 104: users.UserService.GetPrivacySettings:input_type -> google.protobuf.Int64Value
+	65,  // 105: users.UserService.UpdatePrivacySettings:input_type -> users.PrivacySettings
+	66,  // 106: users.UserService.CanInteract:input_type -> users.CanInteractRequest
+	6,   // 107: users.UserService.RegisterUser:output_type -> users.RegisterUserResponse
+	68,  // 108: users.UserService.LoginUser:output_type -> common.User
+	72,  // 109: users.UserService.UpdateUserPassword:output_type -> google.protobuf.Empty
+	72,  // 110: users.UserService.UpdateUserEmail:output_type -> google.protobuf.Empty
+	72,  // 111: users.UserService.DeactivateAccount:output_type -> google.protobuf.Empty
+	73,  // 112: users.UserService.GetFollowersPaginated:output_type -> common.ListUsers
+	73,  // 113: users.UserService.GetFollowingPaginated:output_type -> common.ListUsers
+	12,  // 114: users.UserService.FollowUser:output_type -> users.FollowUserResponse
+	72,  // 115: users.UserService.UnFollowUser:output_type -> google.protobuf.Empty
+	72,  // 116: users.UserService.HandleFollowRequest:output_type -> google.protobuf.Empty
+	69,  // 117: users.UserService.GetFollowingIds:output_type -> common.UserIds
+	69,  // 118: users.UserService.GetFollowerIds:output_type -> common.UserIds
+	73,  // 119: users.UserService.GetFollowSuggestions:output_type -> common.ListUsers
+	74,  // 120: users.UserService.IsFollowing:output_type -> google.protobuf.BoolValue
+	15,  // 121: users.UserService.AreFollowingEachOther:output_type -> users.AreFollowingEachOtherResponse
+	17,  // 122: users.UserService.GetAllGroupsPaginated:output_type -> users.GroupArr
+	17,  // 123: users.UserService.GetUserGroupsPaginated:output_type -> users.GroupArr
+	16,  // 124: users.UserService.GetGroupInfo:output_type -> users.Group
+	16,  // 125: users.UserService.GetGroupBasicInfo:output_type -> users.Group
+	21,  // 126: users.UserService.GetGroupMembers:output_type -> users.GroupUserArr
+	2,   // 127: users.UserService.GetAllGroupMemberIds:output_type -> users.Ids
+	2,   // 128: users.UserService.GetUserGroupIds:output_type -> users.Ids
+	48,  // 129: users.UserService.GetPendingGroupJoinRequests:output_type -> users.PendingJoinRequestArr
+	1,   // 130: users.UserService.GetPendingGroupJoinRequestsCount:output_type -> users.CountResp
+	73,  // 131: users.UserService.GetFollowersNotInvitedToGroup:output_type -> common.ListUsers
+	17,  // 132: users.UserService.SearchGroups:output_type -> users.GroupArr
+	17,  // 133: users.UserService.DiscoverGroups:output_type -> users.GroupArr
+	29,  // 134: users.UserService.GetGroupInsights:output_type -> users.GroupInsights
+	72,  // 135: users.UserService.InviteToGroup:output_type -> google.protobuf.Empty
+	74,  // 136: users.UserService.IsGroupMember:output_type -> google.protobuf.BoolValue
+	72,  // 137: users.UserService.RequestJoinGroup:output_type -> google.protobuf.Empty
+	34,  // 138: users.UserService.GetGroupJoinForm:output_type -> users.GroupJoinForm
+	72,  // 139: users.UserService.SetGroupJoinForm:output_type -> google.protobuf.Empty
+	72,  // 140: users.UserService.SetGroupPostApproval:output_type -> google.protobuf.Empty
+	72,  // 141: users.UserService.CancelJoinGroupRequest:output_type -> google.protobuf.Empty
+	72,  // 142: users.UserService.RespondToGroupInvite:output_type -> google.protobuf.Empty
+	72,  // 143: users.UserService.HandleGroupJoinRequest:output_type -> google.protobuf.Empty
+	72,  // 144: users.UserService.LeaveGroup:output_type -> google.protobuf.Empty
+	72,  // 145: users.UserService.RemoveFromGroup:output_type -> google.protobuf.Empty
+	72,  // 146: users.UserService.BanFromGroup:output_type -> google.protobuf.Empty
+	72,  // 147: users.UserService.UnbanFromGroup:output_type -> google.protobuf.Empty
+	56,  // 148: users.UserService.GetGroupBans:output_type -> users.GroupBanArr
+	70,  // 149: users.UserService.CreateGroup:output_type -> google.protobuf.Int64Value
+	72,  // 150: users.UserService.UpdateGroup:output_type -> google.protobuf.Empty
+	72,  // 151: users.UserService.PromoteGroupMember:output_type -> google.protobuf.Empty
+	72,  // 152: users.UserService.DemoteGroupMember:output_type -> google.protobuf.Empty
+	74,  // 153: users.UserService.HasGroupPermission:output_type -> google.protobuf.BoolValue
+	72,  // 154: users.UserService.TransferGroupOwnership:output_type -> google.protobuf.Empty
+	72,  // 155: users.UserService.RespondToOwnershipTransfer:output_type -> google.protobuf.Empty
+	72,  // 156: users.UserService.ArchiveGroup:output_type -> google.protobuf.Empty
+	72,  // 157: users.UserService.UnarchiveGroup:output_type -> google.protobuf.Empty
+	72,  // 158: users.UserService.DeleteGroup:output_type -> google.protobuf.Empty
+	50,  // 159: users.UserService.CreateGroupInviteLink:output_type -> users.GroupInviteLink
+	51,  // 160: users.UserService.GetGroupInviteLinks:output_type -> users.GroupInviteLinkArr
+	72,  // 161: users.UserService.RevokeGroupInviteLink:output_type -> google.protobuf.Empty
+	58,  // 162: users.UserService.JoinGroupByLink:output_type -> users.JoinGroupByLinkResponse
+	68,  // 163: users.UserService.GetBasicUserInfo:output_type -> common.User
+	73,  // 164: users.UserService.GetBatchBasicUserInfo:output_type -> common.ListUsers
+	4,   // 165: users.UserService.GetUserProfile:output_type -> users.UserProfileResponse
+	73,  // 166: users.UserService.SearchUsers:output_type -> common.ListUsers
+	4,   // 167: users.UserService.UpdateUserProfile:output_type -> users.UserProfileResponse
+	72,  // 168: users.UserService.UpdateProfilePrivacy:output_type -> google.protobuf.Empty
+	72,  // 169: users.UserService.ChangeUsername:output_type -> google.protobuf.Empty
+	64,  // 170: users.UserService.ResolveHandle:output_type -> users.ResolvedHandle
+	72,  // 171: users.UserService.RemoveImages:output_type -> google.protobuf.Empty
+	65,  // 172: users.UserService.GetPrivacySettings:output_type -> users.PrivacySettings
+	72,  // 173: users.UserService.UpdatePrivacySettings:output_type -> google.protobuf.Empty
+	74,  // 174: users.UserService.CanInteract:output_type -> google.protobuf.BoolValue
+	107, // [107:175] is the sub-list for method output_type
+	39,  // [39:107] is the sub-list for method input_type
+	39,  // [39:39] is the sub-list for extension type_name
+	39,  // [39:39] is the sub-list for extension extendee
+	0,   // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_users_proto_init() }
@@ -2654,7 +5352,7 @@ func file_users_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_users_proto_rawDesc), len(file_users_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   34,
+			NumMessages:   67,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,12 +26,14 @@ const (
 	UserService_LoginUser_FullMethodName                        = "/users.UserService/LoginUser"
 	UserService_UpdateUserPassword_FullMethodName               = "/users.UserService/UpdateUserPassword"
 	UserService_UpdateUserEmail_FullMethodName                  = "/users.UserService/UpdateUserEmail"
+	UserService_DeactivateAccount_FullMethodName                = "/users.UserService/DeactivateAccount"
 	UserService_GetFollowersPaginated_FullMethodName            = "/users.UserService/GetFollowersPaginated"
 	UserService_GetFollowingPaginated_FullMethodName            = "/users.UserService/GetFollowingPaginated"
 	UserService_FollowUser_FullMethodName                       = "/users.UserService/FollowUser"
 	UserService_UnFollowUser_FullMethodName                     = "/users.UserService/UnFollowUser"
 	UserService_HandleFollowRequest_FullMethodName              = "/users.UserService/HandleFollowRequest"
 	UserService_GetFollowingIds_FullMethodName                  = "/users.UserService/GetFollowingIds"
+	UserService_GetFollowerIds_FullMethodName                   = "/users.UserService/GetFollowerIds"
 	UserService_GetFollowSuggestions_FullMethodName             = "/users.UserService/GetFollowSuggestions"
 	UserService_IsFollowing_FullMethodName                      = "/users.UserService/IsFollowing"
 	UserService_AreFollowingEachOther_FullMethodName            = "/users.UserService/AreFollowingEachOther"
@@ -41,27 +43,53 @@ const (
 	UserService_GetGroupBasicInfo_FullMethodName                = "/users.UserService/GetGroupBasicInfo"
 	UserService_GetGroupMembers_FullMethodName                  = "/users.UserService/GetGroupMembers"
 	UserService_GetAllGroupMemberIds_FullMethodName             = "/users.UserService/GetAllGroupMemberIds"
+	UserService_GetUserGroupIds_FullMethodName                  = "/users.UserService/GetUserGroupIds"
 	UserService_GetPendingGroupJoinRequests_FullMethodName      = "/users.UserService/GetPendingGroupJoinRequests"
 	UserService_GetPendingGroupJoinRequestsCount_FullMethodName = "/users.UserService/GetPendingGroupJoinRequestsCount"
 	UserService_GetFollowersNotInvitedToGroup_FullMethodName    = "/users.UserService/GetFollowersNotInvitedToGroup"
 	UserService_SearchGroups_FullMethodName                     = "/users.UserService/SearchGroups"
+	UserService_DiscoverGroups_FullMethodName                   = "/users.UserService/DiscoverGroups"
+	UserService_GetGroupInsights_FullMethodName                 = "/users.UserService/GetGroupInsights"
 	UserService_InviteToGroup_FullMethodName                    = "/users.UserService/InviteToGroup"
 	UserService_IsGroupMember_FullMethodName                    = "/users.UserService/IsGroupMember"
 	UserService_RequestJoinGroup_FullMethodName                 = "/users.UserService/RequestJoinGroup"
+	UserService_GetGroupJoinForm_FullMethodName                 = "/users.UserService/GetGroupJoinForm"
+	UserService_SetGroupJoinForm_FullMethodName                 = "/users.UserService/SetGroupJoinForm"
+	UserService_SetGroupPostApproval_FullMethodName             = "/users.UserService/SetGroupPostApproval"
 	UserService_CancelJoinGroupRequest_FullMethodName           = "/users.UserService/CancelJoinGroupRequest"
 	UserService_RespondToGroupInvite_FullMethodName             = "/users.UserService/RespondToGroupInvite"
 	UserService_HandleGroupJoinRequest_FullMethodName           = "/users.UserService/HandleGroupJoinRequest"
 	UserService_LeaveGroup_FullMethodName                       = "/users.UserService/LeaveGroup"
 	UserService_RemoveFromGroup_FullMethodName                  = "/users.UserService/RemoveFromGroup"
+	UserService_BanFromGroup_FullMethodName                     = "/users.UserService/BanFromGroup"
+	UserService_UnbanFromGroup_FullMethodName                   = "/users.UserService/UnbanFromGroup"
+	UserService_GetGroupBans_FullMethodName                     = "/users.UserService/GetGroupBans"
 	UserService_CreateGroup_FullMethodName                      = "/users.UserService/CreateGroup"
 	UserService_UpdateGroup_FullMethodName                      = "/users.UserService/UpdateGroup"
+	UserService_PromoteGroupMember_FullMethodName               = "/users.UserService/PromoteGroupMember"
+	UserService_DemoteGroupMember_FullMethodName                = "/users.UserService/DemoteGroupMember"
+	UserService_HasGroupPermission_FullMethodName               = "/users.UserService/HasGroupPermission"
+	UserService_TransferGroupOwnership_FullMethodName           = "/users.UserService/TransferGroupOwnership"
+	UserService_RespondToOwnershipTransfer_FullMethodName       = "/users.UserService/RespondToOwnershipTransfer"
+	UserService_ArchiveGroup_FullMethodName                     = "/users.UserService/ArchiveGroup"
+	UserService_UnarchiveGroup_FullMethodName                   = "/users.UserService/UnarchiveGroup"
+	UserService_DeleteGroup_FullMethodName                      = "/users.UserService/DeleteGroup"
+	UserService_CreateGroupInviteLink_FullMethodName            = "/users.UserService/CreateGroupInviteLink"
+	UserService_GetGroupInviteLinks_FullMethodName              = "/users.UserService/GetGroupInviteLinks"
+	UserService_RevokeGroupInviteLink_FullMethodName            = "/users.UserService/RevokeGroupInviteLink"
+	UserService_JoinGroupByLink_FullMethodName                  = "/users.UserService/JoinGroupByLink"
 	UserService_GetBasicUserInfo_FullMethodName                 = "/users.UserService/GetBasicUserInfo"
 	UserService_GetBatchBasicUserInfo_FullMethodName            = "/users.UserService/GetBatchBasicUserInfo"
 	UserService_GetUserProfile_FullMethodName                   = "/users.UserService/GetUserProfile"
 	UserService_SearchUsers_FullMethodName                      = "/users.UserService/SearchUsers"
 	UserService_UpdateUserProfile_FullMethodName                = "/users.UserService/UpdateUserProfile"
 	UserService_UpdateProfilePrivacy_FullMethodName             = "/users.UserService/UpdateProfilePrivacy"
+	UserService_ChangeUsername_FullMethodName                   = "/users.UserService/ChangeUsername"
+	UserService_ResolveHandle_FullMethodName                    = "/users.UserService/ResolveHandle"
 	UserService_RemoveImages_FullMethodName                     = "/users.UserService/RemoveImages"
+	UserService_GetPrivacySettings_FullMethodName               = "/users.UserService/GetPrivacySettings"
+	UserService_UpdatePrivacySettings_FullMethodName            = "/users.UserService/UpdatePrivacySettings"
+	UserService_CanInteract_FullMethodName                      = "/users.UserService/CanInteract"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -78,16 +106,25 @@ type UserServiceClient interface {
 	// Returns basic user info (id, username, avatar url).
 	// Calls users and media service for user info and avatar url.
 	// Returns invalid argument if identifier and password don't return any rows.
+	// A deactivated account is reactivated and its content restored on login.
 	LoginUser(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*common.User, error)
 	// Updates a user's password after verifying the current password.
 	// Returns permission denied if given old password doesn't match the one in the db.
 	UpdateUserPassword(ctx context.Context, in *UpdatePasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Updates the account email address.
 	UpdateUserEmail(ctx context.Context, in *UpdateEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Temporarily deactivates the given account until its next login.
+	// Hides profile, posts, comments and group memberships from everyone else,
+	// suppresses notifications and rejects incoming messages.
+	// Calls posts service to hide the user's content.
+	// Returns not found if the user is not active.
+	DeactivateAccount(ctx context.Context, in *wrapperspb.Int64Value, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Returns followers of a user with pagination, ordered by most recent follow first.
+	// Returns permission denied if the user's privacy settings hide their follow lists from the requester.
 	// Calls users and media service for user info and avatar url.
 	GetFollowersPaginated(ctx context.Context, in *Pagination, opts ...grpc.CallOption) (*common.ListUsers, error)
 	// Returns accounts the user is following with pagination, ordered my most recent follow first.
+	// Returns permission denied if the user's privacy settings hide their follow lists from the requester.
 	// Calls users and media service for user info and avatar url.
 	GetFollowingPaginated(ctx context.Context, in *Pagination, opts ...grpc.CallOption) (*common.ListUsers, error)
 	// Follows a target user if profile is public, or creates a pending request for private profiles.
@@ -100,11 +137,14 @@ type UserServiceClient interface {
 	HandleFollowRequest(ctx context.Context, in *HandleFollowRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Returns ids that the user is following.
 	GetFollowingIds(ctx context.Context, in *wrapperspb.Int64Value, opts ...grpc.CallOption) (*common.UserIds, error)
+	// Returns ids of the users following the user.
+	GetFollowerIds(ctx context.Context, in *wrapperspb.Int64Value, opts ...grpc.CallOption) (*common.UserIds, error)
 	// Returns a ranked list of user follow suggestions for the given user, based on
 	// second-degree follows (people followed by users you follow) and shared group
 	// memberships. Suggestions are weighted by interaction type, exclude the user
 	// themself and users already followed, and return the top results with slight
 	// randomization to avoid deterministic ordering.
+	// Users who are not discoverable are never suggested.
 	GetFollowSuggestions(ctx context.Context, in *wrapperspb.Int64Value, opts ...grpc.CallOption) (*common.ListUsers, error)
 	// Returns whether follower_id currently follows target_user_id.
 	IsFollowing(ctx context.Context, in *IsFollowingRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
@@ -130,17 +170,20 @@ type UserServiceClient interface {
 	GetGroupMembers(ctx context.Context, in *GroupMembersRequest, opts ...grpc.CallOption) (*GroupUserArr, error)
 	// Returns all member ids of a given group
 	GetAllGroupMemberIds(ctx context.Context, in *IdReq, opts ...grpc.CallOption) (*Ids, error)
-	// Returns all pending group requests with user information for group owner.
-	// Includes pagination, results are sorted by ascending join request date
-	// Returns permission denied if requester is not the group owner.
+	// Returns ids of all groups the user is a member of.
+	GetUserGroupIds(ctx context.Context, in *wrapperspb.Int64Value, opts ...grpc.CallOption) (*Ids, error)
+	//Returns all pending group requests with user information for group staff.
+	//Includes pagination, results are sorted by ascending join request date
+	//Each request carries when it was made and when it expires (nil if requests don't expire).
+	//Returns permission denied if requester's role can't approve join requests.
 	// Calls users and media service for user info and avatar urls.
-	GetPendingGroupJoinRequests(ctx context.Context, in *GroupMembersRequest, opts ...grpc.CallOption) (*common.ListUsers, error)
-	// Returns the total count of all pending group requests for group owner.
-	// Returns permission denied if requester is not the group owner.
+	GetPendingGroupJoinRequests(ctx context.Context, in *GroupMembersRequest, opts ...grpc.CallOption) (*PendingJoinRequestArr, error)
+	//Returns the total count of all pending group requests for group staff.
+	//Returns permission denied if requester's role can't approve join requests.
 	GetPendingGroupJoinRequestsCount(ctx context.Context, in *GeneralGroupRequest, opts ...grpc.CallOption) (*CountResp, error)
-	// Returns paginated user's followers who have not yet been invited to join the group.
-	// Results are sorted by descending follow date.
-	// Returns permission denied if requester is not a group member.
+	//Returns paginated user's followers who have not yet been invited to join the group.
+	//Results are sorted by descending follow date.
+	//Returns permission denied if requester is not a group member.
 	// Calls users and media service for user info and avatar urls.
 	GetFollowersNotInvitedToGroup(ctx context.Context, in *GroupMembersRequest, opts ...grpc.CallOption) (*common.ListUsers, error)
 	// Searches active groups by title and description using substring and fuzzy
@@ -150,44 +193,134 @@ type UserServiceClient interface {
 	// Score is computed from the search query’s similarity to a group’s title and
 	// description: for queries of length 3 or more, fuzzy similarity is used with
 	// higher weight on title matches; for shorter queries, simple substring matches
-	// are scored instead. Popularity is measured by the group’s members_count, with
+	// are scored instead. An exact match on one of the group's tags adds to the score.
+	// Popularity is measured by the group’s members_count, with
 	// groups the user already belongs to ranked ahead of others.
 	SearchGroups(ctx context.Context, in *GroupSearchRequest, opts ...grpc.CallOption) (*GroupArr, error)
+	// Lists listed groups the user hasn't joined, optionally in one category.
+	// Groups are ranked by members the user follows, members joined in the last
+	// week and posts created in the last week.
+	DiscoverGroups(ctx context.Context, in *DiscoverGroupsRequest, opts ...grpc.CallOption) (*GroupArr, error)
+	// Returns a group's activity over the last 30 days: member growth, posts and
+	// comments per day, most active posters, event attendance and join request approval times.
+	// Computed once per UTC day and cached until the day ends.
+	// Returns permission denied unless user's role grants view_insights.
+	GetGroupInsights(ctx context.Context, in *GeneralGroupRequest, opts ...grpc.CallOption) (*GroupInsights, error)
 	// Invites a list of users to join a group.
 	// Returns permission denied if inviter is not a group member.
 	// If invite already exists it's update to "pending".
+	// Invitees whose privacy settings don't allow the inviter or who are banned are skipped.
+	// Returns permission denied if none of the invitees allow the inviter or all are banned.
 	InviteToGroup(ctx context.Context, in *InviteToGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Checks if the requester is a member of the given group.
 	// The onwer is also treated as a member.
 	IsGroupMember(ctx context.Context, in *GeneralGroupRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
 	// Creates a join request for the group.
-	// If the request already exists it updates to "pending".
+	// If the request already exists it updates to "pending" and replaces the previous answers.
+	// Returns failed precondition if the group has rules that were not accepted,
+	// invalid argument if a required question of the join form is not answered
+	// and permission denied if the requester is banned from the group.
 	RequestJoinGroup(ctx context.Context, in *GroupJoinRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Returns the rules and join questions of the group.
+	GetGroupJoinForm(ctx context.Context, in *GeneralGroupRequest, opts ...grpc.CallOption) (*GroupJoinForm, error)
+	// Replaces the rules and join questions of the group.
+	// Returns permission denied if requester's role can't edit group info.
+	// Pending requests keep the questions as they were answered.
+	SetGroupJoinForm(ctx context.Context, in *SetGroupJoinFormRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Turns approval of new posts by group staff on or off.
+	// Returns permission denied if requester's role can't edit group info.
+	// Posts already waiting stay in the queue when approval is turned off.
+	SetGroupPostApproval(ctx context.Context, in *SetGroupPostApprovalRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Cancels a request to join the group.
 	CancelJoinGroupRequest(ctx context.Context, in *GroupJoinRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Accepts or declines a received group invite.
 	RespondToGroupInvite(ctx context.Context, in *HandleGroupInviteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Approves or rejects a user's join request.
-	// Returns permission declined if user handling the request is not owner of the group.
+	// Returns permission denied if the role of the user handling the request can't approve join requests.
 	HandleGroupJoinRequest(ctx context.Context, in *HandleJoinRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Removes user as member of the specified group.
 	// Returns permission denied if user wasn't a member of the group.
+	// The owner has to transfer ownership before leaving.
 	LeaveGroup(ctx context.Context, in *GeneralGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Removes a group member from the group.
-	// Returns permission denied if requester is not the owner of the group.
-	// Owner cannot be removed.
+	// Returns permission denied if requester's role can't remove members
+	// or doesn't outrank the member's role. Owner cannot be removed.
 	RemoveFromGroup(ctx context.Context, in *RemoveFromGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Bans a user from the group, with an optional reason and expiry. Members are removed
+	// and pending invites and join requests dropped. Banned users can't request to join,
+	// be invited or join through a link until the ban expires or is lifted.
+	// Returns permission denied if requester's role can't remove members
+	// or doesn't outrank the user's role.
+	BanFromGroup(ctx context.Context, in *BanFromGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lifts a ban. Returns not found if the user has no active ban from the group.
+	UnbanFromGroup(ctx context.Context, in *UnbanFromGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lists the active bans of the group, newest first.
+	// Returns permission denied if requester's role can't remove members.
+	GetGroupBans(ctx context.Context, in *GroupMembersRequest, opts ...grpc.CallOption) (*GroupBanArr, error)
 	// Creates a new group with requester as owner and returns its id.
 	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*wrapperspb.Int64Value, error)
 	// Updates group info (title, description, image).
-	// Returns permission denied if requester is not group owner.
+	// Returns permission denied if requester's role can't edit group info.
 	// All fields must be included even if they remain unchanged or they will be deleted.
 	UpdateGroup(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// Retrieves basic public info for a user (id, username, avatar id).
+	// Raises a member to a higher role (moderator or admin).
+	// Requester's role must allow managing roles and outrank both the member's current and new role.
+	// Returns failed precondition if the new role is not higher than the current one.
+	// Ownership can't be granted this way.
+	PromoteGroupMember(ctx context.Context, in *GroupRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lowers a member to a lower role (admin, moderator or member).
+	// Requester's role must allow managing roles and outrank the member's current role.
+	// Returns failed precondition if the new role is not lower than the current one.
+	DemoteGroupMember(ctx context.Context, in *GroupRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Returns whether user's role in the group grants the given permission.
+	// Permission is one of "approve_joins", "remove_members", "delete_posts", "pin_posts",
+	// "edit_info", "manage_events", "manage_roles", "manage_invites". Non members have no permissions.
+	HasGroupPermission(ctx context.Context, in *GroupPermissionRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	// Offers group ownership to a member, who must accept it for ownership to change.
+	// A new offer replaces any pending one.
+	// Returns permission denied if requester is not the owner
+	// and failed precondition if the new owner is not a member.
+	TransferGroupOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Accepts or declines a pending ownership offer addressed to the user.
+	// On acceptance the user becomes owner and the previous owner becomes admin, free to leave the group.
+	// Returns not found if there is no pending offer for the user
+	// and failed precondition if the offer is no longer valid.
+	RespondToOwnershipTransfer(ctx context.Context, in *HandleOwnershipTransferRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Makes the group read-only: no posts, events, joins, invites or info edits.
+	// Members keep access to existing content and can still leave.
+	// Returns permission denied if requester is not the owner
+	// and failed precondition if the group is already archived.
+	ArchiveGroup(ctx context.Context, in *GeneralGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Makes an archived group writable again.
+	// Returns permission denied if requester is not the owner
+	// and failed precondition if the group is not archived.
+	UnarchiveGroup(ctx context.Context, in *GeneralGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Soft deletes the group, its memberships, pending invites and join requests.
+	// Group posts and events are hidden and the group conversation is closed.
+	// Returns permission denied if requester is not the owner.
+	DeleteGroup(ctx context.Context, in *GeneralGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Creates a shareable invite link for the group and returns it with its signed token.
+	// Expiry and max uses are optional, auto approve lets users join without a join request.
+	// Returns permission denied if requester's role can't manage invites.
+	CreateGroupInviteLink(ctx context.Context, in *CreateGroupInviteLinkRequest, opts ...grpc.CallOption) (*GroupInviteLink, error)
+	// Lists all invite links of the group, newest first, with their usage stats.
+	// Returns permission denied if requester's role can't manage invites.
+	GetGroupInviteLinks(ctx context.Context, in *GeneralGroupRequest, opts ...grpc.CallOption) (*GroupInviteLinkArr, error)
+	// Revokes an invite link so its token can no longer be used.
+	// Returns permission denied if requester's role can't manage invites
+	// and not found if the link doesn't belong to the group or is already revoked.
+	RevokeGroupInviteLink(ctx context.Context, in *RevokeGroupInviteLinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Joins the group of an invite link token. If the link doesn't auto approve,
+	// a join request is created instead and joined is false.
+	// Returns invalid argument for a malformed or forged token, not found for an unknown link,
+	// failed precondition if the link is revoked, expired or used up,
+	// permission denied if the user is banned and already exists if the user is already a member.
+	JoinGroupByLink(ctx context.Context, in *JoinGroupByLinkRequest, opts ...grpc.CallOption) (*JoinGroupByLinkResponse, error)
+	// Retrieves basic public info for a user (id, username, avatar id, deactivated).
 	// Does not call media service for avatar url
 	// Returns no rows for id not found.
 	GetBasicUserInfo(ctx context.Context, in *wrapperspb.Int64Value, opts ...grpc.CallOption) (*common.User, error)
-	// Retrieves basic info for multiple users (id, username, avatar id).
+	// Retrieves basic info for multiple users (id, username, avatar id, deactivated).
 	// Does not call media service for avatar url
 	// Returns any users found on db, or no rows if no ids are found. Missing users are silently skipped.
 	GetBatchBasicUserInfo(ctx context.Context, in *common.UserIds, opts ...grpc.CallOption) (*common.ListUsers, error)
@@ -195,22 +328,46 @@ type UserServiceClient interface {
 	// Includes following, followers and group counts.
 	// Also includes viewer specific info: whether it's own profile and whether the viewer is following or has pending follow request.
 	// Calls media service for avatar url.
+	// Returns not found if the user is deactivated, unless it's the requester's own profile.
 	GetUserProfile(ctx context.Context, in *GetUserProfileRequest, opts ...grpc.CallOption) (*UserProfileResponse, error)
 	// Searches active users by username, first name, or last name using prefix
 	// matching for short queries and fuzzy similarity for longer queries. Results
 	// are ordered by relevance and limited to at most the requested limit,
 	// with username-based alphabetical ordering used to ensure consistent ordering
 	// when relevance scores are equal.
+	// Users who are not discoverable or deactivated are excluded.
 	SearchUsers(ctx context.Context, in *UserSearchRequest, opts ...grpc.CallOption) (*common.ListUsers, error)
 	// Updates profile fields for the given user and returns it.
-	// All fields must be included even if unchanged or they will be deleted.
+	// All fields must be included even if unchanged or they will be deleted,
+	// except username which is left unchanged if empty.
+	// A different username follows the same rules as ChangeUsername.
 	// Calls media service for avatar url.
 	// Sets/updates cached basic user info to avoid outdated data.
 	UpdateUserProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UserProfileResponse, error)
 	// Toggles public/private profile visibility (public/private).
 	UpdateProfilePrivacy(ctx context.Context, in *UpdateProfilePrivacyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Changes the username of a user. Can be done once every 30 days.
+	// The old username stays reserved for the user for 90 days and keeps resolving to them.
+	// Returns already exists if the username is taken or reserved by another user.
+	// Invalidates cached basic user info.
+	ChangeUsername(ctx context.Context, in *ChangeUsernameRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Maps a current or reserved former username to a user id and current username.
+	// Redirected is set when a former username was used.
+	// Returns not found if no active user matches.
+	ResolveHandle(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*ResolvedHandle, error)
 	// Resets failed or missing avatar ids to 0
 	RemoveImages(ctx context.Context, in *FailedImageIds, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Returns the granular privacy settings of a user.
+	// Returns not found if the user doesn't exist or is deleted.
+	GetPrivacySettings(ctx context.Context, in *wrapperspb.Int64Value, opts ...grpc.CallOption) (*PrivacySettings, error)
+	// Replaces the granular privacy settings of a user.
+	// All fields must be included even if unchanged.
+	UpdatePrivacySettings(ctx context.Context, in *PrivacySettings, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Returns whether actor is allowed to perform action towards target according to target's privacy settings.
+	// Action is one of "message", "comment", "view_follow_lists", "invite_to_group".
+	// A user can always interact with themself.
+	// Returns not found if target doesn't exist or is deleted.
+	CanInteract(ctx context.Context, in *CanInteractRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
 }
 
 type userServiceClient struct {
@@ -261,6 +418,16 @@ func (c *userServiceClient) UpdateUserEmail(ctx context.Context, in *UpdateEmail
 	return out, nil
 }
 
+func (c *userServiceClient) DeactivateAccount(ctx context.Context, in *wrapperspb.Int64Value, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeactivateAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetFollowersPaginated(ctx context.Context, in *Pagination, opts ...grpc.CallOption) (*common.ListUsers, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(common.ListUsers)
@@ -321,6 +488,16 @@ func (c *userServiceClient) GetFollowingIds(ctx context.Context, in *wrapperspb.
 	return out, nil
 }
 
+func (c *userServiceClient) GetFollowerIds(ctx context.Context, in *wrapperspb.Int64Value, opts ...grpc.CallOption) (*common.UserIds, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.UserIds)
+	err := c.cc.Invoke(ctx, UserService_GetFollowerIds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetFollowSuggestions(ctx context.Context, in *wrapperspb.Int64Value, opts ...grpc.CallOption) (*common.ListUsers, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(common.ListUsers)
@@ -411,9 +588,19 @@ func (c *userServiceClient) GetAllGroupMemberIds(ctx context.Context, in *IdReq,
 	return out, nil
 }
 
-func (c *userServiceClient) GetPendingGroupJoinRequests(ctx context.Context, in *GroupMembersRequest, opts ...grpc.CallOption) (*common.ListUsers, error) {
+func (c *userServiceClient) GetUserGroupIds(ctx context.Context, in *wrapperspb.Int64Value, opts ...grpc.CallOption) (*Ids, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(common.ListUsers)
+	out := new(Ids)
+	err := c.cc.Invoke(ctx, UserService_GetUserGroupIds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetPendingGroupJoinRequests(ctx context.Context, in *GroupMembersRequest, opts ...grpc.CallOption) (*PendingJoinRequestArr, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PendingJoinRequestArr)
 	err := c.cc.Invoke(ctx, UserService_GetPendingGroupJoinRequests_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -451,6 +638,26 @@ func (c *userServiceClient) SearchGroups(ctx context.Context, in *GroupSearchReq
 	return out, nil
 }
 
+func (c *userServiceClient) DiscoverGroups(ctx context.Context, in *DiscoverGroupsRequest, opts ...grpc.CallOption) (*GroupArr, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupArr)
+	err := c.cc.Invoke(ctx, UserService_DiscoverGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetGroupInsights(ctx context.Context, in *GeneralGroupRequest, opts ...grpc.CallOption) (*GroupInsights, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupInsights)
+	err := c.cc.Invoke(ctx, UserService_GetGroupInsights_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) InviteToGroup(ctx context.Context, in *InviteToGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -481,6 +688,36 @@ func (c *userServiceClient) RequestJoinGroup(ctx context.Context, in *GroupJoinR
 	return out, nil
 }
 
+func (c *userServiceClient) GetGroupJoinForm(ctx context.Context, in *GeneralGroupRequest, opts ...grpc.CallOption) (*GroupJoinForm, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupJoinForm)
+	err := c.cc.Invoke(ctx, UserService_GetGroupJoinForm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetGroupJoinForm(ctx context.Context, in *SetGroupJoinFormRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_SetGroupJoinForm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetGroupPostApproval(ctx context.Context, in *SetGroupPostApprovalRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_SetGroupPostApproval_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) CancelJoinGroupRequest(ctx context.Context, in *GroupJoinRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -531,6 +768,36 @@ func (c *userServiceClient) RemoveFromGroup(ctx context.Context, in *RemoveFromG
 	return out, nil
 }
 
+func (c *userServiceClient) BanFromGroup(ctx context.Context, in *BanFromGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_BanFromGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UnbanFromGroup(ctx context.Context, in *UnbanFromGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_UnbanFromGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetGroupBans(ctx context.Context, in *GroupMembersRequest, opts ...grpc.CallOption) (*GroupBanArr, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupBanArr)
+	err := c.cc.Invoke(ctx, UserService_GetGroupBans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*wrapperspb.Int64Value, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(wrapperspb.Int64Value)
@@ -551,6 +818,126 @@ func (c *userServiceClient) UpdateGroup(ctx context.Context, in *UpdateGroupRequ
 	return out, nil
 }
 
+func (c *userServiceClient) PromoteGroupMember(ctx context.Context, in *GroupRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_PromoteGroupMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DemoteGroupMember(ctx context.Context, in *GroupRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DemoteGroupMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) HasGroupPermission(ctx context.Context, in *GroupPermissionRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, UserService_HasGroupPermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) TransferGroupOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_TransferGroupOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RespondToOwnershipTransfer(ctx context.Context, in *HandleOwnershipTransferRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_RespondToOwnershipTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ArchiveGroup(ctx context.Context, in *GeneralGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_ArchiveGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UnarchiveGroup(ctx context.Context, in *GeneralGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_UnarchiveGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteGroup(ctx context.Context, in *GeneralGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateGroupInviteLink(ctx context.Context, in *CreateGroupInviteLinkRequest, opts ...grpc.CallOption) (*GroupInviteLink, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupInviteLink)
+	err := c.cc.Invoke(ctx, UserService_CreateGroupInviteLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetGroupInviteLinks(ctx context.Context, in *GeneralGroupRequest, opts ...grpc.CallOption) (*GroupInviteLinkArr, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupInviteLinkArr)
+	err := c.cc.Invoke(ctx, UserService_GetGroupInviteLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeGroupInviteLink(ctx context.Context, in *RevokeGroupInviteLinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_RevokeGroupInviteLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) JoinGroupByLink(ctx context.Context, in *JoinGroupByLinkRequest, opts ...grpc.CallOption) (*JoinGroupByLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinGroupByLinkResponse)
+	err := c.cc.Invoke(ctx, UserService_JoinGroupByLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetBasicUserInfo(ctx context.Context, in *wrapperspb.Int64Value, opts ...grpc.CallOption) (*common.User, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(common.User)
@@ -611,6 +998,26 @@ func (c *userServiceClient) UpdateProfilePrivacy(ctx context.Context, in *Update
 	return out, nil
 }
 
+func (c *userServiceClient) ChangeUsername(ctx context.Context, in *ChangeUsernameRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_ChangeUsername_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ResolveHandle(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*ResolvedHandle, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolvedHandle)
+	err := c.cc.Invoke(ctx, UserService_ResolveHandle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) RemoveImages(ctx context.Context, in *FailedImageIds, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -621,6 +1028,36 @@ func (c *userServiceClient) RemoveImages(ctx context.Context, in *FailedImageIds
 	return out, nil
 }
 
+func (c *userServiceClient) GetPrivacySettings(ctx context.Context, in *wrapperspb.Int64Value, opts ...grpc.CallOption) (*PrivacySettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrivacySettings)
+	err := c.cc.Invoke(ctx, UserService_GetPrivacySettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdatePrivacySettings(ctx context.Context, in *PrivacySettings, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_UpdatePrivacySettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CanInteract(ctx context.Context, in *CanInteractRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, UserService_CanInteract_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -635,16 +1072,25 @@ type UserServiceServer interface {
 	// Returns basic user info (id, username, avatar url).
 	// Calls users and media service for user info and avatar url.
 	// Returns invalid argument if identifier and password don't return any rows.
+	// A deactivated account is reactivated and its content restored on login.
 	LoginUser(context.Context, *LoginRequest) (*common.User, error)
 	// Updates a user's password after verifying the current password.
 	// Returns permission denied if given old password doesn't match the one in the db.
 	UpdateUserPassword(context.Context, *UpdatePasswordRequest) (*emptypb.Empty, error)
 	// Updates the account email address.
 	UpdateUserEmail(context.Context, *UpdateEmailRequest) (*emptypb.Empty, error)
+	// Temporarily deactivates the given account until its next login.
+	// Hides profile, posts, comments and group memberships from everyone else,
+	// suppresses notifications and rejects incoming messages.
+	// Calls posts service to hide the user's content.
+	// Returns not found if the user is not active.
+	DeactivateAccount(context.Context, *wrapperspb.Int64Value) (*emptypb.Empty, error)
 	// Returns followers of a user with pagination, ordered by most recent follow first.
+	// Returns permission denied if the user's privacy settings hide their follow lists from the requester.
 	// Calls users and media service for user info and avatar url.
 	GetFollowersPaginated(context.Context, *Pagination) (*common.ListUsers, error)
 	// Returns accounts the user is following with pagination, ordered my most recent follow first.
+	// Returns permission denied if the user's privacy settings hide their follow lists from the requester.
 	// Calls users and media service for user info and avatar url.
 	GetFollowingPaginated(context.Context, *Pagination) (*common.ListUsers, error)
 	// Follows a target user if profile is public, or creates a pending request for private profiles.
@@ -657,11 +1103,14 @@ type UserServiceServer interface {
 	HandleFollowRequest(context.Context, *HandleFollowRequestRequest) (*emptypb.Empty, error)
 	// Returns ids that the user is following.
 	GetFollowingIds(context.Context, *wrapperspb.Int64Value) (*common.UserIds, error)
+	// Returns ids of the users following the user.
+	GetFollowerIds(context.Context, *wrapperspb.Int64Value) (*common.UserIds, error)
 	// Returns a ranked list of user follow suggestions for the given user, based on
 	// second-degree follows (people followed by users you follow) and shared group
 	// memberships. Suggestions are weighted by interaction type, exclude the user
 	// themself and users already followed, and return the top results with slight
 	// randomization to avoid deterministic ordering.
+	// Users who are not discoverable are never suggested.
 	GetFollowSuggestions(context.Context, *wrapperspb.Int64Value) (*common.ListUsers, error)
 	// Returns whether follower_id currently follows target_user_id.
 	IsFollowing(context.Context, *IsFollowingRequest) (*wrapperspb.BoolValue, error)
@@ -687,17 +1136,20 @@ type UserServiceServer interface {
 	GetGroupMembers(context.Context, *GroupMembersRequest) (*GroupUserArr, error)
 	// Returns all member ids of a given group
 	GetAllGroupMemberIds(context.Context, *IdReq) (*Ids, error)
-	// Returns all pending group requests with user information for group owner.
-	// Includes pagination, results are sorted by ascending join request date
-	// Returns permission denied if requester is not the group owner.
+	// Returns ids of all groups the user is a member of.
+	GetUserGroupIds(context.Context, *wrapperspb.Int64Value) (*Ids, error)
+	//Returns all pending group requests with user information for group staff.
+	//Includes pagination, results are sorted by ascending join request date
+	//Each request carries when it was made and when it expires (nil if requests don't expire).
+	//Returns permission denied if requester's role can't approve join requests.
 	// Calls users and media service for user info and avatar urls.
-	GetPendingGroupJoinRequests(context.Context, *GroupMembersRequest) (*common.ListUsers, error)
-	// Returns the total count of all pending group requests for group owner.
-	// Returns permission denied if requester is not the group owner.
+	GetPendingGroupJoinRequests(context.Context, *GroupMembersRequest) (*PendingJoinRequestArr, error)
+	//Returns the total count of all pending group requests for group staff.
+	//Returns permission denied if requester's role can't approve join requests.
 	GetPendingGroupJoinRequestsCount(context.Context, *GeneralGroupRequest) (*CountResp, error)
-	// Returns paginated user's followers who have not yet been invited to join the group.
-	// Results are sorted by descending follow date.
-	// Returns permission denied if requester is not a group member.
+	//Returns paginated user's followers who have not yet been invited to join the group.
+	//Results are sorted by descending follow date.
+	//Returns permission denied if requester is not a group member.
 	// Calls users and media service for user info and avatar urls.
 	GetFollowersNotInvitedToGroup(context.Context, *GroupMembersRequest) (*common.ListUsers, error)
 	// Searches active groups by title and description using substring and fuzzy
@@ -707,44 +1159,134 @@ type UserServiceServer interface {
 	// Score is computed from the search query’s similarity to a group’s title and
 	// description: for queries of length 3 or more, fuzzy similarity is used with
 	// higher weight on title matches; for shorter queries, simple substring matches
-	// are scored instead. Popularity is measured by the group’s members_count, with
+	// are scored instead. An exact match on one of the group's tags adds to the score.
+	// Popularity is measured by the group’s members_count, with
 	// groups the user already belongs to ranked ahead of others.
 	SearchGroups(context.Context, *GroupSearchRequest) (*GroupArr, error)
+	// Lists listed groups the user hasn't joined, optionally in one category.
+	// Groups are ranked by members the user follows, members joined in the last
+	// week and posts created in the last week.
+	DiscoverGroups(context.Context, *DiscoverGroupsRequest) (*GroupArr, error)
+	// Returns a group's activity over the last 30 days: member growth, posts and
+	// comments per day, most active posters, event attendance and join request approval times.
+	// Computed once per UTC day and cached until the day ends.
+	// Returns permission denied unless user's role grants view_insights.
+	GetGroupInsights(context.Context, *GeneralGroupRequest) (*GroupInsights, error)
 	// Invites a list of users to join a group.
 	// Returns permission denied if inviter is not a group member.
 	// If invite already exists it's update to "pending".
+	// Invitees whose privacy settings don't allow the inviter or who are banned are skipped.
+	// Returns permission denied if none of the invitees allow the inviter or all are banned.
 	InviteToGroup(context.Context, *InviteToGroupRequest) (*emptypb.Empty, error)
 	// Checks if the requester is a member of the given group.
 	// The onwer is also treated as a member.
 	IsGroupMember(context.Context, *GeneralGroupRequest) (*wrapperspb.BoolValue, error)
 	// Creates a join request for the group.
-	// If the request already exists it updates to "pending".
+	// If the request already exists it updates to "pending" and replaces the previous answers.
+	// Returns failed precondition if the group has rules that were not accepted,
+	// invalid argument if a required question of the join form is not answered
+	// and permission denied if the requester is banned from the group.
 	RequestJoinGroup(context.Context, *GroupJoinRequest) (*emptypb.Empty, error)
+	// Returns the rules and join questions of the group.
+	GetGroupJoinForm(context.Context, *GeneralGroupRequest) (*GroupJoinForm, error)
+	// Replaces the rules and join questions of the group.
+	// Returns permission denied if requester's role can't edit group info.
+	// Pending requests keep the questions as they were answered.
+	SetGroupJoinForm(context.Context, *SetGroupJoinFormRequest) (*emptypb.Empty, error)
+	// Turns approval of new posts by group staff on or off.
+	// Returns permission denied if requester's role can't edit group info.
+	// Posts already waiting stay in the queue when approval is turned off.
+	SetGroupPostApproval(context.Context, *SetGroupPostApprovalRequest) (*emptypb.Empty, error)
 	// Cancels a request to join the group.
 	CancelJoinGroupRequest(context.Context, *GroupJoinRequest) (*emptypb.Empty, error)
 	// Accepts or declines a received group invite.
 	RespondToGroupInvite(context.Context, *HandleGroupInviteRequest) (*emptypb.Empty, error)
 	// Approves or rejects a user's join request.
-	// Returns permission declined if user handling the request is not owner of the group.
+	// Returns permission denied if the role of the user handling the request can't approve join requests.
 	HandleGroupJoinRequest(context.Context, *HandleJoinRequest) (*emptypb.Empty, error)
 	// Removes user as member of the specified group.
 	// Returns permission denied if user wasn't a member of the group.
+	// The owner has to transfer ownership before leaving.
 	LeaveGroup(context.Context, *GeneralGroupRequest) (*emptypb.Empty, error)
 	// Removes a group member from the group.
-	// Returns permission denied if requester is not the owner of the group.
-	// Owner cannot be removed.
+	// Returns permission denied if requester's role can't remove members
+	// or doesn't outrank the member's role. Owner cannot be removed.
 	RemoveFromGroup(context.Context, *RemoveFromGroupRequest) (*emptypb.Empty, error)
+	// Bans a user from the group, with an optional reason and expiry. Members are removed
+	// and pending invites and join requests dropped. Banned users can't request to join,
+	// be invited or join through a link until the ban expires or is lifted.
+	// Returns permission denied if requester's role can't remove members
+	// or doesn't outrank the user's role.
+	BanFromGroup(context.Context, *BanFromGroupRequest) (*emptypb.Empty, error)
+	// Lifts a ban. Returns not found if the user has no active ban from the group.
+	UnbanFromGroup(context.Context, *UnbanFromGroupRequest) (*emptypb.Empty, error)
+	// Lists the active bans of the group, newest first.
+	// Returns permission denied if requester's role can't remove members.
+	GetGroupBans(context.Context, *GroupMembersRequest) (*GroupBanArr, error)
 	// Creates a new group with requester as owner and returns its id.
 	CreateGroup(context.Context, *CreateGroupRequest) (*wrapperspb.Int64Value, error)
 	// Updates group info (title, description, image).
-	// Returns permission denied if requester is not group owner.
+	// Returns permission denied if requester's role can't edit group info.
 	// All fields must be included even if they remain unchanged or they will be deleted.
 	UpdateGroup(context.Context, *UpdateGroupRequest) (*emptypb.Empty, error)
-	// Retrieves basic public info for a user (id, username, avatar id).
+	// Raises a member to a higher role (moderator or admin).
+	// Requester's role must allow managing roles and outrank both the member's current and new role.
+	// Returns failed precondition if the new role is not higher than the current one.
+	// Ownership can't be granted this way.
+	PromoteGroupMember(context.Context, *GroupRoleRequest) (*emptypb.Empty, error)
+	// Lowers a member to a lower role (admin, moderator or member).
+	// Requester's role must allow managing roles and outrank the member's current role.
+	// Returns failed precondition if the new role is not lower than the current one.
+	DemoteGroupMember(context.Context, *GroupRoleRequest) (*emptypb.Empty, error)
+	// Returns whether user's role in the group grants the given permission.
+	// Permission is one of "approve_joins", "remove_members", "delete_posts", "pin_posts",
+	// "edit_info", "manage_events", "manage_roles", "manage_invites". Non members have no permissions.
+	HasGroupPermission(context.Context, *GroupPermissionRequest) (*wrapperspb.BoolValue, error)
+	// Offers group ownership to a member, who must accept it for ownership to change.
+	// A new offer replaces any pending one.
+	// Returns permission denied if requester is not the owner
+	// and failed precondition if the new owner is not a member.
+	TransferGroupOwnership(context.Context, *TransferOwnershipRequest) (*emptypb.Empty, error)
+	// Accepts or declines a pending ownership offer addressed to the user.
+	// On acceptance the user becomes owner and the previous owner becomes admin, free to leave the group.
+	// Returns not found if there is no pending offer for the user
+	// and failed precondition if the offer is no longer valid.
+	RespondToOwnershipTransfer(context.Context, *HandleOwnershipTransferRequest) (*emptypb.Empty, error)
+	// Makes the group read-only: no posts, events, joins, invites or info edits.
+	// Members keep access to existing content and can still leave.
+	// Returns permission denied if requester is not the owner
+	// and failed precondition if the group is already archived.
+	ArchiveGroup(context.Context, *GeneralGroupRequest) (*emptypb.Empty, error)
+	// Makes an archived group writable again.
+	// Returns permission denied if requester is not the owner
+	// and failed precondition if the group is not archived.
+	UnarchiveGroup(context.Context, *GeneralGroupRequest) (*emptypb.Empty, error)
+	// Soft deletes the group, its memberships, pending invites and join requests.
+	// Group posts and events are hidden and the group conversation is closed.
+	// Returns permission denied if requester is not the owner.
+	DeleteGroup(context.Context, *GeneralGroupRequest) (*emptypb.Empty, error)
+	// Creates a shareable invite link for the group and returns it with its signed token.
+	// Expiry and max uses are optional, auto approve lets users join without a join request.
+	// Returns permission denied if requester's role can't manage invites.
+	CreateGroupInviteLink(context.Context, *CreateGroupInviteLinkRequest) (*GroupInviteLink, error)
+	// Lists all invite links of the group, newest first, with their usage stats.
+	// Returns permission denied if requester's role can't manage invites.
+	GetGroupInviteLinks(context.Context, *GeneralGroupRequest) (*GroupInviteLinkArr, error)
+	// Revokes an invite link so its token can no longer be used.
+	// Returns permission denied if requester's role can't manage invites
+	// and not found if the link doesn't belong to the group or is already revoked.
+	RevokeGroupInviteLink(context.Context, *RevokeGroupInviteLinkRequest) (*emptypb.Empty, error)
+	// Joins the group of an invite link token. If the link doesn't auto approve,
+	// a join request is created instead and joined is false.
+	// Returns invalid argument for a malformed or forged token, not found for an unknown link,
+	// failed precondition if the link is revoked, expired or used up,
+	// permission denied if the user is banned and already exists if the user is already a member.
+	JoinGroupByLink(context.Context, *JoinGroupByLinkRequest) (*JoinGroupByLinkResponse, error)
+	// Retrieves basic public info for a user (id, username, avatar id, deactivated).
 	// Does not call media service for avatar url
 	// Returns no rows for id not found.
 	GetBasicUserInfo(context.Context, *wrapperspb.Int64Value) (*common.User, error)
-	// Retrieves basic info for multiple users (id, username, avatar id).
+	// Retrieves basic info for multiple users (id, username, avatar id, deactivated).
 	// Does not call media service for avatar url
 	// Returns any users found on db, or no rows if no ids are found. Missing users are silently skipped.
 	GetBatchBasicUserInfo(context.Context, *common.UserIds) (*common.ListUsers, error)
@@ -752,22 +1294,46 @@ type UserServiceServer interface {
 	// Includes following, followers and group counts.
 	// Also includes viewer specific info: whether it's own profile and whether the viewer is following or has pending follow request.
 	// Calls media service for avatar url.
+	// Returns not found if the user is deactivated, unless it's the requester's own profile.
 	GetUserProfile(context.Context, *GetUserProfileRequest) (*UserProfileResponse, error)
 	// Searches active users by username, first name, or last name using prefix
 	// matching for short queries and fuzzy similarity for longer queries. Results
 	// are ordered by relevance and limited to at most the requested limit,
 	// with username-based alphabetical ordering used to ensure consistent ordering
 	// when relevance scores are equal.
+	// Users who are not discoverable or deactivated are excluded.
 	SearchUsers(context.Context, *UserSearchRequest) (*common.ListUsers, error)
 	// Updates profile fields for the given user and returns it.
-	// All fields must be included even if unchanged or they will be deleted.
+	// All fields must be included even if unchanged or they will be deleted,
+	// except username which is left unchanged if empty.
+	// A different username follows the same rules as ChangeUsername.
 	// Calls media service for avatar url.
 	// Sets/updates cached basic user info to avoid outdated data.
 	UpdateUserProfile(context.Context, *UpdateProfileRequest) (*UserProfileResponse, error)
 	// Toggles public/private profile visibility (public/private).
 	UpdateProfilePrivacy(context.Context, *UpdateProfilePrivacyRequest) (*emptypb.Empty, error)
+	// Changes the username of a user. Can be done once every 30 days.
+	// The old username stays reserved for the user for 90 days and keeps resolving to them.
+	// Returns already exists if the username is taken or reserved by another user.
+	// Invalidates cached basic user info.
+	ChangeUsername(context.Context, *ChangeUsernameRequest) (*emptypb.Empty, error)
+	// Maps a current or reserved former username to a user id and current username.
+	// Redirected is set when a former username was used.
+	// Returns not found if no active user matches.
+	ResolveHandle(context.Context, *wrapperspb.StringValue) (*ResolvedHandle, error)
 	// Resets failed or missing avatar ids to 0
 	RemoveImages(context.Context, *FailedImageIds) (*emptypb.Empty, error)
+	// Returns the granular privacy settings of a user.
+	// Returns not found if the user doesn't exist or is deleted.
+	GetPrivacySettings(context.Context, *wrapperspb.Int64Value) (*PrivacySettings, error)
+	// Replaces the granular privacy settings of a user.
+	// All fields must be included even if unchanged.
+	UpdatePrivacySettings(context.Context, *PrivacySettings) (*emptypb.Empty, error)
+	// Returns whether actor is allowed to perform action towards target according to target's privacy settings.
+	// Action is one of "message", "comment", "view_follow_lists", "invite_to_group".
+	// A user can always interact with themself.
+	// Returns not found if target doesn't exist or is deleted.
+	CanInteract(context.Context, *CanInteractRequest) (*wrapperspb.BoolValue, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -790,6 +1356,9 @@ func (UnimplementedUserServiceServer) UpdateUserPassword(context.Context, *Updat
 func (UnimplementedUserServiceServer) UpdateUserEmail(context.Context, *UpdateEmailRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateUserEmail not implemented")
 }
+func (UnimplementedUserServiceServer) DeactivateAccount(context.Context, *wrapperspb.Int64Value) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeactivateAccount not implemented")
+}
 func (UnimplementedUserServiceServer) GetFollowersPaginated(context.Context, *Pagination) (*common.ListUsers, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetFollowersPaginated not implemented")
 }
@@ -808,6 +1377,9 @@ func (UnimplementedUserServiceServer) HandleFollowRequest(context.Context, *Hand
 func (UnimplementedUserServiceServer) GetFollowingIds(context.Context, *wrapperspb.Int64Value) (*common.UserIds, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetFollowingIds not implemented")
 }
+func (UnimplementedUserServiceServer) GetFollowerIds(context.Context, *wrapperspb.Int64Value) (*common.UserIds, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFollowerIds not implemented")
+}
 func (UnimplementedUserServiceServer) GetFollowSuggestions(context.Context, *wrapperspb.Int64Value) (*common.ListUsers, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetFollowSuggestions not implemented")
 }
@@ -835,7 +1407,10 @@ func (UnimplementedUserServiceServer) GetGroupMembers(context.Context, *GroupMem
 func (UnimplementedUserServiceServer) GetAllGroupMemberIds(context.Context, *IdReq) (*Ids, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetAllGroupMemberIds not implemented")
 }
-func (UnimplementedUserServiceServer) GetPendingGroupJoinRequests(context.Context, *GroupMembersRequest) (*common.ListUsers, error) {
+func (UnimplementedUserServiceServer) GetUserGroupIds(context.Context, *wrapperspb.Int64Value) (*Ids, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserGroupIds not implemented")
+}
+func (UnimplementedUserServiceServer) GetPendingGroupJoinRequests(context.Context, *GroupMembersRequest) (*PendingJoinRequestArr, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetPendingGroupJoinRequests not implemented")
 }
 func (UnimplementedUserServiceServer) GetPendingGroupJoinRequestsCount(context.Context, *GeneralGroupRequest) (*CountResp, error) {
@@ -847,6 +1422,12 @@ func (UnimplementedUserServiceServer) GetFollowersNotInvitedToGroup(context.Cont
 func (UnimplementedUserServiceServer) SearchGroups(context.Context, *GroupSearchRequest) (*GroupArr, error) {
 	return nil, status.Error(codes.Unimplemented, "method SearchGroups not implemented")
 }
+func (UnimplementedUserServiceServer) DiscoverGroups(context.Context, *DiscoverGroupsRequest) (*GroupArr, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiscoverGroups not implemented")
+}
+func (UnimplementedUserServiceServer) GetGroupInsights(context.Context, *GeneralGroupRequest) (*GroupInsights, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGroupInsights not implemented")
+}
 func (UnimplementedUserServiceServer) InviteToGroup(context.Context, *InviteToGroupRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method InviteToGroup not implemented")
 }
@@ -856,6 +1437,15 @@ func (UnimplementedUserServiceServer) IsGroupMember(context.Context, *GeneralGro
 func (UnimplementedUserServiceServer) RequestJoinGroup(context.Context, *GroupJoinRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method RequestJoinGroup not implemented")
 }
+func (UnimplementedUserServiceServer) GetGroupJoinForm(context.Context, *GeneralGroupRequest) (*GroupJoinForm, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGroupJoinForm not implemented")
+}
+func (UnimplementedUserServiceServer) SetGroupJoinForm(context.Context, *SetGroupJoinFormRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetGroupJoinForm not implemented")
+}
+func (UnimplementedUserServiceServer) SetGroupPostApproval(context.Context, *SetGroupPostApprovalRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetGroupPostApproval not implemented")
+}
 func (UnimplementedUserServiceServer) CancelJoinGroupRequest(context.Context, *GroupJoinRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method CancelJoinGroupRequest not implemented")
 }
@@ -871,12 +1461,57 @@ func (UnimplementedUserServiceServer) LeaveGroup(context.Context, *GeneralGroupR
 func (UnimplementedUserServiceServer) RemoveFromGroup(context.Context, *RemoveFromGroupRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method RemoveFromGroup not implemented")
 }
+func (UnimplementedUserServiceServer) BanFromGroup(context.Context, *BanFromGroupRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method BanFromGroup not implemented")
+}
+func (UnimplementedUserServiceServer) UnbanFromGroup(context.Context, *UnbanFromGroupRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnbanFromGroup not implemented")
+}
+func (UnimplementedUserServiceServer) GetGroupBans(context.Context, *GroupMembersRequest) (*GroupBanArr, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGroupBans not implemented")
+}
 func (UnimplementedUserServiceServer) CreateGroup(context.Context, *CreateGroupRequest) (*wrapperspb.Int64Value, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateGroup not implemented")
 }
 func (UnimplementedUserServiceServer) UpdateGroup(context.Context, *UpdateGroupRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateGroup not implemented")
 }
+func (UnimplementedUserServiceServer) PromoteGroupMember(context.Context, *GroupRoleRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method PromoteGroupMember not implemented")
+}
+func (UnimplementedUserServiceServer) DemoteGroupMember(context.Context, *GroupRoleRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DemoteGroupMember not implemented")
+}
+func (UnimplementedUserServiceServer) HasGroupPermission(context.Context, *GroupPermissionRequest) (*wrapperspb.BoolValue, error) {
+	return nil, status.Error(codes.Unimplemented, "method HasGroupPermission not implemented")
+}
+func (UnimplementedUserServiceServer) TransferGroupOwnership(context.Context, *TransferOwnershipRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method TransferGroupOwnership not implemented")
+}
+func (UnimplementedUserServiceServer) RespondToOwnershipTransfer(context.Context, *HandleOwnershipTransferRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RespondToOwnershipTransfer not implemented")
+}
+func (UnimplementedUserServiceServer) ArchiveGroup(context.Context, *GeneralGroupRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ArchiveGroup not implemented")
+}
+func (UnimplementedUserServiceServer) UnarchiveGroup(context.Context, *GeneralGroupRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnarchiveGroup not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteGroup(context.Context, *GeneralGroupRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteGroup not implemented")
+}
+func (UnimplementedUserServiceServer) CreateGroupInviteLink(context.Context, *CreateGroupInviteLinkRequest) (*GroupInviteLink, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateGroupInviteLink not implemented")
+}
+func (UnimplementedUserServiceServer) GetGroupInviteLinks(context.Context, *GeneralGroupRequest) (*GroupInviteLinkArr, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGroupInviteLinks not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeGroupInviteLink(context.Context, *RevokeGroupInviteLinkRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeGroupInviteLink not implemented")
+}
+func (UnimplementedUserServiceServer) JoinGroupByLink(context.Context, *JoinGroupByLinkRequest) (*JoinGroupByLinkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method JoinGroupByLink not implemented")
+}
 func (UnimplementedUserServiceServer) GetBasicUserInfo(context.Context, *wrapperspb.Int64Value) (*common.User, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetBasicUserInfo not implemented")
 }
@@ -895,9 +1530,24 @@ func (UnimplementedUserServiceServer) UpdateUserProfile(context.Context, *Update
 func (UnimplementedUserServiceServer) UpdateProfilePrivacy(context.Context, *UpdateProfilePrivacyRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateProfilePrivacy not implemented")
 }
+func (UnimplementedUserServiceServer) ChangeUsername(context.Context, *ChangeUsernameRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ChangeUsername not implemented")
+}
+func (UnimplementedUserServiceServer) ResolveHandle(context.Context, *wrapperspb.StringValue) (*ResolvedHandle, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResolveHandle not implemented")
+}
 func (UnimplementedUserServiceServer) RemoveImages(context.Context, *FailedImageIds) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method RemoveImages not implemented")
 }
+func (UnimplementedUserServiceServer) GetPrivacySettings(context.Context, *wrapperspb.Int64Value) (*PrivacySettings, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPrivacySettings not implemented")
+}
+func (UnimplementedUserServiceServer) UpdatePrivacySettings(context.Context, *PrivacySettings) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePrivacySettings not implemented")
+}
+func (UnimplementedUserServiceServer) CanInteract(context.Context, *CanInteractRequest) (*wrapperspb.BoolValue, error) {
+	return nil, status.Error(codes.Unimplemented, "method CanInteract not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -991,31 +1641,49 @@ func _UserService_UpdateUserEmail_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetFollowersPaginated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Pagination)
+func _UserService_DeactivateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.Int64Value)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetFollowersPaginated(ctx, in)
+		return srv.(UserServiceServer).DeactivateAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetFollowersPaginated_FullMethodName,
+		FullMethod: UserService_DeactivateAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetFollowersPaginated(ctx, req.(*Pagination))
+		return srv.(UserServiceServer).DeactivateAccount(ctx, req.(*wrapperspb.Int64Value))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetFollowingPaginated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _UserService_GetFollowersPaginated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Pagination)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetFollowingPaginated(ctx, in)
+		return srv.(UserServiceServer).GetFollowersPaginated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetFollowersPaginated_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetFollowersPaginated(ctx, req.(*Pagination))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetFollowingPaginated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Pagination)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetFollowingPaginated(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -1099,6 +1767,24 @@ func _UserService_GetFollowingIds_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetFollowerIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.Int64Value)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetFollowerIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetFollowerIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetFollowerIds(ctx, req.(*wrapperspb.Int64Value))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_GetFollowSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(wrapperspb.Int64Value)
 	if err := dec(in); err != nil {
@@ -1261,6 +1947,24 @@ func _UserService_GetAllGroupMemberIds_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetUserGroupIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.Int64Value)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserGroupIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserGroupIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserGroupIds(ctx, req.(*wrapperspb.Int64Value))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_GetPendingGroupJoinRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GroupMembersRequest)
 	if err := dec(in); err != nil {
@@ -1333,6 +2037,42 @@ func _UserService_SearchGroups_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_DiscoverGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscoverGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DiscoverGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DiscoverGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DiscoverGroups(ctx, req.(*DiscoverGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetGroupInsights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneralGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetGroupInsights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetGroupInsights_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetGroupInsights(ctx, req.(*GeneralGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_InviteToGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InviteToGroupRequest)
 	if err := dec(in); err != nil {
@@ -1387,6 +2127,60 @@ func _UserService_RequestJoinGroup_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetGroupJoinForm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneralGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetGroupJoinForm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetGroupJoinForm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetGroupJoinForm(ctx, req.(*GeneralGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetGroupJoinForm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupJoinFormRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetGroupJoinForm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetGroupJoinForm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetGroupJoinForm(ctx, req.(*SetGroupJoinFormRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetGroupPostApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupPostApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetGroupPostApproval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetGroupPostApproval_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetGroupPostApproval(ctx, req.(*SetGroupPostApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_CancelJoinGroupRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GroupJoinRequest)
 	if err := dec(in); err != nil {
@@ -1477,6 +2271,60 @@ func _UserService_RemoveFromGroup_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_BanFromGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanFromGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BanFromGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BanFromGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BanFromGroup(ctx, req.(*BanFromGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UnbanFromGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanFromGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnbanFromGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnbanFromGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnbanFromGroup(ctx, req.(*UnbanFromGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetGroupBans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetGroupBans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetGroupBans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetGroupBans(ctx, req.(*GroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateGroupRequest)
 	if err := dec(in); err != nil {
@@ -1513,6 +2361,222 @@ func _UserService_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_PromoteGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).PromoteGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_PromoteGroupMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).PromoteGroupMember(ctx, req.(*GroupRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DemoteGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DemoteGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DemoteGroupMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DemoteGroupMember(ctx, req.(*GroupRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_HasGroupPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).HasGroupPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_HasGroupPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).HasGroupPermission(ctx, req.(*GroupPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_TransferGroupOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).TransferGroupOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_TransferGroupOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).TransferGroupOwnership(ctx, req.(*TransferOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RespondToOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandleOwnershipTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RespondToOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RespondToOwnershipTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RespondToOwnershipTransfer(ctx, req.(*HandleOwnershipTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ArchiveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneralGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ArchiveGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ArchiveGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ArchiveGroup(ctx, req.(*GeneralGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UnarchiveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneralGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnarchiveGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnarchiveGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnarchiveGroup(ctx, req.(*GeneralGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneralGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteGroup(ctx, req.(*GeneralGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateGroupInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupInviteLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateGroupInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateGroupInviteLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateGroupInviteLink(ctx, req.(*CreateGroupInviteLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetGroupInviteLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneralGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetGroupInviteLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetGroupInviteLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetGroupInviteLinks(ctx, req.(*GeneralGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeGroupInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeGroupInviteLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeGroupInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeGroupInviteLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeGroupInviteLink(ctx, req.(*RevokeGroupInviteLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_JoinGroupByLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinGroupByLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).JoinGroupByLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_JoinGroupByLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).JoinGroupByLink(ctx, req.(*JoinGroupByLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_GetBasicUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(wrapperspb.Int64Value)
 	if err := dec(in); err != nil {
@@ -1621,6 +2685,42 @@ func _UserService_UpdateProfilePrivacy_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ChangeUsername_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeUsernameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ChangeUsername(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ChangeUsername_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ChangeUsername(ctx, req.(*ChangeUsernameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ResolveHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ResolveHandle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ResolveHandle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ResolveHandle(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_RemoveImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FailedImageIds)
 	if err := dec(in); err != nil {
@@ -1639,6 +2739,60 @@ func _UserService_RemoveImages_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetPrivacySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.Int64Value)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPrivacySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetPrivacySettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPrivacySettings(ctx, req.(*wrapperspb.Int64Value))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdatePrivacySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrivacySettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdatePrivacySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdatePrivacySettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdatePrivacySettings(ctx, req.(*PrivacySettings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CanInteract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CanInteractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CanInteract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CanInteract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CanInteract(ctx, req.(*CanInteractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1662,6 +2816,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateUserEmail",
 			Handler:    _UserService_UpdateUserEmail_Handler,
 		},
+		{
+			MethodName: "DeactivateAccount",
+			Handler:    _UserService_DeactivateAccount_Handler,
+		},
 		{
 			MethodName: "GetFollowersPaginated",
 			Handler:    _UserService_GetFollowersPaginated_Handler,
@@ -1686,6 +2844,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFollowingIds",
 			Handler:    _UserService_GetFollowingIds_Handler,
 		},
+		{
+			MethodName: "GetFollowerIds",
+			Handler:    _UserService_GetFollowerIds_Handler,
+		},
 		{
 			MethodName: "GetFollowSuggestions",
 			Handler:    _UserService_GetFollowSuggestions_Handler,
@@ -1722,6 +2884,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllGroupMemberIds",
 			Handler:    _UserService_GetAllGroupMemberIds_Handler,
 		},
+		{
+			MethodName: "GetUserGroupIds",
+			Handler:    _UserService_GetUserGroupIds_Handler,
+		},
 		{
 			MethodName: "GetPendingGroupJoinRequests",
 			Handler:    _UserService_GetPendingGroupJoinRequests_Handler,
@@ -1738,6 +2904,14 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchGroups",
 			Handler:    _UserService_SearchGroups_Handler,
 		},
+		{
+			MethodName: "DiscoverGroups",
+			Handler:    _UserService_DiscoverGroups_Handler,
+		},
+		{
+			MethodName: "GetGroupInsights",
+			Handler:    _UserService_GetGroupInsights_Handler,
+		},
 		{
 			MethodName: "InviteToGroup",
 			Handler:    _UserService_InviteToGroup_Handler,
@@ -1750,6 +2924,18 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RequestJoinGroup",
 			Handler:    _UserService_RequestJoinGroup_Handler,
 		},
+		{
+			MethodName: "GetGroupJoinForm",
+			Handler:    _UserService_GetGroupJoinForm_Handler,
+		},
+		{
+			MethodName: "SetGroupJoinForm",
+			Handler:    _UserService_SetGroupJoinForm_Handler,
+		},
+		{
+			MethodName: "SetGroupPostApproval",
+			Handler:    _UserService_SetGroupPostApproval_Handler,
+		},
 		{
 			MethodName: "CancelJoinGroupRequest",
 			Handler:    _UserService_CancelJoinGroupRequest_Handler,
@@ -1770,6 +2956,18 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveFromGroup",
 			Handler:    _UserService_RemoveFromGroup_Handler,
 		},
+		{
+			MethodName: "BanFromGroup",
+			Handler:    _UserService_BanFromGroup_Handler,
+		},
+		{
+			MethodName: "UnbanFromGroup",
+			Handler:    _UserService_UnbanFromGroup_Handler,
+		},
+		{
+			MethodName: "GetGroupBans",
+			Handler:    _UserService_GetGroupBans_Handler,
+		},
 		{
 			MethodName: "CreateGroup",
 			Handler:    _UserService_CreateGroup_Handler,
@@ -1778,6 +2976,54 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGroup",
 			Handler:    _UserService_UpdateGroup_Handler,
 		},
+		{
+			MethodName: "PromoteGroupMember",
+			Handler:    _UserService_PromoteGroupMember_Handler,
+		},
+		{
+			MethodName: "DemoteGroupMember",
+			Handler:    _UserService_DemoteGroupMember_Handler,
+		},
+		{
+			MethodName: "HasGroupPermission",
+			Handler:    _UserService_HasGroupPermission_Handler,
+		},
+		{
+			MethodName: "TransferGroupOwnership",
+			Handler:    _UserService_TransferGroupOwnership_Handler,
+		},
+		{
+			MethodName: "RespondToOwnershipTransfer",
+			Handler:    _UserService_RespondToOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "ArchiveGroup",
+			Handler:    _UserService_ArchiveGroup_Handler,
+		},
+		{
+			MethodName: "UnarchiveGroup",
+			Handler:    _UserService_UnarchiveGroup_Handler,
+		},
+		{
+			MethodName: "DeleteGroup",
+			Handler:    _UserService_DeleteGroup_Handler,
+		},
+		{
+			MethodName: "CreateGroupInviteLink",
+			Handler:    _UserService_CreateGroupInviteLink_Handler,
+		},
+		{
+			MethodName: "GetGroupInviteLinks",
+			Handler:    _UserService_GetGroupInviteLinks_Handler,
+		},
+		{
+			MethodName: "RevokeGroupInviteLink",
+			Handler:    _UserService_RevokeGroupInviteLink_Handler,
+		},
+		{
+			MethodName: "JoinGroupByLink",
+			Handler:    _UserService_JoinGroupByLink_Handler,
+		},
 		{
 			MethodName: "GetBasicUserInfo",
 			Handler:    _UserService_GetBasicUserInfo_Handler,
@@ -1802,10 +3048,30 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProfilePrivacy",
 			Handler:    _UserService_UpdateProfilePrivacy_Handler,
 		},
+		{
+			MethodName: "ChangeUsername",
+			Handler:    _UserService_ChangeUsername_Handler,
+		},
+		{
+			MethodName: "ResolveHandle",
+			Handler:    _UserService_ResolveHandle_Handler,
+		},
 		{
 			MethodName: "RemoveImages",
 			Handler:    _UserService_RemoveImages_Handler,
 		},
+		{
+			MethodName: "GetPrivacySettings",
+			Handler:    _UserService_GetPrivacySettings_Handler,
+		},
+		{
+			MethodName: "UpdatePrivacySettings",
+			Handler:    _UserService_UpdatePrivacySettings_Handler,
+		},
+		{
+			MethodName: "CanInteract",
+			Handler:    _UserService_CanInteract_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "users.proto",
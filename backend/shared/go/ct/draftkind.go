@@ -0,0 +1,52 @@
+package ct
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// ------------------------------------------------------------
+// DraftKind
+// ------------------------------------------------------------
+
+// What a draft is published as.
+//   - post: published with CreatePost
+//   - comment: published with CreateComment on the draft's parent post
+type DraftKind string
+
+const (
+	DraftKindPost    DraftKind = "post"
+	DraftKindComment DraftKind = "comment"
+)
+
+func (k DraftKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(k))
+}
+
+func (k *DraftKind) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*k = DraftKind(s)
+	return nil
+}
+
+func (k DraftKind) isValid() bool {
+	return slices.Contains(permittedDraftKindValues, k.String())
+}
+
+func (k DraftKind) Validate() error {
+	if !k.isValid() {
+		return fmt.Errorf("%w: draft kind must be one of the following: %v",
+			ErrValidation,
+			permittedDraftKindValues,
+		)
+	}
+	return nil
+}
+
+func (k DraftKind) String() string {
+	return string(k)
+}
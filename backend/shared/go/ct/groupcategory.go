@@ -0,0 +1,133 @@
+package ct
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ------------------------------------------------------------
+// GroupCategory
+// ------------------------------------------------------------
+
+// The topic a group is listed under in discovery. Groups without one are "other".
+type GroupCategory string
+
+const GroupCategoryOther GroupCategory = "other"
+
+func (c GroupCategory) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(c))
+}
+
+func (c *GroupCategory) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*c = GroupCategory(s)
+	return nil
+}
+
+func (c GroupCategory) isValid() bool {
+	return slices.Contains(permittedGroupCategoryValues, c.String())
+}
+
+func (c GroupCategory) Validate() error {
+	if !c.isValid() {
+		return fmt.Errorf("%w: group category must be one of the following: %v",
+			ErrValidation,
+			permittedGroupCategoryValues,
+		)
+	}
+	return nil
+}
+
+func (c GroupCategory) String() string {
+	return string(c)
+}
+
+// ------------------------------------------------------------
+// GroupTag
+// ------------------------------------------------------------
+
+// groupTagRegex validates a group tag.
+// - Lowercase ASCII letters, digits and hyphens only
+// - Must start with a letter or digit
+// - Length must be between 2 and 30 characters
+var groupTagRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,29}$`)
+
+// Free-form keyword a group is found by in search and discovery.
+type GroupTag string
+
+func (t GroupTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(t))
+}
+
+// Tags are stored lowercase, so input is normalized on unmarshal.
+func (t *GroupTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*t = GroupTag(strings.ToLower(strings.TrimSpace(s)))
+	return nil
+}
+
+func (t GroupTag) isValid() bool {
+	return groupTagRegex.MatchString(string(t))
+}
+
+func (t GroupTag) Validate() error {
+	if !t.isValid() {
+		return errors.Join(ErrValidation,
+			errors.New("group tag must be 2-30 lowercase letters, digits or hyphens"),
+		)
+	}
+	return nil
+}
+
+func (t GroupTag) String() string {
+	return string(t)
+}
+
+// A group's set of tags. At most maxGroupTags, no duplicates.
+type GroupTags []GroupTag
+
+func (ts GroupTags) Validate() error {
+	if len(ts) > maxGroupTags {
+		return errors.Join(ErrValidation,
+			fmt.Errorf("a group can have at most %d tags", maxGroupTags),
+		)
+	}
+	seen := make(map[GroupTag]struct{}, len(ts))
+	for _, t := range ts {
+		if err := t.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seen[t]; ok {
+			return errors.Join(ErrValidation, fmt.Errorf("duplicate group tag %q", t))
+		}
+		seen[t] = struct{}{}
+	}
+	return nil
+}
+
+func (ts GroupTags) Strings() []string {
+	out := make([]string, len(ts))
+	for i, t := range ts {
+		out[i] = t.String()
+	}
+	return out
+}
+
+// Normalizes and converts raw strings, validation is left to the caller.
+func GroupTagsFromStrings(ss []string) GroupTags {
+	out := make(GroupTags, len(ss))
+	for i, s := range ss {
+		out[i] = GroupTag(strings.ToLower(strings.TrimSpace(s)))
+	}
+	return out
+}
@@ -0,0 +1,119 @@
+package ct
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// ------------------------------------------------------------
+// GroupRole
+// ------------------------------------------------------------
+
+// Role of a member inside a group.
+type GroupRole string
+
+const (
+	GroupRoleOwner     GroupRole = "owner"
+	GroupRoleAdmin     GroupRole = "admin"
+	GroupRoleModerator GroupRole = "moderator"
+	GroupRoleMember    GroupRole = "member"
+)
+
+func (r GroupRole) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(r))
+}
+
+func (r *GroupRole) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*r = GroupRole(s)
+	return nil
+}
+
+func (r GroupRole) isValid() bool {
+	return slices.Contains(permittedGroupRoleValues, r.String())
+}
+
+func (r GroupRole) Validate() error {
+	if !r.isValid() {
+		return fmt.Errorf("%w: group role must be one of the following: %v",
+			ErrValidation,
+			permittedGroupRoleValues,
+		)
+	}
+	return nil
+}
+
+func (r GroupRole) String() string {
+	return string(r)
+}
+
+// Rank orders roles from member (0) to owner (3). Unknown roles rank below member.
+func (r GroupRole) Rank() int {
+	switch r {
+	case GroupRoleOwner:
+		return 3
+	case GroupRoleAdmin:
+		return 2
+	case GroupRoleModerator:
+		return 1
+	case GroupRoleMember:
+		return 0
+	}
+	return -1
+}
+
+// ------------------------------------------------------------
+// GroupPermission
+// ------------------------------------------------------------
+
+// An action inside a group that is only allowed to some roles.
+type GroupPermission string
+
+const (
+	PermApproveJoins  GroupPermission = "approve_joins"
+	PermRemoveMembers GroupPermission = "remove_members"
+	PermDeletePosts   GroupPermission = "delete_posts"
+	PermPinPosts      GroupPermission = "pin_posts"
+	PermEditInfo      GroupPermission = "edit_info"
+	PermManageEvents  GroupPermission = "manage_events"
+	PermManageRoles   GroupPermission = "manage_roles"
+	PermManageInvites GroupPermission = "manage_invites"
+	PermAnnounce      GroupPermission = "announce"
+	PermViewInsights  GroupPermission = "view_insights"
+	PermApprovePosts  GroupPermission = "approve_posts"
+)
+
+func (p GroupPermission) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(p))
+}
+
+func (p *GroupPermission) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*p = GroupPermission(s)
+	return nil
+}
+
+func (p GroupPermission) isValid() bool {
+	return slices.Contains(permittedGroupPermissionValues, p.String())
+}
+
+func (p GroupPermission) Validate() error {
+	if !p.isValid() {
+		return fmt.Errorf("%w: group permission must be one of the following: %v",
+			ErrValidation,
+			permittedGroupPermissionValues,
+		)
+	}
+	return nil
+}
+
+func (p GroupPermission) String() string {
+	return string(p)
+}
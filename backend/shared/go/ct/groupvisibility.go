@@ -0,0 +1,54 @@
+package ct
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// ------------------------------------------------------------
+// GroupVisibility
+// ------------------------------------------------------------
+
+// Who can find a group and read its posts.
+//   - public: listed and searchable, posts readable by anyone
+//   - private: listed and searchable, posts readable by members only
+//   - secret: hidden from listings and search, joined by invite only
+type GroupVisibility string
+
+const (
+	GroupVisibilityPublic  GroupVisibility = "public"
+	GroupVisibilityPrivate GroupVisibility = "private"
+	GroupVisibilitySecret  GroupVisibility = "secret"
+)
+
+func (v GroupVisibility) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(v))
+}
+
+func (v *GroupVisibility) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*v = GroupVisibility(s)
+	return nil
+}
+
+func (v GroupVisibility) isValid() bool {
+	return slices.Contains(permittedGroupVisibilityValues, v.String())
+}
+
+func (v GroupVisibility) Validate() error {
+	if !v.isValid() {
+		return fmt.Errorf("%w: group visibility must be one of the following: %v",
+			ErrValidation,
+			permittedGroupVisibilityValues,
+		)
+	}
+	return nil
+}
+
+func (v GroupVisibility) String() string {
+	return string(v)
+}
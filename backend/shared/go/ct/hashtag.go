@@ -0,0 +1,58 @@
+package ct
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// ------------------------------------------------------------
+// Hashtag
+// ------------------------------------------------------------
+
+// hashtagRegex validates a hashtag, without its #.
+// - Unicode letters (with their combining marks), digits and underscores only
+// - Length must be between 1 and 50 characters
+// - Must also contain a letter, checked separately, so "#1" isn't a tag
+var hashtagRegex = regexp.MustCompile(`^[\p{L}\p{M}\p{N}_]{1,50}$`)
+
+// A #tag in a post body, stored and compared lowercase and without the #.
+type Hashtag string
+
+func (h Hashtag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(h))
+}
+
+// Input is normalized on unmarshal, a leading # is allowed.
+func (h *Hashtag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*h = NormalizeHashtag(s)
+	return nil
+}
+
+func (h Hashtag) isValid() bool {
+	return hashtagRegex.MatchString(string(h)) && strings.IndexFunc(string(h), unicode.IsLetter) >= 0
+}
+
+func (h Hashtag) Validate() error {
+	if !h.isValid() {
+		return errors.Join(ErrValidation,
+			errors.New("hashtag must be 1-50 letters, digits or underscores, with at least one letter"),
+		)
+	}
+	return nil
+}
+
+func (h Hashtag) String() string {
+	return string(h)
+}
+
+// Trims a leading # and lowercases, validation is left to the caller.
+func NormalizeHashtag(s string) Hashtag {
+	return Hashtag(strings.ToLower(strings.TrimPrefix(strings.TrimSpace(s), "#")))
+}
@@ -0,0 +1,97 @@
+package ct
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// ------------------------------------------------------------
+// PrivacyAudience
+// ------------------------------------------------------------
+
+// Who a user allows to perform an interaction with them.
+type PrivacyAudience string
+
+const (
+	PrivacyEveryone  PrivacyAudience = "everyone"
+	PrivacyFollowers PrivacyAudience = "followers"
+	PrivacyMutuals   PrivacyAudience = "mutuals"
+	PrivacyNobody    PrivacyAudience = "nobody"
+)
+
+func (pa PrivacyAudience) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(pa))
+}
+
+func (pa *PrivacyAudience) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*pa = PrivacyAudience(s)
+	return nil
+}
+
+func (pa PrivacyAudience) isValid() bool {
+	return slices.Contains(permittedPrivacyAudienceValues, pa.String())
+}
+
+func (pa PrivacyAudience) Validate() error {
+	if !pa.isValid() {
+		return fmt.Errorf("%w: privacy audience must be one of the following: %v",
+			ErrValidation,
+			permittedPrivacyAudienceValues,
+		)
+	}
+	return nil
+}
+
+func (pa PrivacyAudience) String() string {
+	return string(pa)
+}
+
+// ------------------------------------------------------------
+// PrivacyAction
+// ------------------------------------------------------------
+
+// An interaction between two users that is subject to the target's privacy settings.
+type PrivacyAction string
+
+const (
+	ActionMessage         PrivacyAction = "message"
+	ActionComment         PrivacyAction = "comment"
+	ActionViewFollowLists PrivacyAction = "view_follow_lists"
+	ActionInviteToGroup   PrivacyAction = "invite_to_group"
+)
+
+func (a PrivacyAction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(a))
+}
+
+func (a *PrivacyAction) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*a = PrivacyAction(s)
+	return nil
+}
+
+func (a PrivacyAction) isValid() bool {
+	return slices.Contains(permittedPrivacyActionValues, a.String())
+}
+
+func (a PrivacyAction) Validate() error {
+	if !a.isValid() {
+		return fmt.Errorf("%w: privacy action must be one of the following: %v",
+			ErrValidation,
+			permittedPrivacyActionValues,
+		)
+	}
+	return nil
+}
+
+func (a PrivacyAction) String() string {
+	return string(a)
+}
@@ -0,0 +1,54 @@
+package ct
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// ------------------------------------------------------------
+// ReactionType
+// ------------------------------------------------------------
+
+// How a user reacted to a post or comment, a user has at most one reaction per entity.
+type ReactionType string
+
+const (
+	ReactionLike  ReactionType = "like"
+	ReactionLove  ReactionType = "love"
+	ReactionLaugh ReactionType = "laugh"
+	ReactionWow   ReactionType = "wow"
+	ReactionSad   ReactionType = "sad"
+	ReactionAngry ReactionType = "angry"
+)
+
+func (r ReactionType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(r))
+}
+
+func (r *ReactionType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*r = ReactionType(s)
+	return nil
+}
+
+func (r ReactionType) isValid() bool {
+	return slices.Contains(permittedReactionTypeValues, r.String())
+}
+
+func (r ReactionType) Validate() error {
+	if !r.isValid() {
+		return fmt.Errorf("%w: reaction type must be one of the following: %v",
+			ErrValidation,
+			permittedReactionTypeValues,
+		)
+	}
+	return nil
+}
+
+func (r ReactionType) String() string {
+	return string(r)
+}
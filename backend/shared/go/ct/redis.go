@@ -2,6 +2,7 @@ package ct
 
 import (
 	"fmt"
+	"time"
 )
 
 type BasicUserInfoKey struct {
@@ -50,3 +51,20 @@ func (k IsGroupMemberKey) GenKey() (string, error) {
 func (k IsGroupMemberKey) String() string {
 	return fmt.Sprintf("is_group:%d.member:%d", k.GroupId.Int64(), k.UserId.Int64())
 }
+
+// Group insights are computed once per UTC day, Day is the start of that day.
+type GroupInsightsKey struct {
+	GroupId Id
+	Day     time.Time
+}
+
+func (k GroupInsightsKey) GenKey() (string, error) {
+	if err := k.GroupId.Validate(); err != nil {
+		return "", err
+	}
+	return k.String(), nil
+}
+
+func (k GroupInsightsKey) String() string {
+	return fmt.Sprintf("group_insights:%d:%s", k.GroupId.Int64(), k.Day.UTC().Format(time.DateOnly))
+}
@@ -40,10 +40,36 @@ const (
 	maxLimit                = 500
 	minTitleChars           = 1
 	maxTitleChars           = 50
+	maxGroupTags            = 5
 )
 
+// Maximum number of images a single post can carry.
+const MaxPostImages = 10
+
+// Maximum number of drafts a user can keep at once.
+const MaxDraftsPerUser = 100
+
+// Deepest reply allowed under a top level comment, which sits at depth 0.
+const MaxCommentDepth = 3
+
 var permittedAudienceValues = []string{"everyone", "group", "followers", "selected"}
 
+var permittedPrivacyAudienceValues = []string{"everyone", "followers", "mutuals", "nobody"}
+
+var permittedPrivacyActionValues = []string{"message", "comment", "view_follow_lists", "invite_to_group"}
+
+var permittedGroupRoleValues = []string{"owner", "admin", "moderator", "member"}
+
+var permittedGroupPermissionValues = []string{"approve_joins", "remove_members", "delete_posts", "pin_posts", "edit_info", "manage_events", "manage_roles", "manage_invites", "announce", "view_insights", "approve_posts"}
+
+var permittedGroupVisibilityValues = []string{"public", "private", "secret"}
+
+var permittedGroupCategoryValues = []string{"other", "arts", "business", "education", "entertainment", "gaming", "health", "hobbies", "local", "music", "news", "science", "sports", "technology", "travel"}
+
+var permittedDraftKindValues = []string{"post", "comment"}
+
+var permittedReactionTypeValues = []string{"like", "love", "laugh", "wow", "sad", "angry"}
+
 // emailRegex validates a basic email address format.
 // - Requires exactly one '@' character
 // - Disallows spaces anywhere in the address
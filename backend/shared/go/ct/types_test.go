@@ -225,6 +225,110 @@ func TestTitleValidation(t *testing.T) {
 	}
 }
 
+// ------------------------------------------------------------
+// PrivacyAudience / PrivacyAction
+// ------------------------------------------------------------
+func TestPrivacyAudienceValidation(t *testing.T) {
+	if err := ct.PrivacyMutuals.Validate(); err != nil {
+		t.Fatalf("unexpected: %v", err)
+	}
+	if err := ct.PrivacyAudience("friends").Validate(); err == nil {
+		t.Fatal("expected error for unknown audience")
+	}
+	if err := ct.PrivacyAudience("").Validate(); err == nil {
+		t.Fatal("expected error for empty audience")
+	}
+}
+
+func TestPrivacyActionValidation(t *testing.T) {
+	if err := ct.ActionInviteToGroup.Validate(); err != nil {
+		t.Fatalf("unexpected: %v", err)
+	}
+	if err := ct.PrivacyAction("poke").Validate(); err == nil {
+		t.Fatal("expected error for unknown action")
+	}
+}
+
+// ------------------------------------------------------------
+// GroupRole / GroupPermission
+// ------------------------------------------------------------
+func TestGroupRoleValidation(t *testing.T) {
+	if err := ct.GroupRoleModerator.Validate(); err != nil {
+		t.Fatalf("unexpected: %v", err)
+	}
+	if err := ct.GroupRole("founder").Validate(); err == nil {
+		t.Fatal("expected error for unknown role")
+	}
+	if ct.GroupRoleAdmin.Rank() <= ct.GroupRoleModerator.Rank() || ct.GroupRoleOwner.Rank() <= ct.GroupRoleAdmin.Rank() {
+		t.Fatal("expected owner > admin > moderator")
+	}
+}
+
+func TestGroupPermissionValidation(t *testing.T) {
+	if err := ct.PermApproveJoins.Validate(); err != nil {
+		t.Fatalf("unexpected: %v", err)
+	}
+	if err := ct.PermAnnounce.Validate(); err != nil {
+		t.Fatalf("unexpected: %v", err)
+	}
+	if err := ct.PermViewInsights.Validate(); err != nil {
+		t.Fatalf("unexpected: %v", err)
+	}
+	if err := ct.PermApprovePosts.Validate(); err != nil {
+		t.Fatalf("unexpected: %v", err)
+	}
+	if err := ct.GroupPermission("ban_everyone").Validate(); err == nil {
+		t.Fatal("expected error for unknown permission")
+	}
+}
+
+// ------------------------------------------------------------
+// GroupVisibility
+// ------------------------------------------------------------
+func TestGroupVisibilityValidation(t *testing.T) {
+	if err := ct.GroupVisibilitySecret.Validate(); err != nil {
+		t.Fatalf("unexpected: %v", err)
+	}
+	if err := ct.GroupVisibility("hidden").Validate(); err == nil {
+		t.Fatal("expected error for unknown visibility")
+	}
+}
+
+// ------------------------------------------------------------
+// GroupCategory / GroupTags
+// ------------------------------------------------------------
+func TestGroupCategoryValidation(t *testing.T) {
+	if err := ct.GroupCategory("gaming").Validate(); err != nil {
+		t.Fatalf("unexpected: %v", err)
+	}
+	if err := ct.GroupCategory("cooking-with-cats").Validate(); err == nil {
+		t.Fatal("expected error for unknown category")
+	}
+}
+
+func TestGroupTagsValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		tags    ct.GroupTags
+		wantErr bool
+	}{
+		{"valid", ct.GroupTagsFromStrings([]string{"Go", " board-games "}), false},
+		{"too short", ct.GroupTags{"a"}, true},
+		{"bad chars", ct.GroupTags{"rock&roll"}, true},
+		{"leading hyphen", ct.GroupTags{"-go"}, true},
+		{"duplicate", ct.GroupTagsFromStrings([]string{"go", "GO"}), true},
+		{"too many", ct.GroupTags{"a1", "b2", "c3", "d4", "e5", "f6"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.tags.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 // ------------------------------------------------------------
 // ValidateStruct
 // ------------------------------------------------------------
@@ -465,3 +569,58 @@ func TestValidateBatch(t *testing.T) {
 // 		t.Logf("Inner error msg: %s, kind: %v", e.Err.(*ct.Error).Msg, e.Err.(*ct.Error).Kind)
 // 	}
 // }
+
+// ------------------------------------------------------------
+// DraftKind
+// ------------------------------------------------------------
+func TestDraftKindValidation(t *testing.T) {
+	for _, k := range []ct.DraftKind{ct.DraftKindPost, ct.DraftKindComment} {
+		if err := k.Validate(); err != nil {
+			t.Fatalf("unexpected for %q: %v", k, err)
+		}
+	}
+	if err := ct.DraftKind("event").Validate(); err == nil {
+		t.Fatal("expected error for unknown draft kind")
+	}
+}
+
+// ------------------------------------------------------------
+// ReactionType
+// ------------------------------------------------------------
+func TestReactionTypeValidation(t *testing.T) {
+	for _, r := range []ct.ReactionType{ct.ReactionLike, ct.ReactionLove, ct.ReactionLaugh, ct.ReactionWow, ct.ReactionSad, ct.ReactionAngry} {
+		if err := r.Validate(); err != nil {
+			t.Fatalf("unexpected for %q: %v", r, err)
+		}
+	}
+	if err := ct.ReactionType("dislike").Validate(); err == nil {
+		t.Fatal("expected error for unknown reaction type")
+	}
+}
+
+func TestHashtagValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		tag     ct.Hashtag
+		wantErr bool
+	}{
+		{"valid", ct.NormalizeHashtag("#GoLang"), false},
+		{"unicode", ct.NormalizeHashtag("Καλημέρα"), false},
+		{"digits and letters", ct.Hashtag("web3"), false},
+		{"digits only", ct.Hashtag("2024"), true},
+		{"empty", ct.Hashtag(""), true},
+		{"punctuation", ct.Hashtag("rock&roll"), true},
+		{"too long", ct.Hashtag(strings.Repeat("a", 51)), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.tag.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate(%q) error = %v, wantErr %v", tt.tag, err, tt.wantErr)
+			}
+		})
+	}
+	if ct.NormalizeHashtag(" #GoLang") != "golang" {
+		t.Fatal("expected hashtag to be trimmed and lowercased")
+	}
+}
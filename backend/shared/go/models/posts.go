@@ -37,22 +37,40 @@ type Post struct {
 	Audience              ct.Audience    `json:"audience"`
 	CommentsCount         int            `json:"comments_count"`
 	ReactionsCount        int            `json:"reactions_count"`
+	ReactionCounts        map[string]int `json:"reaction_counts"` // per reaction type, missing types have none
 	LastCommentedAt       ct.GenDateTime `json:"last_commented_at"`
 	CreatedAt             ct.GenDateTime `json:"created_at"`
 	UpdatedAt             ct.GenDateTime `json:"updated_at" validate:"nullable"`
 	LikedByUser           bool           `json:"liked_by_user"`
+	UserReaction          string         `json:"user_reaction,omitempty"` // empty unless LikedByUser
 	ImageId               ct.Id          `json:"image" validate:"nullable"`
 	ImageUrl              string         `json:"image_url"`
+	ImageIds              ct.Ids         `json:"images" validate:"nullable"` // whole gallery in display order, first one is also ImageId
+	ImageUrls             []string       `json:"image_urls"`
 	SelectedAudienceUsers []User         `json:"selected_audience_users"`
+	Pinned                bool           `json:"pinned"`
+	Announcement          bool           `json:"announcement"`
+	ApprovalStatus        string         `json:"approval_status,omitempty"`  // approved, pending or rejected
+	RejectionReason       string         `json:"rejection_reason,omitempty"` // only shown to the author
+	PublishAt             ct.GenDateTime `json:"publish_at"`                 // only set for scheduled posts, which only their author sees
+	Edited                bool           `json:"edited"`                     // true once the body was changed after publishing, see Revision
+	Mentions              []Mention      `json:"mentions"`
+	SharedPostId          ct.Id          `json:"shared_post_id,omitempty"` // set on reposts and quotes, a repost has an empty body
+	SharedPost            *Post          `json:"shared_post,omitempty"`    // nil if the requester can't see the shared post or it's deleted
+	RepostsCount          int            `json:"reposts_count"`
+	QuotesCount           int            `json:"quotes_count"`
+	RepostedByUser        bool           `json:"reposted_by_user"`
 }
 
 type CreatePostReq struct {
 	CreatorId   ct.Id
-	Body        ct.PostBody `json:"post_body"`
-	GroupId     ct.Id       `json:"group_id" validate:"nullable"`
-	Audience    ct.Audience `json:"audience"`
-	AudienceIds ct.Ids      `json:"audience_ids" validate:"nullable"`
-	ImageId     ct.Id       `json:"image" validate:"nullable"`
+	Body        ct.PostBody    `json:"post_body"`
+	GroupId     ct.Id          `json:"group_id" validate:"nullable"`
+	Audience    ct.Audience    `json:"audience"`
+	AudienceIds ct.Ids         `json:"audience_ids" validate:"nullable"`
+	ImageId     ct.Id          `json:"image" validate:"nullable"`
+	ImageIds    ct.Ids         `json:"images" validate:"nullable"`     // gallery in display order, takes precedence over ImageId
+	PublishAt   ct.GenDateTime `json:"publish_at" validate:"nullable"` // schedules the post, zero publishes right away
 }
 
 type EditPostReq struct {
@@ -60,11 +78,43 @@ type EditPostReq struct {
 	PostId      ct.Id       `json:"post_id"`
 	NewBody     ct.PostBody `json:"new_body" validate:"nullable"`
 	ImageId     ct.Id       `json:"image" validate:"nullable"`
+	ImageIds    ct.Ids      `json:"images" validate:"nullable"` // new gallery in display order, replaces the current one
 	Audience    ct.Audience `json:"audience"`
 	AudienceIds ct.Ids      `json:"audience_ids" validate:"nullable"`
 	DeleteImage bool        `json:"delete_image"`
 }
 
+// Sets or clears a flag (pinned, announcement) on a group post
+type SetPostFlagReq struct {
+	RequesterId ct.Id
+	PostId      ct.Id `json:"post_id"`
+	Value       bool  `json:"value"`
+}
+
+// Approves or rejects a pending group post, reason is only kept on rejection
+type ReviewPostReq struct {
+	RequesterId ct.Id
+	PostId      ct.Id  `json:"post_id"`
+	Reason      string `json:"reason"`
+}
+
+// Moves the publish time of a scheduled post
+type ReschedulePostReq struct {
+	RequesterId ct.Id
+	PostId      ct.Id          `json:"post_id"`
+	PublishAt   ct.GenDateTime `json:"publish_at"`
+}
+
+// Reposts a post, or quotes it when Body is set.
+// Audience can't be wider than the audience of the shared post, see SharePost.
+type SharePostReq struct {
+	RequesterId ct.Id
+	PostId      ct.Id       `json:"post_id"`
+	Body        ct.PostBody `json:"post_body" validate:"nullable"`
+	Audience    ct.Audience `json:"audience"`
+	AudienceIds ct.Ids      `json:"audience_ids" validate:"nullable"`
+}
+
 type GetUserPostsReq struct {
 	CreatorId   ct.Id `json:"creator_id"`
 	RequesterId ct.Id
@@ -95,11 +145,19 @@ type Comment struct {
 	Body           ct.CommentBody `json:"comment_body"`
 	User           User           `json:"user"`
 	ReactionsCount int            `json:"reactions_count"`
+	ReactionCounts map[string]int `json:"reaction_counts"` // per reaction type, missing types have none
 	CreatedAt      ct.GenDateTime `json:"created_at"`
 	UpdatedAt      ct.GenDateTime `json:"updated_at"`
 	LikedByUser    bool           `json:"liked_by_user"`
+	UserReaction   string         `json:"user_reaction,omitempty"` // empty unless LikedByUser
 	ImageId        ct.Id          `json:"image" validate:"nullable"`
 	ImageUrl       string         `json:"image_url"`
+	Edited         bool           `json:"edited"`                          // true once the body was changed, see Revision
+	ReplyToId      ct.Id          `json:"reply_to_id" validate:"nullable"` // 0 for top level comments
+	Depth          int            `json:"depth"`
+	RepliesCount   int            `json:"replies_count"`
+	Deleted        bool           `json:"deleted"` // kept with no body or user so its replies stay readable
+	Mentions       []Mention      `json:"mentions"`
 }
 
 type CreateCommentReq struct {
@@ -107,6 +165,7 @@ type CreateCommentReq struct {
 	ParentId  ct.Id          `json:"parent_id"`
 	Body      ct.CommentBody `json:"comment_body"`
 	ImageId   ct.Id          `json:"image" validate:"nullable"`
+	ReplyToId ct.Id          `json:"reply_to_id" validate:"nullable"` // comment of the same post being replied to
 }
 
 type EditCommentReq struct {
@@ -118,6 +177,144 @@ type EditCommentReq struct {
 	DeleteImage bool           `json:"delete_image"`
 }
 
+// A previous version of a post or comment body
+type Revision struct {
+	RevisionId ct.Id          `json:"revision_id"`
+	Body       string         `json:"body"`
+	CreatedAt  ct.GenDateTime `json:"created_at"` // when this version was written
+}
+
+// A resolved @username in a post or comment body, for clients to render as a link.
+// Start and Length count characters, not bytes, and cover the whole token including the @.
+type Mention struct {
+	UserId ct.Id `json:"user_id"`
+	Start  int   `json:"start"`
+	Length int   `json:"length"`
+}
+
+//-------------------------------------------
+// Drafts
+//-------------------------------------------
+
+// A post or comment saved before publishing, only visible to its creator.
+// Comment drafts carry the post they reply to in ParentId and have no group or audience.
+type Draft struct {
+	DraftId     ct.Id          `json:"draft_id"`
+	Kind        ct.DraftKind   `json:"kind"`
+	Body        ct.PostBody    `json:"body"`
+	ParentId    ct.Id          `json:"parent_id,omitempty"`
+	GroupId     ct.Id          `json:"group_id,omitempty"`
+	Audience    ct.Audience    `json:"audience,omitempty"`
+	AudienceIds ct.Ids         `json:"audience_ids"`
+	ImageIds    ct.Ids         `json:"images"`
+	ImageUrls   []string       `json:"image_urls"`
+	CreatedAt   ct.GenDateTime `json:"created_at"`
+	UpdatedAt   ct.GenDateTime `json:"updated_at"`
+	ExpiresAt   ct.GenDateTime `json:"expires_at"`
+}
+
+type CreateDraftReq struct {
+	CreatorId   ct.Id
+	Kind        ct.DraftKind `json:"kind"`
+	Body        ct.PostBody  `json:"body" validate:"nullable"`
+	ParentId    ct.Id        `json:"parent_id" validate:"nullable"` // required for comment drafts
+	GroupId     ct.Id        `json:"group_id" validate:"nullable"`
+	Audience    ct.Audience  `json:"audience" validate:"nullable"`
+	AudienceIds ct.Ids       `json:"audience_ids" validate:"nullable"`
+	ImageIds    ct.Ids       `json:"images" validate:"nullable"`
+}
+
+// Replaces the content of a draft, its kind and parent stay as created
+type UpdateDraftReq struct {
+	RequesterId ct.Id
+	DraftId     ct.Id       `json:"draft_id"`
+	Body        ct.PostBody `json:"body" validate:"nullable"`
+	GroupId     ct.Id       `json:"group_id" validate:"nullable"`
+	Audience    ct.Audience `json:"audience" validate:"nullable"`
+	AudienceIds ct.Ids      `json:"audience_ids" validate:"nullable"`
+	ImageIds    ct.Ids      `json:"images" validate:"nullable"`
+}
+
+type PublishDraftReq struct {
+	RequesterId ct.Id
+	DraftId     ct.Id          `json:"draft_id"`
+	PublishAt   ct.GenDateTime `json:"publish_at" validate:"nullable"` // schedules a post draft, zero publishes right away
+}
+
+//-------------------------------------------
+// Reactions
+//-------------------------------------------
+
+// Reacting again with the same type removes the reaction, a different type replaces it
+type ReactionReq struct {
+	RequesterId  ct.Id
+	EntityId     ct.Id           `json:"entity_id"`
+	ReactionType ct.ReactionType `json:"reaction_type"`
+}
+
+// Users who reacted to an entity, only with the given type unless it's empty
+type GetReactionsReq struct {
+	RequesterId  ct.Id
+	EntityId     ct.Id           `json:"entity_id"`
+	ReactionType ct.ReactionType `json:"reaction_type" validate:"nullable"`
+}
+
+//-------------------------------------------
+// Hashtags
+//-------------------------------------------
+
+// Posts with a tag the requester can see, newest first
+type GetHashtagFeedReq struct {
+	RequesterId ct.Id
+	Tag         ct.Hashtag `json:"tag"`
+	Limit       ct.Limit   `json:"limit"`
+	Offset      ct.Offset  `json:"offset"`
+}
+
+// Most used tags in public posts of the last WindowHours hours
+type GetTrendingHashtagsReq struct {
+	WindowHours int      `json:"window_hours"`
+	Limit       ct.Limit `json:"limit"`
+}
+
+type TrendingHashtag struct {
+	Tag          ct.Hashtag `json:"tag"`
+	PostsCount   int        `json:"posts_count"`
+	AuthorsCount int        `json:"authors_count"` // distinct users who posted with the tag
+}
+
+//-------------------------------------------
+// Search
+//-------------------------------------------
+
+// Full-text search over the posts, comments and events the requester can see, best match first
+type SearchContentReq struct {
+	RequesterId ct.Id         `json:"requester_id"`
+	SearchTerm  ct.SearchTerm `json:"search_term"`
+	Limit       ct.Limit      `json:"limit"`
+	Offset      ct.Offset     `json:"offset"`
+}
+
+// A post, comment or event matching a search.
+// PostId is the post a comment belongs to, Title is only set for events.
+type SearchResult struct {
+	EntityType string         `json:"entity_type"` // post, comment or event
+	EntityId   ct.Id          `json:"entity_id"`
+	PostId     ct.Id          `json:"post_id,omitempty"`
+	GroupId    ct.Id          `json:"group_id,omitempty"`
+	User       User           `json:"user"`
+	Title      string         `json:"title,omitempty"`
+	Body       string         `json:"body"`
+	CreatedAt  ct.GenDateTime `json:"created_at"`
+}
+
+// Users, groups and content matching a search, each list ranked on its own
+type SearchResults struct {
+	Users   []User         `json:"users"`
+	Groups  []Group        `json:"groups"`
+	Content []SearchResult `json:"content"`
+}
+
 //-------------------------------------------
 // Events
 //-------------------------------------------
@@ -48,10 +48,11 @@ type UpdateEmailRequest struct {
 type UserId int64
 
 type User struct {
-	UserId    ct.Id       `json:"id"`
-	Username  ct.Username `json:"username"`
-	AvatarId  ct.Id       `json:"avatar_id" validate:"nullable"`
-	AvatarURL string      `json:"avatar_url"`
+	UserId      ct.Id       `json:"id"`
+	Username    ct.Username `json:"username"`
+	AvatarId    ct.Id       `json:"avatar_id" validate:"nullable"`
+	AvatarURL   string      `json:"avatar_url"`
+	Deactivated bool        `json:"deactivated,omitempty"`
 }
 
 type Users struct {
@@ -92,7 +93,7 @@ type UserProfileResponse struct {
 
 type UpdateProfileRequest struct {
 	UserId      ct.Id
-	Username    ct.Username    `json:"username"`
+	Username    ct.Username    `json:"username" validate:"nullable"`
 	FirstName   ct.Name        `json:"first_name"`
 	LastName    ct.Name        `json:"last_name"`
 	DateOfBirth ct.DateOfBirth `json:"date_of_birth"`
@@ -101,11 +102,37 @@ type UpdateProfileRequest struct {
 	DeleteImage bool           `json:"delete_image"`
 }
 
+type ChangeUsernameRequest struct {
+	UserId   ct.Id       `json:"user_id"`
+	Username ct.Username `json:"username"`
+}
+
+type ResolvedHandle struct {
+	UserId     ct.Id       `json:"user_id"`
+	Username   ct.Username `json:"username"`   // current handle
+	Redirected bool        `json:"redirected"` // true if resolved through a former handle
+}
+
 type UpdateProfilePrivacyRequest struct {
 	UserId ct.Id `json:"user_id"`
 	Public bool  `json:"public"`
 }
 
+type PrivacySettings struct {
+	UserId               ct.Id              `json:"user_id"`
+	WhoCanMessage        ct.PrivacyAudience `json:"who_can_message"`
+	WhoCanComment        ct.PrivacyAudience `json:"who_can_comment"`
+	WhoCanSeeFollowLists ct.PrivacyAudience `json:"who_can_see_follow_lists"`
+	WhoCanInviteToGroups ct.PrivacyAudience `json:"who_can_invite_to_groups"`
+	Discoverable         bool               `json:"discoverable"`
+}
+
+type CanInteractReq struct {
+	ActorId  ct.Id            `json:"actor_id"`
+	TargetId ct.Id            `json:"target_id"`
+	Action   ct.PrivacyAction `json:"action"`
+}
+
 // -------------------------------------------
 // Groups
 // -------------------------------------------
@@ -122,9 +149,10 @@ type GroupMembersReq struct {
 }
 
 type Pagination struct {
-	UserId ct.Id     `json:"user_id"`
-	Limit  ct.Limit  `json:"limit"`
-	Offset ct.Offset `json:"offset"`
+	UserId      ct.Id     `json:"user_id"`
+	Limit       ct.Limit  `json:"limit"`
+	Offset      ct.Offset `json:"offset"`
+	RequesterId ct.Id     `json:"requester_id" validate:"nullable"`
 }
 
 type GroupUser struct {
@@ -146,18 +174,91 @@ type GroupSearchReq struct {
 	Offset     ct.Offset     `json:"offset"`
 }
 
+type DiscoverGroupsReq struct {
+	UserId   ct.Id            `json:"user_id"`
+	Category ct.GroupCategory `json:"category" validate:"nullable"` // empty means any category
+	Limit    ct.Limit         `json:"limit"`
+	Offset   ct.Offset        `json:"offset"`
+}
+
+// Number of items on one UTC day of a daily series
+type DailyCount struct {
+	Day   ct.GenDateTime `json:"day"`
+	Count int64          `json:"count"`
+}
+
+// One day of a group's member growth
+type GroupMemberGrowth struct {
+	Day    ct.GenDateTime `json:"day"`
+	Joined int64          `json:"joined"`
+	Total  int64          `json:"total"` // members at the end of the day
+}
+
+type GroupActivePoster struct {
+	User         User  `json:"user"`
+	PostCount    int64 `json:"post_count"`
+	CommentCount int64 `json:"comment_count"`
+}
+
+type GroupEventAttendance struct {
+	EventId       ct.Id          `json:"event_id"`
+	Title         ct.Title       `json:"title"`
+	EventDate     ct.GenDateTime `json:"event_date"`
+	GoingCount    int32          `json:"going_count"`
+	NotGoingCount int32          `json:"not_going_count"`
+	// going members out of current members
+	AttendanceRate float64 `json:"attendance_rate"`
+}
+
+// Join requests answered in the insights window
+type GroupJoinRequestStats struct {
+	Accepted int64 `json:"accepted"`
+	Rejected int64 `json:"rejected"`
+	Pending  int64 `json:"pending"` // currently pending, regardless of window
+	// time from request to acceptance, zero if none were accepted
+	AvgApprovalSeconds    float64 `json:"avg_approval_seconds"`
+	MedianApprovalSeconds float64 `json:"median_approval_seconds"`
+}
+
+// Activity from posts service, the content part of group insights
+type GroupContentInsights struct {
+	PostsPerDay    []DailyCount           `json:"posts_per_day"`
+	CommentsPerDay []DailyCount           `json:"comments_per_day"`
+	ActivePosters  []GroupActivePoster    `json:"active_posters"`
+	Events         []GroupEventAttendance `json:"events"`
+}
+
+type GroupInsights struct {
+	GroupId      ct.Id          `json:"group_id"`
+	Since        ct.GenDateTime `json:"since"`
+	ComputedAt   ct.GenDateTime `json:"computed_at"`
+	MembersCount int32          `json:"members_count"`
+
+	MemberGrowth []GroupMemberGrowth `json:"member_growth"`
+	GroupContentInsights
+	// average of the events' attendance rates
+	AttendanceRate float64               `json:"attendance_rate"`
+	JoinRequests   GroupJoinRequestStats `json:"join_requests"`
+}
+
 type Group struct {
-	GroupId          ct.Id    `json:"group_id"`
-	GroupOwnerId     ct.Id    `json:"group_owner_id"`
-	GroupTitle       ct.Title `json:"group_title"`
-	GroupDescription ct.About `json:"group_description"`
-	GroupImage       ct.Id    `json:"group_image_id" validate:"nullable"`
-	GroupImageURL    string   `json:"group_image_url"`
-	MembersCount     int32    `json:"members_count"`
-	IsMember         bool     `json:"is_member"`
-	IsOwner          bool     `json:"is_owner"`
-	PendingRequest   bool     `json:"pending_request"`
-	PendingInvite    bool     `json:"pending_invite"`
+	GroupId              ct.Id              `json:"group_id"`
+	GroupOwnerId         ct.Id              `json:"group_owner_id"`
+	GroupTitle           ct.Title           `json:"group_title"`
+	GroupDescription     ct.About           `json:"group_description"`
+	GroupImage           ct.Id              `json:"group_image_id" validate:"nullable"`
+	GroupImageURL        string             `json:"group_image_url"`
+	MembersCount         int32              `json:"members_count"`
+	IsMember             bool               `json:"is_member"`
+	IsOwner              bool               `json:"is_owner"`
+	PendingRequest       bool               `json:"pending_request"`
+	PendingInvite        bool               `json:"pending_invite"`
+	OwnershipOffered     bool               `json:"ownership_offered"`
+	Archived             bool               `json:"archived"`
+	Visibility           ct.GroupVisibility `json:"visibility" validate:"nullable"`
+	Category             ct.GroupCategory   `json:"category" validate:"nullable"`
+	Tags                 ct.GroupTags       `json:"tags" validate:"nullable"`
+	PostsRequireApproval bool               `json:"posts_require_approval"`
 }
 
 type Groups struct {
@@ -179,6 +280,8 @@ type HandleGroupInviteRequest struct {
 type GroupJoinRequest struct {
 	GroupId     ct.Id `json:"group_id"`
 	RequesterId ct.Id
+	AcceptRules bool              `json:"accept_rules"`
+	Answers     []GroupJoinAnswer `json:"answers"`
 }
 
 type HandleJoinRequest struct {
@@ -200,19 +303,162 @@ type RemoveFromGroupRequest struct {
 }
 
 type CreateGroupRequest struct {
-	OwnerId          ct.Id    `json:"owner_id"`
-	GroupTitle       ct.Title `json:"group_title"`
-	GroupDescription ct.About `json:"group_description"`
-	GroupImage       ct.Id    `json:"group_image_id" validate:"nullable"`
+	OwnerId          ct.Id              `json:"owner_id"`
+	GroupTitle       ct.Title           `json:"group_title"`
+	GroupDescription ct.About           `json:"group_description"`
+	GroupImage       ct.Id              `json:"group_image_id" validate:"nullable"`
+	Visibility       ct.GroupVisibility `json:"visibility" validate:"nullable"` // defaults to private
+	Category         ct.GroupCategory   `json:"category" validate:"nullable"`   // defaults to other
+	Tags             ct.GroupTags       `json:"tags" validate:"nullable"`
 }
 
 type UpdateGroupRequest struct {
 	RequesterId      ct.Id
-	GroupId          ct.Id    `json:"group_id"`
-	GroupTitle       ct.Title `json:"group_title"`
-	GroupDescription ct.About `json:"group_description"`
-	GroupImage       ct.Id    `json:"group_image_id" validate:"nullable"`
-	DeleteImage      bool     `json:"delete_image"`
+	GroupId          ct.Id              `json:"group_id"`
+	GroupTitle       ct.Title           `json:"group_title"`
+	GroupDescription ct.About           `json:"group_description"`
+	GroupImage       ct.Id              `json:"group_image_id" validate:"nullable"`
+	DeleteImage      bool               `json:"delete_image"`
+	Visibility       ct.GroupVisibility `json:"visibility" validate:"nullable"` // empty keeps the current one
+	Category         ct.GroupCategory   `json:"category" validate:"nullable"`   // empty keeps the current one
+	Tags             ct.GroupTags       `json:"tags" validate:"nullable"`       // nil keeps the current ones, empty clears them
+}
+
+type ChangeGroupRoleReq struct {
+	GroupId     ct.Id        `json:"group_id"`
+	RequesterId ct.Id        `json:"requester_id"`
+	MemberId    ct.Id        `json:"member_id"`
+	Role        ct.GroupRole `json:"role"`
+}
+
+type TransferOwnershipReq struct {
+	GroupId    ct.Id `json:"group_id"`
+	OwnerId    ct.Id `json:"owner_id"`
+	NewOwnerId ct.Id `json:"new_owner_id"`
+}
+
+type HandleOwnershipTransferReq struct {
+	GroupId  ct.Id `json:"group_id"`
+	UserId   ct.Id `json:"user_id"`
+	Accepted bool  `json:"accepted"`
+}
+
+type GroupPermissionReq struct {
+	GroupId    ct.Id              `json:"group_id"`
+	UserId     ct.Id              `json:"user_id"`
+	Permission ct.GroupPermission `json:"permission"`
+}
+
+type PendingJoinRequest struct {
+	User
+	RequestedAt   ct.GenDateTime    `json:"requested_at"`
+	ExpiresAt     ct.GenDateTime    `json:"expires_at"` // null if join requests don't expire
+	RulesAccepted bool              `json:"rules_accepted"`
+	Answers       []GroupJoinAnswer `json:"answers"`
+}
+
+type PendingJoinRequests struct {
+	Users []PendingJoinRequest `json:"users"`
+}
+
+type GroupJoinQuestion struct {
+	QuestionId ct.Id  `json:"question_id"` // ignored when setting the join form
+	Question   string `json:"question"`
+	Required   bool   `json:"required"`
+}
+
+type GroupJoinAnswer struct {
+	QuestionId ct.Id  `json:"question_id"`
+	Question   string `json:"question"` // as asked when the request was sent, ignored when answering
+	Answer     string `json:"answer"`
+}
+
+type GroupJoinForm struct {
+	GroupId   ct.Id               `json:"group_id"`
+	Rules     []string            `json:"rules"`
+	Questions []GroupJoinQuestion `json:"questions"`
+}
+
+type SetGroupJoinFormReq struct {
+	GroupId     ct.Id               `json:"group_id"`
+	RequesterId ct.Id               `json:"requester_id"`
+	Rules       []string            `json:"rules"`
+	Questions   []GroupJoinQuestion `json:"questions"`
+}
+
+type SetGroupPostApprovalReq struct {
+	GroupId     ct.Id `json:"group_id"`
+	RequesterId ct.Id `json:"requester_id"`
+	Required    bool  `json:"required"`
+}
+
+type CreateGroupInviteLinkReq struct {
+	GroupId     ct.Id          `json:"group_id"`
+	RequesterId ct.Id          `json:"requester_id"`
+	ExpiresAt   ct.GenDateTime `json:"expires_at" validate:"nullable"` // zero means never
+	MaxUses     int32          `json:"max_uses"`                       // 0 means unlimited
+	AutoApprove bool           `json:"auto_approve"`
+}
+
+type GroupInviteLink struct {
+	LinkId         ct.Id          `json:"link_id"`
+	GroupId        ct.Id          `json:"group_id"`
+	Token          string         `json:"token"`
+	CreatedBy      ct.Id          `json:"created_by"`
+	CreatedAt      ct.GenDateTime `json:"created_at"`
+	ExpiresAt      ct.GenDateTime `json:"expires_at"`
+	MaxUses        int32          `json:"max_uses"`
+	AutoApprove    bool           `json:"auto_approve"`
+	Revoked        bool           `json:"revoked"`
+	Uses           int32          `json:"uses"`
+	JoinedCount    int64          `json:"joined_count"`
+	RequestedCount int64          `json:"requested_count"`
+	LastUsedAt     ct.GenDateTime `json:"last_used_at"`
+}
+
+type RevokeGroupInviteLinkReq struct {
+	GroupId     ct.Id `json:"group_id"`
+	RequesterId ct.Id `json:"requester_id"`
+	LinkId      ct.Id `json:"link_id"`
+}
+
+type JoinGroupByLinkReq struct {
+	UserId ct.Id  `json:"user_id"`
+	Token  string `json:"token"`
+	// only checked when the link creates a join request
+	AcceptRules bool              `json:"accept_rules"`
+	Answers     []GroupJoinAnswer `json:"answers"`
+}
+
+type JoinGroupByLinkResp struct {
+	GroupId ct.Id `json:"group_id"`
+	Joined  bool  `json:"joined"` // false if a join request was created instead
+}
+
+type BanFromGroupReq struct {
+	GroupId     ct.Id          `json:"group_id"`
+	RequesterId ct.Id          `json:"requester_id"`
+	UserId      ct.Id          `json:"user_id"`
+	Reason      string         `json:"reason"`
+	ExpiresAt   ct.GenDateTime `json:"expires_at" validate:"nullable"` // zero means permanent
+}
+
+type UnbanFromGroupReq struct {
+	GroupId     ct.Id `json:"group_id"`
+	RequesterId ct.Id `json:"requester_id"`
+	UserId      ct.Id `json:"user_id"`
+}
+
+type GroupBan struct {
+	User
+	BannedBy  ct.Id          `json:"banned_by" validate:"nullable"`
+	Reason    string         `json:"reason"`
+	ExpiresAt ct.GenDateTime `json:"expires_at"` // null if permanent
+	BannedAt  ct.GenDateTime `json:"banned_at"`
+}
+
+type GroupBans struct {
+	Bans []GroupBan `json:"bans"`
 }
 
 // -------------------------------------------
@@ -2,7 +2,9 @@ package retrieveusers
 
 import (
 	"context"
+	"fmt"
 	userpb "social-network/shared/gen-go/users"
+	ce "social-network/shared/go/commonerrors"
 	ct "social-network/shared/go/ct"
 	"social-network/shared/go/models"
 	tele "social-network/shared/go/telemetry"
@@ -74,3 +76,21 @@ func (h *UserRetriever) removeFailedImages(ctx context.Context, imgIds []int64)
 		tele.Warn(ctx, "failed  to delete failed images @1 from users: @2", "failedImageIds", imgIds, "error", err.Error())
 	}
 }
+
+// Deactivated users are cached like any other user but never handed out,
+// so that their profile stays hidden until they log in again.
+func withoutDeactivated(users map[ct.Id]models.User) map[ct.Id]models.User {
+	for id, u := range users {
+		if u.Deactivated {
+			delete(users, id)
+		}
+	}
+	return users
+}
+
+func checkDeactivated(user models.User, input string) (models.User, error) {
+	if user.Deactivated {
+		return models.User{}, ce.New(ce.ErrNotFound, fmt.Errorf("user %v is deactivated", user.UserId), input).WithPublic("user not found")
+	}
+	return user, nil
+}
@@ -15,6 +15,7 @@ import (
 )
 
 // GetUsers returns a map[userID]User, using cache + batch RPC.
+// Deactivated users are left out of the returned map.
 func (h *UserRetriever) GetUsers(ctx context.Context, userIds ct.Ids) (map[ct.Id]models.User, error) {
 	input := fmt.Sprintf("user retriever: get users: uses ids: %v", userIds)
 	//========================== STEP 1 : get user info from users ===============================================
@@ -57,7 +58,7 @@ func (h *UserRetriever) GetUsers(ctx context.Context, userIds ct.Ids) (map[ct.Id
 
 	// Early return if all found in cache
 	if len(missing) == 0 {
-		return users, nil
+		return withoutDeactivated(users), nil
 	}
 
 	// Batch RPC for missing users
@@ -72,9 +73,10 @@ func (h *UserRetriever) GetUsers(ctx context.Context, userIds ct.Ids) (map[ct.Id
 
 	for _, u := range resp.Users {
 		user := models.User{
-			UserId:   ct.Id(u.UserId),
-			Username: ct.Username(u.Username),
-			AvatarId: ct.Id(u.Avatar),
+			UserId:      ct.Id(u.UserId),
+			Username:    ct.Username(u.Username),
+			AvatarId:    ct.Id(u.Avatar),
+			Deactivated: u.Deactivated,
 		}
 		users[user.UserId] = user
 
@@ -116,9 +118,11 @@ func (h *UserRetriever) GetUsers(ctx context.Context, userIds ct.Ids) (map[ct.Id
 		h.SetToLocal(ctx, user)
 	}
 
-	return users, nil
+	return withoutDeactivated(users), nil
 }
 
+// GetUser returns a single user, using cache + RPC.
+// Returns not found if the user is deactivated.
 func (h *UserRetriever) GetUser(ctx context.Context, userId ct.Id) (models.User, error) {
 	input := fmt.Sprintf("user retriever: get user: id: %v", userId)
 
@@ -131,12 +135,12 @@ func (h *UserRetriever) GetUser(ctx context.Context, userId ct.Id) (models.User,
 	// Local cache lookup
 	u, ok := h.GetFromLocal(ctx, userId)
 	if ok {
-		return u, nil
+		return checkDeactivated(u, input)
 	}
 
 	if user, err := h.GetFromRedis(ctx, userId); err == nil {
 		h.SetToLocal(ctx, user)
-		return user, nil
+		return checkDeactivated(user, input)
 	}
 
 	//========================== STEP 1 : get user info from users ===============================================
@@ -147,9 +151,10 @@ func (h *UserRetriever) GetUser(ctx context.Context, userId ct.Id) (models.User,
 	}
 
 	user := models.User{
-		UserId:   ct.Id(resp.UserId),
-		Username: ct.Username(resp.Username),
-		AvatarId: ct.Id(resp.Avatar),
+		UserId:      ct.Id(resp.UserId),
+		Username:    ct.Username(resp.Username),
+		AvatarId:    ct.Id(resp.Avatar),
+		Deactivated: resp.Deactivated,
 	}
 
 	//========================== STEP 2 : get avatar from media ===============================================
@@ -173,5 +178,5 @@ func (h *UserRetriever) GetUser(ctx context.Context, userId ct.Id) (models.User,
 
 	h.SetToLocal(ctx, user)
 
-	return user, nil
+	return checkDeactivated(user, input)
 }